@@ -261,8 +261,16 @@ func (app *BaseApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 // Otherwise, the ResponseDeliverTx will contain releveant error information.
 // Regardless of tx execution outcome, the ResponseDeliverTx will contain relevant
 // gas execution context.
-func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
-	gInfo := sdk.GasInfo{}
+func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
+	return app.deliverTxResponse(req, gInfo, result, anteEvents, err)
+}
+
+// deliverTxResponse builds the DeliverTx response from the result of the
+// transaction execution, and runs the DeliverTx hooks and listeners.
+func (app *BaseApp) deliverTxResponse(
+	req abci.RequestDeliverTx, gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, err error,
+) (res abci.ResponseDeliverTx) {
 	resultStr := "successful"
 
 	defer func() {
@@ -286,7 +294,6 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
 	}()
 
-	gInfo, result, anteEvents, _, err := app.runTx(runTxModeDeliver, req.Tx)
 	if err != nil {
 		resultStr = "failed"
 		return sdkerrors.ResponseDeliverTxWithEvents(err, gInfo.GasWanted, gInfo.GasUsed, sdk.MarkEventsToIndex(anteEvents, app.indexEvents), app.trace)
@@ -377,6 +384,14 @@ func (app *BaseApp) Commit() abci.ResponseCommit {
 func (app *BaseApp) halt() {
 	app.logger.Info("halting node per configuration", "height", app.haltHeight, "time", app.haltTime)
 
+	// persist the versions committed asynchronously, so that the node restarts
+	// from the halt height
+	if cms, ok := app.cms.(interface{ FlushAsyncCommit() error }); ok {
+		if err := cms.FlushAsyncCommit(); err != nil {
+			app.logger.Error("failed to flush the asynchronous commit", "err", err)
+		}
+	}
+
 	p, err := os.FindProcess(os.Getpid())
 	if err == nil {
 		// attempt cascading signals in case SIGINT fails (os dependent)
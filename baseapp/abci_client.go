@@ -0,0 +1,151 @@
+package baseapp
+
+import (
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmsync "github.com/tendermint/tendermint/libs/sync"
+	"github.com/tendermint/tendermint/proxy"
+)
+
+// txBatchApplication is an ABCI application which can execute the
+// transactions of a block as a batch.
+type txBatchApplication interface {
+	abci.Application
+
+	DeliverTxBatch(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx
+	parallelExecution() bool
+}
+
+// NewLocalClientCreator returns a Tendermint ClientCreator running the app in
+// process, as proxy.NewLocalClientCreator does. When the parallel execution of
+// the app is enabled with SetParallelExecution, the DeliverTx requests of a
+// block are buffered, as Tendermint delivers them one at a time, and executed
+// with DeliverTxBatch before the next request which is not a DeliverTx one,
+// usually EndBlock. Their responses are then returned to Tendermint in order.
+func NewLocalClientCreator(app abci.Application) proxy.ClientCreator {
+	batchApp, ok := app.(txBatchApplication)
+	if !ok || !batchApp.parallelExecution() {
+		return proxy.NewLocalClientCreator(app)
+	}
+
+	return &batchClientCreator{
+		mtx: new(tmsync.Mutex),
+		app: batchApp,
+	}
+}
+
+type batchClientCreator struct {
+	mtx *tmsync.Mutex
+	app txBatchApplication
+}
+
+// NewABCIClient implements proxy.ClientCreator.
+func (c *batchClientCreator) NewABCIClient() (abcicli.Client, error) {
+	return &batchClient{
+		Client: abcicli.NewLocalClient(c.mtx, c.app),
+		mtx:    c.mtx,
+		app:    c.app,
+	}, nil
+}
+
+var _ abcicli.Client = &batchClient{}
+
+// batchClient is a local ABCI client which buffers the DeliverTx requests and
+// executes them as a batch. The requests of a connection are sent by a single
+// goroutine, so the pending requests are not guarded.
+type batchClient struct {
+	abcicli.Client
+
+	// mtx is shared by the clients of all the connections to the app
+	mtx *tmsync.Mutex
+	app txBatchApplication
+
+	callback abcicli.Callback
+	pending  []*abcicli.ReqRes
+}
+
+// SetResponseCallback implements abcicli.Client.
+func (c *batchClient) SetResponseCallback(cb abcicli.Callback) {
+	c.callback = cb
+	c.Client.SetResponseCallback(cb)
+}
+
+// DeliverTxAsync implements abcicli.Client. The request is executed with the
+// next requests of the block, its response is set once executed.
+func (c *batchClient) DeliverTxAsync(req abci.RequestDeliverTx) *abcicli.ReqRes {
+	reqRes := abcicli.NewReqRes(abci.ToRequestDeliverTx(req))
+	c.pending = append(c.pending, reqRes)
+
+	return reqRes
+}
+
+// deliverPending executes the buffered DeliverTx requests, and sets their
+// responses in order.
+func (c *batchClient) deliverPending() {
+	if len(c.pending) == 0 {
+		return
+	}
+
+	pending := c.pending
+	c.pending = nil
+
+	reqs := make([]abci.RequestDeliverTx, len(pending))
+	for i, reqRes := range pending {
+		reqs[i] = *reqRes.Request.GetDeliverTx()
+	}
+
+	c.mtx.Lock()
+	res := c.app.DeliverTxBatch(reqs)
+	c.mtx.Unlock()
+
+	for i, reqRes := range pending {
+		reqRes.Response = abci.ToResponseDeliverTx(res[i])
+		if c.callback != nil {
+			c.callback(reqRes.Request, reqRes.Response)
+		}
+		reqRes.InvokeCallback()
+		reqRes.Done()
+	}
+}
+
+// FlushAsync implements abcicli.Client.
+func (c *batchClient) FlushAsync() *abcicli.ReqRes {
+	c.deliverPending()
+	return c.Client.FlushAsync()
+}
+
+// FlushSync implements abcicli.Client.
+func (c *batchClient) FlushSync() error {
+	c.deliverPending()
+	return c.Client.FlushSync()
+}
+
+// DeliverTxSync implements abcicli.Client.
+func (c *batchClient) DeliverTxSync(req abci.RequestDeliverTx) (*abci.ResponseDeliverTx, error) {
+	c.deliverPending()
+	return c.Client.DeliverTxSync(req)
+}
+
+// EndBlockAsync implements abcicli.Client.
+func (c *batchClient) EndBlockAsync(req abci.RequestEndBlock) *abcicli.ReqRes {
+	c.deliverPending()
+	return c.Client.EndBlockAsync(req)
+}
+
+// EndBlockSync implements abcicli.Client.
+func (c *batchClient) EndBlockSync(req abci.RequestEndBlock) (*abci.ResponseEndBlock, error) {
+	c.deliverPending()
+	return c.Client.EndBlockSync(req)
+}
+
+// CommitAsync implements abcicli.Client.
+func (c *batchClient) CommitAsync() *abcicli.ReqRes {
+	c.deliverPending()
+	return c.Client.CommitAsync()
+}
+
+// CommitSync implements abcicli.Client.
+func (c *batchClient) CommitSync() (*abci.ResponseCommit, error) {
+	c.deliverPending()
+	return c.Client.CommitSync()
+}
@@ -113,6 +113,15 @@ type BaseApp struct { //nolint: maligned
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
+
+	// parallelWorkers is the number of goroutines executing the transactions
+	// of a DeliverTxBatch speculatively. A value lower than 2 disables the
+	// parallel execution.
+	parallelWorkers int
+
+	// storeLimits are the limits on the writes of the transactions to the
+	// KVStores by store name, the stores without limits being unlimited
+	storeLimits map[string]storetypes.StoreLimits
 }
 
 type appStore struct {
@@ -393,6 +402,23 @@ func (app *BaseApp) setTrace(trace bool) {
 	app.trace = trace
 }
 
+func (app *BaseApp) setParallelExecution(workers int) {
+	app.parallelWorkers = workers
+}
+
+// parallelExecution returns true if the transactions of a DeliverTxBatch are
+// executed in parallel.
+func (app *BaseApp) parallelExecution() bool {
+	return app.parallelWorkers > 1
+}
+
+func (app *BaseApp) setStoreLimits(storeName string, limits storetypes.StoreLimits) {
+	if app.storeLimits == nil {
+		app.storeLimits = make(map[string]storetypes.StoreLimits)
+	}
+	app.storeLimits[storeName] = limits
+}
+
 func (app *BaseApp) setIndexEvents(ie []string) {
 	app.indexEvents = make(map[string]struct{})
 
@@ -624,12 +650,21 @@ func (app *BaseApp) cacheTxContext(ctx sdk.Context, txBytes []byte) (sdk.Context
 // returned if the tx does not run out of gas and if all the messages are valid
 // and execute successfully. An error is returned otherwise.
 func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
+	return app.runTxWithContext(app.getContextForTx(mode, txBytes), mode, txBytes)
+}
+
+// runTxWithContext processes a transaction like runTx, using the provided
+// Context instead of the one of the execution mode's state.
+func (app *BaseApp) runTxWithContext(ctx sdk.Context, mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, result *sdk.Result, anteEvents []abci.Event, priority int64, err error) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted uint64
 
-	ctx := app.getContextForTx(mode, txBytes)
+	if len(app.storeLimits) > 0 {
+		ctx = ctx.WithStoreLimits(app.storeLimits)
+	}
+
 	ms := ctx.MultiStore()
 
 	// only run the tx if there is block gas remaining
@@ -639,7 +674,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte) (gInfo sdk.GasInfo, re
 
 	defer func() {
 		if r := recover(); r != nil {
-			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, app.runTxRecoveryMiddleware)
+			recoveryMW := newOutOfGasRecoveryMiddleware(gasWanted, ctx, newStoreLimitRecoveryMiddleware(app.runTxRecoveryMiddleware))
 			err, result = processRecovery(r, recoveryMW), nil
 		}
 
@@ -976,6 +976,48 @@ func TestDeliverTx(t *testing.T) {
 	}
 }
 
+// Test that the store limits fail the transactions exceeding them, without
+// affecting the following ones.
+func TestDeliverTxStoreLimits(t *testing.T) {
+	anteKey := []byte("ante-key")
+	anteOpt := func(bapp *BaseApp) { bapp.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey)) }
+
+	deliverKey := []byte("deliver-key")
+	routerOpt := func(bapp *BaseApp) {
+		r := sdk.NewRoute(routeMsgCounter, handlerMsgCounter(t, capKey1, deliverKey))
+		bapp.Router().AddRoute(r)
+	}
+
+	// the ante handler and each message write once
+	limitsOpt := SetStoreLimits(capKey1.Name(), sdk.StoreLimits{MaxWrites: 2})
+
+	app := setupBaseApp(t, anteOpt, routerOpt, limitsOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	header := tmproto.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	txBytes, err := codec.Marshal(newTxCounter(0, 0, 1))
+	require.NoError(t, err)
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+	space, code, _ := sdkerrors.ABCIInfo(storetypes.ErrStoreLimitExceeded, false)
+	require.Equal(t, space, res.Codespace)
+	require.Equal(t, code, res.Code)
+
+	// the writes of the failed messages are discarded, and the count is per tx
+	txBytes, err = codec.Marshal(newTxCounter(1, 0))
+	require.NoError(t, err)
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	store := app.deliverState.ctx.KVStore(capKey1)
+	require.Equal(t, int64(1), getIntFromStore(store, deliverKey))
+}
+
 // Number of messages doesn't matter to CheckTx.
 func TestMultiMsgCheckTx(t *testing.T) {
 	// TODO: ensure we get the same results
@@ -1772,8 +1814,8 @@ func TestListSnapshots(t *testing.T) {
 		s.Metadata = nil
 	}
 	assert.Equal(t, abci.ResponseListSnapshots{Snapshots: []*abci.Snapshot{
-		{Height: 4, Format: snapshottypes.CurrentFormat, Chunks: 2},
-		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 1},
+		{Height: 4, Format: snapshottypes.CurrentFormat, Chunks: 3},
+		{Height: 2, Format: snapshottypes.CurrentFormat, Chunks: 2},
 	}}, resp)
 }
 
@@ -1792,7 +1834,7 @@ func TestSnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"prune everything with snapshot": {
@@ -1804,7 +1846,7 @@ func TestSnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningEverything),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"default pruning with snapshot": {
@@ -1816,7 +1858,7 @@ func TestSnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningDefault),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"custom": {
@@ -1828,8 +1870,8 @@ func TestSnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewCustomPruningOptions(12, 12),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 25, Format: snapshottypes.CurrentFormat, Chunks: 6},
-				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 5},
+				{Height: 25, Format: snapshottypes.CurrentFormat, Chunks: 7},
+				{Height: 20, Format: snapshottypes.CurrentFormat, Chunks: 6},
 			},
 		},
 		"no snapshots": {
@@ -1850,9 +1892,9 @@ func TestSnapshotWithPruning(t *testing.T) {
 				pruningOpts:        pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
 			},
 			expectedSnapshots: []*abci.Snapshot{
-				{Height: 9, Format: snapshottypes.CurrentFormat, Chunks: 2},
-				{Height: 6, Format: snapshottypes.CurrentFormat, Chunks: 2},
-				{Height: 3, Format: snapshottypes.CurrentFormat, Chunks: 1},
+				{Height: 9, Format: snapshottypes.CurrentFormat, Chunks: 3},
+				{Height: 6, Format: snapshottypes.CurrentFormat, Chunks: 3},
+				{Height: 3, Format: snapshottypes.CurrentFormat, Chunks: 2},
 			},
 		},
 	}
@@ -1977,13 +2019,16 @@ func TestOfferSnapshot_Errors(t *testing.T) {
 			Height: 1, Format: 9, Chunks: 3, Hash: hash, Metadata: metadata,
 		}, abci.ResponseOfferSnapshot_REJECT_FORMAT},
 		"incorrect chunk count": {&abci.Snapshot{
-			Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 2, Hash: hash, Metadata: metadata,
+			Height: 1, Format: snapshottypes.FormatStream, Chunks: 2, Hash: hash, Metadata: metadata,
 		}, abci.ResponseOfferSnapshot_REJECT},
 		"no chunks": {&abci.Snapshot{
-			Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 0, Hash: hash, Metadata: metadata,
+			Height: 1, Format: snapshottypes.FormatStream, Chunks: 0, Hash: hash, Metadata: metadata,
+		}, abci.ResponseOfferSnapshot_REJECT},
+		"missing chunk streams": {&abci.Snapshot{
+			Height: 1, Format: snapshottypes.FormatParallel, Chunks: 3, Hash: hash, Metadata: metadata,
 		}, abci.ResponseOfferSnapshot_REJECT},
 		"invalid metadata serialization": {&abci.Snapshot{
-			Height: 1, Format: snapshottypes.CurrentFormat, Chunks: 0, Hash: hash, Metadata: []byte{3, 1, 4},
+			Height: 1, Format: snapshottypes.FormatStream, Chunks: 0, Hash: hash, Metadata: []byte{3, 1, 4},
 		}, abci.ResponseOfferSnapshot_REJECT},
 	}
 	for name, tc := range testcases {
@@ -1997,7 +2042,7 @@ func TestOfferSnapshot_Errors(t *testing.T) {
 	// Offering a snapshot after one has been accepted should error
 	resp := app.OfferSnapshot(abci.RequestOfferSnapshot{Snapshot: &abci.Snapshot{
 		Height:   1,
-		Format:   snapshottypes.CurrentFormat,
+		Format:   snapshottypes.FormatStream,
 		Chunks:   3,
 		Hash:     []byte{1, 2, 3},
 		Metadata: metadata,
@@ -2006,7 +2051,7 @@ func TestOfferSnapshot_Errors(t *testing.T) {
 
 	resp = app.OfferSnapshot(abci.RequestOfferSnapshot{Snapshot: &abci.Snapshot{
 		Height:   2,
-		Format:   snapshottypes.CurrentFormat,
+		Format:   snapshottypes.FormatStream,
 		Chunks:   3,
 		Hash:     []byte{1, 2, 3},
 		Metadata: metadata,
@@ -17,6 +17,7 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	filters           []MsgFilter
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -31,6 +32,17 @@ func NewMsgServiceRouter() *MsgServiceRouter {
 // MsgServiceHandler defines a function type which handles Msg service message.
 type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
 
+// MsgFilter defines a function type which is run before a Msg service message
+// is handled, the message is rejected if it returns an error.
+type MsgFilter = func(ctx sdk.Context, req sdk.Msg) error
+
+// AddMsgFilter adds a filter run before handling any message, whether it comes
+// from a transaction or from a module executing messages, such as authz, gov
+// or group.
+func (msr *MsgServiceRouter) AddMsgFilter(filter MsgFilter) {
+	msr.filters = append(msr.filters, filter)
+}
+
 // Handler returns the MsgServiceHandler for a given msg or nil if not found.
 func (msr *MsgServiceRouter) Handler(msg sdk.Msg) MsgServiceHandler {
 	return msr.routes[sdk.MsgTypeURL(msg)]
@@ -112,6 +124,11 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
 				return handler(goCtx, req)
 			}
+			for _, filter := range msr.filters {
+				if err := filter(ctx, req); err != nil {
+					return nil, err
+				}
+			}
 			if err := req.ValidateBasic(); err != nil {
 				if mm, ok := req.(getter1); ok {
 					if !mm.GetAmount().Amount.IsZero() {
@@ -22,6 +22,12 @@ func SetPruning(opts pruningtypes.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetStorePruning sets a pruning option on a single store of the multistore
+// associated with the app, overriding the option set by SetPruning.
+func SetStorePruning(storeName string, opts pruningtypes.PruningOptions) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetStorePruning(storeName, opts) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -74,12 +80,34 @@ func SetIAVLLazyLoading(lazyLoading bool) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetLazyLoading(lazyLoading) }
 }
 
+// SetAsyncCommit provides a BaseApp option function that enables the
+// asynchronous commit of the stores, with at most queueSize committed versions
+// pending persistence. A queue size lower than 1 disables it.
+func SetAsyncCommit(queueSize int) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.cms.SetAsyncCommit(queueSize) }
+}
+
 // SetInterBlockCache provides a BaseApp option function that sets the
 // inter-block cache.
 func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetParallelExecution returns a BaseApp option function that executes the
+// transactions of a DeliverTxBatch optimistically in parallel, with the given
+// number of workers. A number of workers lower than 2 disables it.
+func SetParallelExecution(workers int) func(*BaseApp) {
+	return func(app *BaseApp) { app.setParallelExecution(workers) }
+}
+
+// SetStoreLimits returns a BaseApp option function that limits the size of
+// the keys and values written by the transactions to the store with the given
+// name, and the number of keys they set in it. The limits must be the same on
+// all the nodes of a network, and the stores are unlimited by default.
+func SetStoreLimits(storeName string, limits sdk.StoreLimits) func(*BaseApp) {
+	return func(app *BaseApp) { app.setStoreLimits(storeName, limits) }
+}
+
 // SetSnapshot sets the snapshot store.
 func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshot(snapshotStore, opts) }
@@ -0,0 +1,452 @@
+package baseapp
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DeliverTxBatch executes the transactions of a block in DeliverTx mode and
+// returns their responses, in order. It is equivalent to calling DeliverTx for
+// each request, and is meant for callers that know all the transactions of a
+// block upfront. As Tendermint delivers them one at a time over ABCI, the
+// client returned by NewLocalClientCreator buffers them and calls it.
+//
+// When the parallel execution is enabled with SetParallelExecution, the
+// transactions are first executed speculatively and concurrently, each on its
+// own branch of the block state, while recording the keys and ranges it reads.
+// The results are then committed in block order: a transaction which read a
+// key written by a previous transaction of the batch is executed again, on top
+// of the previous ones. The resulting state and responses are identical to the
+// serial execution, as long as the transactions keep all their state in the
+// KVStores, and not in the memory of the keepers.
+func (app *BaseApp) DeliverTxBatch(reqs []abci.RequestDeliverTx) []abci.ResponseDeliverTx {
+	res := make([]abci.ResponseDeliverTx, len(reqs))
+
+	keys := app.storeKeysByName()
+	if !app.parallelExecution() || len(reqs) < 2 || keys == nil || app.deliverState.ms.TracingEnabled() {
+		for i, req := range reqs {
+			res[i] = app.DeliverTx(req)
+		}
+
+		return res
+	}
+
+	b := &txBatch{
+		base:        app.deliverState.ms,
+		keys:        keys,
+		gasConsumed: app.deliverState.ctx.GasMeter().GasConsumed(),
+		written:     make(writeSet),
+	}
+
+	txs := app.executeSpeculatively(b, reqs)
+	for i, req := range reqs {
+		gInfo, result, anteEvents, err := app.commitSpeculativeTx(b, req, txs[i])
+		res[i] = app.deliverTxResponse(req, gInfo, result, anteEvents, err)
+	}
+
+	return res
+}
+
+// storeKeysByName returns the keys of the stores mounted on the multistore,
+// or nil if the multistore does not expose them.
+func (app *BaseApp) storeKeysByName() map[string]storetypes.StoreKey {
+	cms, ok := app.cms.(interface {
+		StoreKeysByName() map[string]storetypes.StoreKey
+	})
+	if !ok {
+		return nil
+	}
+
+	return cms.StoreKeysByName()
+}
+
+// txBatch is the state shared by the transactions of a DeliverTxBatch.
+type txBatch struct {
+	// base is the block state the transactions are executed on
+	base storetypes.CacheMultiStore
+	keys map[string]storetypes.StoreKey
+
+	// gasConsumed is the gas consumed by the block context before the batch,
+	// in BeginBlock notably
+	gasConsumed uint64
+
+	// mtx guards the base stores, which are read concurrently during the
+	// speculative execution
+	mtx sync.Mutex
+
+	// written are the keys written by the transactions committed so far
+	written writeSet
+}
+
+// branch returns a new branch of the base state for a transaction. The reads
+// are recorded in reads if it is not nil, and the writes are recorded in the
+// written keys of the batch once the branch is written.
+func (b *txBatch) branch(reads *readSet) storetypes.CacheMultiStore {
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(b.keys))
+	for _, key := range b.keys {
+		stores[key] = &trackingStore{
+			parent: b.base.GetKVStore(key),
+			key:    key,
+			mtx:    &b.mtx,
+			reads:  reads,
+			writes: b.written,
+		}
+	}
+
+	return cachemulti.NewStore(dbm.NewMemDB(), stores, b.keys, nil, nil)
+}
+
+// speculativeTx is the result of the speculative execution of a transaction.
+type speculativeTx struct {
+	ms    storetypes.CacheMultiStore
+	reads *readSet
+
+	// gasMeter and blockGasMeter replace the meters of the block context, which
+	// must not be shared between the transactions. gasMeter starts with the gas
+	// consumed by the block context before the batch.
+	gasMeter      sdk.GasMeter
+	blockGasMeter sdk.GasMeter
+
+	gInfo      sdk.GasInfo
+	result     *sdk.Result
+	anteEvents []abci.Event
+	err        error
+}
+
+// executeSpeculatively executes all the transactions concurrently on their own
+// branch of the block state.
+func (app *BaseApp) executeSpeculatively(b *txBatch, reqs []abci.RequestDeliverTx) []*speculativeTx {
+	txs := make([]*speculativeTx, len(reqs))
+	indexes := make(chan int, len(reqs))
+	for i := range reqs {
+		indexes <- i
+	}
+	close(indexes)
+
+	workers := app.parallelWorkers
+	if workers > len(reqs) {
+		workers = len(reqs)
+	}
+
+	wg := new(sync.WaitGroup)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				txs[i] = app.executeSpeculativeTx(b, reqs[i].Tx)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return txs
+}
+
+// executeSpeculativeTx executes a transaction on its own branch of the block
+// state, with its own gas meters and event manager.
+func (app *BaseApp) executeSpeculativeTx(b *txBatch, txBytes []byte) *speculativeTx {
+	tx := &speculativeTx{
+		reads:         newReadSet(),
+		gasMeter:      sdk.NewInfiniteGasMeter(),
+		blockGasMeter: sdk.NewInfiniteGasMeter(),
+	}
+	tx.gasMeter.ConsumeGas(b.gasConsumed, "block context")
+	tx.ms = b.branch(tx.reads)
+
+	ctx := app.deliverState.ctx.
+		WithMultiStore(tx.ms).
+		WithTxBytes(txBytes).
+		WithVoteInfos(app.voteInfos).
+		WithGasMeter(tx.gasMeter).
+		WithBlockGasMeter(tx.blockGasMeter).
+		WithEventManager(sdk.NewEventManager())
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	tx.gInfo, tx.result, tx.anteEvents, _, tx.err = app.runTxWithContext(ctx, runTxModeDeliver, txBytes)
+
+	return tx
+}
+
+// commitSpeculativeTx writes the result of a speculative execution to the
+// block state if it is the same as the result of the serial execution, or
+// executes the transaction again otherwise.
+func (app *BaseApp) commitSpeculativeTx(b *txBatch, req abci.RequestDeliverTx, tx *speculativeTx) (sdk.GasInfo, *sdk.Result, []abci.Event, error) {
+	blockGasMeter := app.deliverState.ctx.BlockGasMeter()
+	blockGas := tx.blockGasMeter.GasConsumed()
+
+	// The speculative result is discarded if the transaction read a key written
+	// by a previous transaction, if the gas meter of the block context, whose
+	// consumption accumulates over the block, was used by the transaction or by
+	// a previous one, or if the serial execution would have run out of block gas.
+	if !tx.reads.conflicts(b.written) &&
+		tx.gasMeter.GasConsumed() == b.gasConsumed && app.deliverState.ctx.GasMeter().GasConsumed() == b.gasConsumed &&
+		!blockGasMeter.IsOutOfGas() && blockGas <= blockGasMeter.GasRemaining() {
+		blockGasMeter.ConsumeGas(blockGas, "block gas meter")
+		tx.ms.Write()
+
+		return tx.gInfo, tx.result, tx.anteEvents, tx.err
+	}
+
+	telemetry.IncrCounter(1, "tx", "reexecuted")
+
+	ms := b.branch(nil)
+	ctx := app.getContextForTx(runTxModeDeliver, req.Tx).WithMultiStore(ms)
+	gInfo, result, anteEvents, _, err := app.runTxWithContext(ctx, runTxModeDeliver, req.Tx)
+	ms.Write()
+
+	return gInfo, result, anteEvents, err
+}
+
+// keyRange is a range of keys read by an iterator, with nil meaning unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+// contains returns true if the key is in the range.
+func (r keyRange) contains(key []byte) bool {
+	return (r.start == nil || bytes.Compare(key, r.start) >= 0) &&
+		(r.end == nil || bytes.Compare(key, r.end) < 0)
+}
+
+// readSet records the keys and the key ranges read by a transaction from the
+// block state.
+type readSet struct {
+	keys   map[storetypes.StoreKey]map[string]struct{}
+	ranges map[storetypes.StoreKey][]keyRange
+}
+
+func newReadSet() *readSet {
+	return &readSet{
+		keys:   make(map[storetypes.StoreKey]map[string]struct{}),
+		ranges: make(map[storetypes.StoreKey][]keyRange),
+	}
+}
+
+func (rs *readSet) addKey(storeKey storetypes.StoreKey, key []byte) {
+	keys, ok := rs.keys[storeKey]
+	if !ok {
+		keys = make(map[string]struct{})
+		rs.keys[storeKey] = keys
+	}
+
+	keys[string(key)] = struct{}{}
+}
+
+func (rs *readSet) addRange(storeKey storetypes.StoreKey, start, end []byte) {
+	rs.ranges[storeKey] = append(rs.ranges[storeKey], keyRange{
+		start: copyBytes(start),
+		end:   copyBytes(end),
+	})
+}
+
+// conflicts returns true if any of the keys written was read.
+func (rs *readSet) conflicts(written writeSet) bool {
+	for storeKey, keys := range written {
+		read := rs.keys[storeKey]
+		ranges := rs.ranges[storeKey]
+
+		for key := range keys {
+			if _, ok := read[key]; ok {
+				return true
+			}
+
+			for _, r := range ranges {
+				if r.contains([]byte(key)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// writeSet records the keys written to the block state.
+type writeSet map[storetypes.StoreKey]map[string]struct{}
+
+func (ws writeSet) addKey(storeKey storetypes.StoreKey, key []byte) {
+	keys, ok := ws[storeKey]
+	if !ok {
+		keys = make(map[string]struct{})
+		ws[storeKey] = keys
+	}
+
+	keys[string(key)] = struct{}{}
+}
+
+func copyBytes(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+
+	return append([]byte{}, bz...)
+}
+
+var _ storetypes.KVStore = &trackingStore{}
+
+// trackingStore wraps a store of the block state, serializing the accesses to
+// it and recording the keys read and written.
+type trackingStore struct {
+	parent storetypes.KVStore
+	key    storetypes.StoreKey
+	mtx    *sync.Mutex
+	reads  *readSet
+	writes writeSet
+}
+
+// GetStoreType implements Store.
+func (s *trackingStore) GetStoreType() storetypes.StoreType {
+	return s.parent.GetStoreType()
+}
+
+// Get implements KVStore.
+func (s *trackingStore) Get(key []byte) []byte {
+	if s.reads != nil {
+		s.reads.addKey(s.key, key)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.parent.Get(key)
+}
+
+// Has implements KVStore.
+func (s *trackingStore) Has(key []byte) bool {
+	if s.reads != nil {
+		s.reads.addKey(s.key, key)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.parent.Has(key)
+}
+
+// Set implements KVStore.
+func (s *trackingStore) Set(key, value []byte) {
+	s.writes.addKey(s.key, key)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.parent.Set(key, value)
+}
+
+// Delete implements KVStore.
+func (s *trackingStore) Delete(key []byte) {
+	s.writes.addKey(s.key, key)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.parent.Delete(key)
+}
+
+// Iterator implements KVStore.
+func (s *trackingStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, true)
+}
+
+// ReverseIterator implements KVStore.
+func (s *trackingStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s *trackingStore) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	if s.reads != nil {
+		s.reads.addRange(s.key, start, end)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var parent storetypes.Iterator
+	if ascending {
+		parent = s.parent.Iterator(start, end)
+	} else {
+		parent = s.parent.ReverseIterator(start, end)
+	}
+
+	return &lockedIterator{parent: parent, mtx: s.mtx}
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *trackingStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *trackingStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+var _ storetypes.Iterator = &lockedIterator{}
+
+// lockedIterator serializes the accesses to an iterator of the block state.
+type lockedIterator struct {
+	parent storetypes.Iterator
+	mtx    *sync.Mutex
+}
+
+func (it *lockedIterator) Domain() (start, end []byte) {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+
+	return it.parent.Domain()
+}
+
+func (it *lockedIterator) Valid() bool {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+
+	return it.parent.Valid()
+}
+
+func (it *lockedIterator) Next() {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+
+	it.parent.Next()
+}
+
+func (it *lockedIterator) Key() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+
+	return it.parent.Key()
+}
+
+func (it *lockedIterator) Value() []byte {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+
+	return it.parent.Value()
+}
+
+func (it *lockedIterator) Error() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+
+	return it.parent.Error()
+}
+
+func (it *lockedIterator) Close() error {
+	it.mtx.Lock()
+	defer it.mtx.Unlock()
+
+	return it.parent.Close()
+}
@@ -0,0 +1,307 @@
+package baseapp
+
+import (
+	"fmt"
+	"math/rand"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abcicli "github.com/tendermint/tendermint/abci/client"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// setupParallelTestApp returns a BaseApp whose ante handler increments a
+// counter per tx counter, and whose handlers increment a counter per msg
+// counter or sum all of them. The number of ante handler executions is
+// counted in anteCalls.
+func setupParallelTestApp(t *testing.T, anteCalls *int64, options ...func(*BaseApp)) *BaseApp {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			atomic.AddInt64(anteCalls, 1)
+
+			ctx = ctx.WithGasMeter(sdk.NewGasMeter(100000))
+			txTest := tx.(txTest)
+			if txTest.FailOnAnte {
+				return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+
+			store := ctx.KVStore(capKey1)
+			key := []byte(fmt.Sprintf("account/%d", txTest.Counter))
+			setIntOnStore(store, key, getIntFromStore(store, key)+1)
+
+			return ctx, nil
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			m := msg.(*msgCounter)
+			if m.FailOnHandler {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message handler failure")
+			}
+
+			store := ctx.KVStore(capKey1)
+			key := []byte(fmt.Sprintf("counter/%d", m.Counter))
+			count := getIntFromStore(store, key) + 1
+			setIntOnStore(store, key, count)
+
+			return &sdk.Result{Log: fmt.Sprintf("%d", count)}, nil
+		}))
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter2, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			store := ctx.KVStore(capKey1)
+			iterator := sdk.KVStorePrefixIterator(store, []byte("counter/"))
+			defer iterator.Close()
+
+			var sum int64
+			for ; iterator.Valid(); iterator.Next() {
+				sum += getIntFromStore(store, iterator.Key())
+			}
+			setIntOnStore(store, []byte("sum"), sum)
+
+			return &sdk.Result{Log: fmt.Sprintf("%d", sum)}, nil
+		}))
+	}
+
+	return setupBaseApp(t, append([]func(*BaseApp){anteOpt, routerOpt}, options...)...)
+}
+
+// randomBlockTxs returns the encoded txs of a block, using a few accounts and
+// counters so that some of them conflict.
+func randomBlockTxs(t *testing.T, r *rand.Rand, nTxs int) []abci.RequestDeliverTx {
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	reqs := make([]abci.RequestDeliverTx, nTxs)
+	for i := range reqs {
+		tx := &txTest{Counter: r.Int63n(8), FailOnAnte: r.Intn(10) == 0}
+		for j := r.Intn(3); j >= 0; j-- {
+			if r.Intn(8) == 0 {
+				tx.Msgs = append(tx.Msgs, msgCounter2{})
+			} else {
+				tx.Msgs = append(tx.Msgs, msgCounter{Counter: r.Int63n(16), FailOnHandler: r.Intn(10) == 0})
+			}
+		}
+
+		txBytes, err := codec.Marshal(tx)
+		require.NoError(t, err)
+		reqs[i] = abci.RequestDeliverTx{Tx: txBytes}
+	}
+
+	return reqs
+}
+
+func TestParallelExecutionMatchesSerial(t *testing.T) {
+	var serialCalls, parallelCalls int64
+	serialApp := setupParallelTestApp(t, &serialCalls)
+	parallelApp := setupParallelTestApp(t, &parallelCalls, SetParallelExecution(4))
+
+	serialApp.InitChain(abci.RequestInitChain{})
+	parallelApp.InitChain(abci.RequestInitChain{})
+
+	r := rand.New(rand.NewSource(1))
+	for height := int64(1); height <= 10; height++ {
+		reqs := randomBlockTxs(t, r, 50)
+		header := tmproto.Header{Height: height}
+
+		serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		serialRes := make([]abci.ResponseDeliverTx, len(reqs))
+		for i, req := range reqs {
+			serialRes[i] = serialApp.DeliverTx(req)
+		}
+		serialApp.EndBlock(abci.RequestEndBlock{Height: height})
+		serialCommit := serialApp.Commit()
+
+		parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+		parallelRes := parallelApp.DeliverTxBatch(reqs)
+		parallelApp.EndBlock(abci.RequestEndBlock{Height: height})
+		parallelCommit := parallelApp.Commit()
+
+		require.Equal(t, serialRes, parallelRes, "height %d", height)
+		require.Equal(t, serialCommit.Data, parallelCommit.Data, "height %d", height)
+	}
+
+	// some txs were executed again
+	require.Greater(t, parallelCalls, serialCalls)
+}
+
+func TestParallelExecutionConflicts(t *testing.T) {
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	nTxs := 10
+	newReqs := func(counter func(i int) int64) []abci.RequestDeliverTx {
+		reqs := make([]abci.RequestDeliverTx, nTxs)
+		for i := range reqs {
+			txBytes, err := codec.Marshal(newTxCounter(counter(i), counter(i)))
+			require.NoError(t, err)
+			reqs[i] = abci.RequestDeliverTx{Tx: txBytes}
+		}
+		return reqs
+	}
+
+	testCases := map[string]struct {
+		reqs     []abci.RequestDeliverTx
+		expCalls int64
+	}{
+		"independent txs": {
+			reqs:     newReqs(func(i int) int64 { return int64(i) }),
+			expCalls: int64(nTxs),
+		},
+		"conflicting txs": {
+			reqs:     newReqs(func(i int) int64 { return 0 }),
+			expCalls: int64(2*nTxs - 1),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var calls int64
+			app := setupParallelTestApp(t, &calls, SetParallelExecution(4))
+			app.InitChain(abci.RequestInitChain{})
+			app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+
+			for _, res := range app.DeliverTxBatch(tc.reqs) {
+				require.True(t, res.IsOK(), res.Log)
+			}
+			require.Equal(t, tc.expCalls, calls)
+		})
+	}
+}
+
+func TestParallelExecutionBeginBlockGas(t *testing.T) {
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	// the begin blocker consumes gas on the meter of the block context
+	beginBlockerOpt := func(bapp *BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Get([]byte("sum"))
+			return abci.ResponseBeginBlock{}
+		})
+	}
+
+	nTxs := 10
+	reqs := make([]abci.RequestDeliverTx, nTxs)
+	for i := range reqs {
+		txBytes, err := codec.Marshal(newTxCounter(int64(i), int64(i)))
+		require.NoError(t, err)
+		reqs[i] = abci.RequestDeliverTx{Tx: txBytes}
+	}
+
+	var serialCalls, parallelCalls int64
+	serialApp := setupParallelTestApp(t, &serialCalls, beginBlockerOpt)
+	parallelApp := setupParallelTestApp(t, &parallelCalls, beginBlockerOpt, SetParallelExecution(4))
+	serialApp.InitChain(abci.RequestInitChain{})
+	parallelApp.InitChain(abci.RequestInitChain{})
+	header := tmproto.Header{Height: 1}
+
+	serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	require.Positive(t, serialApp.deliverState.ctx.GasMeter().GasConsumed())
+	serialRes := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		serialRes[i] = serialApp.DeliverTx(req)
+	}
+
+	parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	parallelRes := parallelApp.DeliverTxBatch(reqs)
+
+	// the independent txs are not executed again
+	require.Equal(t, int64(nTxs), parallelCalls)
+	require.Equal(t, serialRes, parallelRes)
+	require.Equal(t, serialApp.Commit().Data, parallelApp.Commit().Data)
+}
+
+func TestParallelExecutionBlockGasLimit(t *testing.T) {
+	var serialCalls, parallelCalls int64
+	serialApp := setupParallelTestApp(t, &serialCalls)
+	parallelApp := setupParallelTestApp(t, &parallelCalls, SetParallelExecution(4))
+
+	initReq := abci.RequestInitChain{
+		ConsensusParams: &abci.ConsensusParams{
+			Block: &abci.BlockParams{MaxGas: 50000},
+		},
+	}
+	serialApp.InitChain(initReq)
+	parallelApp.InitChain(initReq)
+
+	reqs := randomBlockTxs(t, rand.New(rand.NewSource(2)), 30)
+	header := tmproto.Header{Height: 1}
+
+	serialApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	serialRes := make([]abci.ResponseDeliverTx, len(reqs))
+	for i, req := range reqs {
+		serialRes[i] = serialApp.DeliverTx(req)
+	}
+
+	parallelApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	parallelRes := parallelApp.DeliverTxBatch(reqs)
+
+	// the block ran out of gas
+	require.Equal(t, sdkerrors.ErrOutOfGas.ABCICode(), serialRes[len(reqs)-1].Code)
+	require.Equal(t, serialRes, parallelRes)
+	require.Equal(t,
+		serialApp.deliverState.ctx.BlockGasMeter().GasConsumed(),
+		parallelApp.deliverState.ctx.BlockGasMeter().GasConsumed(),
+	)
+	require.Equal(t, serialApp.Commit().Data, parallelApp.Commit().Data)
+}
+
+func TestLocalClientCreatorParallelExecution(t *testing.T) {
+	var serialCalls, parallelCalls int64
+	serialApp := setupParallelTestApp(t, &serialCalls)
+	parallelApp := setupParallelTestApp(t, &parallelCalls, SetParallelExecution(4))
+
+	// execute the blocks as Tendermint does, delivering the txs asynchronously
+	// and collecting their responses with the response callback
+	newClient := func(app *BaseApp) (abcicli.Client, *[]abci.ResponseDeliverTx) {
+		client, err := NewLocalClientCreator(app).NewABCIClient()
+		require.NoError(t, err)
+
+		res := new([]abci.ResponseDeliverTx)
+		client.SetResponseCallback(func(_ *abci.Request, r *abci.Response) {
+			if r, ok := r.Value.(*abci.Response_DeliverTx); ok {
+				*res = append(*res, *r.DeliverTx)
+			}
+		})
+		_, err = client.InitChainSync(abci.RequestInitChain{})
+		require.NoError(t, err)
+
+		return client, res
+	}
+	serialClient, serialRes := newClient(serialApp)
+	parallelClient, parallelRes := newClient(parallelApp)
+	require.IsType(t, &batchClient{}, parallelClient)
+
+	r := rand.New(rand.NewSource(1))
+	for height := int64(1); height <= 10; height++ {
+		reqs := randomBlockTxs(t, r, 50)
+		header := tmproto.Header{Height: height}
+
+		var commits [][]byte
+		for _, client := range []abcicli.Client{serialClient, parallelClient} {
+			_, err := client.BeginBlockSync(abci.RequestBeginBlock{Header: header})
+			require.NoError(t, err)
+			for _, req := range reqs {
+				client.DeliverTxAsync(req)
+			}
+			_, err = client.EndBlockSync(abci.RequestEndBlock{Height: height})
+			require.NoError(t, err)
+			commit, err := client.CommitSync()
+			require.NoError(t, err)
+			commits = append(commits, commit.Data)
+		}
+
+		require.Len(t, *parallelRes, len(reqs)*int(height), "height %d", height)
+		require.Equal(t, *serialRes, *parallelRes, "height %d", height)
+		require.Equal(t, commits[0], commits[1], "height %d", height)
+	}
+
+	// some txs were executed again
+	require.Greater(t, parallelCalls, serialCalls)
+}
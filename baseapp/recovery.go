@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"runtime/debug"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -63,6 +64,21 @@ func newOutOfGasRecoveryMiddleware(gasWanted uint64, ctx sdk.Context, next recov
 	return newRecoveryMiddleware(handler, next)
 }
 
+// newStoreLimitRecoveryMiddleware creates a recovery middleware for app.runTx
+// method, returning the errors of the writes exceeding the limits of a store.
+func newStoreLimitRecoveryMiddleware(next recoveryMiddleware) recoveryMiddleware {
+	handler := func(recoveryObj interface{}) error {
+		err, ok := recoveryObj.(error)
+		if !ok || !sdkerrors.IsOf(err, storetypes.ErrStoreLimitExceeded) {
+			return nil
+		}
+
+		return err
+	}
+
+	return newRecoveryMiddleware(handler, next)
+}
+
 // newDefaultRecoveryMiddleware creates a default (last in chain) recovery middleware for app.runTx method.
 func newDefaultRecoveryMiddleware() recoveryMiddleware {
 	handler := func(recoveryObj interface{}) error {
@@ -0,0 +1,204 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+const (
+	flagHomeB        = "home-b"
+	flagAppDBBackend = "app-db-backend"
+	flagMaxKeys      = "max-keys"
+)
+
+// StoreDiffOutput is the output of the store-diff command.
+type StoreDiffOutput struct {
+	HeightA int64             `json:"height_a"`
+	HeightB int64             `json:"height_b"`
+	Stores  []StoreDiffResult `json:"stores"`
+}
+
+// StoreDiffResult lists the keys which differ in a store.
+type StoreDiffResult struct {
+	Name      string           `json:"name"`
+	HashA     tmbytes.HexBytes `json:"hash_a"`
+	HashB     tmbytes.HexBytes `json:"hash_b"`
+	Keys      []KeyDiffResult  `json:"keys"`
+	Truncated bool             `json:"truncated"`
+}
+
+// KeyDiffResult is a key whose value differs, with the difference decoded by
+// the store decoder of the module, if any.
+type KeyDiffResult struct {
+	Key     tmbytes.HexBytes `json:"key"`
+	ValueA  tmbytes.HexBytes `json:"value_a"`
+	ValueB  tmbytes.HexBytes `json:"value_b"`
+	Decoded string           `json:"decoded,omitempty"`
+}
+
+// StoreDiffCmd compares the multistore of the application at two heights, or
+// the ones of two application homes, and prints the differing keys as JSON.
+// The application is created on an in-memory database to get the store
+// decoders of its modules, if it exposes a simulation manager.
+func StoreDiffCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-diff [height-a] [height-b]",
+		Short: "Compare the application state at two heights",
+		Long: fmt.Sprintf(`Compare the application state at two heights, or at two heights of two application
+homes with --%s, such as the ones of two nodes whose app hashes mismatch. The stores whose hashes
+differ are listed with their differing keys, and the values are decoded by the store decoders
+of the modules when registered. The node must be stopped, and the application databases are only read.
+If the second height is omitted, the homes are compared at the same height.
+
+Example:
+$ %s debug store-diff 100 101
+$ %s debug store-diff 100 --%s /path/to/other/home
+`, flagHomeB, version.AppName, version.AppName, flagHomeB),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			heightA, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %s: %w", args[0], err)
+			}
+			heightB := heightA
+			if len(args) > 1 {
+				if heightB, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+					return fmt.Errorf("invalid height %s: %w", args[1], err)
+				}
+			}
+
+			backend := server.GetAppDBBackend(vp)
+			homeA, homeB := vp.GetString(flags.FlagHome), vp.GetString(flagHomeB)
+			dbA, err := openReadOnlyDB(homeA, backend)
+			if err != nil {
+				return err
+			}
+			defer dbA.Close()
+
+			dbB := dbA
+			if homeB != "" && filepath.Clean(homeB) != filepath.Clean(homeA) {
+				if dbB, err = openReadOnlyDB(homeB, backend); err != nil {
+					return err
+				}
+				defer dbB.Close()
+			}
+
+			diffs, err := rootmulti.Diff(dbA, dbB, heightA, heightB, vp.GetInt(flagMaxKeys))
+			if err != nil {
+				return err
+			}
+
+			decoders, err := storeDecoders(appCreator, vp)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(newStoreDiffOutput(heightA, heightB, diffs, decoders), "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(bz))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, "", "The application home directory")
+	cmd.Flags().String(flagHomeB, "", "The application home directory of the second height, the first one if empty")
+	cmd.Flags().String(flagAppDBBackend, "", "The type of database of the application")
+	cmd.Flags().Int(flagMaxKeys, 100, "The maximum number of differing keys listed by store, unlimited if 0")
+
+	return cmd
+}
+
+// openReadOnlyDB opens the application database of the home, read-only if
+// the backend supports it.
+func openReadOnlyDB(home string, backend dbm.BackendType) (dbm.DB, error) {
+	dataDir := filepath.Join(home, "data")
+	if backend == dbm.GoLevelDBBackend {
+		return dbm.NewGoLevelDBWithOpts("application", dataDir, &opt.Options{ReadOnly: true})
+	}
+	return dbm.NewDB("application", backend, dataDir)
+}
+
+// storeDecoders returns the store decoders of the application modules, the
+// application being created in a temporary home so that nothing is written to
+// the compared ones.
+func storeDecoders(appCreator servertypes.AppCreator, vp *viper.Viper) (sdk.StoreDecoderRegistry, error) {
+	home, err := os.MkdirTemp("", "store-diff")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(home)
+	vp.Set(flags.FlagHome, home)
+
+	app := appCreator(log.NewNopLogger(), dbm.NewMemDB(), nil, vp)
+
+	simApp, ok := app.(interface {
+		SimulationManager() *module.SimulationManager
+	})
+	if !ok || simApp.SimulationManager() == nil {
+		return nil, nil
+	}
+	return simApp.SimulationManager().StoreDecoders, nil
+}
+
+func newStoreDiffOutput(heightA, heightB int64, diffs []rootmulti.StoreDiff, decoders sdk.StoreDecoderRegistry) StoreDiffOutput {
+	output := StoreDiffOutput{HeightA: heightA, HeightB: heightB, Stores: []StoreDiffResult{}}
+	for _, diff := range diffs {
+		result := StoreDiffResult{
+			Name:      diff.Name,
+			HashA:     diff.HashA,
+			HashB:     diff.HashB,
+			Keys:      make([]KeyDiffResult, 0, len(diff.Pairs)),
+			Truncated: diff.Truncated,
+		}
+		for _, pair := range diff.Pairs {
+			result.Keys = append(result.Keys, KeyDiffResult{
+				Key:     pair.Key,
+				ValueA:  pair.ValueA,
+				ValueB:  pair.ValueB,
+				Decoded: decodePair(decoders[diff.Name], pair),
+			})
+		}
+		output.Stores = append(output.Stores, result)
+	}
+	return output
+}
+
+// decodePair decodes the values of the key with the store decoder, which
+// panics on the keys it does not know.
+func decodePair(decoder func(kvA, kvB kv.Pair) string, pair rootmulti.PairDiff) (decoded string) {
+	if decoder == nil {
+		return ""
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			decoded = ""
+		}
+	}()
+	return decoder(kv.Pair{Key: pair.Key, Value: pair.ValueA}, kv.Pair{Key: pair.Key, Value: pair.ValueB})
+}
@@ -0,0 +1,109 @@
+package historical
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/historical"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+)
+
+const (
+	FlagAppDBBackend = "app-db-backend"
+	FlagToHeight     = "to-height"
+)
+
+// BackfillCmd records the state changes of the heights kept by the IAVL stores
+// of the node into the historical database, so that queries can be served from
+// it once the IAVL stores are pruned.
+func BackfillCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill-historical",
+		Short: "Record the state changes of the past heights into the historical database",
+		Long: `Record the state changes of the past heights into the historical database, by comparing
+		the consecutive versions of the IAVL stores, from the latest height recorded, or the earliest
+		version of the stores if none was recorded, up to the latest height of the node.
+		The IAVL stores must have kept all these versions, as on an archive node, and the node must be stopped.
+		Once recorded, the queries can be served from the historical database with the 'historical'
+		streamer and its 'serve-queries' option.
+		`,
+		Example: "backfill-historical --home './' --app-db-backend 'goleveldb'",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			vp := viper.New()
+
+			// Bind flags to the Context's Viper so we can get the database options.
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+			// the versions are only read, make sure none is pruned on loading
+			vp.Set(server.FlagPruning, pruningtypes.PruningOptionNothing)
+
+			home := vp.GetString(flags.FlagHome)
+			dataDir := filepath.Join(home, "data")
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(vp), dataDir)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+			app := appCreator(logger, db, nil, vp)
+			cms := app.CommitMultiStore()
+
+			rootMultiStore, ok := cms.(*rootmulti.Store)
+			if !ok {
+				return fmt.Errorf("currently only support the backfill of rootmulti.Store type")
+			}
+
+			toHeight := vp.GetInt64(FlagToHeight)
+			if latestHeight := rootmulti.GetLatestVersion(db); toHeight == 0 || toHeight > latestHeight {
+				toHeight = latestHeight
+			}
+			if toHeight <= 0 {
+				return fmt.Errorf("the database has no valid heights to backfill, the latest height: %v", toHeight)
+			}
+
+			stores := make(map[string]*iavl.Store)
+			for name, key := range rootMultiStore.StoreKeysByName() {
+				if store, ok := rootMultiStore.GetCommitKVStore(key).(*iavl.Store); ok {
+					stores[name] = store
+				}
+			}
+
+			historicalDB, err := dbm.NewDB(historical.DBName, server.GetAppDBBackend(vp), dataDir)
+			if err != nil {
+				return err
+			}
+			defer historicalDB.Close()
+
+			store := historical.NewStore(historicalDB)
+			fmt.Printf("backfilling the historical state up to height %v\n", toHeight)
+			if err := store.Backfill(stores, toHeight); err != nil {
+				return err
+			}
+
+			earliest, err := store.EarliestHeight()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("successfully backfilled the historical state from height %v to %v\n", earliest, toHeight)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, "", "The database home directory")
+	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and historical databases")
+	cmd.Flags().Int64(FlagToHeight, 0, "Height to backfill up to, the latest height of the node if 0")
+
+	return cmd
+}
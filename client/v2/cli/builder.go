@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
 )
@@ -16,4 +17,15 @@ type Builder struct {
 	// GetClientConn specifies how CLI commands will resolve a grpc.ClientConnInterface
 	// from a given context.
 	GetClientConn func(context.Context) grpc.ClientConnInterface
+
+	// MethodOptions specifies options for the commands generated for specific
+	// methods, keyed by the full name of the method.
+	MethodOptions map[protoreflect.FullName]MethodOptions
+}
+
+// MethodOptions specifies options for the command generated for a method.
+type MethodOptions struct {
+	// PositionalArgs lists the names of the request fields, in order, which are
+	// read from positional arguments instead of flags.
+	PositionalArgs []protoreflect.Name
 }
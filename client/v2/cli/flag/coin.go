@@ -0,0 +1,111 @@
+package flag
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
+)
+
+const coinMessageName protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+
+// coinType parses a cosmos.base.v1beta1.Coin from its string form, ex. 10stake.
+type coinType struct {
+	messageDesc protoreflect.MessageDescriptor
+}
+
+func (c coinType) NewValue(_ context.Context, builder *Builder) pflag.Value {
+	return &coinValue{messageType: util.ResolveMessageType(builder.TypeResolver, c.messageDesc)}
+}
+
+func (c coinType) DefaultValue() string {
+	return ""
+}
+
+type coinValue struct {
+	messageType protoreflect.MessageType
+	value       protoreflect.Message
+	str         string
+}
+
+func (c coinValue) Get() protoreflect.Value {
+	if c.value == nil {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfMessage(c.value)
+}
+
+func (c coinValue) String() string {
+	return c.str
+}
+
+func (c *coinValue) Set(s string) error {
+	coin, err := sdk.ParseCoinNormalized(s)
+	if err != nil {
+		return err
+	}
+
+	c.value = newCoinMessage(c.messageType, coin)
+	c.str = coin.String()
+	return nil
+}
+
+func (c coinValue) Type() string {
+	return "coin"
+}
+
+// coinListType parses a repeated cosmos.base.v1beta1.Coin field from a comma
+// separated list of coins, ex. 10stake,5atom. The flag may be repeated.
+type coinListType struct {
+	messageDesc protoreflect.MessageDescriptor
+}
+
+func (c coinListType) NewValue(_ context.Context, builder *Builder) pflag.Value {
+	return &coinListValue{messageType: util.ResolveMessageType(builder.TypeResolver, c.messageDesc)}
+}
+
+func (c coinListType) DefaultValue() string {
+	return ""
+}
+
+type coinListValue struct {
+	messageType protoreflect.MessageType
+	coins       sdk.Coins
+}
+
+func (c coinListValue) AppendTo(list protoreflect.List) {
+	for _, coin := range c.coins {
+		list.Append(protoreflect.ValueOfMessage(newCoinMessage(c.messageType, coin)))
+	}
+}
+
+func (c coinListValue) String() string {
+	return c.coins.String()
+}
+
+func (c *coinListValue) Set(s string) error {
+	coins, err := sdk.ParseCoinsNormalized(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+
+	c.coins = c.coins.Add(coins...)
+	return nil
+}
+
+func (c coinListValue) Type() string {
+	return "coins"
+}
+
+func newCoinMessage(messageType protoreflect.MessageType, coin sdk.Coin) protoreflect.Message {
+	msg := messageType.New()
+	fields := messageType.Descriptor().Fields()
+	msg.Set(fields.ByName("denom"), protoreflect.ValueOfString(coin.Denom))
+	msg.Set(fields.ByName("amount"), protoreflect.ValueOfString(coin.Amount.String()))
+	return msg
+}
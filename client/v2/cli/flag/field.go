@@ -22,6 +22,14 @@ type FieldValueBinder interface {
 type Options struct {
 	// Prefix is a prefix to prepend to all flags.
 	Prefix string
+
+	// PositionalArgs lists the names of the fields, in order, which are bound
+	// to positional arguments instead of flags. Only used by AddMessageFlags.
+	PositionalArgs []protoreflect.Name
+
+	// Exclude lists the names of the fields for which neither a flag nor a
+	// positional argument is created. Only used by AddMessageFlags.
+	Exclude []protoreflect.Name
 }
 
 // AddFieldFlag adds a flag for the provided field to the flag set.
@@ -68,6 +76,10 @@ func (b *Builder) AddFieldFlag(ctx context.Context, flagSet *pflag.FlagSet, fiel
 }
 
 func (b *Builder) resolveFlagType(field protoreflect.FieldDescriptor) Type {
+	if field.IsList() && field.Kind() == protoreflect.MessageKind && field.Message().FullName() == coinMessageName {
+		return coinListType{messageDesc: field.Message()}
+	}
+
 	typ := b.resolveFlagTypeBasic(field)
 	if field.IsList() {
 		if typ != nil {
@@ -98,6 +110,10 @@ func (b *Builder) resolveFlagTypeBasic(field protoreflect.FieldDescriptor) Type
 			return flagType
 		}
 
+		if field.Message().FullName() == coinMessageName {
+			return coinType{messageDesc: field.Message()}
+		}
+
 		return jsonMessageFlagType{
 			messageDesc: field.Message(),
 		}
@@ -124,5 +140,5 @@ type listValueBinder struct {
 }
 
 func (s listValueBinder) Bind(message protoreflect.Message, field protoreflect.FieldDescriptor) {
-	s.AppendTo(message.NewField(field).List())
+	s.AppendTo(message.Mutable(field).List())
 }
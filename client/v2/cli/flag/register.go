@@ -6,37 +6,95 @@ import (
 
 	"github.com/spf13/pflag"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
 )
 
 // AddMessageFlags adds flags for each field in the message to the flag set.
+// Fields listed in options.PositionalArgs are bound to positional arguments
+// instead, see MessageBinder.BindPositionalArgs, and fields listed in
+// options.Exclude are skipped.
 func (b *Builder) AddMessageFlags(ctx context.Context, set *pflag.FlagSet, messageType protoreflect.MessageType, options Options) *MessageBinder {
 	fields := messageType.Descriptor().Fields()
 	numFields := fields.Len()
 	handler := &MessageBinder{
 		messageType: messageType,
 	}
+
+	excluded := make(map[protoreflect.Name]bool, len(options.Exclude))
+	for _, name := range options.Exclude {
+		excluded[name] = true
+	}
+
+	if len(options.PositionalArgs) > 0 {
+		// positional arguments are parsed through a flag set of their own so
+		// that they share the flag value types with regular flags
+		handler.positionalFlagSet = pflag.NewFlagSet("positional args", pflag.ContinueOnError)
+	}
+	for _, name := range options.PositionalArgs {
+		field := fields.ByName(name)
+		if field == nil {
+			panic(fmt.Errorf("can't find field %s on %s", name, messageType.Descriptor().FullName()))
+		}
+
+		binder := b.AddFieldFlag(ctx, handler.positionalFlagSet, field, Options{})
+		if binder == nil {
+			panic(fmt.Errorf("unable to bind field %s to a positional argument", field))
+		}
+		excluded[name] = true
+		handler.positionalArgs = append(handler.positionalArgs, name)
+		handler.flagFieldPairs = append(handler.flagFieldPairs, fieldBinding{binder: binder, field: field})
+	}
+
 	for i := 0; i < numFields; i++ {
 		field := fields.Get(i)
-		binder := b.AddFieldFlag(ctx, set, field, options)
+		if excluded[field.Name()] {
+			continue
+		}
+
+		binder := b.AddFieldFlag(ctx, set, field, Options{Prefix: options.Prefix})
 		if binder == nil {
 			fmt.Printf("unable to bind field %s to a flag, support will be added soon\n", field)
 			continue
 		}
-		handler.flagFieldPairs = append(handler.flagFieldPairs, struct {
-			binder FieldValueBinder
-			field  protoreflect.FieldDescriptor
-		}{binder: binder, field: field})
+		handler.flagFieldPairs = append(handler.flagFieldPairs, fieldBinding{binder: binder, field: field})
 	}
 	return handler
 }
 
+type fieldBinding struct {
+	binder FieldValueBinder
+	field  protoreflect.FieldDescriptor
+}
+
 // MessageBinder binds multiple flags in a flag set to a protobuf message.
 type MessageBinder struct {
-	flagFieldPairs []struct {
-		binder FieldValueBinder
-		field  protoreflect.FieldDescriptor
+	flagFieldPairs    []fieldBinding
+	positionalFlagSet *pflag.FlagSet
+	positionalArgs    []protoreflect.Name
+	messageType       protoreflect.MessageType
+}
+
+// PositionalArgs returns the names of the fields bound to positional
+// arguments, in order.
+func (m MessageBinder) PositionalArgs() []protoreflect.Name {
+	return m.positionalArgs
+}
+
+// BindPositionalArgs parses the provided positional arguments into the fields
+// they are bound to. It must be called before BuildMessage.
+func (m MessageBinder) BindPositionalArgs(args []string) error {
+	if len(args) != len(m.positionalArgs) {
+		return fmt.Errorf("expected %d positional arguments, got %d", len(m.positionalArgs), len(args))
+	}
+
+	for i, arg := range args {
+		field := m.messageType.Descriptor().Fields().ByName(m.positionalArgs[i])
+		if err := m.positionalFlagSet.Set(util.DescriptorKebabName(field), arg); err != nil {
+			return fmt.Errorf("invalid argument %q for %s: %w", arg, field.Name(), err)
+		}
 	}
-	messageType protoreflect.MessageType
+	return nil
 }
 
 // BuildMessage builds and returns a new message for the bound flags.
@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	msgv1 "github.com/cosmos/cosmos-sdk/api/cosmos/msg/v1"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/cosmos-sdk/client/v2/cli/flag"
+	"github.com/cosmos/cosmos-sdk/client/v2/internal/util"
+)
+
+// AddMsgServiceCommands adds a sub-command to the provided command for each
+// method in the specified Msg service and returns the command. A Msg service
+// is a service whose request messages declare their signers with the
+// cosmos.msg.v1.signer option.
+func (b *Builder) AddMsgServiceCommands(command *cobra.Command, serviceName protoreflect.FullName) *cobra.Command {
+	resolver := b.FileResolver
+	if resolver == nil {
+		resolver = protoregistry.GlobalFiles
+	}
+	descriptor, err := resolver.FindDescriptorByName(serviceName)
+	if err != nil {
+		panic(err)
+	}
+
+	service := descriptor.(protoreflect.ServiceDescriptor)
+	methods := service.Methods()
+	n := methods.Len()
+	for i := 0; i < n; i++ {
+		cmd := b.CreateMsgMethodCommand(methods.Get(i))
+		command.AddCommand(cmd)
+	}
+	return command
+}
+
+// CreateMsgMethodCommand creates a command which builds the request of the
+// given Msg service method from flags and positional arguments, fills its
+// signer fields from --from and then generates, signs and broadcasts a tx
+// containing it through client/tx.Factory.
+func (b *Builder) CreateMsgMethodCommand(descriptor protoreflect.MethodDescriptor) *cobra.Command {
+	docs := util.DescriptorDocs(descriptor)
+	inputDesc := descriptor.Input()
+	inputType := util.ResolveMessageType(b.TypeResolver, inputDesc)
+
+	signers := msgSigners(inputDesc)
+	if len(signers) == 0 {
+		panic(fmt.Errorf("%s is not a Msg: it has no cosmos.msg.v1.signer option", inputDesc.FullName()))
+	}
+	// only address signers can be filled from --from, signers nested in other
+	// messages (ex. MsgMultiSend inputs) are still read from flags
+	var signerFields []protoreflect.FieldDescriptor
+	for _, signer := range signers {
+		field := inputDesc.Fields().ByName(protoreflect.Name(signer))
+		if field == nil {
+			panic(fmt.Errorf("can't find signer field %s on %s", signer, inputDesc.FullName()))
+		}
+		if field.Kind() == protoreflect.StringKind && !field.IsList() {
+			signerFields = append(signerFields, field)
+		}
+	}
+
+	options := b.MethodOptions[descriptor.FullName()]
+	cmd := &cobra.Command{
+		Use:  methodUse(descriptor, options),
+		Long: docs,
+		Args: cobra.ExactArgs(len(options.PositionalArgs)),
+	}
+
+	exclude := make([]protoreflect.Name, len(signerFields))
+	for i, field := range signerFields {
+		exclude[i] = field.Name()
+	}
+	binder := b.AddMessageFlags(cmd.Context(), cmd.Flags(), inputType, flag.Options{
+		PositionalArgs: options.PositionalArgs,
+		Exclude:        exclude,
+	})
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+
+		if err := binder.BindPositionalArgs(args); err != nil {
+			return err
+		}
+		input := binder.BuildMessage()
+
+		from := clientCtx.GetFromAddress()
+		if from.Empty() && len(signerFields) > 0 {
+			return fmt.Errorf("the signer of %s must be provided with --%s", inputDesc.FullName(), flags.FlagFrom)
+		}
+		for _, field := range signerFields {
+			input.Set(field, protoreflect.ValueOfString(from.String()))
+		}
+
+		msg, err := toSDKMsg(clientCtx.InterfaceRegistry, input)
+		if err != nil {
+			return err
+		}
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func msgSigners(descriptor protoreflect.MessageDescriptor) []string {
+	signers, ok := proto.GetExtension(descriptor.Options(), msgv1.E_Signer).([]string)
+	if !ok {
+		return nil
+	}
+	return signers
+}
+
+// toSDKMsg converts a protoreflect message into the sdk.Msg implementation
+// registered for its type URL so that it can be encoded in a transaction.
+func toSDKMsg(registry codectypes.InterfaceRegistry, message protoreflect.Message) (sdk.Msg, error) {
+	if registry == nil {
+		return nil, fmt.Errorf("an interface registry is required in the client context to encode %s", message.Descriptor().FullName())
+	}
+
+	typeURL := "/" + string(message.Descriptor().FullName())
+	resolved, err := registry.Resolve(typeURL)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, ok := resolved.(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("%s does not implement sdk.Msg", typeURL)
+	}
+
+	bz, err := proto.Marshal(message.Interface())
+	if err != nil {
+		return nil, err
+	}
+	if err := gogoproto.Unmarshal(bz, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
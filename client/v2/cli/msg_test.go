@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gotest.tools/v3/assert"
+
+	bankv1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/bank/v1beta1"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	testFromAddress = "cosmos1y74p8wyy4enfhfn342njve6cjmj5c8dtl6emdk"
+	testToAddress   = "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r"
+)
+
+func testExecMsg(t *testing.T, args ...string) (client.TxConfig, *bytes.Buffer, error) {
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+	txConfig := authtx.NewTxConfig(cdc, authtx.DefaultSignModes)
+
+	out := &bytes.Buffer{}
+	clientCtx := client.Context{}.
+		WithCodec(cdc).
+		WithInterfaceRegistry(registry).
+		WithTxConfig(txConfig).
+		WithOutput(out).
+		WithChainID("test-chain")
+
+	b := &Builder{
+		MethodOptions: map[protoreflect.FullName]MethodOptions{
+			"cosmos.bank.v1beta1.Msg.Send": {PositionalArgs: []protoreflect.Name{"to_address", "amount"}},
+		},
+	}
+	cmd := b.AddMsgServiceCommands(&cobra.Command{Use: "test"}, bankv1beta1.File_cosmos_bank_v1beta1_tx_proto.Services().Get(0).FullName())
+	cmd.SetArgs(args)
+	cmd.SetOut(out)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	return txConfig, out, cmd.ExecuteContext(ctx)
+}
+
+func TestMsgGenerateOnly(t *testing.T) {
+	txConfig, out, err := testExecMsg(t,
+		"send", testToAddress, "10stake,5atom",
+		"--"+flags.FlagFrom, testFromAddress,
+		"--"+flags.FlagGenerateOnly,
+	)
+	assert.NilError(t, err)
+
+	tx, err := txConfig.TxJSONDecoder()(out.Bytes())
+	assert.NilError(t, err)
+	msgs := tx.GetMsgs()
+	assert.Equal(t, len(msgs), 1)
+
+	msg, ok := msgs[0].(*banktypes.MsgSend)
+	assert.Assert(t, ok)
+	assert.Equal(t, msg.FromAddress, testFromAddress)
+	assert.Equal(t, msg.ToAddress, testToAddress)
+	assert.Equal(t, msg.Amount.String(), sdk.NewCoins(sdk.NewInt64Coin("atom", 5), sdk.NewInt64Coin("stake", 10)).String())
+}
+
+func TestMsgMultiSendFromFlags(t *testing.T) {
+	_, _, err := testExecMsg(t,
+		"multi-send",
+		"--inputs", `{"address":"`+testFromAddress+`","coins":[{"denom":"stake","amount":"10"}]}`,
+		"--outputs", `{"address":"`+testToAddress+`","coins":[{"denom":"stake","amount":"10"}]}`,
+		"--"+flags.FlagFrom, testFromAddress,
+		"--"+flags.FlagGenerateOnly,
+	)
+	assert.NilError(t, err)
+}
+
+func TestMsgErrors(t *testing.T) {
+	// the signer field is filled from --from and has no flag of its own
+	_, _, err := testExecMsg(t,
+		"send", testToAddress, "10stake",
+		"--from-address", testFromAddress,
+		"--"+flags.FlagGenerateOnly,
+	)
+	assert.ErrorContains(t, err, "unknown flag")
+
+	_, _, err = testExecMsg(t,
+		"send", testToAddress, "10stake",
+		"--"+flags.FlagGenerateOnly,
+	)
+	assert.ErrorContains(t, err, "--from")
+
+	_, _, err = testExecMsg(t,
+		"send", testToAddress, "ten",
+		"--"+flags.FlagFrom, testFromAddress,
+		"--"+flags.FlagGenerateOnly,
+	)
+	assert.ErrorContains(t, err, "invalid argument")
+
+	_, _, err = testExecMsg(t,
+		"send", testToAddress,
+		"--"+flags.FlagFrom, testFromAddress,
+		"--"+flags.FlagGenerateOnly,
+	)
+	assert.ErrorContains(t, err, "accepts 2 arg(s)")
+}
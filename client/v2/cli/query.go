@@ -45,12 +45,16 @@ func (b *Builder) CreateQueryMethodCommand(descriptor protoreflect.MethodDescrip
 	inputDesc := descriptor.Input()
 	inputType := util.ResolveMessageType(b.TypeResolver, inputDesc)
 	outputType := util.ResolveMessageType(b.TypeResolver, descriptor.Output())
+	options := b.MethodOptions[descriptor.FullName()]
 	cmd := &cobra.Command{
-		Use:  protoNameToCliName(descriptor.Name()),
+		Use:  methodUse(descriptor, options),
 		Long: docs,
+		Args: cobra.ExactArgs(len(options.PositionalArgs)),
 	}
 
-	binder := b.AddMessageFlags(cmd.Context(), cmd.Flags(), inputType, flag.Options{})
+	binder := b.AddMessageFlags(cmd.Context(), cmd.Flags(), inputType, flag.Options{
+		PositionalArgs: options.PositionalArgs,
+	})
 
 	jsonMarshalOptions := protojson.MarshalOptions{
 		Indent:          "  ",
@@ -63,6 +67,9 @@ func (b *Builder) CreateQueryMethodCommand(descriptor protoreflect.MethodDescrip
 	cmd.RunE = func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 		clientConn := getClientConn(ctx)
+		if err := binder.BindPositionalArgs(args); err != nil {
+			return err
+		}
 		input := binder.BuildMessage()
 		output := outputType.New()
 		err := clientConn.Invoke(ctx, methodName, input.Interface(), output.Interface())
@@ -85,3 +92,13 @@ func (b *Builder) CreateQueryMethodCommand(descriptor protoreflect.MethodDescrip
 func protoNameToCliName(name protoreflect.Name) string {
 	return strcase.ToKebab(string(name))
 }
+
+// methodUse returns the usage line of a method command, listing its positional
+// arguments if any.
+func methodUse(descriptor protoreflect.MethodDescriptor, options MethodOptions) string {
+	use := protoNameToCliName(descriptor.Name())
+	for _, arg := range options.PositionalArgs {
+		use += fmt.Sprintf(" [%s]", protoNameToCliName(arg))
+	}
+	return use
+}
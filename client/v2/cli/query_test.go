@@ -58,7 +58,7 @@ func TestEcho(t *testing.T) {
 		"--i-64", "-234602347",
 		"--str", "def",
 		"--timestamp", "2019-01-02T00:01:02Z",
-		"--a-coin", `{"denom":"foo","amount":"100000"}`,
+		"--a-coin", "100000foo",
 		"--an-address", "cosmossdghdsfoi2134sdgh",
 		"--bz", "c2RncXdlZndkZ3NkZw==",
 		"--page-count-total",
@@ -0,0 +1,156 @@
+package pebbledb
+
+import (
+	"sync/atomic"
+
+	"github.com/cockroachdb/pebble"
+
+	"github.com/cosmos/cosmos-sdk/db"
+	dbutil "github.com/cosmos/cosmos-sdk/db/internal"
+)
+
+// dbWriter writes to a pebble batch. A writer created by ReadWriter uses an
+// indexed batch, which can be read from, and records the keys it read to
+// detect conflicts with the transactions committed since it was opened.
+type dbWriter struct {
+	dbTxn
+	batch *pebble.Batch
+	// Commit sequence number when the transaction was opened
+	startSeq uint64
+	// Keys read by the transaction, nil for a write-only transaction
+	reads   map[string]struct{}
+	written map[string]struct{}
+}
+
+// Get implements DBReader.
+func (tx *dbWriter) Get(key []byte) ([]byte, error) {
+	if tx.reader == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return nil, db.ErrKeyEmpty
+	}
+	tx.reads[string(key)] = struct{}{}
+	return get(tx.reader, key)
+}
+
+// Has implements DBReader.
+func (tx *dbWriter) Has(key []byte) (bool, error) {
+	bytes, err := tx.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return bytes != nil, nil
+}
+
+// Set implements DBWriter.
+func (tx *dbWriter) Set(key []byte, value []byte) error {
+	if tx.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	if err := dbutil.ValidateKv(key, value); err != nil {
+		return err
+	}
+	tx.written[string(key)] = struct{}{}
+	return tx.batch.Set(key, value, nil)
+}
+
+// Delete implements DBWriter.
+func (tx *dbWriter) Delete(key []byte) error {
+	if tx.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return db.ErrKeyEmpty
+	}
+	tx.written[string(key)] = struct{}{}
+	return tx.batch.Delete(key, nil)
+}
+
+// Commit implements DBWriter.
+func (tx *dbWriter) Commit() (err error) {
+	if tx.batch == nil {
+		return db.ErrTransactionClosed
+	}
+	defer func() { err = dbutil.CombineErrors(err, tx.Discard(), "Discard also failed") }()
+	return tx.mgr.commits.commit(tx)
+}
+
+// Discard implements DBWriter.
+func (tx *dbWriter) Discard() error {
+	if tx.batch == nil {
+		return nil // Discard() is idempotent
+	}
+	defer atomic.AddInt32(&tx.mgr.openWriters, -1)
+	if tx.reads != nil {
+		tx.mgr.commits.end(tx.startSeq)
+	}
+	err := tx.batch.Close()
+	tx.batch = nil
+	tx.reader = nil
+	return err
+}
+
+// begin registers a new read-write transaction and returns its starting
+// sequence number.
+func (cl *commitLog) begin() uint64 {
+	cl.mtx.Lock()
+	defer cl.mtx.Unlock()
+	cl.open[cl.seq]++
+	cl.numOpen++
+	return cl.seq
+}
+
+// end unregisters a read-write transaction and drops the commits which are
+// not needed anymore to detect conflicts.
+func (cl *commitLog) end(startSeq uint64) {
+	cl.mtx.Lock()
+	defer cl.mtx.Unlock()
+	cl.open[startSeq]--
+	cl.numOpen--
+	if cl.open[startSeq] == 0 {
+		delete(cl.open, startSeq)
+	}
+
+	oldest := cl.seq
+	for seq := range cl.open {
+		if seq < oldest {
+			oldest = seq
+		}
+	}
+	i := 0
+	for i < len(cl.writes) && cl.writes[i].seq <= oldest {
+		i++
+	}
+	cl.writes = cl.writes[i:]
+}
+
+// commit writes the transaction's batch, unless it read a key written by a
+// transaction committed since it was opened.
+func (cl *commitLog) commit(tx *dbWriter) error {
+	cl.mtx.Lock()
+	defer cl.mtx.Unlock()
+	for _, w := range cl.writes {
+		if w.seq <= tx.startSeq {
+			continue
+		}
+		for key := range tx.reads {
+			if _, has := w.keys[key]; has {
+				return errWriteConflict
+			}
+		}
+	}
+	if err := tx.batch.Commit(pebble.NoSync); err != nil {
+		return err
+	}
+	cl.seq++
+	// only keep the writes if another read-write transaction may conflict
+	others := cl.numOpen
+	if tx.reads != nil {
+		others--
+	}
+	if others > 0 {
+		cl.writes = append(cl.writes, committedWrites{seq: cl.seq, keys: tx.written})
+	}
+	return nil
+}
@@ -0,0 +1,457 @@
+package pebbledb
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/vfs"
+
+	"github.com/cosmos/cosmos-sdk/db"
+)
+
+var (
+	currentDBFileName    string = "current.db"
+	checkpointFileFormat string = "%020d.db"
+)
+
+var (
+	_ db.DBConnection = (*PebbleDB)(nil)
+	_ db.DBReader     = (*dbTxn)(nil)
+	_ db.DBWriter     = (*dbWriter)(nil)
+	_ db.DBReadWriter = (*dbWriter)(nil)
+)
+
+// errWriteConflict is returned when a read-write transaction is committed after
+// a key it read was modified by another transaction.
+var errWriteConflict = errors.New("transaction conflict: a key read was modified")
+
+// PebbleDB is a connection to a PebbleDB key-value database.
+type PebbleDB = dbManager
+
+type dbManager struct {
+	current *pebble.DB
+	dir     string
+	opts    *pebble.Options
+	vmgr    *db.VersionManager
+	mtx     sync.RWMutex
+	// Track open DBWriters
+	openWriters int32
+	cpCache     checkpointCache
+	commits     commitLog
+}
+
+// commitLog tracks the keys written by the commits which happened while
+// read-write transactions were open, to detect their conflicts.
+type commitLog struct {
+	// Serializes the commits, so that conflicts are checked atomically
+	mtx sync.Mutex
+	// Sequence number of the last commit
+	seq uint64
+	// Keys written by each commit more recent than the oldest open read-write transaction
+	writes []committedWrites
+	// Number of open read-write transactions by starting sequence number
+	open    map[uint64]int
+	numOpen int
+}
+
+type committedWrites struct {
+	seq  uint64
+	keys map[string]struct{}
+}
+
+type checkpointCache struct {
+	cache map[uint64]*cpCacheEntry
+	mtx   sync.RWMutex
+}
+
+type cpCacheEntry struct {
+	cxn       *pebble.DB
+	openCount uint
+}
+
+type dbTxn struct {
+	reader  pebble.Reader
+	mgr     *dbManager
+	version uint64
+}
+
+// NewDB creates a new PebbleDB key-value database inside the given directory,
+// with the default options. If dir does not exist, it will be created.
+func NewDB(dir string) (*dbManager, error) {
+	return NewDBWithOptions(dir, &pebble.Options{})
+}
+
+// NewDBWithOptions creates a new PebbleDB key-value database inside the given
+// directory, with the specified Options
+// (https://pkg.go.dev/github.com/cockroachdb/pebble#Options).
+// If dir does not exist, it will be created.
+func NewDBWithOptions(dir string, opts *pebble.Options) (*dbManager, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	mgr := &dbManager{
+		dir:     dir,
+		opts:    opts.EnsureDefaults(),
+		cpCache: checkpointCache{cache: map[uint64]*cpCacheEntry{}},
+		commits: commitLog{open: map[uint64]int{}},
+	}
+
+	err := os.MkdirAll(mgr.checkpointsDir(), 0o755)
+	if err != nil {
+		return nil, err
+	}
+	if mgr.vmgr, err = readVersions(mgr.checkpointsDir()); err != nil {
+		return nil, err
+	}
+	dbPath := filepath.Join(dir, currentDBFileName)
+	// if the current db file is missing but there are checkpoints, restore it
+	if mgr.vmgr.Count() > 0 {
+		if _, err = os.Stat(dbPath); os.IsNotExist(err) {
+			err = mgr.restoreFromCheckpoint(mgr.vmgr.Last(), dbPath)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	mgr.current, err = pebble.Open(dbPath, mgr.opts)
+	if err != nil {
+		return nil, err
+	}
+	return mgr, nil
+}
+
+func (mgr *dbManager) checkpointsDir() string {
+	return filepath.Join(mgr.dir, "checkpoints")
+}
+
+// Reads directory for checkpoints files
+func readVersions(dir string) (*db.VersionManager, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var versions []uint64
+	for _, f := range files {
+		var version uint64
+		if _, err := fmt.Sscanf(f.Name(), checkpointFileFormat, &version); err != nil {
+			return nil, err
+		}
+		versions = append(versions, version)
+	}
+	return db.NewVersionManager(versions), nil
+}
+
+func (mgr *dbManager) checkpointPath(version uint64) (string, error) {
+	dbPath := filepath.Join(mgr.checkpointsDir(), fmt.Sprintf(checkpointFileFormat, version))
+	if stat, err := os.Stat(dbPath); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			err = db.ErrVersionDoesNotExist
+		}
+		return "", err
+	} else if !stat.IsDir() {
+		return "", db.ErrVersionDoesNotExist
+	}
+	return dbPath, nil
+}
+
+func (mgr *dbManager) openCheckpoint(version uint64) (*pebble.DB, error) {
+	mgr.cpCache.mtx.Lock()
+	defer mgr.cpCache.mtx.Unlock()
+	cp, has := mgr.cpCache.cache[version]
+	if has {
+		cp.openCount++
+		return cp.cxn, nil
+	}
+	dbPath, err := mgr.checkpointPath(version)
+	if err != nil {
+		return nil, err
+	}
+	opts := *mgr.opts
+	opts.ReadOnly = true
+	d, err := pebble.Open(dbPath, &opts)
+	if err != nil {
+		return nil, err
+	}
+	mgr.cpCache.cache[version] = &cpCacheEntry{cxn: d, openCount: 1}
+	return d, nil
+}
+
+// Reader implements DBConnection.
+func (mgr *dbManager) Reader() db.DBReader {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	return &dbTxn{
+		reader: mgr.current.NewSnapshot(),
+		mgr:    mgr,
+	}
+}
+
+// ReaderAt implements DBConnection.
+func (mgr *dbManager) ReaderAt(version uint64) (db.DBReader, error) {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	d, err := mgr.openCheckpoint(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dbTxn{
+		reader:  d,
+		mgr:     mgr,
+		version: version,
+	}, nil
+}
+
+// ReadWriter implements DBConnection.
+func (mgr *dbManager) ReadWriter() db.DBReadWriter {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	atomic.AddInt32(&mgr.openWriters, 1)
+	batch := mgr.current.NewIndexedBatch()
+	return &dbWriter{
+		dbTxn:    dbTxn{reader: batch, mgr: mgr},
+		batch:    batch,
+		startSeq: mgr.commits.begin(),
+		reads:    map[string]struct{}{},
+		written:  map[string]struct{}{},
+	}
+}
+
+// Writer implements DBConnection.
+func (mgr *dbManager) Writer() db.DBWriter {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	atomic.AddInt32(&mgr.openWriters, 1)
+	return &dbWriter{
+		dbTxn:   dbTxn{mgr: mgr},
+		batch:   mgr.current.NewBatch(),
+		written: map[string]struct{}{},
+	}
+}
+
+// Versions implements DBConnection.
+func (mgr *dbManager) Versions() (db.VersionSet, error) {
+	mgr.mtx.RLock()
+	defer mgr.mtx.RUnlock()
+	return mgr.vmgr, nil
+}
+
+// SaveNextVersion implements DBConnection.
+func (mgr *dbManager) SaveNextVersion() (uint64, error) {
+	return mgr.save(0)
+}
+
+// SaveVersion implements DBConnection.
+func (mgr *dbManager) SaveVersion(target uint64) error {
+	if target == 0 {
+		return db.ErrInvalidVersion
+	}
+	_, err := mgr.save(target)
+	return err
+}
+
+func (mgr *dbManager) save(target uint64) (uint64, error) {
+	mgr.mtx.Lock()
+	defer mgr.mtx.Unlock()
+	if atomic.LoadInt32(&mgr.openWriters) > 0 {
+		return 0, db.ErrOpenTransactions
+	}
+	newVmgr := mgr.vmgr.Copy()
+	target, err := newVmgr.Save(target)
+	if err != nil {
+		return 0, err
+	}
+	dir := filepath.Join(mgr.checkpointsDir(), fmt.Sprintf(checkpointFileFormat, target))
+	if err := mgr.current.Checkpoint(dir, pebble.WithFlushedWAL()); err != nil {
+		return 0, err
+	}
+	mgr.vmgr = newVmgr
+	return target, nil
+}
+
+// DeleteVersion implements DBConnection.
+func (mgr *dbManager) DeleteVersion(ver uint64) error {
+	if mgr.cpCache.has(ver) {
+		return db.ErrOpenTransactions
+	}
+	mgr.mtx.Lock()
+	defer mgr.mtx.Unlock()
+	dbPath, err := mgr.checkpointPath(ver)
+	if err != nil {
+		return err
+	}
+	mgr.vmgr = mgr.vmgr.Copy()
+	mgr.vmgr.Delete(ver)
+	return os.RemoveAll(dbPath)
+}
+
+// Revert implements DBConnection.
+func (mgr *dbManager) Revert() (err error) {
+	mgr.mtx.Lock()
+	defer mgr.mtx.Unlock()
+	if atomic.LoadInt32(&mgr.openWriters) > 0 {
+		return db.ErrOpenTransactions
+	}
+	// Close current connection and replace it with a checkpoint (created from the last checkpoint)
+	if err = mgr.current.Close(); err != nil {
+		return
+	}
+	dbPath := filepath.Join(mgr.dir, currentDBFileName)
+	err = os.RemoveAll(dbPath)
+	if err != nil {
+		return
+	}
+	if last := mgr.vmgr.Last(); last != 0 {
+		err = mgr.restoreFromCheckpoint(last, dbPath)
+		if err != nil {
+			return
+		}
+	}
+	mgr.current, err = pebble.Open(dbPath, mgr.opts)
+	return
+}
+
+// restoreFromCheckpoint copies a checkpoint to the given path. The sstables
+// are immutable and hard-linked when possible, while the other files, such as
+// the MANIFEST which is appended to, are copied.
+func (mgr *dbManager) restoreFromCheckpoint(version uint64, path string) error {
+	cpPath, err := mgr.checkpointPath(version)
+	if err != nil {
+		return err
+	}
+	files, err := os.ReadDir(cpPath)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(path, 0o755); err != nil {
+		return err
+	}
+	for _, f := range files {
+		src, dst := filepath.Join(cpPath, f.Name()), filepath.Join(path, f.Name())
+		if filepath.Ext(f.Name()) == ".sst" {
+			err = vfs.LinkOrCopy(vfs.Default, src, dst)
+		} else {
+			err = vfs.Copy(vfs.Default, src, dst)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close implements DBConnection.
+func (mgr *dbManager) Close() error {
+	return mgr.current.Close()
+}
+
+// Stats implements DBConnection.
+func (mgr *dbManager) Stats() map[string]string {
+	return map[string]string{"pebble.stats": mgr.current.Metrics().String()}
+}
+
+// Get implements DBReader.
+func (tx *dbTxn) Get(key []byte) ([]byte, error) {
+	if tx.reader == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if len(key) == 0 {
+		return nil, db.ErrKeyEmpty
+	}
+	return get(tx.reader, key)
+}
+
+// Has implements DBReader.
+func (tx *dbTxn) Has(key []byte) (bool, error) {
+	bytes, err := tx.Get(key)
+	if err != nil {
+		return false, err
+	}
+	return bytes != nil, nil
+}
+
+// Iterator implements DBReader.
+func (tx *dbTxn) Iterator(start, end []byte) (db.Iterator, error) {
+	if tx.reader == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, db.ErrKeyEmpty
+	}
+	itr := tx.reader.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return newPebbleDBIterator(itr, start, end, false), nil
+}
+
+// ReverseIterator implements DBReader.
+func (tx *dbTxn) ReverseIterator(start, end []byte) (db.Iterator, error) {
+	if tx.reader == nil {
+		return nil, db.ErrTransactionClosed
+	}
+	if (start != nil && len(start) == 0) || (end != nil && len(end) == 0) {
+		return nil, db.ErrKeyEmpty
+	}
+	itr := tx.reader.NewIter(&pebble.IterOptions{LowerBound: start, UpperBound: end})
+	return newPebbleDBIterator(itr, start, end, true), nil
+}
+
+// Discard implements DBReader.
+func (tx *dbTxn) Discard() error {
+	if tx.reader == nil {
+		return nil // Discard() is idempotent
+	}
+	defer func() { tx.reader = nil }()
+	if tx.version == 0 {
+		return tx.reader.Close()
+	}
+	if !tx.mgr.cpCache.decrement(tx.version) {
+		return fmt.Errorf("transaction has no corresponding checkpoint cache entry: %v", tx.version)
+	}
+	return nil
+}
+
+// get returns a copy of the value of the key, or nil if it does not exist.
+func get(reader pebble.Reader, key []byte) ([]byte, error) {
+	res, closer, err := reader.Get(key)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer closer.Close()
+	return copyBytes(res), nil
+}
+
+func copyBytes(bz []byte) []byte {
+	v := make([]byte, len(bz))
+	copy(v, bz)
+	return v
+}
+
+func (cpc *checkpointCache) has(ver uint64) bool {
+	cpc.mtx.RLock()
+	defer cpc.mtx.RUnlock()
+	_, has := cpc.cache[ver]
+	return has
+}
+
+func (cpc *checkpointCache) decrement(ver uint64) bool {
+	cpc.mtx.Lock()
+	defer cpc.mtx.Unlock()
+	cp, has := cpc.cache[ver]
+	if !has {
+		return false
+	}
+	cp.openCount--
+	if cp.openCount == 0 {
+		cp.cxn.Close()
+		delete(cpc.cache, ver)
+	}
+	return true
+}
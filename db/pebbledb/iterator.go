@@ -0,0 +1,85 @@
+package pebbledb
+
+import (
+	"github.com/cockroachdb/pebble"
+
+	"github.com/cosmos/cosmos-sdk/db"
+)
+
+type pebbleDBIterator struct {
+	source     *pebble.Iterator
+	start, end []byte
+	isReverse  bool
+	// Whether iterator has been advanced to the first element (is fully initialized)
+	primed bool
+}
+
+var _ db.Iterator = (*pebbleDBIterator)(nil)
+
+// newPebbleDBIterator wraps a pebble iterator whose bounds are already set to
+// the domain.
+func newPebbleDBIterator(source *pebble.Iterator, start, end []byte, isReverse bool) *pebbleDBIterator {
+	return &pebbleDBIterator{
+		source:    source,
+		start:     start,
+		end:       end,
+		isReverse: isReverse,
+	}
+}
+
+// Domain implements Iterator.
+func (itr *pebbleDBIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid returns whether the iterator is positioned on a key.
+func (itr *pebbleDBIterator) Valid() bool {
+	return itr.primed && itr.source.Valid()
+}
+
+// Key implements Iterator.
+func (itr *pebbleDBIterator) Key() []byte {
+	itr.assertIsValid()
+	return copyBytes(itr.source.Key())
+}
+
+// Value implements Iterator.
+func (itr *pebbleDBIterator) Value() []byte {
+	itr.assertIsValid()
+	return copyBytes(itr.source.Value())
+}
+
+// Next implements Iterator.
+func (itr *pebbleDBIterator) Next() bool {
+	switch {
+	case !itr.primed:
+		itr.primed = true
+		if itr.isReverse {
+			return itr.source.Last()
+		}
+		return itr.source.First()
+	case !itr.source.Valid():
+		// once invalid, the iterator remains invalid
+		return false
+	case itr.isReverse:
+		return itr.source.Prev()
+	default:
+		return itr.source.Next()
+	}
+}
+
+// Error implements Iterator.
+func (itr *pebbleDBIterator) Error() error {
+	return itr.source.Error()
+}
+
+// Close implements Iterator.
+func (itr *pebbleDBIterator) Close() error {
+	return itr.source.Close()
+}
+
+func (itr *pebbleDBIterator) assertIsValid() {
+	if !itr.Valid() {
+		panic("iterator is invalid")
+	}
+}
@@ -1,11 +1,24 @@
 package pruning
 
+import (
+	"container/list"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
 var (
 	PruneHeightsKey         = pruneHeightsKey
 	PruneSnapshotHeightsKey = pruneSnapshotHeightsKey
 
-	Int64SliceToBytes          = int64SliceToBytes
-	ListToBytes                = listToBytes
-	LoadPruningHeights         = loadPruningHeights
-	LoadPruningSnapshotHeights = loadPruningSnapshotHeights
+	Int64SliceToBytes = int64SliceToBytes
+	ListToBytes       = listToBytes
+	StorePruningKey   = storePruningKey
 )
+
+func LoadPruningHeights(db dbm.DB) ([]int64, error) {
+	return loadPruningHeights(db, pruneHeightsKey)
+}
+
+func LoadPruningSnapshotHeights(db dbm.DB) (*list.List, error) {
+	return loadPruningSnapshotHeights(db, pruneSnapshotHeightsKey)
+}
@@ -4,6 +4,7 @@ import (
 	"container/list"
 	"encoding/binary"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -31,6 +32,12 @@ type Manager struct {
 	// These are the heights that are multiples of snapshotInterval and kept for state sync snapshots.
 	// The heights are added to this list to be pruned when a snapshot is complete.
 	pruneSnapshotHeights *list.List
+	// Keys under which the heights are flushed to disk.
+	pruneHeightsKey         []byte
+	pruneSnapshotHeightsKey []byte
+	// Managers of the stores whose pruning options override opts, by store name.
+	// They track the heights to prune from their store independently.
+	storeManagers map[string]*Manager
 }
 
 // NegativeHeightsError is returned when a negative height is provided to the manager.
@@ -54,12 +61,19 @@ var (
 // keeps all heights. Users of the Manager may change the strategy
 // by calling SetOptions.
 func NewManager(db dbm.DB, logger log.Logger) *Manager {
+	return newManager(db, logger, pruneHeightsKey, pruneSnapshotHeightsKey)
+}
+
+func newManager(db dbm.DB, logger log.Logger, pruneHeightsKey, pruneSnapshotHeightsKey []byte) *Manager {
 	return &Manager{
-		db:                   db,
-		logger:               logger,
-		opts:                 types.NewPruningOptions(types.PruningNothing),
-		pruneHeights:         []int64{},
-		pruneSnapshotHeights: list.New(),
+		db:                      db,
+		logger:                  logger,
+		opts:                    types.NewPruningOptions(types.PruningNothing),
+		pruneHeights:            []int64{},
+		pruneSnapshotHeights:    list.New(),
+		pruneHeightsKey:         pruneHeightsKey,
+		pruneSnapshotHeightsKey: pruneSnapshotHeightsKey,
+		storeManagers:           make(map[string]*Manager),
 	}
 }
 
@@ -73,6 +87,63 @@ func (m *Manager) GetOptions() types.PruningOptions {
 	return m.opts
 }
 
+// SetStoreOptions sets the pruning strategy of a single store, overriding the
+// strategy set by SetOptions. The heights to prune from the store are tracked
+// and flushed to disk separately from the other stores.
+func (m *Manager) SetStoreOptions(storeName string, opts types.PruningOptions) {
+	sm, ok := m.storeManagers[storeName]
+	if !ok {
+		sm = newManager(
+			m.db,
+			m.logger.With("store", storeName),
+			storePruningKey(pruneHeightsKey, storeName),
+			storePruningKey(pruneSnapshotHeightsKey, storeName),
+		)
+		sm.snapshotInterval = m.snapshotInterval
+		m.storeManagers[storeName] = sm
+	}
+	sm.opts = opts
+}
+
+// GetStoreOptions fetches the pruning strategy of a store, which is the one
+// set by SetOptions unless it was overridden by SetStoreOptions.
+func (m *Manager) GetStoreOptions(storeName string) types.PruningOptions {
+	if sm, ok := m.storeManagers[storeName]; ok {
+		return sm.opts
+	}
+	return m.opts
+}
+
+// HasStoreOptions returns true if the pruning strategy of the store was
+// overridden by SetStoreOptions.
+func (m *Manager) HasStoreOptions(storeName string) bool {
+	_, ok := m.storeManagers[storeName]
+	return ok
+}
+
+// StoresToPruneAtHeight returns the sorted names of the stores with their own
+// pruning strategy which should be pruned at the given height.
+func (m *Manager) StoresToPruneAtHeight(height int64) []string {
+	var storeNames []string
+	for storeName, sm := range m.storeManagers {
+		if sm.ShouldPruneAtHeight(height) {
+			storeNames = append(storeNames, storeName)
+		}
+	}
+	sort.Strings(storeNames)
+	return storeNames
+}
+
+// GetFlushAndResetStorePruningHeights is analogous to GetFlushAndResetPruningHeights
+// for a store whose pruning strategy was overridden by SetStoreOptions.
+func (m *Manager) GetFlushAndResetStorePruningHeights(storeName string) ([]int64, error) {
+	sm, ok := m.storeManagers[storeName]
+	if !ok {
+		return nil, fmt.Errorf("store %s has no pruning options of its own", storeName)
+	}
+	return sm.GetFlushAndResetPruningHeights()
+}
+
 // GetFlushAndResetPruningHeights returns all heights to be pruned during the next call to Prune().
 // It also flushes and resets the pruning heights.
 func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
@@ -83,7 +154,7 @@ func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
 	defer m.pruneHeightsMx.Unlock()
 
 	// flush the updates to disk so that it is not lost if crash happens.
-	if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
+	if err := m.db.SetSync(m.pruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
 		return nil, err
 	}
 
@@ -99,7 +170,12 @@ func (m *Manager) GetFlushAndResetPruningHeights() ([]int64, error) {
 // the pruning strategy. Returns previousHeight, if it was kept to be pruned at the next call to Prune(), 0 otherwise.
 // previousHeight must be greater than 0 for the handling to take effect since valid heights start at 1 and 0 represents
 // the latest height. The latest height cannot be pruned. As a result, if previousHeight is less than or equal to 0, 0 is returned.
+// The height is also handled by the managers of the stores with their own pruning strategy.
 func (m *Manager) HandleHeight(previousHeight int64) int64 {
+	for _, sm := range m.storeManagers {
+		sm.HandleHeight(previousHeight)
+	}
+
 	if m.opts.GetPruningStrategy() == types.PruningNothing || previousHeight <= 0 {
 		return 0
 	}
@@ -128,7 +204,7 @@ func (m *Manager) HandleHeight(previousHeight int64) int64 {
 		}
 
 		// flush the updates to disk so that they are not lost if crash happens.
-		if err := m.db.SetSync(pruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
+		if err := m.db.SetSync(m.pruneHeightsKey, int64SliceToBytes(m.pruneHeights)); err != nil {
 			panic(err)
 		}
 	}()
@@ -156,6 +232,10 @@ func (m *Manager) HandleHeight(previousHeight int64) int64 {
 // The input height must be greater than 0 and pruning strategy any but pruning nothing.
 // If one of these conditions is not met, this function does nothing.
 func (m *Manager) HandleHeightSnapshot(height int64) {
+	for _, sm := range m.storeManagers {
+		sm.HandleHeightSnapshot(height)
+	}
+
 	if m.opts.GetPruningStrategy() == types.PruningNothing || height <= 0 {
 		return
 	}
@@ -167,7 +247,7 @@ func (m *Manager) HandleHeightSnapshot(height int64) {
 	m.pruneSnapshotHeights.PushBack(height)
 
 	// flush the updates to disk so that they are not lost if crash happens.
-	if err := m.db.SetSync(pruneSnapshotHeightsKey, listToBytes(m.pruneSnapshotHeights)); err != nil {
+	if err := m.db.SetSync(m.pruneSnapshotHeightsKey, listToBytes(m.pruneSnapshotHeights)); err != nil {
 		panic(err)
 	}
 }
@@ -175,6 +255,9 @@ func (m *Manager) HandleHeightSnapshot(height int64) {
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 func (m *Manager) SetSnapshotInterval(snapshotInterval uint64) {
 	m.snapshotInterval = snapshotInterval
+	for _, sm := range m.storeManagers {
+		sm.SetSnapshotInterval(snapshotInterval)
+	}
 }
 
 // ShouldPruneAtHeight return true if the given height should be pruned, false otherwise
@@ -184,10 +267,16 @@ func (m *Manager) ShouldPruneAtHeight(height int64) bool {
 
 // LoadPruningHeights loads the pruning heights from the database as a crash recovery.
 func (m *Manager) LoadPruningHeights(db dbm.DB) error {
+	for _, sm := range m.storeManagers {
+		if err := sm.LoadPruningHeights(db); err != nil {
+			return err
+		}
+	}
+
 	if m.opts.GetPruningStrategy() == types.PruningNothing {
 		return nil
 	}
-	loadedPruneHeights, err := loadPruningHeights(db)
+	loadedPruneHeights, err := loadPruningHeights(db, m.pruneHeightsKey)
 	if err != nil {
 		return err
 	}
@@ -198,7 +287,7 @@ func (m *Manager) LoadPruningHeights(db dbm.DB) error {
 		m.pruneHeights = loadedPruneHeights
 	}
 
-	loadedPruneSnapshotHeights, err := loadPruningSnapshotHeights(db)
+	loadedPruneSnapshotHeights, err := loadPruningSnapshotHeights(db, m.pruneSnapshotHeightsKey)
 	if err != nil {
 		return err
 	}
@@ -212,7 +301,13 @@ func (m *Manager) LoadPruningHeights(db dbm.DB) error {
 	return nil
 }
 
-func loadPruningHeights(db dbm.DB) ([]int64, error) {
+// storePruningKey returns the key under which the heights of a store with its
+// own pruning strategy are flushed.
+func storePruningKey(key []byte, storeName string) []byte {
+	return append(append(append([]byte{}, key...), '/'), storeName...)
+}
+
+func loadPruningHeights(db dbm.DB, pruneHeightsKey []byte) ([]int64, error) {
 	bz, err := db.Get(pruneHeightsKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pruned heights: %w", err)
@@ -237,7 +332,7 @@ func loadPruningHeights(db dbm.DB) ([]int64, error) {
 	return prunedHeights, nil
 }
 
-func loadPruningSnapshotHeights(db dbm.DB) (*list.List, error) {
+func loadPruningSnapshotHeights(db dbm.DB, pruneSnapshotHeightsKey []byte) (*list.List, error) {
 	bz, err := db.Get(pruneSnapshotHeightsKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get post-snapshot pruned heights: %w", err)
@@ -530,3 +530,57 @@ func TestGetFlushAndResetPruningHeights_DbErr_Panic(t *testing.T) {
 	require.Error(t, err)
 	require.Nil(t, heights)
 }
+
+func TestStoreOptions(t *testing.T) {
+	db := db.NewMemDB()
+	manager := pruning.NewManager(db, log.NewNopLogger())
+	manager.SetOptions(types.NewPruningOptions(types.PruningNothing))
+	manager.SetSnapshotInterval(5)
+
+	everything := types.NewPruningOptions(types.PruningEverything)
+	manager.SetStoreOptions("orderbook", everything)
+
+	require.True(t, manager.HasStoreOptions("orderbook"))
+	require.False(t, manager.HasStoreOptions("bank"))
+	require.Equal(t, everything, manager.GetStoreOptions("orderbook"))
+	require.Equal(t, types.NewPruningOptions(types.PruningNothing), manager.GetStoreOptions("bank"))
+
+	_, err := manager.GetFlushAndResetStorePruningHeights("bank")
+	require.Error(t, err)
+
+	// the store heights are tracked even though the default strategy keeps everything
+	for height := int64(1); height < 10; height++ {
+		require.Equal(t, int64(0), manager.HandleHeight(height))
+		require.Empty(t, manager.StoresToPruneAtHeight(height))
+	}
+	manager.HandleHeightSnapshot(5)
+	require.Equal(t, []string{"orderbook"}, manager.StoresToPruneAtHeight(10))
+
+	// the snapshot height is only pruned once it is no longer recent
+	manager.HandleHeight(10)
+	heights, err := manager.GetFlushAndResetStorePruningHeights("orderbook")
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4, 6, 7, 8, 5}, heights)
+
+	heights, err = manager.GetFlushAndResetPruningHeights()
+	require.NoError(t, err)
+	require.Empty(t, heights)
+
+	// the store heights are flushed and loaded under their own keys
+	manager.HandleHeight(11)
+	loaded := pruning.NewManager(db, log.NewNopLogger())
+	loaded.SetStoreOptions("orderbook", everything)
+	require.NoError(t, loaded.LoadPruningHeights(db))
+
+	heights, err = loaded.GetFlushAndResetStorePruningHeights("orderbook")
+	require.NoError(t, err)
+	require.Equal(t, []int64{9}, heights)
+
+	heights, err = pruning.LoadPruningHeights(db)
+	require.NoError(t, err)
+	require.Empty(t, heights)
+
+	bz, err := db.Get(pruning.StorePruningKey(pruning.PruneHeightsKey, "orderbook"))
+	require.NoError(t, err)
+	require.Equal(t, pruning.Int64SliceToBytes([]int64{9}), bz)
+}
@@ -39,6 +39,17 @@ const (
 
 	// FileStreamer defines the store streaming type for file streaming.
 	FileStreamer = "file"
+
+	// GRPCStreamer defines the store streaming type for gRPC streaming.
+	GRPCStreamer = "grpc"
+
+	// HistoricalStreamer defines the store streaming type recording the state
+	// changes into the historical database.
+	HistoricalStreamer = "historical"
+
+	// DefaultGRPCStreamerAddress defines the default address to bind the gRPC
+	// streaming service to.
+	DefaultGRPCStreamerAddress = "localhost:9092"
 )
 
 // BaseConfig defines the server's basic configuration
@@ -84,6 +95,15 @@ type BaseConfig struct {
 	// InterBlockCache enables inter-block caching.
 	InterBlockCache bool `mapstructure:"inter-block-cache"`
 
+	// InterBlockCacheSize defines the maximum size in bytes of the keys and
+	// values cached for each store by the inter-block cache. If zero, a fixed
+	// number of entries is cached instead.
+	InterBlockCacheSize uint64 `mapstructure:"inter-block-cache-size"`
+
+	// InterBlockCacheWarmupVersions defines the number of latest versions the
+	// written keys of which are loaded into the inter-block cache on start.
+	InterBlockCacheWarmupVersions uint64 `mapstructure:"inter-block-cache-warmup-versions"`
+
 	// IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 	// which informs Tendermint what to index. If empty, all events will be indexed.
 	IndexEvents []string `mapstructure:"index-events"`
@@ -97,6 +117,15 @@ type BaseConfig struct {
 	// IAVLLazyLoading enable/disable the lazy loading of iavl store.
 	IAVLLazyLoading bool `mapstructure:"iavl-lazy-loading"`
 
+	// AsyncCommitQueueSize enables the asynchronous commit of the stores when
+	// positive, with at most this number of committed versions pending
+	// persistence.
+	AsyncCommitQueueSize uint64 `mapstructure:"async-commit-queue-size"`
+
+	// ParallelTxWorkers executes the transactions of a block optimistically in
+	// parallel with this number of workers when greater than 1.
+	ParallelTxWorkers uint `mapstructure:"parallel-tx-workers"`
+
 	// AppDBBackend defines the type of Database to use for the application and snapshots databases.
 	// An empty string indicates that the Tendermint config's DBBackend value should be used.
 	AppDBBackend string `mapstructure:"app-db-backend"`
@@ -209,6 +238,14 @@ type StateSyncConfig struct {
 }
 
 type (
+	// StorePruningConfig defines the pruning configuration of a single store,
+	// overriding the pruning configuration of BaseConfig.
+	StorePruningConfig struct {
+		Pruning           string `mapstructure:"pruning"`
+		PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
+		PruningInterval   string `mapstructure:"pruning-interval"`
+	}
+
 	// StoreConfig defines application configuration for state streaming and other
 	// storage related operations.
 	StoreConfig struct {
@@ -219,7 +256,9 @@ type (
 	// fields are required to be set when state streaming is enabled via a non-empty
 	// list defined by 'StoreConfig.Streamers'.
 	StreamersConfig struct {
-		File FileStreamerConfig `mapstructure:"file"`
+		File       FileStreamerConfig       `mapstructure:"file"`
+		GRPC       GRPCStreamerConfig       `mapstructure:"grpc"`
+		Historical HistoricalStreamerConfig `mapstructure:"historical"`
 	}
 
 	// FileStreamerConfig defines the file streaming configuration options.
@@ -237,6 +276,31 @@ type (
 		// the commit, but don't lose data in face of system crash.
 		Fsync bool `mapstructure:"fsync"`
 	}
+
+	// GRPCStreamerConfig defines the gRPC streaming configuration options.
+	GRPCStreamerConfig struct {
+		Keys    []string `mapstructure:"keys"`
+		Address string   `mapstructure:"address"`
+		// BufferSize is the number of blocks queued for each client before the
+		// client is considered to have fallen behind.
+		BufferSize int `mapstructure:"buffer-size"`
+		// HistorySize is the number of recent blocks kept in memory so that clients
+		// can resume streaming from a past height.
+		HistorySize int `mapstructure:"history-size"`
+		// HaltOnSlowClient specifies if the node stops when a client falls behind,
+		// otherwise the client is disconnected and has to resume streaming.
+		HaltOnSlowClient bool `mapstructure:"halt-on-slow-client"`
+	}
+
+	// HistoricalStreamerConfig defines the historical streaming configuration
+	// options.
+	HistoricalStreamerConfig struct {
+		Keys []string `mapstructure:"keys"`
+		// ServeQueries specifies if the gRPC queries are served from the
+		// historical state rather than from the IAVL versions, which requires the
+		// changes of all the stores to be recorded.
+		ServeQueries bool `mapstructure:"serve-queries"`
+	}
 )
 
 // Config defines the server's top level configuration
@@ -252,6 +316,15 @@ type Config struct {
 	StateSync StateSyncConfig  `mapstructure:"state-sync"`
 	Store     StoreConfig      `mapstructure:"store"`
 	Streamers StreamersConfig  `mapstructure:"streamers"`
+
+	// StorePruning defines the pruning configuration of individual stores by
+	// store name.
+	StorePruning map[string]StorePruningConfig `mapstructure:"store-pruning"`
+
+	// InterBlockCacheStoreSize defines the maximum size in bytes of the keys
+	// and values cached by the inter-block cache of individual stores by store
+	// name, overriding InterBlockCacheSize.
+	InterBlockCacheStoreSize map[string]uint64 `mapstructure:"inter-block-cache-store-size"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -285,17 +358,21 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			InterBlockCache:     true,
-			Pruning:             pruningtypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250, // 50 MB
-			IAVLDisableFastNode: false,
-			IAVLLazyLoading:     false,
-			AppDBBackend:        "",
+			MinGasPrices:                  defaultMinGasPrices,
+			InterBlockCache:               true,
+			InterBlockCacheSize:           0,
+			InterBlockCacheWarmupVersions: 0,
+			Pruning:                       pruningtypes.PruningOptionDefault,
+			PruningKeepRecent:             "0",
+			PruningInterval:               "0",
+			MinRetainBlocks:               0,
+			IndexEvents:                   make([]string, 0),
+			IAVLCacheSize:                 781250, // 50 MB
+			IAVLDisableFastNode:           false,
+			IAVLLazyLoading:               false,
+			AsyncCommitQueueSize:          0,
+			ParallelTxWorkers:             0,
+			AppDBBackend:                  "",
 		},
 		Telemetry: telemetry.Config{
 			Enabled:                  false,
@@ -349,6 +426,17 @@ func DefaultConfig() *Config {
 				// in face of system crash.
 				Fsync: false,
 			},
+			GRPC: GRPCStreamerConfig{
+				Keys:             []string{"*"},
+				Address:          DefaultGRPCStreamerAddress,
+				BufferSize:       100,
+				HistorySize:      100,
+				HaltOnSlowClient: false,
+			},
+			Historical: HistoricalStreamerConfig{
+				Keys:         []string{"*"},
+				ServeQueries: false,
+			},
 		},
 	}
 }
@@ -372,6 +460,14 @@ func (c Config) ValidateBasic() error {
 			"cannot enable state sync snapshots with '%s' pruning setting", pruningtypes.PruningOptionEverything,
 		)
 	}
+	for storeName, storePruning := range c.StorePruning {
+		if storePruning.Pruning == pruningtypes.PruningOptionEverything && c.StateSync.SnapshotInterval > 0 {
+			return sdkerrors.ErrAppConfig.Wrapf(
+				"cannot enable state sync snapshots with '%s' pruning setting of store %s",
+				pruningtypes.PruningOptionEverything, storeName,
+			)
+		}
+	}
 
 	return nil
 }
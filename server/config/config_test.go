@@ -54,6 +54,24 @@ prefix = ""`
 	require.Contains(t, buffer.String(), expectedContents, "config file contents")
 }
 
+func TestParseGRPCStoreStreaming(t *testing.T) {
+	expectedContents := `[streamers.grpc]
+keys = ["bank", "staking", ]
+
+# address defines the address the gRPC streaming endpoint binds to.
+address = "localhost:9092"`
+
+	cfg := DefaultConfig()
+	cfg.Store.Streamers = []string{GRPCStreamer}
+	cfg.Streamers.GRPC.Keys = []string{"bank", "staking"}
+
+	var buffer bytes.Buffer
+	require.NoError(t, configTemplate.Execute(&buffer, cfg), "executing template")
+	require.Contains(t, buffer.String(), expectedContents, "config file contents")
+	require.Contains(t, buffer.String(), "buffer-size = 100\n", "config file contents")
+	require.Contains(t, buffer.String(), "halt-on-slow-client = false\n", "config file contents")
+}
+
 func TestReadConfig(t *testing.T) {
 	cfg := DefaultConfig()
 	tmpFile := filepath.Join(t.TempDir(), "config")
@@ -95,6 +113,25 @@ func TestIndexEventsWriteRead(t *testing.T) {
 	require.Equal(t, expected, actual, "config value")
 }
 
+func TestInterBlockCacheWriteRead(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := DefaultConfig()
+	conf.InterBlockCacheSize = 1 << 20
+	conf.InterBlockCacheWarmupVersions = 10
+	conf.InterBlockCacheStoreSize = map[string]uint64{"bank": 1 << 26, "orderbook": 1 << 28}
+	WriteConfigFile(confFile, conf)
+
+	vpr := viper.New()
+	vpr.SetConfigFile(confFile)
+	require.NoError(t, vpr.ReadInConfig())
+
+	cfg, err := ParseConfig(vpr)
+	require.NoError(t, err)
+	require.Equal(t, conf.InterBlockCacheSize, cfg.InterBlockCacheSize)
+	require.Equal(t, conf.InterBlockCacheWarmupVersions, cfg.InterBlockCacheWarmupVersions)
+	require.Equal(t, conf.InterBlockCacheStoreSize, cfg.InterBlockCacheStoreSize)
+}
+
 func TestGlobalLabelsEventsMarshalling(t *testing.T) {
 	expectedIn := `global-labels = [
   ["labelname1", "labelvalue1"],
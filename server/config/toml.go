@@ -63,6 +63,17 @@ min-retain-blocks = {{ .BaseConfig.MinRetainBlocks }}
 # InterBlockCache enables inter-block caching.
 inter-block-cache = {{ .BaseConfig.InterBlockCache }}
 
+# InterBlockCacheSize defines the maximum size in bytes of the keys and values cached
+# for each store by the inter-block cache, the least recently used ones being evicted.
+# The size of individual stores can be set in the inter-block-cache-store-size section.
+# Default is 0, caching the 1000 most recently used entries of each store instead.
+inter-block-cache-size = {{ .BaseConfig.InterBlockCacheSize }}
+
+# InterBlockCacheWarmupVersions defines the number of latest heights the written keys of
+# which are loaded into the inter-block cache on start, the heights must not be pruned.
+# Default is 0, starting with empty caches.
+inter-block-cache-warmup-versions = {{ .BaseConfig.InterBlockCacheWarmupVersions }}
+
 # IndexEvents defines the set of events in the form {eventType}.{attributeKey},
 # which informs Tendermint what to index. If empty, all events will be indexed.
 #
@@ -82,6 +93,20 @@ iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 # Default is false.
 iavl-lazy-loading = {{ .BaseConfig.IAVLLazyLoading }}
 
+# EXPERIMENTAL: AsyncCommitQueueSize enables the asynchronous commit of the stores when positive:
+# the new state is committed in memory and persisted in the background while the next blocks are
+# executed, with at most this number of committed heights pending persistence. After a crash, the
+# node restarts from the latest persisted height and replays the following blocks.
+# Default is 0, committing synchronously.
+async-commit-queue-size = {{ .BaseConfig.AsyncCommitQueueSize }}
+
+# EXPERIMENTAL: ParallelTxWorkers executes the transactions of a block optimistically in parallel
+# with this number of workers when greater than 1. The transactions reading state written by a
+# previous transaction of the block are executed again, so that the results and the app hash are
+# identical to the serial execution.
+# Default is 0, executing the transactions serially.
+parallel-tx-workers = {{ .BaseConfig.ParallelTxWorkers }}
+
 # AppDBBackend defines the database backend type to use for the application and snapshots DBs.
 # An empty string indicates that a fallback will be used.
 # First fallback is the deprecated compile-time types.DBBackend value.
@@ -247,6 +272,38 @@ snapshot-interval = {{ .StateSync.SnapshotInterval }}
 # snapshot-keep-recent specifies the number of recent snapshots to keep and serve (0 to keep all).
 snapshot-keep-recent = {{ .StateSync.SnapshotKeepRecent }}
 
+###############################################################################
+###                        Store Pruning Configuration                      ###
+###############################################################################
+
+# Pruning options of individual stores by store name, overriding the pruning
+# options above, e.g. to keep the full history of some stores only:
+#
+# [store-pruning.bank]
+# pruning = "nothing"
+#
+# [store-pruning.orderbook]
+# pruning = "custom"
+# pruning-keep-recent = "100"
+# pruning-interval = "10"
+{{ range $name, $opts := .StorePruning }}
+[store-pruning.{{ $name }}]
+pruning = "{{ $opts.Pruning }}"
+pruning-keep-recent = "{{ $opts.PruningKeepRecent }}"
+pruning-interval = "{{ $opts.PruningInterval }}"
+{{ end }}
+###############################################################################
+###                     Inter-Block Cache Configuration                     ###
+###############################################################################
+
+# Maximum size in bytes of the inter-block cache of individual stores by store
+# name, overriding inter-block-cache-size, e.g.:
+#
+# bank = 67108864
+# orderbook = 268435456
+[inter-block-cache-store-size]
+{{ range $name, $size := .InterBlockCacheStoreSize }}{{ $name }} = {{ $size }}
+{{ end }}
 ###############################################################################
 ###                         Store / State Streaming                         ###
 ###############################################################################
@@ -269,6 +326,34 @@ stop-node-on-error = "{{ .Streamers.File.StopNodeOnError }}"
 
 # fsync specifies if call fsync after writing the files.
 fsync = "{{ .Streamers.File.Fsync }}"
+
+[streamers.grpc]
+keys = [{{ range .Streamers.GRPC.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# address defines the address the gRPC streaming endpoint binds to.
+address = "{{ .Streamers.GRPC.Address }}"
+
+# buffer-size defines the number of blocks queued for each client before the
+# client is considered to have fallen behind.
+buffer-size = {{ .Streamers.GRPC.BufferSize }}
+
+# history-size defines the number of recent blocks kept in memory so that
+# clients can resume streaming from a past height.
+history-size = {{ .Streamers.GRPC.HistorySize }}
+
+# halt-on-slow-client specifies if the node stops when a client falls behind,
+# otherwise the client is disconnected and has to resume streaming.
+halt-on-slow-client = {{ .Streamers.GRPC.HaltOnSlowClient }}
+
+[streamers.historical]
+keys = [{{ range .Streamers.Historical.Keys }}{{ printf "%q, " . }}{{end}}]
+
+# serve-queries specifies if the gRPC queries are served from the state changes
+# recorded in data/historical.db rather than from the IAVL versions, so that the
+# IAVL trees can be pruned. It requires keys to be ["*"]. The history of a node
+# with existing state must first be recorded with the backfill-historical
+# command, from an archive node.
+serve-queries = {{ .Streamers.Historical.ServeQueries }}
 `
 
 var configTemplate *template.Template
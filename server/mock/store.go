@@ -130,6 +130,10 @@ func (ms multiStore) SetIAVLCacheSize(size int) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetStorePruning(storeName string, opts pruningtypes.PruningOptions) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetIAVLDisableFastNode(disable bool) {
 	panic("not implemented")
 }
@@ -138,6 +142,10 @@ func (ms multiStore) SetLazyLoading(bool) {
 	panic("not implemented")
 }
 
+func (ms multiStore) SetAsyncCommit(int) {
+	panic("not implemented")
+}
+
 func (ms multiStore) SetInitialVersion(version int64) error {
 	panic("not implemented")
 }
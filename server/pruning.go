@@ -14,7 +14,29 @@ import (
 // PruningOptions. If a pruning strategy is provided, that will be parsed and
 // returned, otherwise, it is assumed custom pruning options are provided.
 func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningOptions, error) {
-	strategy := strings.ToLower(cast.ToString(appOpts.Get(FlagPruning)))
+	return getPruningOptions(appOpts, "")
+}
+
+// GetStorePruningOptionsFromFlags parses the pruning options of individual
+// stores, set in the 'store-pruning' section of the app config, and returns
+// them by store name.
+func GetStorePruningOptionsFromFlags(appOpts types.AppOptions) (map[string]pruningtypes.PruningOptions, error) {
+	storeOpts := make(map[string]pruningtypes.PruningOptions)
+	for storeName := range cast.ToStringMap(appOpts.Get(FlagStorePruning)) {
+		opts, err := getPruningOptions(appOpts, fmt.Sprintf("%s.%s.", FlagStorePruning, storeName))
+		if err != nil {
+			return nil, fmt.Errorf("store %s: %w", storeName, err)
+		}
+		storeOpts[storeName] = opts
+	}
+
+	return storeOpts, nil
+}
+
+// getPruningOptions parses the pruning options whose keys are prefixed by the
+// given prefix.
+func getPruningOptions(appOpts types.AppOptions, prefix string) (pruningtypes.PruningOptions, error) {
+	strategy := strings.ToLower(cast.ToString(appOpts.Get(prefix + FlagPruning)))
 
 	switch strategy {
 	case pruningtypes.PruningOptionDefault, pruningtypes.PruningOptionNothing, pruningtypes.PruningOptionEverything:
@@ -24,8 +46,8 @@ func GetPruningOptionsFromFlags(appOpts types.AppOptions) (pruningtypes.PruningO
 
 	case pruningtypes.PruningOptionCustom:
 		opts := pruningtypes.NewCustomPruningOptions(
-			cast.ToUint64(appOpts.Get(FlagPruningKeepRecent)),
-			cast.ToUint64(appOpts.Get(FlagPruningInterval)),
+			cast.ToUint64(appOpts.Get(prefix+FlagPruningKeepRecent)),
+			cast.ToUint64(appOpts.Get(prefix+FlagPruningInterval)),
 		)
 
 		if err := opts.Validate(); err != nil {
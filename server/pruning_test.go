@@ -1,12 +1,14 @@
 package server
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/server/config"
 )
 
 func TestGetPruningOptionsFromFlags(t *testing.T) {
@@ -66,3 +68,40 @@ func TestGetPruningOptionsFromFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetStorePruningOptionsFromFlags(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := config.DefaultConfig()
+	conf.StorePruning = map[string]config.StorePruningConfig{
+		"bank": {Pruning: pruningtypes.PruningOptionNothing},
+		"orderbook": {
+			Pruning:           pruningtypes.PruningOptionCustom,
+			PruningKeepRecent: "100",
+			PruningInterval:   "10",
+		},
+	}
+	config.WriteConfigFile(confFile, conf)
+
+	v := viper.New()
+	v.SetConfigFile(confFile)
+	require.NoError(t, v.ReadInConfig())
+
+	parsed, err := config.ParseConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, conf.StorePruning, parsed.StorePruning)
+
+	opts, err := GetPruningOptionsFromFlags(v)
+	require.NoError(t, err)
+	require.Equal(t, pruningtypes.NewPruningOptions(pruningtypes.PruningDefault), opts)
+
+	storeOpts, err := GetStorePruningOptionsFromFlags(v)
+	require.NoError(t, err)
+	require.Equal(t, map[string]pruningtypes.PruningOptions{
+		"bank":      pruningtypes.NewPruningOptions(pruningtypes.PruningNothing),
+		"orderbook": pruningtypes.NewCustomPruningOptions(100, 10),
+	}, storeOpts)
+
+	v.Set("store-pruning.orderbook.pruning-interval", "1")
+	_, err = GetStorePruningOptionsFromFlags(v)
+	require.Error(t, err)
+}
@@ -17,11 +17,11 @@ import (
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
-	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/rpc/client/local"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -51,14 +51,21 @@ const (
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
 
-	FlagPruning             = "pruning"
-	FlagPruningKeepRecent   = "pruning-keep-recent"
-	FlagPruningInterval     = "pruning-interval"
-	FlagIndexEvents         = "index-events"
-	FlagMinRetainBlocks     = "min-retain-blocks"
-	FlagIAVLCacheSize       = "iavl-cache-size"
-	FlagDisableIAVLFastNode = "iavl-disable-fastnode"
-	FlagIAVLLazyLoading     = "iavl-lazy-loading"
+	FlagPruning              = "pruning"
+	FlagPruningKeepRecent    = "pruning-keep-recent"
+	FlagPruningInterval      = "pruning-interval"
+	FlagStorePruning         = "store-pruning"
+	FlagIndexEvents          = "index-events"
+	FlagMinRetainBlocks      = "min-retain-blocks"
+	FlagIAVLCacheSize        = "iavl-cache-size"
+	FlagDisableIAVLFastNode  = "iavl-disable-fastnode"
+	FlagIAVLLazyLoading      = "iavl-lazy-loading"
+	FlagAsyncCommitQueueSize = "async-commit-queue-size"
+	FlagParallelTxWorkers    = "parallel-tx-workers"
+
+	FlagInterBlockCacheSize           = "inter-block-cache-size"
+	FlagInterBlockCacheWarmupVersions = "inter-block-cache-warmup-versions"
+	FlagInterBlockCacheStoreSize      = "inter-block-cache-store-size"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -166,6 +173,8 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Bool(FlagInterBlockCache, true, "Enable inter-block caching")
+	cmd.Flags().Uint64(FlagInterBlockCacheSize, 0, "Maximum size in bytes of the inter-block cache of each store (0 caches a fixed number of entries)")
+	cmd.Flags().Uint64(FlagInterBlockCacheWarmupVersions, 0, "Number of latest heights the written keys of which are loaded into the inter-block cache on start")
 	cmd.Flags().String(flagCPUProfile, "", "Enable CPU profiling and write to the provided file")
 	cmd.Flags().Bool(FlagTrace, false, "Provide full stack traces for errors in ABCI Log")
 	cmd.Flags().String(FlagPruning, pruningtypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
@@ -194,6 +203,8 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
 	cmd.Flags().Bool(FlagDisableIAVLFastNode, false, "Disable fast node for IAVL tree")
+	cmd.Flags().Uint64(FlagAsyncCommitQueueSize, 0, "Commit the stores asynchronously, with at most this number of heights pending persistence (0 commits synchronously)")
+	cmd.Flags().Uint(FlagParallelTxWorkers, 0, "Execute the transactions of a block optimistically in parallel with this number of workers (lower than 2 executes them serially)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -298,7 +309,7 @@ func startInProcess(ctx *Context, clientCtx client.Context, appCreator types.App
 			cfg,
 			pvm.LoadOrGenFilePV(cfg.PrivValidatorKeyFile(), cfg.PrivValidatorStateFile()),
 			nodeKey,
-			proxy.NewLocalClientCreator(app),
+			baseapp.NewLocalClientCreator(app),
 			genDocProvider,
 			node.DefaultDBProvider,
 			node.DefaultMetricsProvider(cfg.Instrumentation),
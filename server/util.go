@@ -31,7 +31,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
-	"github.com/cosmos/cosmos-sdk/store"
+	storecache "github.com/cosmos/cosmos-sdk/store/cache"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -422,7 +422,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 	var cache sdk.MultiStorePersistentCache
 
 	if cast.ToBool(appOpts.Get(FlagInterBlockCache)) {
-		cache = store.NewCommitKVStoreCacheManager()
+		cache = GetInterBlockCacheFromFlags(appOpts)
 	}
 
 	pruningOpts, err := GetPruningOptionsFromFlags(appOpts)
@@ -430,6 +430,11 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		panic(err)
 	}
 
+	storePruningOpts, err := GetStorePruningOptionsFromFlags(appOpts)
+	if err != nil {
+		panic(err)
+	}
+
 	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
 	if err = os.MkdirAll(snapshotDir, os.ModePerm); err != nil {
 		panic(fmt.Errorf("failed to create snapshots directory: %w", err))
@@ -449,7 +454,7 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		cast.ToUint32(appOpts.Get(FlagStateSyncSnapshotKeepRecent)),
 	)
 
-	return []func(*baseapp.BaseApp){
+	baseappOptions := []func(*baseapp.BaseApp){
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(cast.ToString(appOpts.Get(FlagMinGasPrices))),
 		baseapp.SetHaltHeight(cast.ToUint64(appOpts.Get(FlagHaltHeight))),
@@ -462,5 +467,25 @@ func DefaultBaseappOptions(appOpts types.AppOptions) []func(*baseapp.BaseApp) {
 		baseapp.SetIAVLCacheSize(cast.ToInt(appOpts.Get(FlagIAVLCacheSize))),
 		baseapp.SetIAVLDisableFastNode(cast.ToBool(appOpts.Get(FlagDisableIAVLFastNode))),
 		baseapp.SetIAVLLazyLoading(cast.ToBool(appOpts.Get(FlagIAVLLazyLoading))),
+		baseapp.SetAsyncCommit(cast.ToInt(appOpts.Get(FlagAsyncCommitQueueSize))),
+		baseapp.SetParallelExecution(cast.ToInt(appOpts.Get(FlagParallelTxWorkers))),
+	}
+	for storeName, opts := range storePruningOpts {
+		baseappOptions = append(baseappOptions, baseapp.SetStorePruning(storeName, opts))
 	}
+
+	return baseappOptions
+}
+
+// GetInterBlockCacheFromFlags returns the inter-block cache configured by the
+// inter-block cache flags, with the size of individual stores read from the
+// inter-block-cache-store-size section of the app config.
+func GetInterBlockCacheFromFlags(appOpts types.AppOptions) sdk.MultiStorePersistentCache {
+	cache := storecache.NewSizedCommitKVStoreCacheManager(cast.ToUint64(appOpts.Get(FlagInterBlockCacheSize)))
+	cache.SetWarmupVersions(cast.ToInt64(appOpts.Get(FlagInterBlockCacheWarmupVersions)))
+	for storeName, size := range cast.ToStringMap(appOpts.Get(FlagInterBlockCacheStoreSize)) {
+		cache.SetStoreCacheSize(storeName, cast.ToUint64(size))
+	}
+
+	return cache
 }
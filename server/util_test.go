@@ -10,7 +10,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/cosmos/iavl"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmcfg "github.com/tendermint/tendermint/config"
@@ -22,6 +24,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	iavlstore "github.com/cosmos/cosmos-sdk/store/iavl"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
@@ -523,3 +527,36 @@ func TestGetAppDBBackend(t *testing.T) {
 		})
 	}
 }
+
+func TestGetInterBlockCacheFromFlags(t *testing.T) {
+	confFile := filepath.Join(t.TempDir(), "app.toml")
+	conf := config.DefaultConfig()
+	conf.InterBlockCacheSize = 10
+	conf.InterBlockCacheStoreSize = map[string]uint64{"bank": 20}
+	config.WriteConfigFile(confFile, conf)
+
+	v := viper.New()
+	v.SetConfigFile(confFile)
+	require.NoError(t, v.ReadInConfig())
+	mngr := server.GetInterBlockCacheFromFlags(v)
+
+	// stores caching one and two entries of 10 bytes respectively
+	for name, cached := range map[string]int{"acc": 1, "bank": 2} {
+		tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 100, false)
+		require.NoError(t, err)
+		store := iavlstore.UnsafeNewStore(tree)
+		kvStore := mngr.GetStoreCache(storetypes.NewKVStoreKey(name), store)
+
+		for i := 0; i < 3; i++ {
+			kvStore.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+			store.Set([]byte(fmt.Sprintf("key%d", i)), []byte("new"))
+		}
+		for i := 2; i >= 0; i-- {
+			expected := "new"
+			if i >= 3-cached {
+				expected = "value"
+			}
+			require.Equal(t, []byte(expected), kvStore.Get([]byte(fmt.Sprintf("key%d", i))), "store %s key %d", name, i)
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/capability"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
@@ -128,8 +129,13 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
 		},
 		{
-			"can register 2->3 migration handler for x/bank, can run migration",
+			"can register 2->3 migration handler for x/bank, cannot run migration",
 			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, can run migration",
+			"bank", 3,
 			false, "", false, "", 1,
 		},
 		{
@@ -188,6 +194,7 @@ func TestRunMigrations(t *testing.T) {
 					"crisis":       crisis.AppModule{}.ConsensusVersion(),
 					"genutil":      genutil.AppModule{}.ConsensusVersion(),
 					"capability":   capability.AppModule{}.ConsensusVersion(),
+					"epoching":     epoching.AppModule{}.ConsensusVersion(),
 				},
 			)
 			if tc.expRunErr {
@@ -240,6 +247,7 @@ func TestInitGenesisOnMigration(t *testing.T) {
 			"crisis":       crisis.AppModule{}.ConsensusVersion(),
 			"genutil":      genutil.AppModule{}.ConsensusVersion(),
 			"capability":   capability.AppModule{}.ConsensusVersion(),
+			"epoching":     epoching.AppModule{}.ConsensusVersion(),
 		},
 	)
 	require.NoError(t, err)
@@ -11,6 +11,7 @@ const (
 	DefaultWeightMsgDeposit                     int = 100
 	DefaultWeightMsgVote                        int = 67
 	DefaultWeightMsgVoteWeighted                int = 33
+	DefaultWeightMsgCancelProposal              int = 5
 	DefaultWeightMsgUnjail                      int = 100
 	DefaultWeightMsgCreateValidator             int = 100
 	DefaultWeightMsgEditValidator               int = 5
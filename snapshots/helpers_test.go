@@ -139,11 +139,11 @@ func (m *mockSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) er
 }
 
 func (m *mockSnapshotter) SnapshotFormat() uint32 {
-	return snapshottypes.CurrentFormat
+	return snapshottypes.FormatStream
 }
 
 func (m *mockSnapshotter) SupportedFormats() []uint32 {
-	return []uint32{snapshottypes.CurrentFormat}
+	return []uint32{snapshottypes.FormatStream}
 }
 
 func (m *mockSnapshotter) PruneSnapshotHeight(height int64) {
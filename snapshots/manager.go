@@ -10,6 +10,7 @@ import (
 	"sort"
 	"sync"
 
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
@@ -178,9 +179,16 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
-	go m.createSnapshot(height, ch)
+	if _, ok := m.multistore.(types.StoreSnapshotter); !ok {
+		go m.createSnapshot(height, ch)
+		return m.store.Save(height, types.FormatStream, ch)
+	}
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	var chunkStreams []uint32
+	go m.createParallelSnapshot(height, ch, &chunkStreams)
+	return m.store.save(height, types.FormatParallel, ch, func(snapshot *types.Snapshot) {
+		snapshot.Metadata.ChunkStreams = chunkStreams
+	})
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
@@ -200,10 +208,18 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 		streamWriter.CloseWithError(err)
 		return
 	}
+	if err := m.snapshotExtensions(height, streamWriter); err != nil {
+		streamWriter.CloseWithError(err)
+		return
+	}
+}
+
+// snapshotExtensions writes the items of the extensions, each preceded by its metadata.
+func (m *Manager) snapshotExtensions(height uint64, protoWriter protoio.Writer) error {
 	for _, name := range m.sortedExtensionNames() {
 		extension := m.extensions[name]
 		// write extension metadata
-		err := streamWriter.WriteMsg(&types.SnapshotItem{
+		err := protoWriter.WriteMsg(&types.SnapshotItem{
 			Item: &types.SnapshotItem_Extension{
 				Extension: &types.SnapshotExtensionMeta{
 					Name:   name,
@@ -212,14 +228,13 @@ func (m *Manager) createSnapshot(height uint64, ch chan<- io.ReadCloser) {
 			},
 		})
 		if err != nil {
-			streamWriter.CloseWithError(err)
-			return
+			return err
 		}
-		if err := extension.Snapshot(height, streamWriter); err != nil {
-			streamWriter.CloseWithError(err)
-			return
+		if err := extension.Snapshot(height, protoWriter); err != nil {
+			return err
 		}
 	}
+	return nil
 }
 
 // List lists snapshots, mirroring ABCI ListSnapshots. It can be concurrent with other operations.
@@ -267,7 +282,20 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	defer m.mtx.Unlock()
 
 	// check multistore supported format preemptive
-	if snapshot.Format != types.CurrentFormat {
+	switch snapshot.Format {
+	case types.FormatStream:
+	case types.FormatParallel:
+		if _, ok := m.multistore.(types.StoreSnapshotter); !ok {
+			return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+		}
+		if uint32(len(snapshot.Metadata.ChunkStreams)) != snapshot.Chunks {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata, "snapshot has %v chunk streams, but %v chunks",
+				len(snapshot.Metadata.ChunkStreams), snapshot.Chunks)
+		}
+		if _, err := validateChunkStreams(snapshot.Metadata.ChunkStreams); err != nil {
+			return err
+		}
+	default:
 		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
 	}
 	if snapshot.Height == 0 {
@@ -288,7 +316,12 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	chDone := make(chan restoreDone, 1)
 
 	go func() {
-		err := m.restoreSnapshot(snapshot, chChunks)
+		var err error
+		if snapshot.Format == types.FormatParallel {
+			err = m.restoreParallelSnapshot(snapshot, chChunks)
+		} else {
+			err = m.restoreSnapshot(snapshot, chChunks)
+		}
 		chDone <- restoreDone{
 			complete: err == nil,
 			err:      err,
@@ -315,6 +348,13 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 	if err != nil {
 		return sdkerrors.Wrap(err, "multistore restore")
 	}
+	return m.restoreExtensions(snapshot.Height, next, streamReader)
+}
+
+// restoreExtensions restores the extensions from the stream, starting with the metadata of the
+// first one.
+func (m *Manager) restoreExtensions(height uint64, next types.SnapshotItem, protoReader protoio.Reader) error {
+	var err error
 	for {
 		if next.Item == nil {
 			// end of stream
@@ -331,7 +371,7 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 		if !IsFormatSupported(extension, metadata.Format) {
 			return sdkerrors.Wrapf(types.ErrUnknownFormat, "format %v for extension %s", metadata.Format, metadata.Name)
 		}
-		next, err = extension.Restore(snapshot.Height, metadata.Format, streamReader)
+		next, err = extension.Restore(height, metadata.Format, protoReader)
 		if err != nil {
 			return sdkerrors.Wrapf(err, "extension %s restore", metadata.Name)
 		}
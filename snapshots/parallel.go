@@ -0,0 +1,427 @@
+package snapshots
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// parallelSnapshotStreams is the number of streams whose chunks are interleaved at a time in the
+// FormatParallel format. Do not change it without new snapshot format (must be uniform across nodes)
+const parallelSnapshotStreams = 8
+
+// streamChunk is a chunk of a stream, or the error which ended it.
+type streamChunk struct {
+	body []byte
+	err  error
+}
+
+// createParallelSnapshot writes the chunks of a FormatParallel snapshot to the channel, and the
+// index of their stream to chunkStreams. Each store, and then the extensions, are written in an
+// independent stream, as with the FormatStream format.
+func (m *Manager) createParallelSnapshot(height uint64, ch chan<- io.ReadCloser, chunkStreams *[]uint32) {
+	defer close(ch)
+
+	multistore := m.multistore.(types.StoreSnapshotter)
+	storeNames, err := multistore.SnapshotStoreNames(height)
+	if err != nil {
+		ch <- errorChunk(err)
+		return
+	}
+
+	streams := make([]func(protoio.Writer) error, 0, len(storeNames))
+	for _, name := range storeNames {
+		name := name
+		streams = append(streams, func(protoWriter protoio.Writer) error {
+			return multistore.SnapshotStore(height, name, protoWriter)
+		})
+	}
+	emit := func(stream int, chunk []byte) {
+		*chunkStreams = append(*chunkStreams, uint32(stream))
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	if err := interleaveStreams(streams, parallelSnapshotStreams, emit); err != nil {
+		ch <- errorChunk(err)
+		return
+	}
+
+	// the extensions are restored once the stores are, so their stream follows the ones of the
+	// stores, not to be held by the restoration while the stores wait for their chunks
+	if len(m.extensions) == 0 {
+		return
+	}
+	extensions := func(protoWriter protoio.Writer) error {
+		return m.snapshotExtensions(height, protoWriter)
+	}
+	err = interleaveStreams([]func(protoio.Writer) error{extensions}, 1, func(_ int, chunk []byte) {
+		emit(len(streams), chunk)
+	})
+	if err != nil {
+		ch <- errorChunk(err)
+	}
+}
+
+// interleaveStreams writes the streams concurrently, up to window of them at a time, and emits
+// their chunks interleaved in a deterministic order: the chunks of the streams being written are
+// taken in turn, in the order the streams were started, and a stream is started, in order, when
+// another one has no more chunks.
+func interleaveStreams(streams []func(protoio.Writer) error, window int, emit func(stream int, chunk []byte)) error {
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
+	type activeStream struct {
+		index  int
+		chunks <-chan streamChunk
+	}
+	var active []activeStream
+	next := 0
+	startNext := func() {
+		if next < len(streams) {
+			active = append(active, activeStream{index: next, chunks: writeStream(streams[next], done, &wg)})
+			next++
+		}
+	}
+	for next < len(streams) && next < window {
+		startNext()
+	}
+
+	for len(active) > 0 {
+		for i := 0; i < len(active); {
+			chunk, ok := <-active[i].chunks
+			if !ok {
+				active = append(active[:i], active[i+1:]...)
+				startNext()
+				continue
+			}
+			if chunk.err != nil {
+				return chunk.err
+			}
+			emit(active[i].index, chunk.body)
+			i++
+		}
+	}
+	return nil
+}
+
+// interleavedChunkStreams returns the streams of the chunks interleaved by interleaveStreams, given
+// the number of chunks of each stream.
+func interleavedChunkStreams(chunkCounts []int, window int) []uint32 {
+	type activeStream struct {
+		index     int
+		remaining int
+	}
+	var (
+		active       []activeStream
+		chunkStreams []uint32
+	)
+	next := 0
+	startNext := func() {
+		if next < len(chunkCounts) {
+			active = append(active, activeStream{index: next, remaining: chunkCounts[next]})
+			next++
+		}
+	}
+	for next < len(chunkCounts) && next < window {
+		startNext()
+	}
+
+	for len(active) > 0 {
+		for i := 0; i < len(active); {
+			if active[i].remaining == 0 {
+				active = append(active[:i], active[i+1:]...)
+				startNext()
+				continue
+			}
+			chunkStreams = append(chunkStreams, uint32(active[i].index))
+			active[i].remaining--
+			i++
+		}
+	}
+	return chunkStreams
+}
+
+// validateChunkStreams checks that the streams of the chunks are laid out as written by
+// createParallelSnapshot, and returns whether the last stream follows the others, as the one of
+// the extensions does.
+func validateChunkStreams(chunkStreams []uint32) (bool, error) {
+	var chunkCounts []int
+	for _, stream := range chunkStreams {
+		if int(stream) >= len(chunkStreams) {
+			return false, sdkerrors.Wrapf(types.ErrInvalidMetadata, "invalid chunk stream %v", stream)
+		}
+		for int(stream) >= len(chunkCounts) {
+			chunkCounts = append(chunkCounts, 0)
+		}
+		chunkCounts[stream]++
+	}
+	for stream, count := range chunkCounts {
+		if count == 0 {
+			return false, sdkerrors.Wrapf(types.ErrInvalidMetadata, "no chunks for stream %v", stream)
+		}
+	}
+	if len(chunkCounts) == 0 {
+		return false, nil
+	}
+
+	last := len(chunkCounts) - 1
+	trailing := interleavedChunkStreams(chunkCounts[:last], parallelSnapshotStreams)
+	for i := 0; i < chunkCounts[last]; i++ {
+		trailing = append(trailing, uint32(last))
+	}
+	if equalChunkStreams(chunkStreams, trailing) {
+		return true, nil
+	}
+	if equalChunkStreams(chunkStreams, interleavedChunkStreams(chunkCounts, parallelSnapshotStreams)) {
+		return false, nil
+	}
+	return false, sdkerrors.Wrap(types.ErrInvalidMetadata, "invalid chunk streams layout")
+}
+
+func equalChunkStreams(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// writeStream writes a stream in the background, and returns the channel of its chunks, which
+// is closed once the stream is complete or failed, or once done is closed.
+func writeStream(write func(protoio.Writer) error, done <-chan struct{}, wg *sync.WaitGroup) <-chan streamChunk {
+	pipes := make(chan io.ReadCloser)
+	chunks := make(chan streamChunk, 1)
+
+	var writeErr error
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		streamWriter := NewStreamWriter(pipes)
+		if streamWriter == nil {
+			return
+		}
+		if err := write(streamWriter); err != nil {
+			writeErr = err
+			streamWriter.CloseWithError(err)
+			return
+		}
+		if err := streamWriter.Close(); err != nil {
+			writeErr = err
+			streamWriter.CloseWithError(err)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		defer close(chunks)
+		// the chunks are read in memory, so that the stream is written while the previous chunk
+		// waits for its turn
+		for pipe := range pipes {
+			body, err := io.ReadAll(pipe)
+			pipe.Close()
+			select {
+			case chunks <- streamChunk{body: body, err: err}:
+			case <-done:
+				DrainChunks(pipes)
+				return
+			}
+			if err != nil {
+				DrainChunks(pipes)
+				return
+			}
+		}
+		// the pipes are closed once the writer returned, an error may not have been passed
+		// through a chunk
+		if writeErr != nil {
+			select {
+			case chunks <- streamChunk{err: writeErr}:
+			case <-done:
+			}
+		}
+	}()
+
+	return chunks
+}
+
+// restoreParallelSnapshot restores a FormatParallel snapshot, dispatching the chunks to the
+// restoration of their stream. The stores are imported concurrently, and the extensions once all
+// the stores are imported.
+func (m *Manager) restoreParallelSnapshot(snapshot types.Snapshot, chChunks <-chan io.ReadCloser) error {
+	chunkStreams := snapshot.Metadata.ChunkStreams
+	trailing, err := validateChunkStreams(chunkStreams)
+	if err != nil {
+		DrainChunks(chChunks)
+		return err
+	}
+	lastChunks := make(map[uint32]int)
+	for i, stream := range chunkStreams {
+		lastChunks[stream] = i
+	}
+
+	restore := &parallelRestore{
+		multistore: m.multistore.(types.StoreSnapshotter),
+		manager:    m,
+		height:     snapshot.Height,
+		storeNames: make(map[string]bool),
+	}
+	restore.storesDone.Add(len(lastChunks))
+
+	var workers sync.WaitGroup
+	errs := make(chan error, len(lastChunks))
+	streams := make(map[uint32]chan io.ReadCloser, len(lastChunks))
+	for stream := range lastChunks {
+		ch := make(chan io.ReadCloser, chunkBufferSize)
+		streams[stream] = ch
+		// only a stream following the others can hold the extensions, which wait for the
+		// stores to be restored
+		extensions := trailing && int(stream) == len(lastChunks)-1
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			errs <- restore.restoreStream(ch, extensions)
+		}()
+	}
+
+	for i := 0; ; i++ {
+		chunk, ok := <-chChunks
+		if !ok {
+			break
+		}
+		if i >= len(chunkStreams) {
+			chunk.Close()
+			DrainChunks(chChunks)
+			break
+		}
+		stream := chunkStreams[i]
+		streams[stream] <- chunk
+		if lastChunks[stream] == i {
+			close(streams[stream])
+			delete(streams, stream)
+		}
+	}
+	// the streams whose chunks were not all received fail to be read
+	for _, ch := range streams {
+		close(ch)
+	}
+
+	workers.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return restore.commit()
+}
+
+// parallelRestore is the state of the restoration of a FormatParallel snapshot shared by its
+// streams.
+type parallelRestore struct {
+	multistore types.StoreSnapshotter
+	manager    *Manager
+	height     uint64
+
+	// storesDone is done once the streams of the stores are restored
+	storesDone sync.WaitGroup
+
+	mtx        sync.Mutex
+	storeNames map[string]bool
+	failed     bool
+
+	commitOnce sync.Once
+	commitErr  error
+}
+
+// restoreStream restores the stream of a store, or of the extensions if allowed.
+func (r *parallelRestore) restoreStream(chunks <-chan io.ReadCloser, allowExtensions bool) (err error) {
+	isStore := true
+	defer func() {
+		if err != nil {
+			r.mtx.Lock()
+			r.failed = true
+			r.mtx.Unlock()
+		}
+		if isStore {
+			r.storesDone.Done()
+		}
+	}()
+
+	streamReader, err := NewStreamReader(chunks)
+	if err != nil {
+		DrainChunks(chunks)
+		return err
+	}
+	defer streamReader.Close()
+
+	var item types.SnapshotItem
+	if err := streamReader.ReadMsg(&item); err != nil {
+		return sdkerrors.Wrap(err, "invalid protobuf message")
+	}
+
+	switch item := item.Item.(type) {
+	case *types.SnapshotItem_Store:
+		r.mtx.Lock()
+		duplicate := r.storeNames[item.Store.Name]
+		r.storeNames[item.Store.Name] = true
+		r.mtx.Unlock()
+		if duplicate {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "duplicate snapshot stream of store %q", item.Store.Name)
+		}
+		if err := r.multistore.RestoreStore(r.height, item.Store.Name, streamReader); err != nil {
+			return sdkerrors.Wrapf(err, "multistore restore of store %q", item.Store.Name)
+		}
+		return nil
+
+	case *types.SnapshotItem_Extension:
+		if !allowExtensions {
+			return sdkerrors.Wrap(sdkerrors.ErrLogic, "unexpected snapshot stream of extensions")
+		}
+		isStore = false
+		r.storesDone.Done()
+
+		// the extensions are restored on top of the restored stores
+		r.storesDone.Wait()
+		r.mtx.Lock()
+		failed := r.failed
+		r.mtx.Unlock()
+		if failed {
+			return sdkerrors.Wrap(sdkerrors.ErrLogic, "multistore restore failed")
+		}
+		if err := r.commit(); err != nil {
+			return err
+		}
+		return r.manager.restoreExtensions(r.height, types.SnapshotItem{Item: item}, streamReader)
+
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot stream item %T", item)
+	}
+}
+
+// commit completes the restoration of the multistore, once.
+func (r *parallelRestore) commit() error {
+	r.commitOnce.Do(func() {
+		if err := r.multistore.CommitRestore(r.height); err != nil {
+			r.commitErr = sdkerrors.Wrap(err, "multistore restore")
+		}
+	})
+	return r.commitErr
+}
+
+// errorChunk returns a chunk failing to be read with the error.
+func errorChunk(err error) io.ReadCloser {
+	pr, pw := io.Pipe()
+	_ = pw.CloseWithError(err) // CloseWithError always returns nil
+	return pr
+}
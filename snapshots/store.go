@@ -222,6 +222,14 @@ func (s *Store) Prune(retain uint32) (uint64, error) {
 // Save saves a snapshot to disk, returning it.
 func (s *Store) Save(
 	height uint64, format uint32, chunks <-chan io.ReadCloser,
+) (*types.Snapshot, error) {
+	return s.save(height, format, chunks, nil)
+}
+
+// save saves a snapshot to disk, calling finalize, if not nil, to complete its metadata once all
+// the chunks are saved.
+func (s *Store) save(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, finalize func(*types.Snapshot),
 ) (*types.Snapshot, error) {
 	defer DrainChunks(chunks)
 	if height == 0 {
@@ -290,6 +298,9 @@ func (s *Store) Save(
 	}
 	snapshot.Chunks = index
 	snapshot.Hash = snapshotHasher.Sum(nil)
+	if finalize != nil {
+		finalize(snapshot)
+	}
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
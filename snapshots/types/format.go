@@ -1,6 +1,16 @@
 package types
 
+const (
+	// FormatStream is the format serializing the stores and the extensions in a single stream.
+	FormatStream uint32 = 2
+
+	// FormatParallel is the format serializing each store, and the extensions, in an independent
+	// stream, the chunks of the streams being interleaved. The stores are exported and imported
+	// concurrently, and the stream of each chunk is recorded in the snapshot metadata.
+	FormatParallel uint32 = 3
+)
+
 // CurrentFormat is the currently used format for snapshots. Snapshots using the same format
 // must be identical across all nodes for a given height, so this must be bumped when the binary
 // snapshot output changes.
-const CurrentFormat uint32 = 2
+const CurrentFormat = FormatParallel
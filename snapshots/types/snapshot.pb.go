@@ -103,6 +103,9 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// chunk_streams is the index of the stream of each chunk, for the formats
+	// splitting the snapshot in independent streams.
+	ChunkStreams []uint32 `protobuf:"varint,2,rep,packed,name=chunk_streams,json=chunkStreams,proto3" json:"chunk_streams,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +148,13 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetChunkStreams() []uint32 {
+	if m != nil {
+		return m.ChunkStreams
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 //
 // Since: cosmos-sdk 0.46
@@ -628,44 +638,45 @@ func init() {
 }
 
 var fileDescriptor_dd7a3c9b0a19e1ee = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x13, 0xc7, 0xa4, 0x63, 0x83, 0xda, 0x55, 0x41, 0x16, 0x12, 0x6e, 0xb0, 0x90, 0x9a,
-	0x43, 0x6b, 0xd3, 0x50, 0x09, 0xae, 0x04, 0x41, 0x5d, 0x15, 0x04, 0xda, 0xa0, 0x1c, 0xb8, 0x54,
-	0x9b, 0x64, 0x1b, 0x47, 0x8e, 0xb3, 0x51, 0x76, 0x63, 0x91, 0x23, 0x7f, 0xc0, 0xaf, 0xf0, 0x17,
-	0x3d, 0xf6, 0xc8, 0x29, 0x42, 0xc9, 0x8f, 0xa0, 0x5d, 0xdb, 0x69, 0x29, 0x2d, 0xa4, 0xa7, 0xcc,
-	0x4c, 0xde, 0x7b, 0x9e, 0x9d, 0xb7, 0xb3, 0xb0, 0xd7, 0x65, 0x3c, 0x61, 0x3c, 0xe8, 0x10, 0x4e,
-	0x03, 0x3e, 0x22, 0x63, 0x1e, 0x31, 0xc1, 0x83, 0xf4, 0xa0, 0x43, 0x05, 0x39, 0x58, 0x55, 0xfc,
-	0xf1, 0x84, 0x09, 0x86, 0x9e, 0x64, 0x68, 0x5f, 0xa2, 0xfd, 0x15, 0xda, 0xcf, 0xd1, 0x8f, 0xb7,
-	0xfb, 0xac, 0xcf, 0x14, 0x32, 0x90, 0x51, 0x46, 0xf2, 0x7e, 0xe8, 0x50, 0x6d, 0xe5, 0x58, 0xf4,
-	0x08, 0xcc, 0x88, 0x0e, 0xfa, 0x91, 0x70, 0xf4, 0x9a, 0x5e, 0x37, 0x70, 0x9e, 0xc9, 0xfa, 0x19,
-	0x9b, 0x24, 0x44, 0x38, 0xa5, 0x9a, 0x5e, 0xbf, 0x8f, 0xf3, 0x4c, 0xd6, 0xbb, 0xd1, 0x74, 0x14,
-	0x73, 0xa7, 0x9c, 0xd5, 0xb3, 0x0c, 0x21, 0x30, 0x22, 0xc2, 0x23, 0xc7, 0xa8, 0xe9, 0x75, 0x1b,
-	0xab, 0x18, 0x1d, 0x43, 0x35, 0xa1, 0x82, 0xf4, 0x88, 0x20, 0x4e, 0xa5, 0xa6, 0xd7, 0xad, 0xc6,
-	0xae, 0xff, 0xcf, 0x86, 0xfd, 0x0f, 0x39, 0xbc, 0x69, 0x9c, 0xcf, 0x77, 0x34, 0xbc, 0xa2, 0x7b,
-	0xfb, 0x50, 0x2d, 0xfe, 0x43, 0x4f, 0xc1, 0x56, 0x1f, 0x3d, 0x95, 0x1f, 0xa1, 0xdc, 0xd1, 0x6b,
-	0xe5, 0xba, 0x8d, 0x2d, 0x55, 0x0b, 0x55, 0xc9, 0xfb, 0x66, 0x80, 0x5d, 0x1c, 0xf1, 0x58, 0xd0,
-	0x04, 0x85, 0x50, 0xe1, 0x82, 0x4d, 0xa8, 0x3a, 0xa5, 0xd5, 0x78, 0xfe, 0x9f, 0x3e, 0x0a, 0x6e,
-	0x4b, 0x72, 0xa4, 0x40, 0xa8, 0xe1, 0x4c, 0x00, 0x7d, 0x04, 0x63, 0x40, 0xd2, 0xa1, 0x1a, 0x8b,
-	0xd5, 0x08, 0xd6, 0x14, 0x3a, 0x7e, 0xdd, 0x7e, 0x2f, 0x75, 0x9a, 0xd5, 0xc5, 0x7c, 0xc7, 0x90,
-	0x59, 0xa8, 0x61, 0x25, 0x84, 0x3e, 0xc3, 0x06, 0xfd, 0x2a, 0xe8, 0x88, 0x0f, 0xd8, 0x48, 0x0d,
-	0xd5, 0x6a, 0x1c, 0xae, 0xa9, 0xfa, 0xb6, 0xe0, 0xc9, 0xd9, 0x84, 0x1a, 0xbe, 0x14, 0x42, 0x67,
-	0xb0, 0xb5, 0x4a, 0x4e, 0xc7, 0x64, 0x36, 0x64, 0xa4, 0xa7, 0xcc, 0xb1, 0x1a, 0x2f, 0xef, 0xaa,
-	0xfe, 0x29, 0xa3, 0x87, 0x1a, 0xde, 0xa4, 0xd7, 0x6a, 0xe8, 0x08, 0x4a, 0x71, 0x9a, 0xbb, 0xbb,
-	0xbf, 0xa6, 0xf0, 0x49, 0x5b, 0x8d, 0xc2, 0x5c, 0xcc, 0x77, 0x4a, 0x27, 0xed, 0x50, 0xc3, 0xa5,
-	0x38, 0x45, 0x47, 0x60, 0xf2, 0x6e, 0x44, 0x13, 0xe2, 0x98, 0x77, 0x12, 0x6b, 0x29, 0x52, 0xa8,
-	0xe1, 0x9c, 0xde, 0x34, 0xc1, 0x18, 0x08, 0x9a, 0x78, 0xbb, 0xb0, 0xf5, 0x97, 0x8d, 0xf2, 0x9a,
-	0x8e, 0x48, 0x92, 0x5d, 0x83, 0x0d, 0xac, 0x62, 0x6f, 0x08, 0x9b, 0xd7, 0x6d, 0x42, 0x9b, 0x50,
-	0x8e, 0xe9, 0x4c, 0xc1, 0x6c, 0x2c, 0x43, 0xb4, 0x0d, 0x95, 0x94, 0x0c, 0xa7, 0x54, 0x19, 0x6f,
-	0xe3, 0x2c, 0x41, 0x0e, 0xdc, 0x4b, 0xe9, 0x64, 0x65, 0x5d, 0x19, 0x17, 0xe9, 0x95, 0xc5, 0x92,
-	0x53, 0xaf, 0x14, 0x8b, 0xe5, 0xbd, 0x81, 0x87, 0x37, 0xda, 0x77, 0x53, 0x6b, 0xb7, 0x6d, 0xa1,
-	0x77, 0x08, 0xce, 0x6d, 0x2e, 0xc9, 0x96, 0x0a, 0xbf, 0xb3, 0xf6, 0x8b, 0xd4, 0x7b, 0x05, 0x0f,
-	0xfe, 0xb4, 0x60, 0xdd, 0x63, 0x7a, 0xcf, 0x2e, 0x99, 0xd9, 0xbc, 0x65, 0xb7, 0x31, 0x9d, 0x15,
-	0xcb, 0xa7, 0xe2, 0xe6, 0xbb, 0xf3, 0x85, 0xab, 0x5f, 0x2c, 0x5c, 0xfd, 0xd7, 0xc2, 0xd5, 0xbf,
-	0x2f, 0x5d, 0xed, 0x62, 0xe9, 0x6a, 0x3f, 0x97, 0xae, 0xf6, 0x65, 0xaf, 0x3f, 0x10, 0xd1, 0xb4,
-	0xe3, 0x77, 0x59, 0x12, 0xe4, 0x0f, 0x5c, 0xf6, 0xb3, 0xcf, 0x7b, 0xf1, 0x95, 0x67, 0x4e, 0xcc,
-	0xc6, 0x94, 0x77, 0x4c, 0xf5, 0x4e, 0xbd, 0xf8, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x70, 0xb0, 0xd0,
-	0xad, 0x0c, 0x05, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xb6, 0x13, 0x27, 0x7f, 0x3a, 0x71, 0xab, 0x76, 0xd5, 0x1f, 0x59, 0x48, 0xb8, 0xc6, 0x20,
+	0xd5, 0x87, 0xd6, 0xa6, 0xa1, 0x12, 0x5c, 0x09, 0x82, 0xba, 0x2a, 0x08, 0xb4, 0x41, 0x3d, 0x70,
+	0xa9, 0x36, 0xe9, 0x36, 0x8e, 0x1c, 0x67, 0xa3, 0xec, 0xd6, 0x22, 0x47, 0xde, 0x80, 0x57, 0xe1,
+	0x2d, 0x7a, 0xec, 0x91, 0x53, 0x85, 0xd2, 0x17, 0x41, 0xbb, 0x6b, 0x87, 0xb6, 0xb4, 0x90, 0x9e,
+	0x32, 0x33, 0xf9, 0xbe, 0x6f, 0xc7, 0xf3, 0xed, 0x2c, 0x6c, 0xf5, 0x18, 0xcf, 0x18, 0x8f, 0xba,
+	0x84, 0xd3, 0x88, 0x8f, 0xc8, 0x98, 0x27, 0x4c, 0xf0, 0x28, 0xdf, 0xe9, 0x52, 0x41, 0x76, 0xe6,
+	0x95, 0x70, 0x3c, 0x61, 0x82, 0xa1, 0x47, 0x1a, 0x1d, 0x4a, 0x74, 0x38, 0x47, 0x87, 0x05, 0xfa,
+	0xe1, 0x7a, 0x9f, 0xf5, 0x99, 0x42, 0x46, 0x32, 0xd2, 0x24, 0xff, 0xbb, 0x09, 0x8d, 0x4e, 0x81,
+	0x45, 0x0f, 0xa0, 0x9e, 0xd0, 0x41, 0x3f, 0x11, 0x8e, 0xe9, 0x99, 0x81, 0x85, 0x8b, 0x4c, 0xd6,
+	0x4f, 0xd8, 0x24, 0x23, 0xc2, 0xa9, 0x78, 0x66, 0xb0, 0x8c, 0x8b, 0x4c, 0xd6, 0x7b, 0xc9, 0xe9,
+	0x28, 0xe5, 0x4e, 0x55, 0xd7, 0x75, 0x86, 0x10, 0x58, 0x09, 0xe1, 0x89, 0x63, 0x79, 0x66, 0x60,
+	0x63, 0x15, 0xa3, 0x7d, 0x68, 0x64, 0x54, 0x90, 0x63, 0x22, 0x88, 0x53, 0xf3, 0xcc, 0xa0, 0xd9,
+	0xda, 0x0c, 0xff, 0xda, 0x70, 0xf8, 0xbe, 0x80, 0xb7, 0xad, 0xb3, 0x8b, 0x0d, 0x03, 0xcf, 0xe9,
+	0x3e, 0x86, 0x46, 0xf9, 0x1f, 0x7a, 0x0c, 0xb6, 0x3a, 0xf4, 0x48, 0x1e, 0x42, 0xb9, 0x63, 0x7a,
+	0xd5, 0xc0, 0xc6, 0x4d, 0x55, 0x8b, 0x55, 0x09, 0x3d, 0x81, 0x65, 0x0d, 0xe1, 0x62, 0x42, 0x49,
+	0xc6, 0x9d, 0x8a, 0x57, 0x0d, 0x96, 0xb1, 0xe6, 0x75, 0x74, 0xcd, 0xff, 0x6a, 0x81, 0x5d, 0xce,
+	0x61, 0x5f, 0xd0, 0x0c, 0xc5, 0x50, 0xe3, 0x82, 0x4d, 0xa8, 0x1a, 0x45, 0xb3, 0xf5, 0xec, 0x1f,
+	0xcd, 0x96, 0xdc, 0x8e, 0xe4, 0x48, 0x81, 0xd8, 0xc0, 0x5a, 0x00, 0x7d, 0x00, 0x6b, 0x40, 0xf2,
+	0xa1, 0x9a, 0x5d, 0xb3, 0x15, 0x2d, 0x28, 0xb4, 0xff, 0xea, 0xf0, 0x9d, 0xd4, 0x69, 0x37, 0x66,
+	0x17, 0x1b, 0x96, 0xcc, 0x62, 0x03, 0x2b, 0x21, 0xf4, 0x09, 0x96, 0xe8, 0x17, 0x41, 0x47, 0x7c,
+	0xc0, 0x46, 0x6a, 0xf2, 0xcd, 0xd6, 0xee, 0x82, 0xaa, 0x6f, 0x4a, 0x9e, 0x1c, 0x60, 0x6c, 0xe0,
+	0xdf, 0x42, 0xe8, 0x04, 0xd6, 0xe6, 0xc9, 0xd1, 0x98, 0x4c, 0x87, 0x8c, 0x1c, 0x2b, 0x07, 0x9b,
+	0xad, 0x17, 0xf7, 0x55, 0xff, 0xa8, 0xe9, 0xb1, 0x81, 0x57, 0xe9, 0x8d, 0x1a, 0xda, 0x83, 0x4a,
+	0x9a, 0x17, 0x57, 0x60, 0x7b, 0x41, 0xe1, 0x83, 0x43, 0x35, 0x8a, 0xfa, 0xec, 0x62, 0xa3, 0x72,
+	0x70, 0x18, 0x1b, 0xb8, 0x92, 0xe6, 0x68, 0x0f, 0xea, 0xbc, 0x97, 0xd0, 0x8c, 0x38, 0xf5, 0x7b,
+	0x89, 0x75, 0x14, 0x29, 0x36, 0x70, 0x41, 0x6f, 0xd7, 0xc1, 0x1a, 0x08, 0x9a, 0xf9, 0x9b, 0xb0,
+	0xf6, 0x87, 0x8d, 0xf2, 0x2e, 0x8f, 0x48, 0xa6, 0xaf, 0xc1, 0x12, 0x56, 0xb1, 0x3f, 0x84, 0xd5,
+	0x9b, 0x36, 0xa1, 0x55, 0xa8, 0xa6, 0x74, 0xaa, 0x60, 0x36, 0x96, 0x21, 0x5a, 0x87, 0x5a, 0x4e,
+	0x86, 0xa7, 0x54, 0x19, 0x6f, 0x63, 0x9d, 0x20, 0x07, 0xfe, 0xcb, 0xe9, 0x64, 0x6e, 0x5d, 0x15,
+	0x97, 0xe9, 0x95, 0xed, 0x93, 0x53, 0xaf, 0x95, 0xdb, 0xe7, 0xbf, 0x86, 0xff, 0x6f, 0xb5, 0xef,
+	0xb6, 0xd6, 0xee, 0x5a, 0x55, 0x7f, 0x17, 0x9c, 0xbb, 0x5c, 0x92, 0x2d, 0x95, 0x7e, 0xeb, 0xf6,
+	0xcb, 0xd4, 0x7f, 0x09, 0x2b, 0xd7, 0x2d, 0x58, 0xf4, 0x33, 0xfd, 0xa7, 0xb0, 0x72, 0x7d, 0xde,
+	0xb2, 0xdb, 0x94, 0x4e, 0xcb, 0x0d, 0x55, 0x71, 0xfb, 0xed, 0xd9, 0xcc, 0x35, 0xcf, 0x67, 0xae,
+	0xf9, 0x73, 0xe6, 0x9a, 0xdf, 0x2e, 0x5d, 0xe3, 0xfc, 0xd2, 0x35, 0x7e, 0x5c, 0xba, 0xc6, 0xe7,
+	0xad, 0xfe, 0x40, 0x24, 0xa7, 0xdd, 0xb0, 0xc7, 0xb2, 0xa8, 0x78, 0x05, 0xf5, 0xcf, 0x36, 0x3f,
+	0x4e, 0xaf, 0xbc, 0x85, 0x62, 0x3a, 0xa6, 0xbc, 0x5b, 0x57, 0x8f, 0xd9, 0xf3, 0x5f, 0x03, 0x00,
+	0xa8, 0x46, 0xda, 0x5e, 0x31, 0x05, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -743,6 +754,24 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChunkStreams) > 0 {
+		dAtA3 := make([]byte, len(m.ChunkStreams)*10)
+		var j2 int
+		for _, num := range m.ChunkStreams {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintSnapshot(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -1171,6 +1200,13 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if len(m.ChunkStreams) > 0 {
+		l = 0
+		for _, e := range m.ChunkStreams {
+			l += sovSnapshot(uint64(e))
+		}
+		n += 1 + sovSnapshot(uint64(l)) + l
+	}
 	return n
 }
 
@@ -1596,6 +1632,82 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChunkStreams = append(m.ChunkStreams, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSnapshot
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthSnapshot
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChunkStreams) == 0 {
+					m.ChunkStreams = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSnapshot
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChunkStreams = append(m.ChunkStreams, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkStreams", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
@@ -28,6 +28,26 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// StoreSnapshotter is a Snapshotter which exports and imports its stores independently, so that
+// they can be snapshotted and restored concurrently in the FormatParallel format.
+type StoreSnapshotter interface {
+	Snapshotter
+
+	// SnapshotStoreNames returns the names of the stores to snapshot at the height, in order.
+	SnapshotStoreNames(height uint64) ([]string, error)
+
+	// SnapshotStore writes the snapshot items of a store into the protobuf writer, starting
+	// with its SnapshotStoreItem.
+	SnapshotStore(height uint64, storeName string, protoWriter protoio.Writer) error
+
+	// RestoreStore imports the snapshot items of a store, following its SnapshotStoreItem,
+	// until the end of the protobuf reader. It is called concurrently for different stores.
+	RestoreStore(height uint64, storeName string, protoReader protoio.Reader) error
+
+	// CommitRestore completes the restoration once all the stores are imported.
+	CommitRestore(height uint64) error
+}
+
 // ExtensionSnapshotter is an extension Snapshotter that is appended to the snapshot stream.
 // ExtensionSnapshotter has an unique name and manages it's own internal formats.
 type ExtensionSnapshotter interface {
@@ -1,33 +1,37 @@
 package cache
 
 import (
-	"fmt"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/iavl"
 
 	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/types"
-
-	lru "github.com/hashicorp/golang-lru"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 )
 
 var (
 	_ types.CommitKVStore             = (*CommitKVStoreCache)(nil)
 	_ types.MultiStorePersistentCache = (*CommitKVStoreCacheManager)(nil)
 
-	// DefaultCommitKVStoreCacheSize defines the persistent ARC cache size for a
-	// CommitKVStoreCache.
+	// DefaultCommitKVStoreCacheSize defines the number of entries of a
+	// CommitKVStoreCache without a size limit in bytes.
 	DefaultCommitKVStoreCacheSize uint = 1000
 )
 
 type (
 	// CommitKVStoreCache implements an inter-block (persistent) cache that wraps a
-	// CommitKVStore. Reads first hit the internal ARC (Adaptive Replacement Cache).
-	// During a cache miss, the read is delegated to the underlying CommitKVStore
-	// and cached. Deletes and writes always happen to both the cache and the
-	// CommitKVStore in a write-through manner. Caching performed in the
-	// CommitKVStore and below is completely irrelevant to this layer.
+	// CommitKVStore. Reads first hit the internal LRU (Least Recently Used) cache,
+	// bounded by a number of entries or by the size in bytes of its keys and
+	// values. During a cache miss, the read is delegated to the underlying
+	// CommitKVStore and cached. Deletes and writes always happen to both the
+	// cache and the CommitKVStore in a write-through manner. Caching performed in
+	// the CommitKVStore and below is completely irrelevant to this layer.
 	CommitKVStoreCache struct {
 		types.CommitKVStore
-		cache *lru.ARCCache
+		cache *lruCache
+
+		// labels of the metrics of the cache
+		labels []metrics.Label
 	}
 
 	// CommitKVStoreCacheManager maintains a mapping from a StoreKey to a
@@ -35,41 +39,107 @@ type (
 	// in an inter-block (persistent) manner and typically provided by a
 	// CommitMultiStore.
 	CommitKVStoreCacheManager struct {
-		cacheSize uint
-		caches    map[string]types.CommitKVStore
+		cacheSize      uint
+		maxBytes       uint64
+		storeMaxBytes  map[string]uint64
+		warmupVersions int64
+		caches         map[string]types.CommitKVStore
+	}
+
+	// versionedStore is implemented by the stores which can list the changes
+	// made at their saved versions, like the IAVL stores.
+	versionedStore interface {
+		GetAllVersions() []int
+		TraverseStateChanges(startVersion, endVersion int64, fn func(version int64, changeSet *iavl.ChangeSet) error) error
 	}
 )
 
+// NewCommitKVStoreCache returns a CommitKVStoreCache caching the given number
+// of entries of the store.
 func NewCommitKVStoreCache(store types.CommitKVStore, size uint) *CommitKVStoreCache {
-	cache, err := lru.NewARC(int(size))
-	if err != nil {
-		panic(fmt.Errorf("failed to create KVStore cache: %s", err))
+	return newCommitKVStoreCache(store, "", int(size), 0)
+}
+
+// NewSizedCommitKVStoreCache returns a CommitKVStoreCache caching the entries
+// of the store up to the given size in bytes of their keys and values.
+func NewSizedCommitKVStoreCache(store types.CommitKVStore, maxBytes uint64) *CommitKVStoreCache {
+	return newCommitKVStoreCache(store, "", 0, maxBytes)
+}
+
+func newCommitKVStoreCache(store types.CommitKVStore, storeName string, maxEntries int, maxBytes uint64) *CommitKVStoreCache {
+	var labels []metrics.Label
+	if storeName != "" {
+		labels = []metrics.Label{telemetry.NewLabel("store", storeName)}
 	}
 
 	return &CommitKVStoreCache{
 		CommitKVStore: store,
-		cache:         cache,
+		cache:         newLRUCache(maxEntries, maxBytes),
+		labels:        labels,
 	}
 }
 
+// NewCommitKVStoreCacheManager returns a CommitKVStoreCacheManager caching the
+// given number of entries of each store.
 func NewCommitKVStoreCacheManager(size uint) *CommitKVStoreCacheManager {
 	return &CommitKVStoreCacheManager{
-		cacheSize: size,
-		caches:    make(map[string]types.CommitKVStore),
+		cacheSize:     size,
+		storeMaxBytes: make(map[string]uint64),
+		caches:        make(map[string]types.CommitKVStore),
 	}
 }
 
+// NewSizedCommitKVStoreCacheManager returns a CommitKVStoreCacheManager caching
+// the entries of each store up to the given size in bytes of their keys and
+// values. A zero size caches DefaultCommitKVStoreCacheSize entries instead.
+func NewSizedCommitKVStoreCacheManager(maxBytes uint64) *CommitKVStoreCacheManager {
+	cmgr := NewCommitKVStoreCacheManager(DefaultCommitKVStoreCacheSize)
+	cmgr.maxBytes = maxBytes
+	return cmgr
+}
+
+// SetStoreCacheSize sets the size in bytes of the keys and values cached for
+// the store with the given name, overriding the size of the manager. It
+// applies to the caches created afterwards.
+func (cmgr *CommitKVStoreCacheManager) SetStoreCacheSize(storeName string, maxBytes uint64) {
+	cmgr.storeMaxBytes[storeName] = maxBytes
+}
+
+// SetWarmupVersions sets the number of the latest versions of the stores the
+// changes of which are loaded into their caches when created, so that the
+// most recently written keys are cached after a restart. The stores must be
+// able to list their changes, like the IAVL stores, and must have kept these
+// versions.
+func (cmgr *CommitKVStoreCacheManager) SetWarmupVersions(versions int64) {
+	cmgr.warmupVersions = versions
+}
+
 // GetStoreCache returns a Cache from the CommitStoreCacheManager for a given
 // StoreKey. If no Cache exists for the StoreKey, then one is created and set.
 // The returned Cache is meant to be used in a persistent manner.
 func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
 	if cmgr.caches[key.Name()] == nil {
-		cmgr.caches[key.Name()] = NewCommitKVStoreCache(store, cmgr.cacheSize)
+		cache := cmgr.newStoreCache(key.Name(), store)
+		if cmgr.warmupVersions > 0 {
+			cache.warmUp(cmgr.warmupVersions)
+		}
+		cmgr.caches[key.Name()] = cache
 	}
 
 	return cmgr.caches[key.Name()]
 }
 
+func (cmgr *CommitKVStoreCacheManager) newStoreCache(storeName string, store types.CommitKVStore) *CommitKVStoreCache {
+	maxBytes, ok := cmgr.storeMaxBytes[storeName]
+	if !ok {
+		maxBytes = cmgr.maxBytes
+	}
+	if maxBytes > 0 {
+		return newCommitKVStoreCache(store, storeName, 0, maxBytes)
+	}
+	return newCommitKVStoreCache(store, storeName, int(cmgr.cacheSize), 0)
+}
+
 // Unwrap returns the underlying CommitKVStore for a given StoreKey.
 func (cmgr *CommitKVStoreCacheManager) Unwrap(key types.StoreKey) types.CommitKVStore {
 	if ckv, ok := cmgr.caches[key.Name()]; ok {
@@ -89,6 +159,43 @@ func (cmgr *CommitKVStoreCacheManager) Reset() {
 	}
 }
 
+// warmUp caches the keys written at the given number of latest versions of
+// the store, up to its current version, the most recently written ones last
+// so that they are evicted last. The cache is left empty if the changes of
+// the store cannot be read.
+func (ckv *CommitKVStoreCache) warmUp(versions int64) {
+	store, ok := ckv.CommitKVStore.(versionedStore)
+	if !ok {
+		return
+	}
+	available := store.GetAllVersions()
+	if len(available) < 2 {
+		return
+	}
+
+	// the changes of a version are relative to the previous saved version,
+	// there are none before the first one
+	latest := ckv.CommitKVStore.LastCommitID().Version
+	start := latest - versions + 1
+	if first := int64(available[0]); start <= first {
+		start = first + 1
+	}
+
+	err := store.TraverseStateChanges(start, latest+1, func(_ int64, changeSet *iavl.ChangeSet) error {
+		for _, pair := range changeSet.Pairs {
+			if pair.Delete {
+				ckv.cache.remove(string(pair.Key))
+			} else {
+				ckv.cache.add(string(pair.Key), pair.Value)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		ckv.cache.purge()
+	}
+}
+
 // CacheWrap implements the CacheWrapper interface
 func (ckv *CommitKVStoreCache) CacheWrap() types.CacheWrap {
 	return cachekv.NewStore(ckv)
@@ -101,15 +208,17 @@ func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
 	types.AssertValidKey(key)
 
 	keyStr := string(key)
-	valueI, ok := ckv.cache.Get(keyStr)
+	value, ok := ckv.cache.get(keyStr)
 	if ok {
 		// cache hit
-		return valueI.([]byte)
+		telemetry.IncrCounterWithLabels([]string{"store", "inter_block_cache", "hit"}, 1, ckv.labels)
+		return value
 	}
 
 	// cache miss; write to cache
-	value := ckv.CommitKVStore.Get(key)
-	ckv.cache.Add(keyStr, value)
+	telemetry.IncrCounterWithLabels([]string{"store", "inter_block_cache", "miss"}, 1, ckv.labels)
+	value = ckv.CommitKVStore.Get(key)
+	ckv.add(keyStr, value)
 
 	return value
 }
@@ -120,13 +229,19 @@ func (ckv *CommitKVStoreCache) Set(key, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
 
-	ckv.cache.Add(string(key), value)
+	ckv.add(string(key), value)
 	ckv.CommitKVStore.Set(key, value)
 }
 
 // Delete removes a key/value pair from both the write-through cache and the
 // underlying CommitKVStore.
 func (ckv *CommitKVStoreCache) Delete(key []byte) {
-	ckv.cache.Remove(string(key))
+	ckv.cache.remove(string(key))
 	ckv.CommitKVStore.Delete(key)
 }
+
+func (ckv *CommitKVStoreCache) add(key string, value []byte) {
+	if evicted := ckv.cache.add(key, value); evicted > 0 {
+		telemetry.IncrCounterWithLabels([]string{"store", "inter_block_cache", "eviction"}, float32(evicted), ckv.labels)
+	}
+}
@@ -67,3 +67,86 @@ func TestStoreCache(t *testing.T) {
 		require.Nil(t, store.Get(key))
 	}
 }
+
+func TestSizedStoreCache(t *testing.T) {
+	mngr := cache.NewSizedCommitKVStoreCacheManager(100)
+	mngr.SetStoreCacheSize("large", 1000)
+
+	for name, maxBytes := range map[string]int{"test": 100, "large": 1000} {
+		tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 100, false)
+		require.NoError(t, err)
+		store := iavlstore.UnsafeNewStore(tree)
+		kvStore := mngr.GetStoreCache(types.NewKVStoreKey(name), store)
+
+		// entries of 10 bytes, the oldest ones being evicted
+		for i := 0; i < 200; i++ {
+			kvStore.Set([]byte(fmt.Sprintf("key%04d", i)), []byte("val"))
+		}
+
+		// the cached entries are served even if the store is modified below
+		// the cache, showing which ones were evicted
+		for i := 0; i < 200; i++ {
+			store.Set([]byte(fmt.Sprintf("key%04d", i)), []byte("new"))
+		}
+		// the most recent ones first, as the misses are cached
+		for i := 199; i >= 0; i-- {
+			value := kvStore.Get([]byte(fmt.Sprintf("key%04d", i)))
+			if i >= 200-maxBytes/10 {
+				require.Equal(t, []byte("val"), value, "store %s key %d", name, i)
+			} else {
+				require.Equal(t, []byte("new"), value, "store %s key %d", name, i)
+			}
+		}
+	}
+
+	// a value larger than the cache is not cached
+	tree, err := iavl.NewMutableTree(dbm.NewMemDB(), 100, false)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+	kvStore := cache.NewSizedCommitKVStoreCache(store, 100)
+	kvStore.Set([]byte("key"), make([]byte, 100))
+	store.Set([]byte("key"), []byte("new"))
+	require.Equal(t, []byte("new"), kvStore.Get([]byte("key")))
+}
+
+func TestStoreCacheWarmup(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree, err := iavl.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	store := iavlstore.UnsafeNewStore(tree)
+
+	// version i writes key i and deletes key i-2
+	for i := 1; i <= 10; i++ {
+		store.Set([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		if i > 2 {
+			store.Delete([]byte(fmt.Sprintf("key%d", i-2)))
+		}
+		store.Commit()
+	}
+
+	// load the store at an older version, the later ones are not cached
+	tree, err = iavl.NewMutableTree(db, 100, false)
+	require.NoError(t, err)
+	_, err = tree.LoadVersion(8)
+	require.NoError(t, err)
+	store = iavlstore.UnsafeNewStore(tree)
+
+	mngr := cache.NewCommitKVStoreCacheManager(cache.DefaultCommitKVStoreCacheSize)
+	mngr.SetWarmupVersions(3)
+	kvStore := mngr.GetStoreCache(types.NewKVStoreKey("test"), store)
+
+	// modify the store below the cache, the keys written at versions 6 to 8
+	// are served from the cache
+	for i := 1; i <= 10; i++ {
+		store.Set([]byte(fmt.Sprintf("key%d", i)), []byte("new"))
+	}
+	for i := 1; i <= 10; i++ {
+		value := kvStore.Get([]byte(fmt.Sprintf("key%d", i)))
+		if i == 7 || i == 8 {
+			require.Equal(t, []byte(fmt.Sprintf("value%d", i)), value, "key %d", i)
+		} else {
+			// key 6 is written at version 6 but deleted at version 8
+			require.Equal(t, []byte("new"), value, "key %d", i)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+)
+
+// lruCache is a least recently used cache of values by key, bounded by the
+// number of entries and by the size in bytes of the keys and values. A zero
+// bound is unlimited. It is safe for concurrent use.
+type lruCache struct {
+	maxEntries int
+	maxBytes   uint64
+
+	mtx     sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List // the most recently used entry first
+	size    uint64
+}
+
+type lruEntry struct {
+	key   string
+	value []byte
+}
+
+func newLRUCache(maxEntries int, maxBytes uint64) *lruCache {
+	return &lruCache{
+		maxEntries: maxEntries,
+		maxBytes:   maxBytes,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+func entrySize(key string, value []byte) uint64 {
+	return uint64(len(key) + len(value))
+}
+
+// get returns the value of the key and marks it as the most recently used, a
+// nil value being cached as well.
+func (c *lruCache) get(key string) ([]byte, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruEntry).value, true
+}
+
+// add sets the value of the key as the most recently used, and returns the
+// number of entries evicted to stay within the bounds. A value larger than
+// the size bound is not cached.
+func (c *lruCache) add(key string, value []byte) int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.removeLocked(key)
+	size := entrySize(key, value)
+	if c.maxBytes > 0 && size > c.maxBytes {
+		return 0
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, value: value})
+	c.size += size
+
+	evicted := 0
+	for (c.maxEntries > 0 && c.order.Len() > c.maxEntries) || (c.maxBytes > 0 && c.size > c.maxBytes) {
+		c.removeLocked(c.order.Back().Value.(*lruEntry).key)
+		evicted++
+	}
+	return evicted
+}
+
+func (c *lruCache) remove(key string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.removeLocked(key)
+}
+
+// purge removes all the entries.
+func (c *lruCache) purge() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+	c.size = 0
+}
+
+func (c *lruCache) removeLocked(key string) {
+	elem, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	entry := c.order.Remove(elem).(*lruEntry)
+	delete(c.entries, key)
+	c.size -= entrySize(entry.key, entry.value)
+}
@@ -2,8 +2,10 @@ package gaskv
 
 import (
 	"io"
+	"sync"
 
 	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var _ types.KVStore = &Store{}
@@ -14,6 +16,36 @@ type Store struct {
 	gasMeter  types.GasMeter
 	gasConfig types.GasConfig
 	parent    types.KVStore
+
+	storeName string
+	limits    types.StoreLimits
+	writes    *WriteCounter
+}
+
+// WriteCounter counts the keys set in each store, shared by the stores of a
+// transaction to enforce their limit of writes.
+type WriteCounter struct {
+	mtx    sync.Mutex
+	counts map[string]uint64
+}
+
+// NewWriteCounter returns a WriteCounter with no writes counted.
+func NewWriteCounter() *WriteCounter {
+	return &WriteCounter{counts: make(map[string]uint64)}
+}
+
+// Count returns the number of keys set in the store with the given name.
+func (c *WriteCounter) Count(storeName string) uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.counts[storeName]
+}
+
+func (c *WriteCounter) increment(storeName string) uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.counts[storeName]++
+	return c.counts[storeName]
 }
 
 // NewStore returns a reference to a new GasKVStore.
@@ -26,6 +58,18 @@ func NewStore(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.Gas
 	return kvs
 }
 
+// NewStoreWithLimits returns a reference to a new GasKVStore which also
+// enforces the limits of the store with the given name, counting its writes
+// with the given counter. A write exceeding the limits panics with an
+// ErrStoreLimitExceeded error.
+func NewStoreWithLimits(parent types.KVStore, gasMeter types.GasMeter, gasConfig types.GasConfig, storeName string, limits types.StoreLimits, writes *WriteCounter) *Store {
+	kvs := NewStore(parent, gasMeter, gasConfig)
+	kvs.storeName = storeName
+	kvs.limits = limits
+	kvs.writes = writes
+	return kvs
+}
+
 // Implements Store.
 func (gs *Store) GetStoreType() types.StoreType {
 	return gs.parent.GetStoreType()
@@ -47,6 +91,7 @@ func (gs *Store) Get(key []byte) (value []byte) {
 func (gs *Store) Set(key []byte, value []byte) {
 	types.AssertValidKey(key)
 	types.AssertValidValue(value)
+	gs.checkLimits(key, value)
 	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostFlat, types.GasWriteCostFlatDesc)
 	// TODO overflow-safe math?
 	gs.gasMeter.ConsumeGas(gs.gasConfig.WriteCostPerByte*types.Gas(len(key)), types.GasWritePerByteDesc)
@@ -54,6 +99,22 @@ func (gs *Store) Set(key []byte, value []byte) {
 	gs.parent.Set(key, value)
 }
 
+// checkLimits panics if setting the key exceeds the limits of the store.
+func (gs *Store) checkLimits(key, value []byte) {
+	if gs.limits.MaxKeySize > 0 && uint64(len(key)) > gs.limits.MaxKeySize {
+		panic(sdkerrors.Wrapf(types.ErrStoreLimitExceeded,
+			"key of %d bytes exceeds the maximum key size of store %s: %d", len(key), gs.storeName, gs.limits.MaxKeySize))
+	}
+	if gs.limits.MaxValueSize > 0 && uint64(len(value)) > gs.limits.MaxValueSize {
+		panic(sdkerrors.Wrapf(types.ErrStoreLimitExceeded,
+			"value of %d bytes exceeds the maximum value size of store %s: %d", len(value), gs.storeName, gs.limits.MaxValueSize))
+	}
+	if gs.limits.MaxWrites > 0 && gs.writes.increment(gs.storeName) > gs.limits.MaxWrites {
+		panic(sdkerrors.Wrapf(types.ErrStoreLimitExceeded,
+			"exceeded the maximum number of writes of store %s: %d", gs.storeName, gs.limits.MaxWrites))
+	}
+}
+
 // Implements KVStore.
 func (gs *Store) Has(key []byte) bool {
 	gs.gasMeter.ConsumeGas(gs.gasConfig.HasCost, types.GasHasDesc)
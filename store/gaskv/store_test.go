@@ -118,3 +118,42 @@ func TestGasKVStoreOutOfGasIterator(t *testing.T) {
 	iterator.Next()
 	require.Panics(t, func() { iterator.Value() }, "Expected out-of-gas")
 }
+
+func TestGasKVStoreLimits(t *testing.T) {
+	mem := dbadapter.Store{DB: dbm.NewMemDB()}
+	meter := types.NewInfiniteGasMeter()
+	writes := gaskv.NewWriteCounter()
+	limits := types.StoreLimits{MaxKeySize: 11, MaxValueSize: 13, MaxWrites: 3}
+	st := gaskv.NewStoreWithLimits(mem, meter, types.KVGasConfig(), "test", limits, writes)
+
+	requireLimitExceeded := func(f func()) {
+		defer func() {
+			err, ok := recover().(error)
+			require.True(t, ok)
+			require.ErrorIs(t, err, types.ErrStoreLimitExceeded)
+		}()
+		f()
+	}
+
+	requireLimitExceeded(func() { st.Set(bz("key000000000"), valFmt(1)) })
+	requireLimitExceeded(func() { st.Set(keyFmt(1), bz("value000000000")) })
+	require.Empty(t, st.Get(keyFmt(1)))
+	require.Equal(t, uint64(0), writes.Count("test"))
+
+	// the writes are counted across the stores sharing the counter, the
+	// deletions not being counted
+	st.Set(keyFmt(1), valFmt(1))
+	st.Delete(keyFmt(1))
+	st2 := gaskv.NewStoreWithLimits(mem, meter, types.KVGasConfig(), "test", limits, writes)
+	st2.Set(keyFmt(2), valFmt(2))
+	st.Set(keyFmt(3), valFmt(3))
+	require.Equal(t, uint64(3), writes.Count("test"))
+	requireLimitExceeded(func() { st2.Set(keyFmt(4), valFmt(4)) })
+	require.Empty(t, st.Get(keyFmt(4)))
+
+	// the zero limits are unlimited
+	st = gaskv.NewStoreWithLimits(mem, meter, types.KVGasConfig(), "other", types.StoreLimits{}, writes)
+	for i := 0; i < 10; i++ {
+		st.Set(append(keyFmt(i), keyFmt(i)...), append(valFmt(i), valFmt(i)...))
+	}
+}
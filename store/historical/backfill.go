@@ -0,0 +1,73 @@
+package historical
+
+import (
+	"fmt"
+	"sort"
+
+	iavltree "github.com/cosmos/iavl"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// Backfill records the changes of the IAVL stores, by store name, at the
+// heights up to toHeight which are not recorded yet. The changes are extracted
+// by comparing the saved versions of the trees, so the stores must have kept
+// all the versions since the latest height recorded, or since the version 1 if
+// none was recorded, as archive nodes do: the state at a height can only be
+// rebuilt from all the changes before it.
+func (s *Store) Backfill(stores map[string]*iavl.Store, toHeight int64) error {
+	latest, err := s.LatestHeight()
+	if err != nil {
+		return err
+	}
+	if toHeight <= latest {
+		return nil
+	}
+
+	storeNames := make([]string, 0, len(stores))
+	for name, store := range stores {
+		if version := store.LastCommitID().Version; version < toHeight {
+			return fmt.Errorf("cannot backfill height %d, store %s is at height %d", toHeight, name, version)
+		}
+		if versions := store.GetAllVersions(); latest == 0 && len(versions) > 0 && versions[0] > 1 {
+			return fmt.Errorf("cannot backfill from height 1, store %s is pruned up to height %d", name, versions[0]-1)
+		}
+		storeNames = append(storeNames, name)
+	}
+	sort.Strings(storeNames)
+
+	for _, name := range storeNames {
+		err := stores[name].TraverseStateChanges(latest+1, toHeight+1, func(version int64, changeSet *iavltree.ChangeSet) error {
+			batch := s.db.NewBatch()
+			defer batch.Close()
+			for _, pair := range changeSet.Pairs {
+				err := writePair(batch, version, types.StoreKVPair{
+					StoreKey: name,
+					Delete:   pair.Delete,
+					Key:      pair.Key,
+					Value:    pair.Value,
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return batch.Write()
+		})
+		if err != nil {
+			return fmt.Errorf("failed to backfill store %s: %w", name, err)
+		}
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	if latest == 0 {
+		if err := setHeight(batch, earliestHeightKey, 1); err != nil {
+			return err
+		}
+	}
+	if err := setHeight(batch, latestHeightKey, toHeight); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
@@ -0,0 +1,120 @@
+package historical
+
+import (
+	"bytes"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// iterator iterates over the keys of a store at a given height, from the
+// changes of the keys ordered by key then height.
+type iterator struct {
+	source     dbm.Iterator
+	prefixLen  int
+	start, end []byte
+	height     []byte
+	reverse    bool
+
+	key, value []byte
+	valid      bool
+}
+
+var _ types.Iterator = (*iterator)(nil)
+
+func newIterator(source dbm.Iterator, prefixLen int, start, end []byte, height int64, reverse bool) *iterator {
+	itr := &iterator{
+		source:    source,
+		prefixLen: prefixLen,
+		start:     start,
+		end:       end,
+		height:    heightBytes(height),
+		reverse:   reverse,
+	}
+	itr.next()
+	return itr
+}
+
+// next moves to the next key existing at the iterator height. The changes of
+// a key are visited in increasing height order when iterating forward, and in
+// decreasing order otherwise, so the visible change is respectively the last
+// and the first one at or below the height.
+func (itr *iterator) next() {
+	for itr.source.Valid() {
+		change := itr.source.Key()
+		encKey := append([]byte{}, change[itr.prefixLen:len(change)-8]...)
+
+		var (
+			value []byte
+			found bool
+		)
+		for ; itr.source.Valid(); itr.source.Next() {
+			change := itr.source.Key()
+			if !bytes.Equal(change[itr.prefixLen:len(change)-8], encKey) {
+				break
+			}
+			if bytes.Compare(change[len(change)-8:], itr.height) > 0 {
+				continue
+			}
+			if !found || !itr.reverse {
+				value = decodeValue(itr.source.Value())
+				found = true
+			}
+		}
+
+		if value != nil {
+			itr.key = decodeKey(encKey)
+			itr.value = value
+			itr.valid = true
+			return
+		}
+	}
+
+	itr.key, itr.value = nil, nil
+	itr.valid = false
+}
+
+// Domain implements Iterator.
+func (itr *iterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements Iterator.
+func (itr *iterator) Valid() bool {
+	return itr.valid
+}
+
+// Next implements Iterator.
+func (itr *iterator) Next() {
+	itr.assertIsValid()
+	itr.next()
+}
+
+// Key implements Iterator.
+func (itr *iterator) Key() []byte {
+	itr.assertIsValid()
+	return itr.key
+}
+
+// Value implements Iterator.
+func (itr *iterator) Value() []byte {
+	itr.assertIsValid()
+	return itr.value
+}
+
+// Error implements Iterator.
+func (itr *iterator) Error() error {
+	return itr.source.Error()
+}
+
+// Close implements Iterator.
+func (itr *iterator) Close() error {
+	return itr.source.Close()
+}
+
+func (itr *iterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
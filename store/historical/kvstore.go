@@ -0,0 +1,76 @@
+package historical
+
+import (
+	"io"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// kvStore is a read-only KVStore serving the state of a store at a height.
+type kvStore struct {
+	store  *Store
+	name   string
+	height int64
+}
+
+var _ types.KVStore = (*kvStore)(nil)
+
+// Get implements KVStore.
+func (s *kvStore) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+	value, err := s.store.Get(s.name, key, s.height)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+// Has implements KVStore.
+func (s *kvStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+// Set implements KVStore, the historical state cannot be written.
+func (s *kvStore) Set(key, value []byte) {
+	panic("historical state is read-only")
+}
+
+// Delete implements KVStore, the historical state cannot be written.
+func (s *kvStore) Delete(key []byte) {
+	panic("historical state is read-only")
+}
+
+// Iterator implements KVStore.
+func (s *kvStore) Iterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, false)
+}
+
+// ReverseIterator implements KVStore.
+func (s *kvStore) ReverseIterator(start, end []byte) types.Iterator {
+	return s.iterator(start, end, true)
+}
+
+func (s *kvStore) iterator(start, end []byte, reverse bool) types.Iterator {
+	itr, err := s.store.Iterator(s.name, start, end, s.height, reverse)
+	if err != nil {
+		panic(err)
+	}
+	return itr
+}
+
+// GetStoreType implements Store.
+func (s *kvStore) GetStoreType() types.StoreType {
+	return types.StoreTypeDB
+}
+
+// CacheWrap implements CacheWrapper.
+func (s *kvStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (s *kvStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
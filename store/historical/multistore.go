@@ -0,0 +1,119 @@
+package historical
+
+import (
+	"fmt"
+	"io"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachemulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MultiStore is a read-only MultiStore serving the state recorded by a Store,
+// to be set as the query multistore of the BaseApp so that queries at past
+// heights do not require the IAVL versions to be kept.
+type MultiStore struct {
+	store      *Store
+	keysByName map[string]types.StoreKey
+}
+
+var _ types.MultiStore = (*MultiStore)(nil)
+
+// NewMultiStore returns a MultiStore serving the state of the stores with the
+// given keys.
+func NewMultiStore(store *Store, keys []types.StoreKey) *MultiStore {
+	keysByName := make(map[string]types.StoreKey, len(keys))
+	for _, key := range keys {
+		keysByName[key.Name()] = key
+	}
+	return &MultiStore{store: store, keysByName: keysByName}
+}
+
+// LatestVersion implements MultiStore.
+func (ms *MultiStore) LatestVersion() int64 {
+	latest, err := ms.store.LatestHeight()
+	if err != nil {
+		panic(err)
+	}
+	return latest
+}
+
+// CacheMultiStoreWithVersion implements MultiStore. An error is returned if the
+// version is outside of the heights recorded by the store.
+func (ms *MultiStore) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	earliest, err := ms.store.EarliestHeight()
+	if err != nil {
+		return nil, err
+	}
+	latest, err := ms.store.LatestHeight()
+	if err != nil {
+		return nil, err
+	}
+	if version < earliest || version > latest || latest == 0 {
+		return nil, sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"height %d is not in the historical store (earliest height: %d, latest height: %d)",
+			version, earliest, latest,
+		)
+	}
+
+	stores := make(map[types.StoreKey]types.CacheWrapper, len(ms.keysByName))
+	for name, key := range ms.keysByName {
+		stores[key] = &kvStore{store: ms.store, name: name, height: version}
+	}
+	return cachemulti.NewStore(dbm.NewMemDB(), stores, ms.keysByName, nil, nil), nil
+}
+
+// CacheMultiStore implements MultiStore, branching the state at the latest height.
+func (ms *MultiStore) CacheMultiStore() types.CacheMultiStore {
+	cms, err := ms.CacheMultiStoreWithVersion(ms.LatestVersion())
+	if err != nil {
+		panic(err)
+	}
+	return cms
+}
+
+// CacheWrap implements CacheWrapper.
+func (ms *MultiStore) CacheWrap() types.CacheWrap {
+	return ms.CacheMultiStore().(types.CacheWrap)
+}
+
+// CacheWrapWithTrace implements CacheWrapper.
+func (ms *MultiStore) CacheWrapWithTrace(_ io.Writer, _ types.TraceContext) types.CacheWrap {
+	return ms.CacheWrap()
+}
+
+// GetStore implements MultiStore, returning the store at the latest height.
+func (ms *MultiStore) GetStore(key types.StoreKey) types.Store {
+	return ms.GetKVStore(key)
+}
+
+// GetKVStore implements MultiStore, returning the store at the latest height.
+func (ms *MultiStore) GetKVStore(key types.StoreKey) types.KVStore {
+	if _, ok := ms.keysByName[key.Name()]; !ok {
+		panic(fmt.Sprintf("store does not exist for key: %s", key.Name()))
+	}
+	return &kvStore{store: ms.store, name: key.Name(), height: ms.LatestVersion()}
+}
+
+// GetStoreType implements Store.
+func (ms *MultiStore) GetStoreType() types.StoreType {
+	return types.StoreTypeMulti
+}
+
+// TracingEnabled implements MultiStore, the historical state is not traced.
+func (ms *MultiStore) TracingEnabled() bool {
+	return false
+}
+
+// SetTracer implements MultiStore, the historical state is not traced.
+func (ms *MultiStore) SetTracer(_ io.Writer) types.MultiStore {
+	return ms
+}
+
+// SetTracingContext implements MultiStore, the historical state is not traced.
+func (ms *MultiStore) SetTracingContext(_ types.TraceContext) types.MultiStore {
+	return ms
+}
@@ -0,0 +1,125 @@
+package historical
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// DBName is the name of the database of the historical state in the data
+// directory of the node.
+const DBName = "historical"
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that
+// records the state changes of each committed block into a Store.
+type StreamingService struct {
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
+	storeKeys      []types.StoreKey
+	db             dbm.DB
+	store          *Store
+
+	currentBlockNumber int64
+}
+
+// NewStreamingService creates a StreamingService recording the changes of the
+// stores with the given keys into the database.
+func NewStreamingService(db dbm.DB, storeKeys []types.StoreKey) *StreamingService {
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+	}
+
+	return &StreamingService{
+		storeListeners: listeners,
+		storeKeys:      storeKeys,
+		db:             db,
+		store:          NewStore(db),
+	}
+}
+
+// Store returns the Store the changes are recorded into.
+func (s *StreamingService) Store() *Store {
+	return s.store
+}
+
+// MultiStore returns a MultiStore serving the recorded state of the stores,
+// to be set as the query multistore of the BaseApp.
+func (s *StreamingService) MultiStore() *MultiStore {
+	return NewMultiStore(s.store, s.storeKeys)
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (s *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(s.storeListeners))
+	for _, listener := range s.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It sets the current
+// block number, and returns an error if a block is missing from the recorded
+// history, e.g. because the service was enabled on a node with existing state
+// which was not backfilled. As the state at a height is rebuilt from all the
+// changes before it, an empty history can only be recorded from the first
+// block of the chain, which has no previous block.
+func (s *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	latest, err := s.store.LatestHeight()
+	if err != nil {
+		return err
+	}
+	if latest == 0 && req.Header.Height != 1 && len(req.Header.LastBlockId.Hash) != 0 {
+		return fmt.Errorf(
+			"historical store is empty and cannot record height %d, it must be backfilled from height 1",
+			req.Header.Height,
+		)
+	}
+	if latest != 0 && req.Header.Height != latest+1 {
+		return fmt.Errorf(
+			"historical store is at height %d and cannot record height %d, it must be backfilled",
+			latest, req.Header.Height,
+		)
+	}
+
+	s.currentBlockNumber = req.Header.Height
+	return nil
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface.
+func (s *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	return nil
+}
+
+// ListenEndBlock satisfies the ABCIListener interface.
+func (s *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	return nil
+}
+
+// ListenCommit satisfies the ABCIListener interface. It records the changes
+// made to the stores since the previous commit at the current block number.
+func (s *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	var pairs []types.StoreKVPair
+	for _, listener := range s.storeListeners {
+		pairs = append(pairs, listener.PopStateCache()...)
+	}
+
+	return s.store.WriteChangeset(s.currentBlockNumber, pairs)
+}
+
+// Stream satisfies the StreamingService interface, the changes are recorded
+// synchronously on commit.
+func (s *StreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+
+// Close satisfies the io.Closer interface, closing the database.
+func (s *StreamingService) Close() error { return s.db.Close() }
@@ -0,0 +1,129 @@
+package historical_test
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/historical"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestStreamingServiceAndBackfill(t *testing.T) {
+	keys := []types.StoreKey{types.NewKVStoreKey("acc"), types.NewKVStoreKey("bank")}
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	for _, key := range keys {
+		ms.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, ms.LoadLatestVersion())
+
+	service := historical.NewStreamingService(dbm.NewMemDB(), keys)
+	for key, listeners := range service.Listeners() {
+		ms.AddListeners(key, listeners)
+	}
+
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(1))
+	for height := int64(1); height <= 20; height++ {
+		require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+		for i := 0; i < 10; i++ {
+			store := ms.GetKVStore(keys[r.Intn(len(keys))])
+			key := []byte(fmt.Sprintf("key%d", r.Intn(20)))
+			if r.Intn(3) == 0 {
+				store.Delete(key)
+			} else {
+				store.Set(key, []byte(fmt.Sprintf("value%d-%d", height, i)))
+			}
+		}
+		ms.Commit()
+		require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}))
+	}
+
+	// a missing height is detected
+	require.Error(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: 22}}, abci.ResponseBeginBlock{}))
+
+	// backfill the history from the IAVL versions, in two steps
+	backfilled := historical.NewStore(dbm.NewMemDB())
+	stores := make(map[string]*iavl.Store)
+	for _, key := range keys {
+		stores[key.Name()] = ms.GetCommitKVStore(key).(*iavl.Store)
+	}
+	require.NoError(t, backfilled.Backfill(stores, 12))
+	require.NoError(t, backfilled.Backfill(stores, 20))
+	require.Error(t, backfilled.Backfill(stores, 21))
+
+	for _, store := range []*historical.Store{service.Store(), backfilled} {
+		earliest, err := store.EarliestHeight()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), earliest)
+
+		hms := historical.NewMultiStore(store, keys)
+		require.Equal(t, int64(20), hms.LatestVersion())
+
+		for height := int64(1); height <= 20; height++ {
+			expected, err := ms.CacheMultiStoreWithVersion(height)
+			require.NoError(t, err)
+			actual, err := hms.CacheMultiStoreWithVersion(height)
+			require.NoError(t, err)
+
+			for _, key := range keys {
+				require.Equal(t,
+					iteratedPairs(expected.GetKVStore(key).Iterator(nil, nil)),
+					iteratedPairs(actual.GetKVStore(key).Iterator(nil, nil)),
+					"height %d store %s", height, key.Name(),
+				)
+			}
+		}
+	}
+}
+
+func TestStreamingServiceEnabledOnExistingState(t *testing.T) {
+	key := types.NewKVStoreKey("bank")
+	keys := []types.StoreKey{key}
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	ms.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	// the key is written before the service is enabled
+	for height := int64(1); height <= 5; height++ {
+		ms.GetKVStore(key).Set([]byte(fmt.Sprintf("key%d", height)), []byte("value"))
+		ms.Commit()
+	}
+
+	ctx := context.Background()
+	header := tmproto.Header{Height: 6, LastBlockId: tmproto.BlockID{Hash: []byte("block5")}}
+	service := historical.NewStreamingService(dbm.NewMemDB(), keys)
+	for key, listeners := range service.Listeners() {
+		ms.AddListeners(key, listeners)
+	}
+
+	// recording cannot start with existing state
+	require.Error(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: header}, abci.ResponseBeginBlock{}))
+
+	// once backfilled, the state written before is served
+	stores := map[string]*iavl.Store{key.Name(): ms.GetCommitKVStore(key).(*iavl.Store)}
+	require.NoError(t, service.Store().Backfill(stores, 5))
+	require.NoError(t, service.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: header}, abci.ResponseBeginBlock{}))
+	ms.GetKVStore(key).Set([]byte("key6"), []byte("value"))
+	ms.Commit()
+	require.NoError(t, service.ListenCommit(ctx, abci.ResponseCommit{}))
+
+	hms := historical.NewMultiStore(service.Store(), keys)
+	cms, err := hms.CacheMultiStoreWithVersion(6)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), cms.GetKVStore(key).Get([]byte("key1")))
+	require.Equal(t, []byte("value"), cms.GetKVStore(key).Get([]byte("key6")))
+
+	// the state cannot be rebuilt from pruned stores
+	require.NoError(t, stores[key.Name()].DeleteVersions(1, 2))
+	require.Error(t, historical.NewStore(dbm.NewMemDB()).Backfill(stores, 6))
+}
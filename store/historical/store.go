@@ -0,0 +1,197 @@
+package historical
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const (
+	latestHeightKey   = "s/latest"
+	earliestHeightKey = "s/earliest"
+
+	// prefix of the changes, followed by the store name and the escaped key
+	changePrefix = 'c'
+
+	valueDeleted = byte(0)
+	valueSet     = byte(1)
+)
+
+var errWriteHeight = errors.New("historical store: heights must be written in increasing order")
+
+// Store persists the changes made to the KV stores of a multistore at each
+// height in its own database, and serves the state of the stores at any height
+// between the earliest and the latest one it has recorded.
+//
+// A change is stored under the store name, the key and the height, in an order
+// preserving encoding, so that the state of a key at a given height is the last
+// change at or below it, and that the keys of a store are iterated in order.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore returns a Store persisting the changes in the given database.
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// LatestHeight returns the latest height recorded, 0 if none.
+func (s *Store) LatestHeight() (int64, error) {
+	return s.getHeight(latestHeightKey)
+}
+
+// EarliestHeight returns the first height recorded, 0 if none.
+func (s *Store) EarliestHeight() (int64, error) {
+	return s.getHeight(earliestHeightKey)
+}
+
+func (s *Store) getHeight(key string) (int64, error) {
+	bz, err := s.db.Get([]byte(key))
+	if err != nil || bz == nil {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// WriteChangeset records the changes made at the given height, which must be
+// greater than the latest height recorded, and makes it the latest height.
+func (s *Store) WriteChangeset(height int64, pairs []types.StoreKVPair) error {
+	latest, err := s.LatestHeight()
+	if err != nil {
+		return err
+	}
+	if height <= latest {
+		return fmt.Errorf("%w: height %d, latest height %d", errWriteHeight, height, latest)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	for _, pair := range pairs {
+		if err := writePair(batch, height, pair); err != nil {
+			return err
+		}
+	}
+	if latest == 0 {
+		if err := setHeight(batch, earliestHeightKey, height); err != nil {
+			return err
+		}
+	}
+	if err := setHeight(batch, latestHeightKey, height); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// Get returns the value of the key of the store at the given height, nil if
+// the key did not exist.
+func (s *Store) Get(storeName string, key []byte, height int64) ([]byte, error) {
+	encKey := changeKey(storeName, key)
+	start := append(append([]byte{}, encKey...), heightBytes(0)...)
+	end := append(encKey, heightBytes(height+1)...)
+	itr, err := s.db.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	defer itr.Close()
+
+	if !itr.Valid() {
+		return nil, itr.Error()
+	}
+	return decodeValue(itr.Value()), nil
+}
+
+// Iterator returns an iterator over the domain [start, end) of the keys of the
+// store at the given height. A nil start or end is unbounded.
+func (s *Store) Iterator(storeName string, start, end []byte, height int64, reverse bool) (types.Iterator, error) {
+	prefix := storePrefix(storeName)
+
+	lower := prefix
+	if start != nil {
+		lower = changeKey(storeName, start)
+	}
+	upper := types.PrefixEndBytes(prefix)
+	if end != nil {
+		upper = changeKey(storeName, end)
+	}
+
+	var (
+		source dbm.Iterator
+		err    error
+	)
+	if reverse {
+		source, err = s.db.ReverseIterator(lower, upper)
+	} else {
+		source, err = s.db.Iterator(lower, upper)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newIterator(source, len(prefix), start, end, height, reverse), nil
+}
+
+func setHeight(batch dbm.Batch, key string, height int64) error {
+	return batch.Set([]byte(key), heightBytes(height))
+}
+
+func writePair(batch dbm.Batch, height int64, pair types.StoreKVPair) error {
+	key := append(changeKey(pair.StoreKey, pair.Key), heightBytes(height)...)
+	if pair.Delete {
+		return batch.Set(key, []byte{valueDeleted})
+	}
+	return batch.Set(key, append([]byte{valueSet}, pair.Value...))
+}
+
+// decodeValue returns the value of a change, nil for a deletion.
+func decodeValue(bz []byte) []byte {
+	if len(bz) == 0 || bz[0] == valueDeleted {
+		return nil
+	}
+	return bz[1:]
+}
+
+func heightBytes(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
+// storePrefix returns the prefix of the changes of a store, the store name is
+// length prefixed so that no store prefix is a prefix of another one.
+func storePrefix(storeName string) []byte {
+	prefix := []byte{changePrefix}
+	prefix = binary.AppendUvarint(prefix, uint64(len(storeName)))
+	return append(prefix, storeName...)
+}
+
+// changeKey returns the prefix of the changes of a key of a store. The key is
+// escaped and terminated so that the encoding preserves the order of the keys
+// and no encoded key is a prefix of another one: 0x00 is escaped as 0x00 0xFF
+// and the key is terminated by 0x00 0x00.
+func changeKey(storeName string, key []byte) []byte {
+	bz := storePrefix(storeName)
+	for _, b := range key {
+		if b == 0 {
+			bz = append(bz, 0, 0xFF)
+		} else {
+			bz = append(bz, b)
+		}
+	}
+	return append(bz, 0, 0)
+}
+
+// decodeKey returns the key of an escaped and terminated key.
+func decodeKey(encKey []byte) []byte {
+	key := make([]byte, 0, len(encKey)-2)
+	for i := 0; i < len(encKey)-2; i++ {
+		key = append(key, encKey[i])
+		if encKey[i] == 0 {
+			i++
+		}
+	}
+	return key
+}
@@ -0,0 +1,157 @@
+package historical_test
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/historical"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type kvPair struct {
+	key, value []byte
+}
+
+// randomChangesets writes random changesets to the store, using short keys
+// including 0x00 bytes and keys prefixing other ones, and returns the state
+// of each store at each height.
+func randomChangesets(t *testing.T, store *historical.Store, storeNames []string, fromHeight, toHeight int64) map[int64]map[string]map[string][]byte {
+	r := rand.New(rand.NewSource(int64(len(storeNames)) + fromHeight))
+	states := make(map[int64]map[string]map[string][]byte)
+	state := make(map[string]map[string][]byte)
+	for _, name := range storeNames {
+		state[name] = make(map[string][]byte)
+	}
+
+	for height := fromHeight; height <= toHeight; height++ {
+		var pairs []types.StoreKVPair
+		for i := 0; i < 20; i++ {
+			name := storeNames[r.Intn(len(storeNames))]
+			key := make([]byte, 1+r.Intn(3))
+			for j := range key {
+				key[j] = byte(r.Intn(3))
+			}
+
+			if r.Intn(4) == 0 {
+				delete(state[name], string(key))
+				pairs = append(pairs, types.StoreKVPair{StoreKey: name, Key: key, Delete: true})
+			} else {
+				value := []byte{byte(height), byte(i)}
+				state[name][string(key)] = value
+				pairs = append(pairs, types.StoreKVPair{StoreKey: name, Key: key, Value: value})
+			}
+		}
+		require.NoError(t, store.WriteChangeset(height, pairs))
+
+		states[height] = make(map[string]map[string][]byte)
+		for name, kvs := range state {
+			states[height][name] = make(map[string][]byte)
+			for k, v := range kvs {
+				states[height][name][k] = v
+			}
+		}
+	}
+
+	return states
+}
+
+func sortedPairs(kvs map[string][]byte, start, end []byte, reverse bool) []kvPair {
+	var pairs []kvPair
+	for k, v := range kvs {
+		key := []byte(k)
+		if (start == nil || bytes.Compare(key, start) >= 0) && (end == nil || bytes.Compare(key, end) < 0) {
+			pairs = append(pairs, kvPair{key, v})
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return bytes.Compare(pairs[i].key, pairs[j].key) < 0 != reverse
+	})
+	return pairs
+}
+
+func iteratedPairs(itr types.Iterator) []kvPair {
+	defer itr.Close()
+	var pairs []kvPair
+	for ; itr.Valid(); itr.Next() {
+		pairs = append(pairs, kvPair{itr.Key(), itr.Value()})
+	}
+	return pairs
+}
+
+func TestStoreHistoricalState(t *testing.T) {
+	store := historical.NewStore(dbm.NewMemDB())
+	storeNames := []string{"a", "ab", "b"}
+	states := randomChangesets(t, store, storeNames, 3, 30)
+
+	earliest, err := store.EarliestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), earliest)
+	latest, err := store.LatestHeight()
+	require.NoError(t, err)
+	require.Equal(t, int64(30), latest)
+
+	bounds := [][]byte{nil, {0}, {0, 0}, {0, 1}, {1}, {1, 0, 2}, {2, 2, 2, 2}}
+	for height, state := range states {
+		for _, name := range storeNames {
+			for _, pair := range sortedPairs(state[name], nil, nil, false) {
+				value, err := store.Get(name, pair.key, height)
+				require.NoError(t, err)
+				require.Equal(t, pair.value, value, "height %d store %s key %X", height, name, pair.key)
+			}
+
+			for _, start := range bounds {
+				for _, end := range bounds {
+					for _, reverse := range []bool{false, true} {
+						itr, err := store.Iterator(name, start, end, height, reverse)
+						require.NoError(t, err)
+						require.Equal(t,
+							sortedPairs(state[name], start, end, reverse), iteratedPairs(itr),
+							"height %d store %s start %X end %X reverse %t", height, name, start, end, reverse,
+						)
+					}
+				}
+			}
+		}
+	}
+
+	// deleted and missing keys
+	value, err := store.Get("a", []byte{3}, 30)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// heights are written in increasing order
+	require.Error(t, store.WriteChangeset(30, nil))
+}
+
+func TestMultiStore(t *testing.T) {
+	store := historical.NewStore(dbm.NewMemDB())
+	keyA, keyB := types.NewKVStoreKey("a"), types.NewKVStoreKey("b")
+	ms := historical.NewMultiStore(store, []types.StoreKey{keyA, keyB})
+
+	_, err := ms.CacheMultiStoreWithVersion(1)
+	require.Error(t, err)
+
+	states := randomChangesets(t, store, []string{"a", "b"}, 5, 10)
+	require.Equal(t, int64(10), ms.LatestVersion())
+
+	for _, height := range []int64{4, 11} {
+		_, err := ms.CacheMultiStoreWithVersion(height)
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+	}
+
+	cms, err := ms.CacheMultiStoreWithVersion(7)
+	require.NoError(t, err)
+	kvStore := cms.GetKVStore(keyB)
+	require.Equal(t, sortedPairs(states[7]["b"], nil, nil, false), iteratedPairs(kvStore.Iterator(nil, nil)))
+
+	// the branch can be written to, but not the historical state
+	kvStore.Set([]byte{9}, []byte{9})
+	require.Equal(t, []byte{9}, kvStore.Get([]byte{9}))
+	require.Panics(t, cms.Write)
+}
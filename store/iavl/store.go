@@ -287,6 +287,17 @@ func (st *Store) SetInitialVersion(version int64) {
 	st.tree.SetInitialVersion(uint64(version))
 }
 
+// TraverseStateChanges calls fn with the changes made at each saved version of
+// the tree in the range [startVersion, endVersion), compared to the previous
+// saved version.
+func (st *Store) TraverseStateChanges(startVersion, endVersion int64, fn func(version int64, changeSet *iavl.ChangeSet) error) error {
+	tree, ok := st.tree.(*iavl.MutableTree)
+	if !ok {
+		return errors.New("iavl traverse state changes failed: unable to fetch tree")
+	}
+	return tree.TraverseStateChanges(startVersion, endVersion, fn)
+}
+
 // Exports the IAVL store at the given version, returning an iavl.Exporter for the tree.
 func (st *Store) Export(version int64) (*iavl.Exporter, error) {
 	istore, err := st.GetImmutable(version)
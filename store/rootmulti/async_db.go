@@ -0,0 +1,419 @@
+package rootmulti
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tidwall/btree"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+var (
+	errAsyncDBClosed = errors.New("async commit database is closed")
+	errBatchClosed   = errors.New("batch has been written or closed")
+	errKeyEmpty      = errors.New("key cannot be empty")
+	errValueNil      = errors.New("value cannot be nil")
+)
+
+// asyncDB is a write-behind database used by the asynchronous commit. The
+// writes are applied to an in-memory overlay and queued, and a background
+// goroutine persists them to the underlying database in the order they were
+// made. Reads are served from the overlay over the underlying database, so the
+// pending writes are visible before they are persisted.
+//
+// The commit metadata of a version is written after the changes of its stores,
+// so the latest version persisted is always a fully flushed one, which the
+// store loads from after a crash.
+type asyncDB struct {
+	dbm.DB
+
+	logger     log.Logger
+	maxPending int64
+
+	// mtx guards the fields below, cond signals the changes of the queue
+	mtx  sync.Mutex
+	cond *sync.Cond
+
+	// pending holds the latest write of each key not yet persisted
+	pending *btree.BTreeG[pendingItem]
+	queue   []asyncWrite
+	seq     uint64
+	closed  bool
+	err     error
+
+	committedVersion int64
+	flushedVersion   int64
+	done             chan struct{}
+}
+
+// pendingItem is a write of a key waiting to be persisted, the value is nil
+// for a deletion.
+type pendingItem struct {
+	key   []byte
+	value []byte
+	seq   uint64
+}
+
+// asyncWrite is an entry of the queue, either a batch of writes or the marker
+// of the end of the writes of a committed version.
+type asyncWrite struct {
+	seq  uint64
+	ops  []pendingItem
+	sync bool
+	// version is the committed version for a marker, 0 otherwise
+	version int64
+}
+
+var _ dbm.DB = (*asyncDB)(nil)
+
+func newAsyncDB(db dbm.DB, maxPending int64, logger log.Logger) *asyncDB {
+	adb := &asyncDB{
+		DB:         db,
+		logger:     logger,
+		maxPending: maxPending,
+		pending: btree.NewBTreeGOptions(func(a, b pendingItem) bool {
+			return bytes.Compare(a.key, b.key) < 0
+		}, btree.Options{
+			Degree: bTreeDegree,
+			// the overlay is guarded by the mutex of the database, and the
+			// iterators read their own copy of it
+			NoLocks: true,
+		}),
+		done: make(chan struct{}),
+	}
+	adb.cond = sync.NewCond(&adb.mtx)
+
+	go adb.flushLoop()
+	return adb
+}
+
+// bTreeDegree is the approximate number of items and children per B-tree node.
+const bTreeDegree = 32
+
+// Get implements DB.
+func (db *asyncDB) Get(key []byte) ([]byte, error) {
+	db.mtx.Lock()
+	item, found := db.pending.Get(pendingItem{key: key})
+	db.mtx.Unlock()
+	if found {
+		return item.value, nil
+	}
+	return db.DB.Get(key)
+}
+
+// Has implements DB.
+func (db *asyncDB) Has(key []byte) (bool, error) {
+	db.mtx.Lock()
+	item, found := db.pending.Get(pendingItem{key: key})
+	db.mtx.Unlock()
+	if found {
+		return item.value != nil, nil
+	}
+	return db.DB.Has(key)
+}
+
+// Set implements DB.
+func (db *asyncDB) Set(key, value []byte) error {
+	batch := db.NewBatch()
+	if err := batch.Set(key, value); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// SetSync implements DB.
+func (db *asyncDB) SetSync(key, value []byte) error {
+	batch := db.NewBatch()
+	if err := batch.Set(key, value); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// Delete implements DB.
+func (db *asyncDB) Delete(key []byte) error {
+	batch := db.NewBatch()
+	if err := batch.Delete(key); err != nil {
+		return err
+	}
+	return batch.Write()
+}
+
+// DeleteSync implements DB.
+func (db *asyncDB) DeleteSync(key []byte) error {
+	batch := db.NewBatch()
+	if err := batch.Delete(key); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// Iterator implements DB.
+func (db *asyncDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, true)
+}
+
+// ReverseIterator implements DB.
+func (db *asyncDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return db.newIterator(start, end, false)
+}
+
+// newIterator merges an iterator of the underlying database with a copy of the
+// overlay. Both are taken under the lock, as the writes are removed from the
+// overlay once persisted.
+func (db *asyncDB) newIterator(start, end []byte, ascending bool) (dbm.Iterator, error) {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	var (
+		parent dbm.Iterator
+		err    error
+	)
+	if ascending {
+		parent, err = db.DB.Iterator(start, end)
+	} else {
+		parent, err = db.DB.ReverseIterator(start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newAsyncIterator(parent, db.pending.Copy(), start, end, ascending), nil
+}
+
+// NewBatch implements DB.
+func (db *asyncDB) NewBatch() dbm.Batch {
+	return &asyncBatch{db: db}
+}
+
+// Close implements DB. It waits for the pending writes to be persisted before
+// closing the underlying database.
+func (db *asyncDB) Close() error {
+	db.mtx.Lock()
+	if !db.closed {
+		db.closed = true
+		db.cond.Broadcast()
+	}
+	db.mtx.Unlock()
+
+	<-db.done
+	if err := db.DB.Close(); err != nil {
+		return err
+	}
+	return db.err
+}
+
+// commitVersion marks the end of the writes of a committed version, and waits
+// until at most maxPending versions are not fully persisted.
+func (db *asyncDB) commitVersion(version int64) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	if err := db.checkWritable(); err != nil {
+		return err
+	}
+	db.seq++
+	db.queue = append(db.queue, asyncWrite{seq: db.seq, version: version})
+	db.committedVersion = version
+	db.cond.Broadcast()
+	db.emitMetrics()
+
+	for db.err == nil && db.committedVersion-db.flushedVersion > db.maxPending {
+		db.cond.Wait()
+	}
+	return db.err
+}
+
+// flush waits until all the writes are persisted.
+func (db *asyncDB) flush() error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	for db.err == nil && len(db.queue) > 0 {
+		db.cond.Wait()
+	}
+	return db.err
+}
+
+// writeOps applies the writes to the overlay and queues them.
+func (db *asyncDB) writeOps(ops []pendingItem, sync bool) error {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+
+	if err := db.checkWritable(); err != nil {
+		return err
+	}
+	db.seq++
+	for i := range ops {
+		ops[i].seq = db.seq
+		db.pending.Set(ops[i])
+	}
+	db.queue = append(db.queue, asyncWrite{seq: db.seq, ops: ops, sync: sync})
+	db.cond.Broadcast()
+	return nil
+}
+
+func (db *asyncDB) checkWritable() error {
+	if db.err != nil {
+		return db.err
+	}
+	if db.closed {
+		return errAsyncDBClosed
+	}
+	return nil
+}
+
+// flushLoop persists the queued writes in order, until the database is closed
+// and the queue is drained, or a write fails. After a failure the writes are
+// kept in the overlay, and the following ones are rejected.
+func (db *asyncDB) flushLoop() {
+	defer close(db.done)
+
+	for {
+		db.mtx.Lock()
+		for len(db.queue) == 0 && !db.closed {
+			db.cond.Wait()
+		}
+		if len(db.queue) == 0 {
+			db.mtx.Unlock()
+			return
+		}
+		write := db.queue[0]
+		db.mtx.Unlock()
+
+		err := db.persist(write)
+
+		db.mtx.Lock()
+		if err != nil {
+			db.err = fmt.Errorf("failed to persist asynchronous commit writes: %w", err)
+			db.logger.Error("asynchronous commit failed", "err", err, "flushed_version", db.flushedVersion)
+			db.cond.Broadcast()
+			db.mtx.Unlock()
+			return
+		}
+		for _, op := range write.ops {
+			if item, found := db.pending.Get(op); found && item.seq == write.seq {
+				db.pending.Delete(op)
+			}
+		}
+		db.queue = db.queue[1:]
+		if write.version != 0 {
+			db.flushedVersion = write.version
+			db.emitMetrics()
+		}
+		db.cond.Broadcast()
+		db.mtx.Unlock()
+	}
+}
+
+func (db *asyncDB) persist(write asyncWrite) error {
+	if write.version != 0 {
+		return nil
+	}
+	defer telemetry.MeasureSince(time.Now(), "store", "async_commit", "write")
+
+	batch := db.DB.NewBatch()
+	defer batch.Close()
+	for _, op := range write.ops {
+		var err error
+		if op.value == nil {
+			err = batch.Delete(op.key)
+		} else {
+			err = batch.Set(op.key, op.value)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if write.sync {
+		return batch.WriteSync()
+	}
+	return batch.Write()
+}
+
+// emitMetrics reports the number of committed versions not fully persisted
+// and the number of queued writes.
+// CONTRACT: the mutex is held.
+func (db *asyncDB) emitMetrics() {
+	telemetry.SetGauge(float32(db.committedVersion-db.flushedVersion), "store", "async_commit", "flush_lag")
+	telemetry.SetGauge(float32(len(db.queue)), "store", "async_commit", "queue_size")
+}
+
+// newPendingItem copies the key and value, as the caller may reuse them.
+func newPendingItem(key, value []byte) pendingItem {
+	item := pendingItem{key: append([]byte{}, key...)}
+	if value != nil {
+		item.value = append([]byte{}, value...)
+	}
+	return item
+}
+
+// asyncBatch records the writes of a batch, which are applied to the overlay
+// and queued together on Write.
+type asyncBatch struct {
+	db  *asyncDB
+	ops []pendingItem
+}
+
+var _ dbm.Batch = (*asyncBatch)(nil)
+
+// Set implements Batch.
+func (b *asyncBatch) Set(key, value []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if value == nil {
+		return errValueNil
+	}
+	if b.db == nil {
+		return errBatchClosed
+	}
+	b.ops = append(b.ops, newPendingItem(key, value))
+	return nil
+}
+
+// Delete implements Batch.
+func (b *asyncBatch) Delete(key []byte) error {
+	if len(key) == 0 {
+		return errKeyEmpty
+	}
+	if b.db == nil {
+		return errBatchClosed
+	}
+	b.ops = append(b.ops, newPendingItem(key, nil))
+	return nil
+}
+
+// Write implements Batch.
+func (b *asyncBatch) Write() error {
+	return b.write(false)
+}
+
+// WriteSync implements Batch.
+func (b *asyncBatch) WriteSync() error {
+	return b.write(true)
+}
+
+func (b *asyncBatch) write(sync bool) error {
+	if b.db == nil {
+		return errBatchClosed
+	}
+	err := b.db.writeOps(b.ops, sync)
+	// like the batches of the underlying databases, the batch is closed once written
+	b.Close()
+	return err
+}
+
+// Close implements Batch.
+func (b *asyncBatch) Close() error {
+	b.db = nil
+	b.ops = nil
+	return nil
+}
@@ -0,0 +1,229 @@
+package rootmulti
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// gatedDB is a database whose batch writes wait for the gate, and fail once
+// the limit of writes is reached, if any, to simulate a crash.
+type gatedDB struct {
+	dbm.DB
+
+	gate   sync.RWMutex
+	mtx    sync.Mutex
+	writes int
+	limit  int
+}
+
+type gatedBatch struct {
+	dbm.Batch
+	db *gatedDB
+}
+
+func (db *gatedDB) NewBatch() dbm.Batch {
+	return &gatedBatch{Batch: db.DB.NewBatch(), db: db}
+}
+
+func (db *gatedDB) setLimit(limit int) {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+	db.limit = limit
+}
+
+func (db *gatedDB) writeCount() int {
+	db.mtx.Lock()
+	defer db.mtx.Unlock()
+	return db.writes
+}
+
+func (b *gatedBatch) Write() error {
+	b.db.gate.RLock()
+	defer b.db.gate.RUnlock()
+
+	b.db.mtx.Lock()
+	defer b.db.mtx.Unlock()
+	if b.db.limit > 0 && b.db.writes >= b.db.limit {
+		return errors.New("crashed")
+	}
+	b.db.writes++
+	return b.Batch.Write()
+}
+
+func (b *gatedBatch) WriteSync() error {
+	return b.Write()
+}
+
+func iteratedKVs(t *testing.T, itr dbm.Iterator) [][2]string {
+	defer itr.Close()
+	var kvs [][2]string
+	for ; itr.Valid(); itr.Next() {
+		kvs = append(kvs, [2]string{string(itr.Key()), string(itr.Value())})
+	}
+	require.NoError(t, itr.Error())
+	return kvs
+}
+
+func TestAsyncDB(t *testing.T) {
+	gated := &gatedDB{DB: dbm.NewMemDB()}
+	db := newAsyncDB(gated, 1, log.NewNopLogger())
+	expected := dbm.NewMemDB()
+
+	r := rand.New(rand.NewSource(1))
+	randomKey := func() []byte {
+		return []byte{byte('a' + r.Intn(8)), byte('a' + r.Intn(8))}
+	}
+
+	// write random batches, of which only some are persisted before the gate
+	// is closed, and compare the reads with a database written synchronously
+	for i := 0; i < 50; i++ {
+		if i == 20 {
+			require.NoError(t, db.flush())
+			gated.gate.Lock()
+		}
+
+		batch, expectedBatch := db.NewBatch(), expected.NewBatch()
+		for j := 0; j < 10; j++ {
+			key := randomKey()
+			if r.Intn(3) == 0 {
+				require.NoError(t, batch.Delete(key))
+				require.NoError(t, expectedBatch.Delete(key))
+			} else {
+				value := []byte(fmt.Sprintf("%d-%d", i, j))
+				require.NoError(t, batch.Set(key, value))
+				require.NoError(t, expectedBatch.Set(key, value))
+			}
+		}
+		require.NoError(t, batch.Write())
+		require.NoError(t, expectedBatch.Write())
+		require.Error(t, batch.Set([]byte("a"), []byte("a")))
+
+		for j := 0; j < 10; j++ {
+			key := randomKey()
+			value, err := db.Get(key)
+			require.NoError(t, err)
+			expectedValue, err := expected.Get(key)
+			require.NoError(t, err)
+			require.Equal(t, expectedValue, value)
+
+			has, err := db.Has(key)
+			require.NoError(t, err)
+			require.Equal(t, expectedValue != nil, has)
+		}
+
+		bounds := [][]byte{nil, []byte("b"), []byte("cd"), []byte("f")}
+		for _, start := range bounds {
+			for _, end := range bounds {
+				itr, err := db.Iterator(start, end)
+				require.NoError(t, err)
+				expectedItr, err := expected.Iterator(start, end)
+				require.NoError(t, err)
+				require.Equal(t, iteratedKVs(t, expectedItr), iteratedKVs(t, itr))
+
+				itr, err = db.ReverseIterator(start, end)
+				require.NoError(t, err)
+				expectedItr, err = expected.ReverseIterator(start, end)
+				require.NoError(t, err)
+				require.Equal(t, iteratedKVs(t, expectedItr), iteratedKVs(t, itr))
+			}
+		}
+	}
+
+	// nothing was persisted after the gate was closed, until it is opened
+	require.Equal(t, 20, gated.writeCount())
+	gated.gate.Unlock()
+	require.NoError(t, db.flush())
+	require.Equal(t, 50, gated.writeCount())
+
+	itr, err := gated.Iterator(nil, nil)
+	require.NoError(t, err)
+	expectedItr, err := expected.Iterator(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, iteratedKVs(t, expectedItr), iteratedKVs(t, itr))
+
+	require.NoError(t, db.Close())
+	require.Error(t, db.Set([]byte("a"), []byte("a")))
+}
+
+func TestMultiStore_AsyncCommit(t *testing.T) {
+	db := dbm.NewMemDB()
+	gated := &gatedDB{DB: db}
+
+	commit := func(store *Store, version int) types.CommitID {
+		for i, key := range []types.StoreKey{testStoreKey1, testStoreKey2, testStoreKey3} {
+			kv := store.GetKVStore(key)
+			kv.Set([]byte(fmt.Sprintf("key%d", version%3)), []byte(fmt.Sprintf("value%d-%d", version, i)))
+			if version%4 == 0 {
+				kv.Delete([]byte("key1"))
+			}
+		}
+		return store.Commit()
+	}
+
+	store := newMultiStoreWithMounts(gated, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	store.SetAsyncCommit(2)
+	require.NoError(t, store.LoadLatestVersion())
+
+	commitIDs := make(map[int64]types.CommitID)
+	for version := 1; version <= 3; version++ {
+		commitIDs[int64(version)] = commit(store, version)
+	}
+	require.NoError(t, store.FlushAsyncCommit())
+	require.Equal(t, int64(3), GetLatestVersion(db))
+
+	// the versions committed while the writes are blocked are served from memory
+	gated.gate.Lock()
+	writes := gated.writeCount()
+	for version := 4; version <= 5; version++ {
+		commitIDs[int64(version)] = commit(store, version)
+	}
+	require.Equal(t, int64(5), store.LastCommitID().Version)
+	require.Equal(t, int64(3), GetLatestVersion(db))
+
+	cms, err := store.CacheMultiStoreWithVersion(4)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value3-0"), cms.GetKVStore(testStoreKey1).Get([]byte("key0")))
+	require.Nil(t, cms.GetKVStore(testStoreKey1).Get([]byte("key1")))
+	require.Equal(t, []byte("value5-1"), store.GetKVStore(testStoreKey2).Get([]byte("key2")))
+
+	// crash after a part of the writes of version 4 are persisted
+	gated.setLimit(writes + 1)
+	gated.gate.Unlock()
+	require.Error(t, store.FlushAsyncCommit())
+	require.Equal(t, int64(3), GetLatestVersion(db))
+
+	// the restarted store replays from the last fully persisted version
+	store = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	store.SetAsyncCommit(2)
+	require.NoError(t, store.LoadLatestVersion())
+	require.Equal(t, commitIDs[3], store.LastCommitID())
+
+	for version := 4; version <= 8; version++ {
+		commitID := commit(store, version)
+		if expected, ok := commitIDs[int64(version)]; ok {
+			require.Equal(t, expected, commitID)
+		}
+	}
+	require.NoError(t, store.FlushAsyncCommit())
+	require.Equal(t, int64(8), GetLatestVersion(db))
+
+	// the persisted state matches the one of a synchronous commit
+	syncStore := newMultiStoreWithMounts(dbm.NewMemDB(), pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, syncStore.LoadLatestVersion())
+	for version := 1; version <= 8; version++ {
+		commit(syncStore, version)
+	}
+	restarted := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, restarted.LoadLatestVersion())
+	require.Equal(t, syncStore.LastCommitID(), restarted.LastCommitID())
+}
@@ -0,0 +1,152 @@
+package rootmulti
+
+import (
+	"bytes"
+
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tidwall/btree"
+)
+
+// asyncIterator iterates over the pending writes of an asyncDB merged with an
+// iterator of the underlying database. The pending writes shadow the persisted
+// values, and the deleted keys are skipped.
+type asyncIterator struct {
+	parent    dbm.Iterator
+	pending   btree.GenericIter[pendingItem]
+	start     []byte
+	end       []byte
+	ascending bool
+
+	// pendingValid is whether the pending iterator is on an item of the domain
+	pendingValid bool
+
+	key, value []byte
+	valid      bool
+}
+
+var _ dbm.Iterator = (*asyncIterator)(nil)
+
+func newAsyncIterator(parent dbm.Iterator, pending *btree.BTreeG[pendingItem], start, end []byte, ascending bool) *asyncIterator {
+	itr := &asyncIterator{
+		parent:    parent,
+		pending:   pending.Iter(),
+		start:     start,
+		end:       end,
+		ascending: ascending,
+	}
+
+	var valid bool
+	switch {
+	case ascending && start != nil:
+		valid = itr.pending.Seek(pendingItem{key: start})
+	case ascending:
+		valid = itr.pending.First()
+	case end != nil:
+		// the end is exclusive
+		if itr.pending.Seek(pendingItem{key: end}) {
+			valid = itr.pending.Prev()
+		} else {
+			valid = itr.pending.Last()
+		}
+	default:
+		valid = itr.pending.Last()
+	}
+	itr.pendingValid = valid && itr.inDomain(itr.pending.Item().key)
+
+	itr.next()
+	return itr
+}
+
+// next moves to the next existing key of the merged iterators.
+func (itr *asyncIterator) next() {
+	for itr.parent.Valid() || itr.pendingValid {
+		if !itr.pendingValid || (itr.parent.Valid() && itr.compare(itr.parent.Key(), itr.pending.Item().key) < 0) {
+			itr.key, itr.value = itr.parent.Key(), itr.parent.Value()
+			itr.valid = true
+			itr.parent.Next()
+			return
+		}
+
+		item := itr.pending.Item()
+		if itr.parent.Valid() && bytes.Equal(itr.parent.Key(), item.key) {
+			itr.parent.Next()
+		}
+		itr.nextPending()
+		if item.value != nil {
+			itr.key, itr.value = item.key, item.value
+			itr.valid = true
+			return
+		}
+	}
+
+	itr.key, itr.value = nil, nil
+	itr.valid = false
+}
+
+func (itr *asyncIterator) nextPending() {
+	var valid bool
+	if itr.ascending {
+		valid = itr.pending.Next()
+	} else {
+		valid = itr.pending.Prev()
+	}
+	itr.pendingValid = valid && itr.inDomain(itr.pending.Item().key)
+}
+
+func (itr *asyncIterator) inDomain(key []byte) bool {
+	return (itr.start == nil || bytes.Compare(key, itr.start) >= 0) &&
+		(itr.end == nil || bytes.Compare(key, itr.end) < 0)
+}
+
+// compare is like bytes.Compare in the order of the iteration.
+func (itr *asyncIterator) compare(a, b []byte) int {
+	if itr.ascending {
+		return bytes.Compare(a, b)
+	}
+	return -bytes.Compare(a, b)
+}
+
+// Domain implements Iterator.
+func (itr *asyncIterator) Domain() ([]byte, []byte) {
+	return itr.start, itr.end
+}
+
+// Valid implements Iterator.
+func (itr *asyncIterator) Valid() bool {
+	return itr.valid
+}
+
+// Next implements Iterator.
+func (itr *asyncIterator) Next() {
+	itr.assertIsValid()
+	itr.next()
+}
+
+// Key implements Iterator.
+func (itr *asyncIterator) Key() []byte {
+	itr.assertIsValid()
+	return itr.key
+}
+
+// Value implements Iterator.
+func (itr *asyncIterator) Value() []byte {
+	itr.assertIsValid()
+	return itr.value
+}
+
+// Error implements Iterator.
+func (itr *asyncIterator) Error() error {
+	return itr.parent.Error()
+}
+
+// Close implements Iterator.
+func (itr *asyncIterator) Close() error {
+	itr.pending.Release()
+	return itr.parent.Close()
+}
+
+func (itr *asyncIterator) assertIsValid() {
+	if !itr.valid {
+		panic("iterator is invalid")
+	}
+}
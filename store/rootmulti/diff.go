@@ -0,0 +1,158 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	iavltree "github.com/cosmos/iavl"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// StoreDiff is the difference of a store between two versions of a
+// multistore, the hash being nil when the store is absent from a version.
+type StoreDiff struct {
+	Name  string
+	HashA []byte
+	HashB []byte
+	Pairs []PairDiff
+	// Truncated is whether more keys differ than the pairs listed
+	Truncated bool
+}
+
+// PairDiff is a key whose value differs between two versions of a store, the
+// value being nil when the key is absent from a version.
+type PairDiff struct {
+	Key    []byte
+	ValueA []byte
+	ValueB []byte
+}
+
+// GetCommitInfo returns the commit info of the given version of a multistore
+// persisted in the database.
+func GetCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
+	return getCommitInfo(db, ver)
+}
+
+// Diff compares the version versionA of the multistore persisted in dbA with
+// the version versionB of the one persisted in dbB, which may be the same
+// database. The stores are compared by their hash in the commit infos, and
+// the keys of the differing stores are listed in order, up to maxKeys keys by
+// store if it is positive. The databases are only read, and the versions are
+// read without loading the stores, so Diff can be used on the database of a
+// stopped node with any pruning options.
+func Diff(dbA, dbB dbm.DB, versionA, versionB int64, maxKeys int) ([]StoreDiff, error) {
+	infoA, err := getCommitInfo(dbA, versionA)
+	if err != nil {
+		return nil, fmt.Errorf("version %d: %w", versionA, err)
+	}
+	infoB, err := getCommitInfo(dbB, versionB)
+	if err != nil {
+		return nil, fmt.Errorf("version %d: %w", versionB, err)
+	}
+
+	hashesA, hashesB := storeHashes(infoA), storeHashes(infoB)
+	names := make([]string, 0, len(hashesA)+len(hashesB))
+	for name := range hashesA {
+		names = append(names, name)
+	}
+	for name := range hashesB {
+		if _, ok := hashesA[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var diffs []StoreDiff
+	for _, name := range names {
+		hashA, okA := hashesA[name]
+		hashB, okB := hashesB[name]
+		if okA && okB && bytes.Equal(hashA, hashB) {
+			continue
+		}
+
+		diff := StoreDiff{Name: name, HashA: hashA, HashB: hashB}
+		if err := diffStore(&diff, dbA, dbB, okA, okB, versionA, versionB, maxKeys); err != nil {
+			return nil, fmt.Errorf("store %s: %w", name, err)
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+func storeHashes(info *types.CommitInfo) map[string][]byte {
+	hashes := make(map[string][]byte, len(info.StoreInfos))
+	for _, storeInfo := range info.StoreInfos {
+		hashes[storeInfo.Name] = storeInfo.CommitId.Hash
+	}
+	return hashes
+}
+
+// diffStore lists the keys of the store which differ between the versions,
+// the store being read as empty from a version it is absent from.
+func diffStore(diff *StoreDiff, dbA, dbB dbm.DB, okA, okB bool, versionA, versionB int64, maxKeys int) error {
+	itrA, err := storeIterator(dbA, diff.Name, versionA, okA)
+	if err != nil {
+		return err
+	}
+	defer itrA.Close()
+	itrB, err := storeIterator(dbB, diff.Name, versionB, okB)
+	if err != nil {
+		return err
+	}
+	defer itrB.Close()
+
+	for itrA.Valid() || itrB.Valid() {
+		var pair PairDiff
+		switch {
+		case !itrB.Valid() || (itrA.Valid() && bytes.Compare(itrA.Key(), itrB.Key()) < 0):
+			pair = PairDiff{Key: itrA.Key(), ValueA: itrA.Value()}
+			itrA.Next()
+
+		case !itrA.Valid() || bytes.Compare(itrB.Key(), itrA.Key()) < 0:
+			pair = PairDiff{Key: itrB.Key(), ValueB: itrB.Value()}
+			itrB.Next()
+
+		default:
+			pair = PairDiff{Key: itrA.Key(), ValueA: itrA.Value(), ValueB: itrB.Value()}
+			itrA.Next()
+			itrB.Next()
+			if bytes.Equal(pair.ValueA, pair.ValueB) {
+				continue
+			}
+		}
+
+		if maxKeys > 0 && len(diff.Pairs) == maxKeys {
+			diff.Truncated = true
+			break
+		}
+		diff.Pairs = append(diff.Pairs, pair)
+	}
+
+	if err := itrA.Error(); err != nil {
+		return err
+	}
+	return itrB.Error()
+}
+
+// storeIterator iterates over the IAVL tree of the store at the version, or
+// over nothing if the store is absent from the version.
+func storeIterator(db dbm.DB, name string, version int64, ok bool) (dbm.Iterator, error) {
+	if !ok {
+		return dbm.NewMemDB().Iterator(nil, nil)
+	}
+
+	prefix := "s/k:" + name + "/"
+	tree, err := iavltree.NewMutableTreeWithOpts(dbm.NewPrefixDB(db, []byte(prefix)), 0, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	immutable, err := tree.GetImmutable(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load version %d: %w", version, err)
+	}
+	return immutable.Iterator(nil, nil, true)
+}
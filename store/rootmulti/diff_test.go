@@ -0,0 +1,77 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	pruningtypes "github.com/cosmos/cosmos-sdk/pruning/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestDiff(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, store.LoadLatestVersion())
+
+	kv1, kv2 := store.GetKVStore(testStoreKey1), store.GetKVStore(testStoreKey2)
+	kv1.Set([]byte("a"), []byte("1"))
+	kv1.Set([]byte("b"), []byte("1"))
+	kv1.Set([]byte("c"), []byte("1"))
+	kv2.Set([]byte("a"), []byte("1"))
+	store.Commit()
+
+	kv1.Set([]byte("a"), []byte("2"))
+	kv1.Delete([]byte("b"))
+	kv1.Set([]byte("d"), []byte("2"))
+	kv1.Set([]byte("c"), []byte("1"))
+	store.Commit()
+
+	// the unchanged stores are skipped
+	diffs, err := Diff(db, db, 1, 2, 0)
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.Equal(t, "store1", diffs[0].Name)
+	require.NotEqual(t, diffs[0].HashA, diffs[0].HashB)
+	require.Equal(t, []PairDiff{
+		{Key: []byte("a"), ValueA: []byte("1"), ValueB: []byte("2")},
+		{Key: []byte("b"), ValueA: []byte("1")},
+		{Key: []byte("d"), ValueB: []byte("2")},
+	}, diffs[0].Pairs)
+	require.False(t, diffs[0].Truncated)
+
+	diffs, err = Diff(db, db, 2, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, []PairDiff{
+		{Key: []byte("a"), ValueA: []byte("2"), ValueB: []byte("1")},
+		{Key: []byte("b"), ValueB: []byte("1")},
+	}, diffs[0].Pairs)
+	require.True(t, diffs[0].Truncated)
+
+	// a store absent from a database is compared as empty
+	otherDB := dbm.NewMemDB()
+	other := NewStore(otherDB, log.NewNopLogger())
+	other.MountStoreWithDB(testStoreKey1, types.StoreTypeIAVL, nil)
+	require.NoError(t, other.LoadLatestVersion())
+	other.GetKVStore(testStoreKey1).Set([]byte("a"), []byte("1"))
+	other.Commit()
+
+	diffs, err = Diff(db, otherDB, 1, 1, 0)
+	require.NoError(t, err)
+	require.Len(t, diffs, 3)
+	require.Equal(t, "store1", diffs[0].Name)
+	require.Equal(t, []PairDiff{
+		{Key: []byte("b"), ValueA: []byte("1")},
+		{Key: []byte("c"), ValueA: []byte("1")},
+	}, diffs[0].Pairs)
+	require.Equal(t, "store2", diffs[1].Name)
+	require.Nil(t, diffs[1].HashB)
+	require.Equal(t, []PairDiff{{Key: []byte("a"), ValueA: []byte("1")}}, diffs[1].Pairs)
+	require.Equal(t, "store3", diffs[2].Name)
+	require.Empty(t, diffs[2].Pairs)
+
+	_, err = Diff(db, db, 1, 3, 0)
+	require.Error(t, err)
+}
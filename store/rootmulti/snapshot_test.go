@@ -10,6 +10,7 @@ import (
 	"math/rand"
 	"testing"
 
+	protoio "github.com/gogo/protobuf/io"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -206,7 +207,7 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 
 	streamReader, err := snapshots.NewStreamReader(chunks)
 	require.NoError(t, err)
-	nextItem, err := target.Restore(version, snapshottypes.CurrentFormat, streamReader)
+	nextItem, err := target.Restore(version, snapshottypes.FormatStream, streamReader)
 	require.NoError(t, err)
 	require.Equal(t, *dummyExtensionItem.GetExtension(), *nextItem.GetExtension())
 
@@ -224,6 +225,95 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+// payloadExtension is an extension snapshotter of raw payloads.
+type payloadExtension struct {
+	payloads [][]byte
+}
+
+func (e *payloadExtension) SnapshotName() string       { return "payloads" }
+func (e *payloadExtension) SnapshotFormat() uint32     { return 1 }
+func (e *payloadExtension) SupportedFormats() []uint32 { return []uint32{1} }
+func (e *payloadExtension) PruneSnapshotHeight(int64)  {}
+func (e *payloadExtension) SetSnapshotInterval(uint64) {}
+
+func (e *payloadExtension) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	for _, payload := range e.payloads {
+		if err := snapshottypes.WriteExtensionItem(protoWriter, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *payloadExtension) Restore(height uint64, format uint32, protoReader protoio.Reader) (snapshottypes.SnapshotItem, error) {
+	for {
+		item := snapshottypes.SnapshotItem{}
+		if err := protoReader.ReadMsg(&item); err == io.EOF {
+			return snapshottypes.SnapshotItem{}, nil
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			return item, nil
+		}
+		e.payloads = append(e.payloads, payload.Payload)
+	}
+}
+
+func TestMultistoreSnapshotRestore_Parallel(t *testing.T) {
+	// more stores than the streams exported at once
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 12, 1000)
+	version := uint64(source.LastCommitID().Version)
+	opts := snapshottypes.NewSnapshotOptions(1, 1)
+
+	sourceSnapshots, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	sourceExtension := &payloadExtension{payloads: [][]byte{{1, 2, 3}, {4, 5, 6}}}
+	sourceManager := snapshots.NewManager(sourceSnapshots, opts, source, nil, log.NewNopLogger())
+	require.NoError(t, sourceManager.RegisterExtensions(sourceExtension))
+
+	snapshot, err := sourceManager.Create(version)
+	require.NoError(t, err)
+	require.Equal(t, snapshottypes.FormatParallel, snapshot.Format)
+	require.Len(t, snapshot.Metadata.ChunkStreams, int(snapshot.Chunks))
+
+	// the chunks are identical across nodes
+	other, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	otherManager := snapshots.NewManager(other, opts, newMultiStoreWithGeneratedData(dbm.NewMemDB(), 12, 1000), nil, log.NewNopLogger())
+	require.NoError(t, otherManager.RegisterExtensions(&payloadExtension{payloads: sourceExtension.payloads}))
+	otherSnapshot, err := otherManager.Create(version)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, otherSnapshot)
+
+	target := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	for _, key := range source.StoreKeysByName() {
+		target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+	}
+	require.NoError(t, target.LoadLatestVersion())
+	targetSnapshots, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	targetExtension := &payloadExtension{}
+	targetManager := snapshots.NewManager(targetSnapshots, opts, target, nil, log.NewNopLogger())
+	require.NoError(t, targetManager.RegisterExtensions(targetExtension))
+
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := sourceManager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	assert.Equal(t, sourceExtension.payloads, targetExtension.payloads)
+	for _, key := range source.StoreKeysByName() {
+		assertStoresEqual(t, source.GetCommitKVStore(key), target.GetCommitKVStore(key), "store %q not equal", key.Name())
+	}
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
@@ -287,7 +377,7 @@ func benchmarkMultistoreSnapshotRestore(b *testing.B, stores uint8, storeKeys ui
 		}()
 		reader, err := snapshots.NewStreamReader(chunks)
 		require.NoError(b, err)
-		_, err = target.Restore(version, snapshottypes.CurrentFormat, reader)
+		_, err = target.Restore(version, snapshottypes.FormatStream, reader)
 		require.NoError(b, err)
 		require.Equal(b, source.LastCommitID(), target.LastCommitID())
 	}
@@ -60,12 +60,18 @@ type Store struct {
 
 	interBlockCache types.MultiStorePersistentCache
 
+	// asyncDB persists the commits in the background when the asynchronous
+	// commit is enabled
+	asyncDB *asyncDB
+
 	listeners map[types.StoreKey][]types.WriteListener
 }
 
 var (
 	_ types.CommitMultiStore = (*Store)(nil)
 	_ types.Queryable        = (*Store)(nil)
+
+	_ snapshottypes.StoreSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -99,6 +105,21 @@ func (rs *Store) SetPruning(pruningOpts pruningtypes.PruningOptions) {
 	rs.pruningManager.SetOptions(pruningOpts)
 }
 
+// GetStorePruning fetches the pruning strategy of the store with the given
+// name, which is the one of the root store unless overridden by SetStorePruning.
+func (rs *Store) GetStorePruning(storeName string) pruningtypes.PruningOptions {
+	return rs.pruningManager.GetStoreOptions(storeName)
+}
+
+// SetStorePruning sets the pruning strategy of the store with the given name,
+// overriding the strategy set by SetPruning. The heights of the store are
+// tracked and pruned independently of the other stores.
+// Note, it must be called prior to LoadVersion or LoadLatestVersion for the
+// heights pending pruning to be loaded.
+func (rs *Store) SetStorePruning(storeName string, pruningOpts pruningtypes.PruningOptions) {
+	rs.pruningManager.SetStoreOptions(storeName, pruningOpts)
+}
+
 // SetSnapshotInterval sets the interval at which the snapshots are taken.
 // It is used by the store to determine which heights to retain until after the snapshot is complete.
 func (rs *Store) SetSnapshotInterval(snapshotInterval uint64) {
@@ -113,6 +134,30 @@ func (rs *Store) SetIAVLDisableFastNode(disableFastNode bool) {
 	rs.iavlDisableFastNode = disableFastNode
 }
 
+// SetAsyncCommit enables the asynchronous commit of the stores when queueSize
+// is positive: the stores are committed in memory, and persisted in the
+// background while the next blocks are executed, with at most queueSize
+// committed versions pending persistence. After a crash, the store is loaded
+// at the latest fully persisted version, and the following blocks must be
+// replayed.
+// Note, it must be called prior to LoadVersion or LoadLatestVersion.
+func (rs *Store) SetAsyncCommit(queueSize int) {
+	if queueSize <= 0 || rs.asyncDB != nil {
+		return
+	}
+	rs.asyncDB = newAsyncDB(rs.db, int64(queueSize), rs.logger)
+	rs.db = rs.asyncDB
+}
+
+// FlushAsyncCommit waits until the committed versions are persisted when the
+// asynchronous commit is enabled.
+func (rs *Store) FlushAsyncCommit() error {
+	if rs.asyncDB == nil {
+		return nil
+	}
+	return rs.asyncDB.flush()
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -426,6 +471,15 @@ func (rs *Store) Commit() types.CommitID {
 	}
 
 	rs.lastCommitInfo = commitStores(version, rs.stores, rs.removalMap)
+	if rs.asyncDB != nil {
+		// the deferred calls are run in reverse order, the version is marked
+		// as committed once its metadata is written
+		defer func() {
+			if err := rs.asyncDB.commitVersion(version); err != nil {
+				panic(err)
+			}
+		}()
+	}
 	defer rs.flushMetadata(rs.db, version, rs.lastCommitInfo)
 
 	// remove remnants of removed stores
@@ -491,10 +545,13 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 
 			// Attempt to lazy-load an already saved IAVL store version. If the
 			// version does not exist or is pruned, an error should be returned.
+			if err := rs.checkVersionNotPruned(key, store.(*iavl.Store), version); err != nil {
+				return nil, err
+			}
 			var err error
 			cacheStore, err = store.(*iavl.Store).GetImmutable(version)
 			if err != nil {
-				return nil, err
+				return nil, sdkerrors.Wrapf(err, "failed to load version %d of store %s", version, key.Name())
 			}
 		default:
 			cacheStore = store
@@ -552,17 +609,40 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 
 func (rs *Store) handlePruning(version int64) error {
 	rs.pruningManager.HandleHeight(version - 1) // we should never prune the current version.
-	if !rs.pruningManager.ShouldPruneAtHeight(version) {
+	pruneDefault := rs.pruningManager.ShouldPruneAtHeight(version)
+	storeNames := rs.pruningManager.StoresToPruneAtHeight(version)
+	if !pruneDefault && len(storeNames) == 0 {
 		return nil
 	}
 	rs.logger.Info("prune start", "height", version)
 	defer rs.logger.Info("prune end", "height", version)
-	return rs.PruneStores(true, nil)
+	if pruneDefault {
+		if err := rs.PruneStores(true, nil); err != nil {
+			return err
+		}
+	}
+	for _, storeName := range storeNames {
+		heights, err := rs.pruningManager.GetFlushAndResetStorePruningHeights(storeName)
+		if err != nil {
+			return err
+		}
+		key := rs.keysByName[storeName]
+		if key == nil {
+			continue
+		}
+		rs.logger.Debug("pruning store heights", "store", storeName, "heights", heights)
+		if err := rs.pruneStore(key, heights); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // PruneStores prunes the specific heights of the multi store.
 // If clearPruningManager is true, the pruning manager will return the pruning heights,
 // and they are appended to the pruningHeights to be pruned.
+// The stores whose pruning strategy was set by SetStorePruning are left untouched,
+// their heights are pruned according to their own strategy on commit.
 func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (err error) {
 	if clearPruningManager {
 		heights, err := rs.pruningManager.GetFlushAndResetPruningHeights()
@@ -584,27 +664,68 @@ func (rs *Store) PruneStores(clearPruningManager bool, pruningHeights []int64) (
 
 	rs.logger.Debug("pruning heights", "heights", pruningHeights)
 
-	for key, store := range rs.stores {
-		// If the store is wrapped with an inter-block cache, we must first unwrap
-		// it to get the underlying IAVL store.
-		if store.GetStoreType() != types.StoreTypeIAVL {
+	for key := range rs.stores {
+		if rs.pruningManager.HasStoreOptions(key.Name()) {
 			continue
 		}
+		if err := rs.pruneStore(key, pruningHeights); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-		store = rs.GetCommitKVStore(key)
+// pruneStore deletes the given heights from the store if it is an IAVL store.
+func (rs *Store) pruneStore(key types.StoreKey, pruningHeights []int64) error {
+	if len(pruningHeights) == 0 || rs.stores[key].GetStoreType() != types.StoreTypeIAVL {
+		return nil
+	}
 
-		err := store.(*iavl.Store).DeleteVersions(pruningHeights...)
-		if err == nil {
-			continue
-		}
+	// If the store is wrapped with an inter-block cache, we must first unwrap
+	// it to get the underlying IAVL store.
+	store := rs.GetCommitKVStore(key)
 
-		if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
-			return err
-		}
+	err := store.(*iavl.Store).DeleteVersions(pruningHeights...)
+	if err == nil {
+		return nil
+	}
+
+	if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+		return err
 	}
 	return nil
 }
 
+// checkVersionNotPruned returns an error if the given version of the IAVL store
+// does not exist because it was pruned according to the store's pruning
+// strategy. A missing version which was never pruned, e.g. one preceding the
+// addition of the store, is not an error.
+func (rs *Store) checkVersionNotPruned(key types.StoreKey, store *iavl.Store, version int64) error {
+	if store.VersionExists(version) {
+		return nil
+	}
+
+	opts := rs.pruningManager.GetStoreOptions(key.Name())
+	if opts.GetPruningStrategy() == pruningtypes.PruningNothing {
+		return nil
+	}
+	if version > rs.LastCommitID().Version-int64(opts.KeepRecent) {
+		return nil
+	}
+
+	// The commit infos are never pruned, a store added by an upgrade is missing
+	// from the ones of the versions preceding its addition.
+	cInfo, err := getCommitInfo(rs.db, version)
+	if err != nil || !hasStoreInfo(cInfo, key.Name()) {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(
+		sdkerrors.ErrInvalidHeight, "version %d of store %s has been pruned (keep-recent: %d)",
+		version, key.Name(), opts.KeepRecent,
+	)
+}
+
 // getStoreByName performs a lookup of a StoreKey given a store name typically
 // provided in a path. The StoreKey is then used to perform a lookup and return
 // a Store. If the Store is wrapped in an inter-block cache, it will be unwrapped
@@ -715,18 +836,68 @@ func parsePath(path string) (storeName string, subpath string, err error) {
 // given format changes (at the byte level), the snapshot format must be bumped - see
 // TestMultistoreSnapshot_Checksum test.
 func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
+
+	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
+	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
+	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
+	// are demarcated by new SnapshotStore items.
+	for _, store := range stores {
+		if err := snapshotStore(store.Store, store.name, height, protoWriter); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SnapshotStoreNames implements snapshottypes.StoreSnapshotter.
+func (rs *Store) SnapshotStoreNames(height uint64) ([]string, error) {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(stores))
+	for i, store := range stores {
+		names[i] = store.name
+	}
+	return names, nil
+}
+
+// SnapshotStore implements snapshottypes.StoreSnapshotter.
+func (rs *Store) SnapshotStore(height uint64, storeName string, protoWriter protoio.Writer) error {
+	stores, err := rs.snapshotStores(height)
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		if store.name == storeName {
+			return snapshotStore(store.Store, store.name, height, protoWriter)
+		}
+	}
+	return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot unknown store %q", storeName)
+}
+
+type namedStore struct {
+	*iavl.Store
+	name string
+}
+
+// snapshotStores returns the stores to snapshot at the height, sorted by name.
+func (rs *Store) snapshotStores(height uint64) ([]namedStore, error) {
 	if height == 0 {
-		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
 	}
 	if height > uint64(GetLatestVersion(rs.db)) {
-		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
 	}
 
 	// Collect stores to snapshot (only IAVL stores are supported)
-	type namedStore struct {
-		*iavl.Store
-		name string
-	}
 	stores := []namedStore{}
 	for key := range rs.stores {
 		switch store := rs.GetCommitKVStore(key).(type) {
@@ -736,60 +907,62 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 			// Non-persisted stores shouldn't be snapshotted
 			continue
 		default:
-			return sdkerrors.Wrapf(sdkerrors.ErrLogic,
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic,
 				"don't know how to snapshot store %q of type %T", key.Name(), store)
 		}
 	}
 	sort.Slice(stores, func(i, j int) bool {
 		return strings.Compare(stores[i].name, stores[j].name) == -1
 	})
-
-	// Export each IAVL store. Stores are serialized as a stream of SnapshotItem Protobuf
-	// messages. The first item contains a SnapshotStore with store metadata (i.e. name),
-	// and the following messages contain a SnapshotNode (i.e. an ExportNode). Store changes
-	// are demarcated by new SnapshotStore items.
 	for _, store := range stores {
-		exporter, err := store.Export(int64(height))
-		if err != nil {
+		if err := rs.checkVersionNotPruned(rs.keysByName[store.name], store.Store, int64(height)); err != nil {
+			return nil, sdkerrors.Wrap(err, "cannot snapshot height")
+		}
+	}
+
+	return stores, nil
+}
+
+// snapshotStore writes the SnapshotStore item of the store followed by its exported nodes.
+func snapshotStore(store *iavl.Store, name string, height uint64, protoWriter protoio.Writer) error {
+	exporter, err := store.Export(int64(height))
+	if err != nil {
+		return err
+	}
+	defer exporter.Close()
+
+	err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
+		Item: &snapshottypes.SnapshotItem_Store{
+			Store: &snapshottypes.SnapshotStoreItem{
+				Name: name,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		node, err := exporter.Next()
+		if err == iavltree.ExportDone {
+			return nil
+		} else if err != nil {
 			return err
 		}
-		defer exporter.Close()
 		err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-			Item: &snapshottypes.SnapshotItem_Store{
-				Store: &snapshottypes.SnapshotStoreItem{
-					Name: store.name,
+			Item: &snapshottypes.SnapshotItem_IAVL{
+				IAVL: &snapshottypes.SnapshotIAVLItem{
+					Key:     node.Key,
+					Value:   node.Value,
+					Height:  int32(node.Height),
+					Version: node.Version,
 				},
 			},
 		})
 		if err != nil {
 			return err
 		}
-
-		for {
-			node, err := exporter.Next()
-			if err == iavltree.ExportDone {
-				break
-			} else if err != nil {
-				return err
-			}
-			err = protoWriter.WriteMsg(&snapshottypes.SnapshotItem{
-				Item: &snapshottypes.SnapshotItem_IAVL{
-					IAVL: &snapshottypes.SnapshotIAVLItem{
-						Key:     node.Key,
-						Value:   node.Value,
-						Height:  int32(node.Height),
-						Version: node.Version,
-					},
-				},
-			})
-			if err != nil {
-				return err
-			}
-		}
-		exporter.Close()
 	}
-
-	return nil
 }
 
 // Restore implements snapshottypes.Snapshotter.
@@ -821,13 +994,9 @@ loop:
 				}
 				importer.Close()
 			}
-			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
-			if !ok || store == nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
-			}
-			importer, err = store.Import(int64(height))
+			importer, err = rs.storeImporter(item.Store.Name, height)
 			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "import failed")
+				return snapshottypes.SnapshotItem{}, err
 			}
 			defer importer.Close()
 
@@ -835,27 +1004,8 @@ loop:
 			if importer == nil {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
-			if item.IAVL.Height > math.MaxInt8 {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			node := &iavltree.ExportNode{
-				Key:     item.IAVL.Key,
-				Value:   item.IAVL.Value,
-				Height:  int8(item.IAVL.Height),
-				Version: item.IAVL.Version,
-			}
-			// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 && node.Value == nil {
-				node.Value = []byte{}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL node import failed")
+			if err := importNode(importer, item.IAVL); err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
 
 		default:
@@ -871,8 +1021,82 @@ loop:
 		importer.Close()
 	}
 
+	return snapshotItem, rs.CommitRestore(height)
+}
+
+// RestoreStore implements snapshottypes.StoreSnapshotter.
+func (rs *Store) RestoreStore(height uint64, storeName string, protoReader protoio.Reader) error {
+	importer, err := rs.storeImporter(storeName, height)
+	if err != nil {
+		return err
+	}
+	defer importer.Close()
+
+	for {
+		snapshotItem := snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&snapshotItem)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		item, ok := snapshotItem.Item.(*snapshottypes.SnapshotItem_IAVL)
+		if !ok {
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unexpected snapshot item %T in store %q", snapshotItem.Item, storeName)
+		}
+		if err := importNode(importer, item.IAVL); err != nil {
+			return err
+		}
+	}
+
+	if err := importer.Commit(); err != nil {
+		return sdkerrors.Wrap(err, "IAVL commit failed")
+	}
+	return nil
+}
+
+// CommitRestore implements snapshottypes.StoreSnapshotter.
+func (rs *Store) CommitRestore(height uint64) error {
 	rs.flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)))
-	return snapshotItem, rs.LoadLatestVersion()
+	return rs.LoadLatestVersion()
+}
+
+func (rs *Store) storeImporter(storeName string, height uint64) (*iavltree.Importer, error) {
+	store, ok := rs.GetStoreByName(storeName).(*iavl.Store)
+	if !ok || store == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", storeName)
+	}
+	importer, err := store.Import(int64(height))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "import failed")
+	}
+	return importer, nil
+}
+
+func importNode(importer *iavltree.Importer, item *snapshottypes.SnapshotIAVLItem) error {
+	if item.Height > math.MaxInt8 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "node height %v cannot exceed %v",
+			item.Height, math.MaxInt8)
+	}
+	node := &iavltree.ExportNode{
+		Key:     item.Key,
+		Value:   item.Value,
+		Height:  int8(item.Height),
+		Version: item.Version,
+	}
+	// Protobuf does not differentiate between []byte{} as nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 && node.Value == nil {
+		node.Value = []byte{}
+	}
+	if err := importer.Add(node); err != nil {
+		return sdkerrors.Wrap(err, "IAVL node import failed")
+	}
+	return nil
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
@@ -1083,6 +1307,16 @@ func getCommitInfo(db dbm.DB, ver int64) (*types.CommitInfo, error) {
 	return cInfo, nil
 }
 
+// hasStoreInfo returns true if the commitInfo contains the info of the store.
+func hasStoreInfo(cInfo *types.CommitInfo, name string) bool {
+	for _, si := range cInfo.StoreInfos {
+		if si.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func flushCommitInfo(batch dbm.Batch, version int64, cInfo *types.CommitInfo) {
 	bz, err := cInfo.Marshal()
 	if err != nil {
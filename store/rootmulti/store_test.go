@@ -482,9 +482,9 @@ func TestMultiStore_Pruning(t *testing.T) {
 		saved       []int64
 	}{
 		{"prune nothing", 10, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), nil, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
-		{"prune everything", 10, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything), []int64{1, 2, 3, 4, 5, 6, 7, 8, 9}, []int64{10}},
-		{"prune some; no batch", 10, pruningtypes.NewCustomPruningOptions(2, 1), []int64{1, 2, 4, 5, 7}, []int64{3, 6, 8, 9, 10}},
-		{"prune some; small batch", 10, pruningtypes.NewCustomPruningOptions(2, 3), []int64{1, 2, 4, 5}, []int64{3, 6, 7, 8, 9, 10}},
+		{"prune everything", 10, pruningtypes.NewPruningOptions(pruningtypes.PruningEverything), []int64{1, 2, 3, 4, 5, 6, 7}, []int64{8, 9, 10}},
+		{"prune some; no batch", 10, pruningtypes.NewCustomPruningOptions(2, 1), []int64{1, 2, 3, 4, 5, 6, 7}, []int64{8, 9, 10}},
+		{"prune some; small batch", 10, pruningtypes.NewCustomPruningOptions(2, 3), []int64{1, 2, 3, 4, 5, 6}, []int64{7, 8, 9, 10}},
 		{"prune some; large batch", 10, pruningtypes.NewCustomPruningOptions(2, 11), nil, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
 	}
 
@@ -502,12 +502,12 @@ func TestMultiStore_Pruning(t *testing.T) {
 
 			for _, v := range tc.saved {
 				_, err := ms.CacheMultiStoreWithVersion(v)
-				require.NoError(t, err, "expected error when loading height: %d", v)
+				require.NoError(t, err, "expected no error when loading height: %d", v)
 			}
 
 			for _, v := range tc.deleted {
 				_, err := ms.CacheMultiStoreWithVersion(v)
-				require.NoError(t, err, "expected error when loading height: %d", v)
+				require.Error(t, err, "expected error when loading height: %d", v)
 			}
 		})
 	}
@@ -613,6 +613,94 @@ func TestMultiStore_PruningRestart(t *testing.T) {
 	}
 }
 
+func TestMultiStore_StorePruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStorePruning(testStoreKey3.Name(), pruningtypes.NewCustomPruningOptions(2, 3))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	require.Equal(t, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing), ms.GetStorePruning(testStoreKey1.Name()))
+	require.Equal(t, pruningtypes.NewCustomPruningOptions(2, 3), ms.GetStorePruning(testStoreKey3.Name()))
+
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+
+	store1 := ms.GetCommitKVStore(testStoreKey1).(*iavl.Store)
+	store3 := ms.GetCommitKVStore(testStoreKey3).(*iavl.Store)
+	for v := int64(1); v <= 10; v++ {
+		require.True(t, store1.VersionExists(v), "version %d", v)
+		require.Equal(t, v > 6, store3.VersionExists(v), "version %d", v)
+	}
+
+	_, err := ms.CacheMultiStoreWithVersion(7)
+	require.NoError(t, err)
+	_, err = ms.CacheMultiStoreWithVersion(6)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+	require.Contains(t, err.Error(), testStoreKey3.Name())
+
+	err = ms.Snapshot(6, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+	require.Contains(t, err.Error(), testStoreKey3.Name())
+
+	// pruning the heights explicitly leaves the store with its own options untouched
+	require.NoError(t, ms.PruneStores(false, []int64{8}))
+	require.False(t, store1.VersionExists(8))
+	require.True(t, store3.VersionExists(8))
+
+	// the heights of the store pending pruning are loaded after a restart
+	ms.Commit()
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.SetStorePruning(testStoreKey3.Name(), pruningtypes.NewCustomPruningOptions(2, 3))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit()
+
+	store1 = ms.GetCommitKVStore(testStoreKey1).(*iavl.Store)
+	store3 = ms.GetCommitKVStore(testStoreKey3).(*iavl.Store)
+	for v := int64(7); v <= 9; v++ {
+		require.False(t, store3.VersionExists(v), "version %d", v)
+	}
+	require.True(t, store3.VersionExists(10))
+	require.True(t, store1.VersionExists(9))
+}
+
+func TestMultiStore_StorePruningAddedStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := 0; i < 10; i++ {
+		ms.Commit()
+	}
+
+	// add a store pruning its versions with an upgrade
+	key4 := types.NewKVStoreKey("store4")
+	ms = newMultiStoreWithMounts(db, pruningtypes.NewPruningOptions(pruningtypes.PruningNothing))
+	ms.MountStoreWithDB(key4, types.StoreTypeIAVL, nil)
+	ms.SetStorePruning(key4.Name(), pruningtypes.NewCustomPruningOptions(2, 1))
+	require.NoError(t, ms.LoadLatestVersionAndUpgrade(&types.StoreUpgrades{Added: []string{key4.Name()}}))
+	for i := 0; i < 5; i++ {
+		ms.GetKVStore(key4).Set([]byte("key"), []byte("value"))
+		ms.Commit()
+	}
+
+	store4 := ms.GetCommitKVStore(key4).(*iavl.Store)
+	require.False(t, store4.VersionExists(5))
+	require.False(t, store4.VersionExists(11))
+
+	// the versions preceding the addition of the store were never pruned
+	cms, err := ms.CacheMultiStoreWithVersion(5)
+	require.NoError(t, err)
+	require.Nil(t, cms.GetKVStore(key4).Get([]byte("key")))
+
+	_, err = ms.CacheMultiStoreWithVersion(11)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidHeight)
+	require.Contains(t, err.Error(), key4.Name())
+
+	cms, err = ms.CacheMultiStoreWithVersion(15)
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), cms.GetKVStore(key4).Get([]byte("key")))
+}
+
 // TestUnevenStoresHeightCheck tests if loading root store correctly errors when
 // there's any module store with the wrong height
 func TestUnevenStoresHeightCheck(t *testing.T) {
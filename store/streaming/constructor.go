@@ -10,12 +10,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/historical"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/spf13/cast"
+	dbm "github.com/tendermint/tm-db"
 )
 
 // ServiceConstructor is used to construct a streaming service
@@ -27,6 +31,8 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	GRPC
+	Historical
 )
 
 // Streaming option keys
@@ -37,6 +43,13 @@ const (
 	OptStreamersFileStopNodeOnError = "streamers.file.stop-node-on-error"
 	OptStreamersFileFsync           = "streamers.file.fsync"
 
+	OptStreamersGRPCAddress          = "streamers.grpc.address"
+	OptStreamersGRPCBufferSize       = "streamers.grpc.buffer-size"
+	OptStreamersGRPCHistorySize      = "streamers.grpc.history-size"
+	OptStreamersGRPCHaltOnSlowClient = "streamers.grpc.halt-on-slow-client"
+
+	OptStreamersHistoricalServeQueries = "streamers.historical.serve-queries"
+
 	OptStoreStreamers = "store.streamers"
 )
 
@@ -47,6 +60,12 @@ func ServiceTypeFromString(name string) ServiceType {
 	case "file", "f":
 		return File
 
+	case "grpc":
+		return GRPC
+
+	case "historical":
+		return Historical
+
 	default:
 		return Unknown
 	}
@@ -58,6 +77,12 @@ func (sst ServiceType) String() string {
 	case File:
 		return "file"
 
+	case GRPC:
+		return "grpc"
+
+	case Historical:
+		return "historical"
+
 	default:
 		return "unknown"
 	}
@@ -66,7 +91,9 @@ func (sst ServiceType) String() string {
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to
 // streaming.ServiceConstructors types.
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:       NewFileStreamingService,
+	GRPC:       NewGRPCStreamingService,
+	Historical: NewHistoricalStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding
@@ -113,6 +140,38 @@ func NewFileStreamingService(
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, outputMetadata, stopNodeOnErr, fsync)
 }
 
+// NewGRPCStreamingService is the streaming.ServiceConstructor function for
+// creating a gRPC StreamingService.
+func NewGRPCStreamingService(
+	opts serverTypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+) (baseapp.StreamingService, error) {
+	address := cast.ToString(opts.Get(OptStreamersGRPCAddress))
+	bufferSize := cast.ToInt(opts.Get(OptStreamersGRPCBufferSize))
+	historySize := cast.ToInt(opts.Get(OptStreamersGRPCHistorySize))
+	haltOnSlowClient := cast.ToBool(opts.Get(OptStreamersGRPCHaltOnSlowClient))
+
+	return grpc.NewStreamingService(address, keys, bufferSize, historySize, haltOnSlowClient)
+}
+
+// NewHistoricalStreamingService is the streaming.ServiceConstructor function
+// for creating a historical StreamingService, recording the state changes in
+// the historical database of the node home directory.
+func NewHistoricalStreamingService(
+	opts serverTypes.AppOptions,
+	keys []types.StoreKey,
+	_ codec.BinaryCodec,
+) (baseapp.StreamingService, error) {
+	dataDir := path.Join(cast.ToString(opts.Get(flags.FlagHome)), "data")
+	db, err := dbm.NewDB(historical.DBName, server.GetAppDBBackend(opts), dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return historical.NewStreamingService(db, keys), nil
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the
 // BaseApp using the provided AppOptions, codec, and keys. It returns the
 // WaitGroup and quit channel used to synchronize with the streaming services
@@ -179,6 +238,19 @@ func LoadStreamingServices(
 			return nil, nil, err
 		}
 
+		// serve the queries from the historical state if enabled, which requires
+		// the changes of every store to be recorded
+		if hss, ok := streamingService.(*historical.StreamingService); ok && cast.ToBool(appOpts.Get(OptStreamersHistoricalServeQueries)) {
+			if len(exposeStoreKeys) != len(keys) {
+				for _, activeStreamer := range append(activeStreamers, streamingService) {
+					activeStreamer.Close()
+				}
+
+				return nil, nil, fmt.Errorf("serving queries from the historical state requires the keys of all the stores to be exposed")
+			}
+			bApp.SetQueryMultiStore(hss.MultiStore())
+		}
+
 		// register the streaming service with the BaseApp
 		bApp.SetStreamingService(streamingService)
 
@@ -8,8 +8,10 @@ import (
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store/historical"
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/grpc"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -54,6 +56,34 @@ func TestStreamingServiceConstructor(t *testing.T) {
 	}
 }
 
+func TestGRPCStreamingServiceConstructor(t *testing.T) {
+	constructor, err := streaming.NewServiceConstructor("grpc")
+	require.Nil(t, err)
+
+	serv, err := constructor(grpcOptions{}, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	defer serv.Close()
+	require.IsType(t, &grpc.StreamingService{}, serv)
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+}
+
+type grpcOptions struct{}
+
+func (grpcOptions) Get(key string) interface{} {
+	switch key {
+	case streaming.OptStreamersGRPCAddress:
+		return "127.0.0.1:0"
+	case streaming.OptStreamersGRPCBufferSize:
+		return 10
+	default:
+		return nil
+	}
+}
+
 func TestLoadStreamingServices(t *testing.T) {
 	db := dbm.NewMemDB()
 	encCdc := simapp.MakeTestEncodingConfig()
@@ -105,3 +135,65 @@ func (ao streamingAppOptions) Get(o string) interface{} {
 		return nil
 	}
 }
+
+func TestLoadHistoricalStreamingService(t *testing.T) {
+	encCdc := simapp.MakeTestEncodingConfig()
+	keys := sdk.NewKVStoreKeys("mockKey1", "mockKey2")
+
+	testCases := map[string]struct {
+		keys         []string
+		serveQueries bool
+		expErr       bool
+	}{
+		"recording only": {
+			keys: []string{"mockKey1"},
+		},
+		"serving queries": {
+			keys:         []string{"*"},
+			serveQueries: true,
+		},
+		"serving queries without all the StoreKeys": {
+			keys:         []string{"mockKey1"},
+			serveQueries: true,
+			expErr:       true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bApp := baseapp.NewBaseApp("appName", log.NewNopLogger(), dbm.NewMemDB(), nil)
+			appOpts := historicalAppOptions{home: t.TempDir(), keys: tc.keys, serveQueries: tc.serveQueries}
+
+			activeStreamers, _, err := streaming.LoadStreamingServices(bApp, appOpts, encCdc.Codec, keys)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, activeStreamers, 1)
+			require.IsType(t, &historical.StreamingService{}, activeStreamers[0])
+			require.NoError(t, activeStreamers[0].Close())
+		})
+	}
+}
+
+type historicalAppOptions struct {
+	home         string
+	keys         []string
+	serveQueries bool
+}
+
+func (ao historicalAppOptions) Get(o string) interface{} {
+	switch o {
+	case "home":
+		return ao.home
+	case "store.streamers":
+		return []string{"historical"}
+	case "streamers.historical.keys":
+		return ao.keys
+	case streaming.OptStreamersHistoricalServeQueries:
+		return ao.serveQueries
+	default:
+		return nil
+	}
+}
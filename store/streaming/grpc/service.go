@@ -0,0 +1,375 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	_ baseapp.StreamingService = &StreamingService{}
+	_ StateStreamingServer     = &StreamingService{}
+)
+
+// StreamingService is a concrete implementation of StreamingService that pushes
+// the ABCI messages and state changes of each committed block to the clients
+// of a server-streaming gRPC endpoint.
+type StreamingService struct {
+	storeListeners []*types.MemoryListener // a series of KVStore listeners for each KVStore
+	storeKeys      map[string]bool         // names of the exposed KVStores
+
+	listener net.Listener
+	server   *grpc.Server
+
+	currentBlockNumber int64
+	blockMetadata      types.BlockMetadata
+
+	// bufferSize is the number of blocks queued for each client before it is
+	// considered too slow.
+	bufferSize int
+
+	// historySize is the number of recent blocks kept in memory so that clients
+	// can resume streaming from a past height.
+	historySize int
+
+	// haltOnSlowClient, if true, will return an error and stop the node during
+	// ABCI Commit when a client falls behind, otherwise the client is
+	// disconnected and has to resume streaming from the last height it received.
+	haltOnSlowClient bool
+
+	mtx         sync.Mutex
+	history     []*BlockData
+	subscribers map[*subscriber]struct{}
+	closed      bool
+}
+
+// subscriber is a client of the StreamBlocks endpoint.
+type subscriber struct {
+	storeKeys  map[string]bool // names of the KVStores streamed, all of them if empty
+	nextHeight int64           // height of the next block to send, any if zero
+	blocks     chan *BlockData // blocks to send
+	done       chan struct{}   // closed when the subscriber is dropped
+	err        error           // reason the subscriber was dropped
+}
+
+// NewStreamingService creates a StreamingService serving the StateStreaming
+// gRPC endpoint at the given address.
+func NewStreamingService(
+	address string,
+	storeKeys []types.StoreKey,
+	bufferSize, historySize int,
+	haltOnSlowClient bool,
+) (*StreamingService, error) {
+	if bufferSize <= 0 {
+		return nil, fmt.Errorf("grpc streaming buffer size must be positive: %d", bufferSize)
+	}
+	if historySize < 0 {
+		return nil, fmt.Errorf("grpc streaming history size must not be negative: %d", historySize)
+	}
+
+	// sort storeKeys for deterministic output
+	sort.SliceStable(storeKeys, func(i, j int) bool {
+		return storeKeys[i].Name() < storeKeys[j].Name()
+	})
+
+	listeners := make([]*types.MemoryListener, len(storeKeys))
+	names := make(map[string]bool, len(storeKeys))
+	for i, key := range storeKeys {
+		listeners[i] = types.NewMemoryListener(key)
+		names[key.Name()] = true
+	}
+
+	// Listen at initialization so that an invalid or busy address is reported
+	// before the node starts.
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the messages do not contain any Any, an empty registry is enough
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	server := grpc.NewServer(grpc.ForceServerCodec(cdc.GRPCCodec()))
+
+	ss := &StreamingService{
+		storeListeners:   listeners,
+		storeKeys:        names,
+		listener:         lis,
+		server:           server,
+		bufferSize:       bufferSize,
+		historySize:      historySize,
+		haltOnSlowClient: haltOnSlowClient,
+		subscribers:      make(map[*subscriber]struct{}),
+	}
+	RegisterStateStreamingServer(server, ss)
+
+	return ss, nil
+}
+
+// Addr returns the network address the gRPC endpoint listens on.
+func (ss *StreamingService) Addr() net.Addr {
+	return ss.listener.Addr()
+}
+
+// Listeners satisfies the StreamingService interface. It returns the
+// StreamingService's underlying WriteListeners. Use for registering the
+// underlying WriteListeners with the BaseApp.
+func (ss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(ss.storeListeners))
+	for _, listener := range ss.storeListeners {
+		listeners[listener.StoreKey()] = []types.WriteListener{listener}
+	}
+
+	return listeners
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface. It sets the received
+// BeginBlock request, response and the current block number. Note, these are
+// not sent until ListenCommit is executed.
+func (ss *StreamingService) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	ss.blockMetadata = types.BlockMetadata{
+		RequestBeginBlock:  &req,
+		ResponseBeginBlock: &res,
+	}
+	ss.currentBlockNumber = req.Header.Height
+	return nil
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface. It appends the received
+// DeliverTx request and response to a list of DeliverTxs objects. Note, these
+// are not sent until ListenCommit is executed.
+func (ss *StreamingService) ListenDeliverTx(ctx context.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	ss.blockMetadata.DeliverTxs = append(ss.blockMetadata.DeliverTxs, &types.BlockMetadata_DeliverTx{
+		Request:  &req,
+		Response: &res,
+	})
+
+	return nil
+}
+
+// ListenEndBlock satisfies the ABCIListener interface. It sets the received
+// EndBlock request and response. Note, these are not sent until ListenCommit
+// is executed.
+func (ss *StreamingService) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	ss.blockMetadata.RequestEndBlock = &req
+	ss.blockMetadata.ResponseEndBlock = &res
+	return nil
+}
+
+// ListenCommit satisfies the ABCIListener interface. It is executed during the
+// ABCI Commit request and queues the block data for every client. It will only
+// return a non-nil error when haltOnSlowClient is set and a client fell behind.
+func (ss *StreamingService) ListenCommit(ctx context.Context, res abci.ResponseCommit) error {
+	metadata := ss.blockMetadata
+	metadata.ResponseCommit = &res
+	ss.blockMetadata = types.BlockMetadata{}
+
+	block := &BlockData{
+		Height:   ss.currentBlockNumber,
+		Metadata: &metadata,
+	}
+	for _, listener := range ss.storeListeners {
+		cache := listener.PopStateCache()
+		for i := range cache {
+			block.StateChanges = append(block.StateChanges, &cache[i])
+		}
+	}
+
+	return ss.publish(block)
+}
+
+// publish adds the block to the history and queues it for every subscriber.
+func (ss *StreamingService) publish(block *BlockData) error {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	if ss.historySize > 0 {
+		ss.history = append(ss.history, block)
+		if len(ss.history) > ss.historySize {
+			ss.history = ss.history[len(ss.history)-ss.historySize:]
+		}
+	}
+
+	for sub := range ss.subscribers {
+		switch {
+		case sub.nextHeight != 0 && block.Height < sub.nextHeight:
+			// the client starts at a later height
+			continue
+
+		case sub.nextHeight != 0 && block.Height > sub.nextHeight:
+			ss.drop(sub, status.Errorf(codes.OutOfRange, "height %d is not available, next available height is %d", sub.nextHeight, block.Height))
+			continue
+		}
+
+		select {
+		case sub.blocks <- block:
+			sub.nextHeight = block.Height + 1
+
+		default:
+			if ss.haltOnSlowClient {
+				return fmt.Errorf("grpc streaming client fell behind at height %d", block.Height)
+			}
+
+			ss.drop(sub, status.Errorf(codes.ResourceExhausted, "client fell behind, resume streaming from height %d", block.Height))
+		}
+	}
+
+	return nil
+}
+
+// StreamBlocks implements the StateStreaming/StreamBlocks gRPC method.
+func (ss *StreamingService) StreamBlocks(req *StreamBlocksRequest, stream StateStreaming_StreamBlocksServer) error {
+	if req.StartHeight < 0 {
+		return status.Errorf(codes.InvalidArgument, "invalid start height %d", req.StartHeight)
+	}
+
+	storeKeys := make(map[string]bool, len(req.StoreKeys))
+	for _, name := range req.StoreKeys {
+		if !ss.storeKeys[name] {
+			return status.Errorf(codes.InvalidArgument, "store %s is not exposed", name)
+		}
+		storeKeys[name] = true
+	}
+
+	sub, replay, err := ss.subscribe(req.StartHeight, storeKeys)
+	if err != nil {
+		return err
+	}
+	defer ss.unsubscribe(sub)
+
+	for _, block := range replay {
+		if err := stream.Send(sub.filter(block)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case block := <-sub.blocks:
+			if err := stream.Send(sub.filter(block)); err != nil {
+				return err
+			}
+
+		case <-sub.done:
+			return sub.err
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// subscribe registers a new subscriber starting at the given height, and
+// returns the blocks of the history it has to be sent first.
+func (ss *StreamingService) subscribe(startHeight int64, storeKeys map[string]bool) (*subscriber, []*BlockData, error) {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	if ss.closed {
+		return nil, nil, status.Error(codes.Unavailable, "streaming service is closed")
+	}
+
+	sub := &subscriber{
+		storeKeys:  storeKeys,
+		nextHeight: startHeight,
+		blocks:     make(chan *BlockData, ss.bufferSize),
+		done:       make(chan struct{}),
+	}
+
+	var replay []*BlockData
+	if startHeight > 0 && len(ss.history) > 0 {
+		if oldest := ss.history[0].Height; startHeight < oldest {
+			return nil, nil, status.Errorf(codes.OutOfRange, "height %d is not available, oldest available height is %d", startHeight, oldest)
+		}
+
+		for _, block := range ss.history {
+			if block.Height >= startHeight {
+				replay = append(replay, block)
+				sub.nextHeight = block.Height + 1
+			}
+		}
+	}
+
+	ss.subscribers[sub] = struct{}{}
+
+	return sub, replay, nil
+}
+
+// unsubscribe removes the subscriber if it is still registered.
+func (ss *StreamingService) unsubscribe(sub *subscriber) {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	delete(ss.subscribers, sub)
+}
+
+// drop removes the subscriber and ends its stream with the given error. It
+// must be called with the lock held.
+func (ss *StreamingService) drop(sub *subscriber, err error) {
+	delete(ss.subscribers, sub)
+	sub.err = err
+	close(sub.done)
+}
+
+// filter returns the block with only the state changes of the subscriber's
+// stores.
+func (sub *subscriber) filter(block *BlockData) *BlockData {
+	if len(sub.storeKeys) == 0 {
+		return block
+	}
+
+	filtered := &BlockData{
+		Height:   block.Height,
+		Metadata: block.Metadata,
+	}
+	for _, kv := range block.StateChanges {
+		if sub.storeKeys[kv.StoreKey] {
+			filtered.StateChanges = append(filtered.StateChanges, kv)
+		}
+	}
+
+	return filtered
+}
+
+// Stream satisfies the StreamingService interface. It starts serving the gRPC
+// endpoint in the background.
+func (ss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// Serve only returns once the server is stopped by Close
+		_ = ss.server.Serve(ss.listener)
+	}()
+
+	return nil
+}
+
+// Close satisfies the StreamingService interface. It disconnects the clients
+// and stops the gRPC endpoint.
+func (ss *StreamingService) Close() error {
+	ss.mtx.Lock()
+	if !ss.closed {
+		ss.closed = true
+		for sub := range ss.subscribers {
+			ss.drop(sub, status.Error(codes.Unavailable, "streaming service is closed"))
+		}
+	}
+	ss.mtx.Unlock()
+
+	// Stop closes the listener once served, close it in case Stream was not called
+	_ = ss.listener.Close()
+	ss.server.Stop()
+
+	return nil
+}
@@ -0,0 +1,198 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+
+	testDeliverTxReq = abci.RequestDeliverTx{Tx: []byte{9, 8, 7}}
+	testDeliverTxRes = abci.ResponseDeliverTx{Code: 1, Log: "mockLog"}
+	testCommitRes    = abci.ResponseCommit{Data: []byte{1}}
+)
+
+func newTestService(t *testing.T, bufferSize, historySize int, haltOnSlowClient bool) *StreamingService {
+	ss, err := NewStreamingService("127.0.0.1:0", []types.StoreKey{mockStoreKey2, mockStoreKey1}, bufferSize, historySize, haltOnSlowClient)
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, ss.Stream(wg))
+	t.Cleanup(func() {
+		require.NoError(t, ss.Close())
+		wg.Wait()
+	})
+
+	return ss
+}
+
+func newTestClient(t *testing.T, ss *StreamingService) StateStreamingClient {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	conn, err := grpc.Dial(
+		ss.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(cdc.GRPCCodec())),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return NewStateStreamingClient(conn)
+}
+
+// commitBlock runs the listeners for a block at the given height, with a
+// transaction and a write to each store.
+func commitBlock(t *testing.T, ss *StreamingService, height int64) error {
+	ctx := context.Background()
+	require.NoError(t, ss.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: tmproto.Header{Height: height}}, abci.ResponseBeginBlock{}))
+	require.NoError(t, ss.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes))
+
+	listeners := ss.Listeners()
+	for _, key := range []types.StoreKey{mockStoreKey1, mockStoreKey2} {
+		require.Len(t, listeners[key], 1)
+		require.NoError(t, listeners[key][0].OnWrite(key, []byte(key.Name()), []byte{byte(height)}, false))
+	}
+
+	require.NoError(t, ss.ListenEndBlock(ctx, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+	return ss.ListenCommit(ctx, testCommitRes)
+}
+
+func TestNewStreamingService(t *testing.T) {
+	_, err := NewStreamingService("127.0.0.1:0", nil, 0, 1, false)
+	require.Error(t, err)
+
+	_, err = NewStreamingService("127.0.0.1:0", nil, 1, -1, false)
+	require.Error(t, err)
+
+	_, err = NewStreamingService("invalid address", nil, 1, 1, false)
+	require.Error(t, err)
+}
+
+func TestStreamBlocks(t *testing.T) {
+	ss := newTestService(t, 10, 2, false)
+	client := newTestClient(t, ss)
+	ctx := context.Background()
+
+	// invalid requests
+	stream, err := client.StreamBlocks(ctx, &StreamBlocksRequest{StoreKeys: []string{"unknown"}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stream, err = client.StreamBlocks(ctx, &StreamBlocksRequest{StartHeight: -1})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	for height := int64(1); height <= 3; height++ {
+		require.NoError(t, commitBlock(t, ss, height))
+	}
+
+	// height 1 is no longer kept in the history
+	stream, err = client.StreamBlocks(ctx, &StreamBlocksRequest{StartHeight: 1})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Equal(t, codes.OutOfRange, status.Code(err))
+
+	// resume from height 2, with the changes of the first store only
+	stream, err = client.StreamBlocks(ctx, &StreamBlocksRequest{StoreKeys: []string{mockStoreKey1.Name()}, StartHeight: 2})
+	require.NoError(t, err)
+
+	for height := int64(2); height <= 3; height++ {
+		block, err := stream.Recv()
+		require.NoError(t, err)
+		require.Equal(t, height, block.Height)
+		require.Equal(t, []*types.StoreKVPair{
+			{StoreKey: mockStoreKey1.Name(), Key: []byte(mockStoreKey1.Name()), Value: []byte{byte(height)}},
+		}, block.StateChanges)
+	}
+
+	// the next committed blocks are pushed
+	require.NoError(t, commitBlock(t, ss, 4))
+	block, err := stream.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(4), block.Height)
+	require.Len(t, block.StateChanges, 1)
+	require.Equal(t, int64(4), block.Metadata.RequestBeginBlock.Header.Height)
+	require.Equal(t, []*types.BlockMetadata_DeliverTx{{Request: &testDeliverTxReq, Response: &testDeliverTxRes}}, block.Metadata.DeliverTxs)
+	require.Equal(t, int64(4), block.Metadata.RequestEndBlock.Height)
+	require.Equal(t, &testCommitRes, block.Metadata.ResponseCommit)
+
+	// a new client streams all the stores from the next block
+	stream2, err := client.StreamBlocks(ctx, &StreamBlocksRequest{})
+	require.NoError(t, err)
+	// wait for the subscription before committing
+	require.Eventually(t, func() bool {
+		ss.mtx.Lock()
+		defer ss.mtx.Unlock()
+		return len(ss.subscribers) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, commitBlock(t, ss, 5))
+	block, err = stream2.Recv()
+	require.NoError(t, err)
+	require.Equal(t, int64(5), block.Height)
+	require.Len(t, block.StateChanges, 2)
+
+	// closing the service ends the streams
+	require.NoError(t, ss.Close())
+	_, err = stream2.Recv()
+	require.Error(t, err)
+}
+
+func TestSlowClient(t *testing.T) {
+	// the client is disconnected
+	ss := newTestService(t, 1, 0, false)
+	sub, replay, err := ss.subscribe(0, nil)
+	require.NoError(t, err)
+	require.Empty(t, replay)
+
+	require.NoError(t, commitBlock(t, ss, 1))
+	require.NoError(t, commitBlock(t, ss, 2))
+	<-sub.done
+	require.Equal(t, codes.ResourceExhausted, status.Code(sub.err))
+	require.Empty(t, ss.subscribers)
+
+	// the node halts
+	ss = newTestService(t, 1, 0, true)
+	_, _, err = ss.subscribe(0, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, commitBlock(t, ss, 1))
+	require.Error(t, commitBlock(t, ss, 2))
+}
+
+func TestStartHeightGap(t *testing.T) {
+	ss := newTestService(t, 10, 0, false)
+
+	// height 1 was committed before the client subscribed
+	sub, _, err := ss.subscribe(1, nil)
+	require.NoError(t, err)
+	require.NoError(t, commitBlock(t, ss, 2))
+	<-sub.done
+	require.Equal(t, codes.OutOfRange, status.Code(sub.err))
+
+	// the client waits for a later height
+	sub, _, err = ss.subscribe(4, nil)
+	require.NoError(t, err)
+	require.NoError(t, commitBlock(t, ss, 3))
+	require.NoError(t, commitBlock(t, ss, 4))
+	block := <-sub.blocks
+	require.Equal(t, int64(4), block.Height)
+}
@@ -0,0 +1,769 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/base/store/v1beta1/streaming.proto
+
+package grpc
+
+import (
+	context "context"
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/store/types"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// StreamBlocksRequest is the request type for the StateStreaming/StreamBlocks
+// RPC method.
+type StreamBlocksRequest struct {
+	// store_keys are the names of the stores to stream the state changes of. All
+	// the stores exposed by the service are streamed if empty.
+	StoreKeys []string `protobuf:"bytes,1,rep,name=store_keys,json=storeKeys,proto3" json:"store_keys,omitempty"`
+	// start_height is the height to resume streaming from. The blocks from this
+	// height that are still kept by the service are sent first. The stream starts
+	// with the next committed block if zero.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+}
+
+func (m *StreamBlocksRequest) Reset()         { *m = StreamBlocksRequest{} }
+func (m *StreamBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamBlocksRequest) ProtoMessage()    {}
+func (*StreamBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20155f3e7501d264, []int{0}
+}
+func (m *StreamBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamBlocksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlocksRequest.Merge(m, src)
+}
+func (m *StreamBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamBlocksRequest proto.InternalMessageInfo
+
+func (m *StreamBlocksRequest) GetStoreKeys() []string {
+	if m != nil {
+		return m.StoreKeys
+	}
+	return nil
+}
+
+func (m *StreamBlocksRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+// BlockData contains the ABCI messages and the state changes of a committed
+// block.
+type BlockData struct {
+	Height       int64                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Metadata     *types.BlockMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	StateChanges []*types.StoreKVPair `protobuf:"bytes,3,rep,name=state_changes,json=stateChanges,proto3" json:"state_changes,omitempty"`
+}
+
+func (m *BlockData) Reset()         { *m = BlockData{} }
+func (m *BlockData) String() string { return proto.CompactTextString(m) }
+func (*BlockData) ProtoMessage()    {}
+func (*BlockData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_20155f3e7501d264, []int{1}
+}
+func (m *BlockData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockData.Merge(m, src)
+}
+func (m *BlockData) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockData proto.InternalMessageInfo
+
+func (m *BlockData) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockData) GetMetadata() *types.BlockMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *BlockData) GetStateChanges() []*types.StoreKVPair {
+	if m != nil {
+		return m.StateChanges
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*StreamBlocksRequest)(nil), "cosmos.base.store.v1beta1.StreamBlocksRequest")
+	proto.RegisterType((*BlockData)(nil), "cosmos.base.store.v1beta1.BlockData")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/base/store/v1beta1/streaming.proto", fileDescriptor_20155f3e7501d264)
+}
+
+var fileDescriptor_20155f3e7501d264 = []byte{
+	// 343 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0xbb, 0x5f, 0xa0, 0x7c, 0xdd, 0x56, 0x0f, 0x2b, 0x48, 0x2d, 0x18, 0x6a, 0x11, 0x89,
+	0x07, 0x37, 0xb6, 0xbe, 0x41, 0xed, 0x41, 0x28, 0x82, 0x24, 0xa0, 0xe0, 0xa5, 0x6c, 0xd2, 0x35,
+	0x09, 0x6d, 0xba, 0x35, 0x33, 0x15, 0xfb, 0x16, 0x3e, 0x8d, 0xcf, 0xe0, 0xb1, 0x47, 0x8f, 0xd2,
+	0xbe, 0x88, 0x74, 0x1a, 0x8b, 0x20, 0xc6, 0x53, 0xd8, 0xe1, 0xf7, 0xff, 0xc1, 0xfc, 0x27, 0xfc,
+	0x34, 0x34, 0x90, 0x1a, 0x70, 0x03, 0x05, 0xda, 0x05, 0x34, 0x99, 0x76, 0x9f, 0xda, 0x81, 0x46,
+	0xd5, 0x76, 0x01, 0x33, 0xad, 0xd2, 0x64, 0x12, 0xc9, 0x69, 0x66, 0xd0, 0x88, 0x83, 0x0d, 0x2a,
+	0xd7, 0xa8, 0x24, 0x54, 0xe6, 0x68, 0xa3, 0xc0, 0x32, 0x4e, 0x00, 0xf5, 0x64, 0x6b, 0x69, 0xdd,
+	0xf1, 0x3d, 0x9f, 0xc4, 0xdd, 0xb1, 0x09, 0x47, 0xe0, 0xe9, 0xc7, 0x99, 0x06, 0x14, 0x87, 0x9c,
+	0x53, 0x6e, 0x30, 0xd2, 0x73, 0xa8, 0xb3, 0xa6, 0xe5, 0x54, 0xbc, 0x0a, 0x4d, 0xfa, 0x7a, 0x0e,
+	0xe2, 0x88, 0xd7, 0x00, 0x55, 0x86, 0x83, 0x58, 0x27, 0x51, 0x8c, 0xf5, 0x7f, 0x4d, 0xe6, 0x58,
+	0x5e, 0x95, 0x66, 0x57, 0x34, 0x6a, 0xbd, 0x32, 0x5e, 0x21, 0x67, 0x4f, 0xa1, 0x12, 0xfb, 0xbc,
+	0x9c, 0xa3, 0x8c, 0xd0, 0xfc, 0x25, 0x7a, 0xfc, 0x7f, 0xaa, 0x51, 0x0d, 0x15, 0x2a, 0x92, 0x54,
+	0x3b, 0x8e, 0xfc, 0x75, 0x2f, 0x49, 0xbe, 0xeb, 0x9c, 0xf7, 0xb6, 0x49, 0xd1, 0xe7, 0x3b, 0x80,
+	0x0a, 0xf5, 0x20, 0x8c, 0xd5, 0x24, 0xd2, 0x50, 0xb7, 0x9a, 0x96, 0x53, 0xed, 0x9c, 0x14, 0xa8,
+	0x7c, 0xda, 0xe5, 0xf6, 0x46, 0x25, 0x99, 0x57, 0xa3, 0xf0, 0xe5, 0x26, 0xdb, 0x79, 0xe6, 0xbb,
+	0xfe, 0xfa, 0xed, 0x7f, 0xf5, 0x2d, 0x1e, 0x78, 0xed, 0x7b, 0x47, 0x42, 0x16, 0x7a, 0x7f, 0x94,
+	0xd9, 0x38, 0xfe, 0x6b, 0xa5, 0x75, 0x45, 0xe7, 0xac, 0xdb, 0x7f, 0x5b, 0xda, 0x6c, 0xb1, 0xb4,
+	0xd9, 0xc7, 0xd2, 0x66, 0x2f, 0x2b, 0xbb, 0xb4, 0x58, 0xd9, 0xa5, 0xf7, 0x95, 0x5d, 0xba, 0x6f,
+	0x47, 0x09, 0xc6, 0xb3, 0x40, 0x86, 0x26, 0x75, 0xf3, 0xdb, 0x6e, 0x3e, 0x67, 0x30, 0x1c, 0xe5,
+	0x17, 0xde, 0xfe, 0x1f, 0x6e, 0x94, 0x4d, 0xc3, 0xa0, 0x4c, 0xf7, 0xbd, 0xf8, 0x1c, 0x00, 0xc8,
+	0xc7, 0x17, 0x55, 0x52, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StateStreamingClient is the client API for StateStreaming service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StateStreamingClient interface {
+	// StreamBlocks streams the data of every committed block, starting at the
+	// requested height.
+	StreamBlocks(ctx context.Context, in *StreamBlocksRequest, opts ...grpc.CallOption) (StateStreaming_StreamBlocksClient, error)
+}
+
+type stateStreamingClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewStateStreamingClient(cc grpc1.ClientConn) StateStreamingClient {
+	return &stateStreamingClient{cc}
+}
+
+func (c *stateStreamingClient) StreamBlocks(ctx context.Context, in *StreamBlocksRequest, opts ...grpc.CallOption) (StateStreaming_StreamBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StateStreaming_serviceDesc.Streams[0], "/cosmos.base.store.v1beta1.StateStreaming/StreamBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateStreamingStreamBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StateStreaming_StreamBlocksClient interface {
+	Recv() (*BlockData, error)
+	grpc.ClientStream
+}
+
+type stateStreamingStreamBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateStreamingStreamBlocksClient) Recv() (*BlockData, error) {
+	m := new(BlockData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// StateStreamingServer is the server API for StateStreaming service.
+type StateStreamingServer interface {
+	// StreamBlocks streams the data of every committed block, starting at the
+	// requested height.
+	StreamBlocks(*StreamBlocksRequest, StateStreaming_StreamBlocksServer) error
+}
+
+// UnimplementedStateStreamingServer can be embedded to have forward compatible implementations.
+type UnimplementedStateStreamingServer struct {
+}
+
+func (*UnimplementedStateStreamingServer) StreamBlocks(req *StreamBlocksRequest, srv StateStreaming_StreamBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlocks not implemented")
+}
+
+func RegisterStateStreamingServer(s grpc1.Server, srv StateStreamingServer) {
+	s.RegisterService(&_StateStreaming_serviceDesc, srv)
+}
+
+func _StateStreaming_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateStreamingServer).StreamBlocks(m, &stateStreamingStreamBlocksServer{stream})
+}
+
+type StateStreaming_StreamBlocksServer interface {
+	Send(*BlockData) error
+	grpc.ServerStream
+}
+
+type stateStreamingStreamBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateStreamingStreamBlocksServer) Send(m *BlockData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _StateStreaming_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.base.store.v1beta1.StateStreaming",
+	HandlerType: (*StateStreamingServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _StateStreaming_StreamBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cosmos/base/store/v1beta1/streaming.proto",
+}
+
+func (m *StreamBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StoreKeys) > 0 {
+		for iNdEx := len(m.StoreKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StoreKeys[iNdEx])
+			copy(dAtA[i:], m.StoreKeys[iNdEx])
+			i = encodeVarintStreaming(dAtA, i, uint64(len(m.StoreKeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateChanges) > 0 {
+		for iNdEx := len(m.StateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStreaming(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStreaming(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintStreaming(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStreaming(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStreaming(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StoreKeys) > 0 {
+		for _, s := range m.StoreKeys {
+			l = len(s)
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovStreaming(uint64(m.StartHeight))
+	}
+	return n
+}
+
+func (m *BlockData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStreaming(uint64(m.Height))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovStreaming(uint64(l))
+	}
+	if len(m.StateChanges) > 0 {
+		for _, e := range m.StateChanges {
+			l = e.Size()
+			n += 1 + l + sovStreaming(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStreaming(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStreaming(x uint64) (n int) {
+	return sovStreaming(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreKeys = append(m.StoreKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &types.BlockMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateChanges = append(m.StateChanges, &types.StoreKVPair{})
+			if err := m.StateChanges[len(m.StateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStreaming(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStreaming
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStreaming(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStreaming
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStreaming
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStreaming
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStreaming
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStreaming
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStreaming        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStreaming          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStreaming = fmt.Errorf("proto: unexpected end of group")
+)
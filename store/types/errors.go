@@ -7,3 +7,6 @@ import (
 const StoreCodespace = "store"
 
 var ErrInvalidProof = sdkerrors.Register(StoreCodespace, 2, "invalid proof")
+
+// ErrStoreLimitExceeded is returned when a write exceeds the StoreLimits of a store.
+var ErrStoreLimitExceeded = sdkerrors.Register(StoreCodespace, 3, "store limit exceeded")
@@ -252,3 +252,15 @@ func TransientGasConfig() GasConfig {
 		IterNextCostFlat: 3,
 	}
 }
+
+// StoreLimits defines absolute limits on the writes made to a KVStore by a
+// transaction, on top of the gas charged for them. A zero limit is unlimited.
+type StoreLimits struct {
+	// MaxKeySize is the maximum length in bytes of a key written.
+	MaxKeySize uint64
+	// MaxValueSize is the maximum length in bytes of a value written.
+	MaxValueSize uint64
+	// MaxWrites is the maximum number of keys set by a transaction, the
+	// deletions not being counted.
+	MaxWrites uint64
+}
@@ -185,12 +185,20 @@ type CommitMultiStore interface {
 	// SetIAVLCacheSize sets the cache size of the IAVL tree.
 	SetIAVLCacheSize(size int)
 
+	// SetStorePruning sets the pruning strategy of the store with the given
+	// name, overriding the strategy set by SetPruning.
+	SetStorePruning(storeName string, opts pruningtypes.PruningOptions)
+
 	// SetIAVLDisableFastNode enables/disables fastnode feature on iavl.
 	SetIAVLDisableFastNode(disable bool)
 
 	// SetIAVLLazyLoading enable/disable lazy loading on iavl.
 	SetLazyLoading(lazyLoading bool)
 
+	// SetAsyncCommit enables the asynchronous commit of the stores, with at
+	// most queueSize committed versions pending persistence.
+	SetAsyncCommit(queueSize int)
+
 	// RollbackToVersion rollback the db to specific version(height).
 	RollbackToVersion(version int64) error
 
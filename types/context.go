@@ -41,6 +41,8 @@ type Context struct {
 	priority             int64 // The tx priority, only relevant in CheckTx
 	kvGasConfig          storetypes.GasConfig
 	transientKVGasConfig storetypes.GasConfig
+	storeLimits          map[string]storetypes.StoreLimits
+	storeWrites          *gaskv.WriteCounter
 }
 
 // Proposed rename, not done to avoid API breakage
@@ -65,6 +67,9 @@ func (c Context) Priority() int64                            { return c.priority
 func (c Context) KVGasConfig() storetypes.GasConfig          { return c.kvGasConfig }
 func (c Context) TransientKVGasConfig() storetypes.GasConfig { return c.transientKVGasConfig }
 
+// StoreLimits returns the limits on the writes to the KVStores by store name.
+func (c Context) StoreLimits() map[string]storetypes.StoreLimits { return c.storeLimits }
+
 // clone the header before returning
 func (c Context) BlockHeader() tmproto.Header {
 	msg := proto.Clone(&c.header).(*tmproto.Header)
@@ -214,6 +219,15 @@ func (c Context) WithTransientKVGasConfig(gasConfig storetypes.GasConfig) Contex
 	return c
 }
 
+// WithStoreLimits returns a Context enforcing the given limits on the writes to
+// the KVStores by store name, with the writes counted from zero, e.g. for the
+// execution of a transaction.
+func (c Context) WithStoreLimits(limits map[string]storetypes.StoreLimits) Context {
+	c.storeLimits = limits
+	c.storeWrites = gaskv.NewWriteCounter()
+	return c
+}
+
 // WithIsCheckTx enables or disables CheckTx value for verifying transactions and returns an updated Context
 func (c Context) WithIsCheckTx(isCheckTx bool) Context {
 	c.checkTx = isCheckTx
@@ -278,6 +292,9 @@ func (c Context) Value(key interface{}) interface{} {
 
 // KVStore fetches a KVStore from the MultiStore.
 func (c Context) KVStore(key storetypes.StoreKey) KVStore {
+	if limits, ok := c.storeLimits[key.Name()]; ok {
+		return gaskv.NewStoreWithLimits(c.MultiStore().GetKVStore(key), c.GasMeter(), c.kvGasConfig, key.Name(), limits, c.storeWrites)
+	}
 	return gaskv.NewStore(c.MultiStore().GetKVStore(key), c.GasMeter(), c.kvGasConfig)
 }
 
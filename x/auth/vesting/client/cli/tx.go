@@ -18,6 +18,10 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagMerge   = "merge"
+	FlagLockup  = "lockup"
+	FlagVesting = "vesting"
+	FlagDest    = "dest"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -34,6 +38,8 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreateVestingAccountCmd(),
 		NewMsgCreatePermanentLockedAccountCmd(),
 		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -166,35 +172,134 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 				return err
 			}
 
-			contents, err := os.ReadFile(args[1])
+			startTime, periods, err := readScheduleFile(args[1])
 			if err != nil {
 				return err
 			}
 
-			var vestingData VestingData
+			merge, _ := cmd.Flags().GetBool(FlagMerge)
 
-			err = json.Unmarshal(contents, &vestingData)
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, periods, merge)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagMerge, false, "Merge the new grant into an existing periodic vesting account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address]",
+		Short: "Create a new vesting account funded with an allocation of tokens, subject to clawback.",
+		Long: `Create a new vesting account funded with an allocation of tokens, which the
+funder can claw back while they are not vested. Coins are spendable once they are
+both unlocked, along the schedule of the '--lockup' file, and vested, along the
+schedule of the '--vesting' file. Both files use the format of the
+create-periodic-vesting-account command and must have the same start time. One of
+them can be omitted, in which case the coins unlock or vest at the start time. With
+'--merge', the grant is added to an existing clawback vesting account with the same
+funder.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			var periods []types.Period
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
 
-			for i, p := range vestingData.Periods {
+			lockupFile, _ := cmd.Flags().GetString(FlagLockup)
+			vestingFile, _ := cmd.Flags().GetString(FlagVesting)
+			if lockupFile == "" && vestingFile == "" {
+				return fmt.Errorf("must specify at least one of --%s or --%s", FlagLockup, FlagVesting)
+			}
 
-				amount, err := sdk.ParseCoinsNormalized(p.Coins)
+			var (
+				lockupStart, vestingStart     int64
+				lockupPeriods, vestingPeriods []types.Period
+			)
+			if lockupFile != "" {
+				lockupStart, lockupPeriods, err = readScheduleFile(lockupFile)
 				if err != nil {
 					return err
 				}
+			}
+			if vestingFile != "" {
+				vestingStart, vestingPeriods, err = readScheduleFile(vestingFile)
+				if err != nil {
+					return err
+				}
+			}
 
-				if p.Length < 0 {
-					return fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+			startTime := lockupStart
+			switch {
+			case lockupFile == "":
+				startTime = vestingStart
+			case vestingFile != "" && lockupStart != vestingStart:
+				return fmt.Errorf("lockup start time %d and vesting start time %d must be the same", lockupStart, vestingStart)
+			}
+
+			merge, _ := cmd.Flags().GetBool(FlagMerge)
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, startTime, lockupPeriods, vestingPeriods, merge)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockup, "", "Path to the file of the lockup schedule")
+	cmd.Flags().String(FlagVesting, "", "Path to the file of the vesting schedule")
+	cmd.Flags().Bool(FlagMerge, false, "Merge the new grant into an existing clawback vesting account")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a
+// MsgClawback transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Claw back the unvested tokens of a clawback vesting account.",
+		Long: `Claw back the unvested tokens of a clawback vesting account. Only the funder
+of the account can claw back. The tokens are returned to the funder, or to the
+'--dest' address if set.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destString, _ := cmd.Flags().GetString(FlagDest); destString != "" {
+				dest, err = sdk.AccAddressFromBech32(destString)
+				if err != nil {
+					return err
 				}
-				period := types.Period{Length: p.Length, Amount: amount}
-				periods = append(periods, period)
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -203,7 +308,42 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagDest, "", "Address to send the clawed back tokens to, defaults to the funder")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// readScheduleFile reads the start time and the periods of a schedule from a
+// JSON file in the VestingData format.
+func readScheduleFile(path string) (int64, []types.Period, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var vestingData VestingData
+
+	err = json.Unmarshal(contents, &vestingData)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var periods []types.Period
+
+	for i, p := range vestingData.Periods {
+
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		if p.Length < 0 {
+			return 0, nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+		period := types.Period{Length: p.Length, Amount: amount}
+		periods = append(periods, period)
+	}
+
+	return vestingData.StartTime, periods, nil
+}
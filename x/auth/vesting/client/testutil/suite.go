@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -221,3 +222,120 @@ func (s *IntegrationTestSuite) TestNewMsgCreatePermanentLockedAccountCmd() {
 		s.T().Logf("Height now: %d", height)
 	}
 }
+
+func (s *IntegrationTestSuite) TestNewMsgCreateClawbackVestingAccountCmd() {
+	val := s.network.Validators[0]
+
+	vestingFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{
+  "start_time": 1625204910,
+  "periods": [
+    {"coins": "10%[1]s", "length_seconds": 2592000},
+    {"coins": "10%[1]s", "length_seconds": 2592000}
+  ]
+}`, s.cfg.BondDenom))
+	lockupFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{
+  "start_time": 1625204910,
+  "periods": [
+    {"coins": "20%[1]s", "length_seconds": 4070908800}
+  ]
+}`, s.cfg.BondDenom))
+	mismatchedFile := testutil.WriteToNewTempFile(s.T(), fmt.Sprintf(`{
+  "start_time": 1625204911,
+  "periods": [
+    {"coins": "20%[1]s", "length_seconds": 2592000}
+  ]
+}`, s.cfg.BondDenom))
+
+	testCases := map[string]struct {
+		args         []string
+		expectErr    bool
+		expectedCode uint32
+		respType     proto.Message
+	}{
+		"create a clawback vesting account": {
+			args: []string{
+				sdk.AccAddress("addr5_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockup, lockupFile.Name()),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vestingFile.Name()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"create a clawback vesting account without lockup": {
+			args: []string{
+				sdk.AccAddress("addr6_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vestingFile.Name()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			expectErr:    false,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"no schedule": {
+			args: []string{
+				sdk.AccAddress("addr7_______________").String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"mismatched start times": {
+			args: []string{
+				sdk.AccAddress("addr7_______________").String(),
+				fmt.Sprintf("--%s=%s", cli.FlagLockup, mismatchedFile.Name()),
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vestingFile.Name()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+		"invalid address": {
+			args: []string{
+				"addr7",
+				fmt.Sprintf("--%s=%s", cli.FlagVesting, vestingFile.Name()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address),
+			},
+			expectErr:    true,
+			expectedCode: 0,
+			respType:     &sdk.TxResponse{},
+		},
+	}
+
+	// Synchronize height between test runs, to ensure sequence numbers are
+	// properly updated.
+	height, err := s.network.LatestHeight()
+	s.Require().NoError(err, "Getting initial latest height")
+	s.T().Logf("Initial latest height: %d", height)
+	for name, tc := range testCases {
+		tc := tc
+
+		s.Run(name, func() {
+			clientCtx := val.ClientCtx
+
+			bw, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMsgCreateClawbackVestingAccountCmd(), tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(bw.Bytes(), tc.respType), bw.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+		next, err := s.network.WaitForHeight(height + 1)
+		s.Require().NoError(err, "Waiting for height...")
+		height = next
+		s.T().Logf("Height now: %d", height)
+	}
+}
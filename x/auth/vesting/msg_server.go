@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	"github.com/armon/go-metrics"
 
@@ -16,12 +17,13 @@ import (
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -168,10 +170,6 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
-	if acc := ak.GetAccount(ctx, to); acc != nil {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
-	}
-
 	var totalCoins sdk.Coins
 	for _, period := range msg.VestingPeriods {
 		totalCoins = totalCoins.Add(period.Amount...)
@@ -181,14 +179,32 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
-	baseAccount := authtypes.NewBaseAccountWithAddress(to)
-	baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
-	vestingAccount := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+	madeNewAcc := false
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		if !msg.Merge {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+		}
 
-	ak.SetAccount(ctx, vestingAccount)
+		vestingAccount, ok := acc.(*types.PeriodicVestingAccount)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s must be a periodic vesting account", msg.ToAddress)
+		}
+
+		vestingAccount.AddGrant(ctx.BlockTime(), s.delegatedCoins(ctx, to), msg.StartTime, msg.VestingPeriods, totalCoins)
+		ak.SetAccount(ctx, vestingAccount)
+	} else {
+		baseAccount := authtypes.NewBaseAccountWithAddress(to)
+		baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+		vestingAccount := types.NewPeriodicVestingAccount(baseAccount, totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+
+		ak.SetAccount(ctx, vestingAccount)
+		madeNewAcc = true
+	}
 
 	defer func() {
-		telemetry.IncrCounter(1, "new", "account")
+		if madeNewAcc {
+			telemetry.IncrCounter(1, "new", "account")
+		}
 
 		for _, a := range totalCoins {
 			if a.Amount.IsInt64() {
@@ -213,3 +229,221 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 	)
 	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 }
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
+	// an empty schedule unlocks or vests all the coins at the start time
+	lockupPeriods := types.Periods(msg.LockupPeriods)
+	vestingPeriods := types.Periods(msg.VestingPeriods)
+	var totalCoins sdk.Coins
+	if len(lockupPeriods) == 0 {
+		totalCoins = vestingPeriods.TotalAmount()
+		lockupPeriods = types.Periods{{Length: 0, Amount: totalCoins}}
+	} else {
+		totalCoins = lockupPeriods.TotalAmount()
+	}
+	if len(vestingPeriods) == 0 {
+		vestingPeriods = types.Periods{{Length: 0, Amount: totalCoins}}
+	}
+
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
+	}
+
+	madeNewAcc := false
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		if !msg.Merge {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+		}
+
+		vestingAccount, ok := acc.(*types.ClawbackVestingAccount)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s must be a clawback vesting account", msg.ToAddress)
+		}
+		if vestingAccount.FunderAddress != msg.FromAddress {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "account %s can only accept grants from its funder %s", msg.ToAddress, vestingAccount.FunderAddress)
+		}
+
+		vestingAccount.AddGrant(ctx.BlockTime(), s.delegatedCoins(ctx, to), msg.StartTime, lockupPeriods, vestingPeriods, totalCoins)
+		ak.SetAccount(ctx, vestingAccount)
+	} else {
+		baseAccount := authtypes.NewBaseAccountWithAddress(to)
+		baseAccount = ak.NewAccount(ctx, baseAccount).(*authtypes.BaseAccount)
+		vestingAccount := types.NewClawbackVestingAccount(baseAccount, from, totalCoins.Sort(), msg.StartTime, lockupPeriods, vestingPeriods)
+
+		ak.SetAccount(ctx, vestingAccount)
+		madeNewAcc = true
+	}
+
+	defer func() {
+		if madeNewAcc {
+			telemetry.IncrCounter(1, "new", "account")
+		}
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	if err = bk.SendCoins(ctx, from, to, totalCoins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+	sk := s.StakingKeeper
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	dest, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Address)
+	}
+	vestingAccount, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s must be a clawback vesting account", msg.Address)
+	}
+	if vestingAccount.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the funder %s", vestingAccount.FunderAddress)
+	}
+
+	toClawBack := vestingAccount.Clawback(ctx.BlockTime(), s.delegatedCoins(ctx, addr), bk.GetAllBalances(ctx, addr))
+	if toClawBack.IsZero() {
+		return &types.MsgClawbackResponse{}, nil
+	}
+	ak.SetAccount(ctx, vestingAccount)
+
+	// take the spendable coins first, then the unbonding and bonded coins
+	toXfer := bk.SpendableCoins(ctx, addr).Min(toClawBack)
+	if err := bk.SendCoins(ctx, addr, dest, toXfer); err != nil {
+		return nil, err
+	}
+	toClawBack = toClawBack.Sub(toXfer...)
+
+	wantAmt := toClawBack.AmountOf(sk.BondDenom(ctx))
+	for _, ubd := range sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16) {
+		if !wantAmt.IsPositive() {
+			break
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(ubd.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		transferred := sk.TransferUnbonding(ctx, addr, dest, valAddr, wantAmt)
+		wantAmt = wantAmt.Sub(transferred)
+	}
+
+	for _, delegation := range sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16) {
+		if !wantAmt.IsPositive() {
+			break
+		}
+
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := sk.GetValidator(ctx, valAddr)
+		if !found {
+			continue
+		}
+
+		wantShares, err := validator.SharesFromTokensTruncated(wantAmt)
+		if err != nil {
+			// the validator has no tokens left
+			continue
+		}
+
+		transferredShares, err := sk.TransferDelegation(ctx, addr, dest, valAddr, wantShares)
+		if err != nil {
+			return nil, err
+		}
+
+		// round up so that no more than the clawed back coins are taken
+		transferred := validator.TokensFromSharesRoundUp(transferredShares).Ceil().TruncateInt()
+		wantAmt = wantAmt.Sub(sdk.MinInt(transferred, wantAmt))
+	}
+
+	// the unbonding and bonded coins could not all be transferred, e.g. when
+	// the destination reaches the maximum number of unbonding or redelegation entries
+	if wantAmt.IsPositive() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "unable to claw back %s%s of the unbonding and bonded coins", wantAmt, sk.BondDenom(ctx))
+	}
+
+	defer func() {
+		for _, a := range toXfer {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "clawback"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// delegatedCoins returns the coins of addr which are bonded or unbonding.
+func (s msgServer) delegatedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	bonded := s.GetDelegatorBonded(ctx, addr)
+	unbonding := s.GetDelegatorUnbonding(ctx, addr)
+	return sdk.NewCoins(sdk.NewCoin(s.BondDenom(ctx), bonded.Add(unbonding)))
+}
@@ -0,0 +1,202 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestCreatePeriodicVestingAccountMerge(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Unix(1600000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	msgServer := vesting.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	funder := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(1000))[0]
+	addr := sdk.AccAddress("vest________________")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100))
+	periods := []types.Period{{Length: 100, Amount: coins}}
+
+	_, err := msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreatePeriodicVestingAccount(funder, addr, now.Unix(), periods, false))
+	require.NoError(t, err)
+
+	// an existing account only accepts merged grants
+	_, err = msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreatePeriodicVestingAccount(funder, addr, now.Unix(), periods, false))
+	require.Error(t, err)
+
+	_, err = msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreatePeriodicVestingAccount(funder, addr, now.Unix()+50, periods, true))
+	require.NoError(t, err)
+
+	va, ok := app.AccountKeeper.GetAccount(ctx, addr).(*types.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, coins.Add(coins...), va.OriginalVesting)
+	require.Equal(t, now.Unix()+150, va.EndTime)
+	require.Equal(t, coins.Add(coins...), app.BankKeeper.GetAllBalances(ctx, addr))
+
+	// only periodic vesting accounts accept merged grants
+	_, err = msgServer.CreatePeriodicVestingAccount(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreatePeriodicVestingAccount(addr, funder, now.Unix(), periods, true))
+	require.Error(t, err)
+}
+
+func TestClawback(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Unix(1600000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	msgServer := vesting.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(1000))
+	funder, other := addrs[0], addrs[1]
+	addr := sdk.AccAddress("vest________________")
+	vestingPeriods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))},
+	}
+
+	_, err := msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreateClawbackVestingAccount(funder, addr, now.Unix(), nil, vestingPeriods, false))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 100)), app.BankKeeper.GetAllBalances(ctx, addr))
+
+	// delegate most of the unvested coins
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	_, err = app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(80), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// only the funder can claw back
+	ctx = ctx.WithBlockTime(now.Add(150 * time.Second))
+	_, err = msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(other, addr, nil))
+	require.Error(t, err)
+
+	funderBalance := app.BankKeeper.GetBalance(ctx, funder, bondDenom)
+	_, err = msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, addr, nil))
+	require.NoError(t, err)
+
+	// the unvested coins are taken from the balance, then from the delegation
+	require.Equal(t, funderBalance.AddAmount(sdk.NewInt(20)), app.BankKeeper.GetBalance(ctx, funder, bondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addr).IsZero())
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, validator.GetOperator())
+	require.True(t, found)
+	funderDelegation, found := app.StakingKeeper.GetDelegation(ctx, funder, validator.GetOperator())
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(30), validator.TokensFromShares(funderDelegation.Shares).TruncateInt())
+	require.Equal(t, sdk.NewInt(50), app.StakingKeeper.GetDelegatorBonded(ctx, addr))
+
+	va, ok := app.AccountKeeper.GetAccount(ctx, addr).(*types.ClawbackVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50)), va.OriginalVesting)
+	require.True(t, va.GetVestingCoins(ctx.BlockTime()).IsZero())
+	require.True(t, va.DelegatedVesting.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50)), va.DelegatedFree)
+}
+
+func TestClawbackValidatorBond(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Unix(1600000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	msgServer := vesting.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	funder := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(1000))[0]
+	addr := sdk.AccAddress("vest________________")
+	vestingPeriods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))},
+	}
+
+	_, err := msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreateClawbackVestingAccount(funder, addr, now.Unix(), nil, vestingPeriods, false))
+	require.NoError(t, err)
+
+	// delegate most of the unvested coins as a validator bond
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	valAddr := validator.GetOperator()
+	_, err = app.StakingKeeper.Delegate(ctx, addr, sdk.NewInt(80), stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+	require.NoError(t, app.StakingKeeper.ValidatorBond(ctx, addr, valAddr))
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	bondShares := validator.ValidatorBondShares
+
+	// the clawed back shares cannot leave the liquid shares above the validator bond cap
+	params := app.StakingKeeper.GetParams(ctx)
+	params.ValidatorBondFactor = sdk.NewDec(10)
+	app.StakingKeeper.SetParams(ctx, params)
+	validator.LiquidShares = validator.TokensFromShares(sdk.NewDec(600))
+	app.StakingKeeper.SetValidator(ctx, validator)
+
+	ctx = ctx.WithBlockTime(now.Add(150 * time.Second))
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = msgServer.Clawback(sdk.WrapSDKContext(cacheCtx), types.NewMsgClawback(funder, addr, nil))
+	require.ErrorIs(t, err, stakingtypes.ErrInsufficientValidatorBondShares)
+
+	validator.LiquidShares = sdk.ZeroDec()
+	app.StakingKeeper.SetValidator(ctx, validator)
+	_, err = msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, addr, nil))
+	require.NoError(t, err)
+
+	// the shares moved to the funder no longer count toward the validator bond shares
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	funderDelegation, found := app.StakingKeeper.GetDelegation(ctx, funder, valAddr)
+	require.True(t, found)
+	require.False(t, funderDelegation.ValidatorBond)
+	require.True(t, validator.ValidatorBondShares.Equal(bondShares.Sub(funderDelegation.Shares)))
+	addrDelegation, found := app.StakingKeeper.GetDelegation(ctx, addr, valAddr)
+	require.True(t, found)
+	require.True(t, validator.ValidatorBondShares.Equal(addrDelegation.Shares))
+}
+
+func TestClawbackShortfall(t *testing.T) {
+	app := simapp.Setup(t, false)
+	now := time.Unix(1600000000, 0).UTC()
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: now})
+	msgServer := vesting.NewMsgServerImpl(app.AccountKeeper, app.BankKeeper, app.StakingKeeper)
+
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	funder := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(1000))[0]
+	addr := sdk.AccAddress("vest________________")
+	vestingPeriods := []types.Period{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))},
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 50))},
+	}
+
+	_, err := msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(ctx),
+		types.NewMsgCreateClawbackVestingAccount(funder, addr, now.Unix(), nil, vestingPeriods, false))
+	require.NoError(t, err)
+
+	// the funder already has the maximum number of unbonding entries with the validator
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MaxEntries = 1
+	app.StakingKeeper.SetParams(ctx, params)
+	validator := app.StakingKeeper.GetAllValidators(ctx)[0]
+	valAddr := validator.GetOperator()
+	for _, delAddr := range []sdk.AccAddress{funder, addr} {
+		validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		shares, err := app.StakingKeeper.Delegate(ctx, delAddr, sdk.NewInt(80), stakingtypes.Unbonded, validator, true)
+		require.NoError(t, err)
+		_, err = app.StakingKeeper.Undelegate(ctx, delAddr, valAddr, shares)
+		require.NoError(t, err)
+	}
+
+	// the unbonding coins cannot be transferred to the funder
+	ctx = ctx.WithBlockTime(now.Add(150 * time.Second))
+	_, err = msgServer.Clawback(sdk.WrapSDKContext(ctx), types.NewMsgClawback(funder, addr, nil))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
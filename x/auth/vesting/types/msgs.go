@@ -16,12 +16,22 @@ const TypeMsgCreatePermanentLockedAccount = "msg_create_permanent_locked_account
 // TypeMsgCreatePeriodicVestingAccount defines the type value for a MsgCreateVestingAccount.
 const TypeMsgCreatePeriodicVestingAccount = "msg_create_periodic_vesting_account"
 
+// TypeMsgCreateClawbackVestingAccount defines the type value for a MsgCreateClawbackVestingAccount.
+const TypeMsgCreateClawbackVestingAccount = "msg_create_clawback_vesting_account"
+
+// TypeMsgClawback defines the type value for a MsgClawback.
+const TypeMsgClawback = "msg_clawback"
+
 var _ sdk.Msg = &MsgCreateVestingAccount{}
 
 var _ sdk.Msg = &MsgCreatePermanentLockedAccount{}
 
 var _ sdk.Msg = &MsgCreatePeriodicVestingAccount{}
 
+var _ sdk.Msg = &MsgCreateClawbackVestingAccount{}
+
+var _ sdk.Msg = &MsgClawback{}
+
 // NewMsgCreateVestingAccount returns a reference to a new MsgCreateVestingAccount.
 //
 //nolint:interfacer
@@ -129,12 +139,13 @@ func (msg MsgCreatePermanentLockedAccount) GetSigners() []sdk.AccAddress {
 // NewMsgCreatePeriodicVestingAccount returns a reference to a new MsgCreatePeriodicVestingAccount.
 //
 //nolint:interfacer
-func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period) *MsgCreatePeriodicVestingAccount {
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods []Period, merge bool) *MsgCreatePeriodicVestingAccount {
 	return &MsgCreatePeriodicVestingAccount{
 		FromAddress:    fromAddr.String(),
 		ToAddress:      toAddr.String(),
 		StartTime:      startTime,
 		VestingPeriods: periods,
+		Merge:          merge,
 	}
 }
 
@@ -181,7 +192,139 @@ func (msg MsgCreatePeriodicVestingAccount) ValidateBasic() error {
 		return fmt.Errorf("invalid start time of %d, length must be greater than 0", msg.StartTime)
 	}
 
-	for i, period := range msg.VestingPeriods {
+	return validatePeriods(msg.VestingPeriods)
+}
+
+// NewMsgCreateClawbackVestingAccount returns a reference to a new MsgCreateClawbackVestingAccount.
+//
+//nolint:interfacer
+func NewMsgCreateClawbackVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, lockupPeriods, vestingPeriods []Period, merge bool) *MsgCreateClawbackVestingAccount {
+	return &MsgCreateClawbackVestingAccount{
+		FromAddress:    fromAddr.String(),
+		ToAddress:      toAddr.String(),
+		StartTime:      startTime,
+		LockupPeriods:  lockupPeriods,
+		VestingPeriods: vestingPeriods,
+		Merge:          merge,
+	}
+}
+
+// Route returns the message route for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) Type() string { return TypeMsgCreateClawbackVestingAccount }
+
+// GetSigners returns the expected signers for a MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgCreateClawbackVestingAccount.
+func (msg MsgCreateClawbackVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateClawbackVestingAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if msg.StartTime < 1 {
+		return fmt.Errorf("invalid start time of %d, length must be greater than 0", msg.StartTime)
+	}
+
+	if len(msg.LockupPeriods) == 0 && len(msg.VestingPeriods) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "lockup and vesting periods cannot both be empty")
+	}
+
+	if err := validatePeriods(msg.LockupPeriods); err != nil {
+		return err
+	}
+	if err := validatePeriods(msg.VestingPeriods); err != nil {
+		return err
+	}
+
+	if len(msg.LockupPeriods) > 0 && len(msg.VestingPeriods) > 0 {
+		lockupCoins := Periods(msg.LockupPeriods).TotalAmount()
+		vestingCoins := Periods(msg.VestingPeriods).TotalAmount()
+		if !lockupCoins.IsEqual(vestingCoins) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lockup periods total %s does not match vesting periods total %s", lockupCoins, vestingCoins)
+		}
+	}
+
+	return nil
+}
+
+// NewMsgClawback returns a reference to a new MsgClawback. An empty dest
+// address returns the clawed back coins to the funder.
+//
+//nolint:interfacer
+func NewMsgClawback(funder, addr, dest sdk.AccAddress) *MsgClawback {
+	var destString string
+	if dest != nil {
+		destString = dest.String()
+	}
+
+	return &MsgClawback{
+		FunderAddress: funder.String(),
+		Address:       addr.String(),
+		DestAddress:   destString,
+	}
+}
+
+// Route returns the message route for a MsgClawback.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type returns the message type for a MsgClawback.
+func (msg MsgClawback) Type() string { return TypeMsgClawback }
+
+// GetSigners returns the expected signers for a MsgClawback.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{funder}
+}
+
+// GetSignBytes returns the bytes all expected signers must sign over for a
+// MsgClawback.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FunderAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid funder address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid account address: %s", err)
+	}
+
+	if msg.DestAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.DestAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// validatePeriods checks that the periods have valid positive amounts and
+// positive lengths.
+func validatePeriods(periods []Period) error {
+	for i, period := range periods {
 		if !period.Amount.IsValid() {
 			return sdkerrors.ErrInvalidCoins.Wrap(period.Amount.String())
 		}
@@ -58,3 +58,133 @@ func (p Periods) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Vesting Periods:
 		%s`, strings.Join(periodsListString, ", ")))
 }
+
+// ReadSchedule returns the coins released by the given periods at readTime.
+// The periods start at startTime and release totalCoins by endTime.
+func ReadSchedule(startTime, endTime int64, periods Periods, totalCoins sdk.Coins, readTime int64) sdk.Coins {
+	if readTime <= startTime {
+		return sdk.NewCoins()
+	}
+	if readTime >= endTime {
+		return totalCoins
+	}
+
+	coins := sdk.NewCoins()
+	time := startTime
+	for _, period := range periods {
+		if readTime < time+period.Length {
+			break
+		}
+		coins = coins.Add(period.Amount...)
+		time += period.Length
+	}
+
+	return coins
+}
+
+// DisjunctPeriods returns the union of the schedules P and Q, starting at
+// startP and startQ respectively: the merged schedule releases the coins of
+// both schedules at their original times, combining simultaneous events. It
+// returns the start time, the end time and the periods of the merged schedule.
+func DisjunctPeriods(startP, startQ int64, periodsP, periodsQ Periods) (startTime, endTime int64, merged Periods) {
+	timeP, timeQ := startP, startQ
+	iP, iQ := 0, 0
+	startTime = min64(startP, startQ)
+	time := startTime
+	merged = Periods{}
+
+	emit := func(nextTime int64, amount sdk.Coins) {
+		merged = append(merged, Period{Length: nextTime - time, Amount: amount})
+		time = nextTime
+	}
+
+	for iP < len(periodsP) || iQ < len(periodsQ) {
+		switch {
+		case iQ == len(periodsQ) || (iP < len(periodsP) && timeP+periodsP[iP].Length < timeQ+periodsQ[iQ].Length):
+			timeP += periodsP[iP].Length
+			emit(timeP, periodsP[iP].Amount)
+			iP++
+
+		case iP == len(periodsP) || timeQ+periodsQ[iQ].Length < timeP+periodsP[iP].Length:
+			timeQ += periodsQ[iQ].Length
+			emit(timeQ, periodsQ[iQ].Amount)
+			iQ++
+
+		default:
+			timeP += periodsP[iP].Length
+			timeQ = timeP
+			emit(timeP, periodsP[iP].Amount.Add(periodsQ[iQ].Amount...))
+			iP++
+			iQ++
+		}
+	}
+
+	return startTime, time, merged
+}
+
+// ConjunctPeriods returns the intersection of the schedules P and Q, starting
+// at startP and startQ respectively: at any time, the merged schedule has
+// released the minimum of the coins released by each schedule. It returns the
+// start time, the end time and the periods of the merged schedule.
+func ConjunctPeriods(startP, startQ int64, periodsP, periodsQ Periods) (startTime, endTime int64, merged Periods) {
+	timeP, timeQ := startP, startQ
+	iP, iQ := 0, 0
+	startTime = min64(startP, startQ)
+	time := startTime
+	merged = Periods{}
+
+	amount := sdk.NewCoins()
+	amountP, amountQ := sdk.NewCoins(), sdk.NewCoins()
+
+	// emit adds a period when the minimum of both schedules increases
+	emit := func(nextTime int64) {
+		diff := amountP.Min(amountQ).Sub(amount...)
+		if diff.IsZero() {
+			return
+		}
+		merged = append(merged, Period{Length: nextTime - time, Amount: diff})
+		time = nextTime
+		amount = amount.Add(diff...)
+	}
+
+	for iP < len(periodsP) || iQ < len(periodsQ) {
+		switch {
+		case iQ == len(periodsQ) || (iP < len(periodsP) && timeP+periodsP[iP].Length < timeQ+periodsQ[iQ].Length):
+			timeP += periodsP[iP].Length
+			amountP = amountP.Add(periodsP[iP].Amount...)
+			emit(timeP)
+			iP++
+
+		case iP == len(periodsP) || timeQ+periodsQ[iQ].Length < timeP+periodsP[iP].Length:
+			timeQ += periodsQ[iQ].Length
+			amountQ = amountQ.Add(periodsQ[iQ].Amount...)
+			emit(timeQ)
+			iQ++
+
+		default:
+			timeP += periodsP[iP].Length
+			timeQ = timeP
+			amountP = amountP.Add(periodsP[iP].Amount...)
+			amountQ = amountQ.Add(periodsQ[iQ].Amount...)
+			emit(timeP)
+			iP++
+			iQ++
+		}
+	}
+
+	return startTime, time, merged
+}
+
+func min64(i, j int64) int64 {
+	if i < j {
+		return i
+	}
+	return j
+}
+
+func max64(i, j int64) int64 {
+	if i > j {
+		return i
+	}
+	return j
+}
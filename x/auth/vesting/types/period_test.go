@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func TestReadSchedule(t *testing.T) {
+	periods := types.Periods{
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10))},
+		{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20))},
+		{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 5))},
+	}
+	total := periods.TotalAmount()
+
+	require.Equal(t, sdk.NewCoins(), types.ReadSchedule(100, 130, periods, total, 100))
+	require.Equal(t, sdk.NewCoins(), types.ReadSchedule(100, 130, periods, total, 109))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10)), types.ReadSchedule(100, 130, periods, total, 110))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10)), types.ReadSchedule(100, 130, periods, total, 129))
+	require.Equal(t, total, types.ReadSchedule(100, 130, periods, total, 130))
+	require.Equal(t, total, types.ReadSchedule(100, 130, periods, total, 200))
+}
+
+func TestDisjunctPeriods(t *testing.T) {
+	p := types.Periods{
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10))},
+		{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20))},
+	}
+	q := types.Periods{
+		{Length: 5, Amount: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 5))},
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 10))},
+	}
+
+	// q starts at 105: its events happen at 110 and 120
+	startTime, endTime, merged := types.DisjunctPeriods(100, 105, p, q)
+	require.Equal(t, int64(100), startTime)
+	require.Equal(t, int64(130), endTime)
+	require.Equal(t, types.Periods{
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 10), sdk.NewInt64Coin(feeDenom, 5))},
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 10))},
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 20))},
+	}, merged)
+	require.Equal(t, p.TotalAmount().Add(q.TotalAmount()...), merged.TotalAmount())
+
+	// merging with an empty schedule keeps the schedule
+	startTime, endTime, merged = types.DisjunctPeriods(100, 100, p, types.Periods{})
+	require.Equal(t, int64(100), startTime)
+	require.Equal(t, int64(130), endTime)
+	require.Equal(t, p, merged)
+}
+
+func TestConjunctPeriods(t *testing.T) {
+	p := types.Periods{
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))},
+		{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))},
+	}
+	q := types.Periods{
+		{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 100))},
+	}
+
+	// at any time, the minimum of both schedules is released
+	startTime, endTime, merged := types.ConjunctPeriods(100, 100, p, q)
+	require.Equal(t, int64(100), startTime)
+	require.Equal(t, int64(130), endTime)
+	require.Equal(t, types.Periods{
+		{Length: 20, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))},
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))},
+	}, merged)
+
+	// capping a schedule stops it once the cap is released
+	capPeriods := types.Periods{{Length: 0, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))}}
+	_, endTime, merged = types.ConjunctPeriods(100, 100, p, capPeriods)
+	require.Equal(t, int64(110), endTime)
+	require.Equal(t, types.Periods{
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, 50))},
+	}, merged)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -18,6 +19,7 @@ var (
 	_ vestexported.VestingAccount = (*ContinuousVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*PeriodicVestingAccount)(nil)
 	_ vestexported.VestingAccount = (*DelayedVestingAccount)(nil)
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
 )
 
 // Base Vesting Account
@@ -164,6 +166,8 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -405,6 +409,24 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// AddGrant merges a new grant of grantCoins, vesting along grantVestingPeriods
+// from grantStartTime, into the vesting schedule of the account. delegated is
+// the amount of coins currently bonded or unbonding by the account, which is
+// used to rebase its delegation bookkeeping.
+func (pva *PeriodicVestingAccount) AddGrant(blockTime time.Time, delegated sdk.Coins, grantStartTime int64, grantVestingPeriods Periods, grantCoins sdk.Coins) {
+	unvested := pva.GetVestingCoins(blockTime)
+	newDelegated := rebaseDelegated(pva.BaseVestingAccount, delegated, unvested)
+
+	startTime, endTime, periods := DisjunctPeriods(pva.StartTime, grantStartTime, pva.VestingPeriods, grantVestingPeriods)
+	pva.StartTime = startTime
+	pva.EndTime = endTime
+	pva.VestingPeriods = periods
+	pva.OriginalVesting = pva.OriginalVesting.Add(grantCoins...)
+
+	pva.DelegatedVesting = newDelegated.Min(pva.GetVestingCoins(blockTime))
+	pva.DelegatedFree = newDelegated.Sub(pva.DelegatedVesting...)
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -591,6 +613,219 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var (
+	_ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+	_ authtypes.GenesisAccount    = (*ClawbackVestingAccount)(nil)
+)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. Coins are
+// unlocked along lockupPeriods and vested along vestingPeriods, both starting
+// at startTime.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	endTime := max64(startTime+lockupPeriods.TotalLength(), startTime+vestingPeriods.TotalLength())
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  originalVesting,
+		DelegatedFree:    sdk.NewCoins(),
+		DelegatedVesting: sdk.NewCoins(),
+		EndTime:          endTime,
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetUnlockedOnly returns the coins unlocked by the lockup schedule at
+// blockTime, regardless of the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.EndTime, va.LockupPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule at blockTime,
+// regardless of the lockup schedule.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return ReadSchedule(va.StartTime, va.EndTime, va.VestingPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetVestedCoins returns the total number of vested coins, i.e. the coins
+// both unlocked and vested. If no coins are vested, an empty slice of Coins
+// is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return va.GetUnlockedOnly(blockTime).Min(va.GetVestedOnly(blockTime))
+}
+
+// GetVestingCoins returns the total number of vesting coins. If no coins are
+// vesting, nil is returned.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime)...)
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting starts for a clawback vesting
+// account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetFunder returns the address of the funder of a clawback vesting account.
+func (va ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(va.FunderAddress)
+}
+
+// AddGrant merges a new grant of grantCoins, unlocked along grantLockupPeriods
+// and vested along grantVestingPeriods from grantStartTime, into the schedules
+// of the account. delegated is the amount of coins currently bonded or
+// unbonding by the account, which is used to rebase its delegation
+// bookkeeping.
+func (va *ClawbackVestingAccount) AddGrant(blockTime time.Time, delegated sdk.Coins, grantStartTime int64, grantLockupPeriods, grantVestingPeriods Periods, grantCoins sdk.Coins) {
+	unvested := va.OriginalVesting.Sub(va.GetVestedOnly(blockTime)...)
+	newDelegated := rebaseDelegated(va.BaseVestingAccount, delegated, unvested)
+
+	lockupStart, lockupEnd, lockupPeriods := DisjunctPeriods(va.StartTime, grantStartTime, va.LockupPeriods, grantLockupPeriods)
+	_, vestingEnd, vestingPeriods := DisjunctPeriods(va.StartTime, grantStartTime, va.VestingPeriods, grantVestingPeriods)
+	va.StartTime = lockupStart
+	va.EndTime = max64(lockupEnd, vestingEnd)
+	va.LockupPeriods = lockupPeriods
+	va.VestingPeriods = vestingPeriods
+	va.OriginalVesting = va.OriginalVesting.Add(grantCoins...)
+
+	va.DelegatedVesting = newDelegated.Min(va.GetVestingCoins(blockTime))
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting...)
+}
+
+// Clawback removes the coins which are not vested at clawbackTime from the
+// schedules of the account, and returns them. Vested coins which are still
+// locked stay locked. delegated is the amount of coins currently bonded or
+// unbonding by the account and unbonded its balance, which are used to rebase
+// its delegation bookkeeping on the coins left once the returned coins are
+// removed.
+//
+// The caller is responsible for transferring the returned coins, first from
+// the spendable balance of the account and then from its delegations.
+func (va *ClawbackVestingAccount) Clawback(clawbackTime time.Time, delegated, unbonded sdk.Coins) sdk.Coins {
+	// truncate the vesting schedule, a tie goes to the clawback
+	vestTime := va.StartTime
+	totalVested, totalUnvested := sdk.NewCoins(), sdk.NewCoins()
+	vestedIdx := 0
+	for i, period := range va.VestingPeriods {
+		vestTime += period.Length
+		if vestTime < clawbackTime.Unix() {
+			totalVested = totalVested.Add(period.Amount...)
+			vestedIdx = i + 1
+		} else {
+			totalUnvested = totalUnvested.Add(period.Amount...)
+		}
+	}
+
+	// cap the lockup schedule to the vested coins
+	capPeriods := Periods{{Length: 0, Amount: totalVested}}
+	_, _, lockupPeriods := ConjunctPeriods(va.StartTime, va.StartTime, va.LockupPeriods, capPeriods)
+
+	va.OriginalVesting = totalVested
+	va.LockupPeriods = lockupPeriods
+	va.VestingPeriods = va.VestingPeriods[:vestedIdx]
+
+	// Rebase the delegation bookkeeping on the coins left in the account.
+	// Coins missing from the delegations were slashed and are deducted from
+	// the clawback.
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated)...)
+	total := delegated.Add(unbonded...)
+	toClawBack := totalUnvested.Min(total)
+	newDelegated := delegated.Min(total.Sub(toClawBack...)).Add(slashed...)
+
+	va.DelegatedVesting = newDelegated.Min(va.GetVestingCoins(clawbackTime))
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting...)
+
+	return toClawBack
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if va.GetStartTime() >= va.GetEndTime() {
+		return errors.New("vesting start-time must be before end-time")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+
+	if va.StartTime+Periods(va.LockupPeriods).TotalLength() > va.EndTime {
+		return errors.New("lockup schedule extends beyond account end time")
+	}
+	if !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+
+	if va.StartTime+Periods(va.VestingPeriods).TotalLength() > va.EndTime {
+		return errors.New("vesting schedule extends beyond account end time")
+	}
+	if !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
+// rebaseDelegated returns the coins delegated by a vesting account, given the
+// coins it currently has bonded or unbonding. Slashed coins are still counted
+// as delegated up to the unvested coins, as they have been deducted from the
+// coins of the schedule.
+func rebaseDelegated(bva *BaseVestingAccount, delegated, unvested sdk.Coins) sdk.Coins {
+	oldDelegated := bva.DelegatedVesting.Add(bva.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated)...)
+	return delegated.Add(slashed.Min(unvested)...)
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
@@ -677,6 +677,161 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+
+	// delegate all the stake
+	pva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, pva.DelegatedVesting)
+
+	// add a grant six hours later, vesting at the same time as the second period
+	grantCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 20)}
+	grantPeriods := types.Periods{types.Period{Length: int64(18 * 60 * 60), Amount: grantCoins}}
+	pva.AddGrant(now.Add(6*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, now.Add(6*time.Hour).Unix(), grantPeriods, grantCoins)
+
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), pva.EndTime)
+	require.Equal(t, origCoins.Add(grantCoins...), pva.OriginalVesting)
+	require.Equal(t, types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 70)}},
+	}, types.Periods(pva.VestingPeriods))
+	require.NoError(t, pva.Validate())
+
+	// the delegation is still fully vesting
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, pva.DelegatedVesting)
+	require.True(t, pva.DelegatedFree.IsZero())
+}
+
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	require.Equal(t, now.Add(24*time.Hour).Unix(), va.EndTime)
+
+	// require no coins vested at the beginning of the schedules
+	require.Equal(t, sdk.NewCoins(), va.GetVestedCoins(now))
+	require.Equal(t, origCoins, va.LockedCoins(now))
+
+	// require no coins vested while still locked
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedOnly(now.Add(6*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), va.GetVestedCoins(now.Add(6*time.Hour)))
+
+	// require 50% of coins vested once unlocked
+	require.Equal(t, origCoins, va.GetUnlockedOnly(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, va.LockedCoins(now.Add(12*time.Hour)))
+
+	// require 75% of coins vested after the second vesting period
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 750), sdk.NewInt64Coin(stakeDenom, 75)}, va.GetVestedCoins(now.Add(18*time.Hour)))
+
+	// require all coins vested at the end of the schedules
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), va.LockedCoins(now.Add(24*time.Hour)))
+}
+
+func TestClawbackClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}},
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	vestedCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	// claw back an account which did not delegate, while vested coins are
+	// still locked
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	toClawBack := va.Clawback(now.Add(7*time.Hour), sdk.NewCoins(), origCoins)
+	require.Equal(t, vestedCoins, toClawBack)
+	require.Equal(t, vestedCoins, va.OriginalVesting)
+	require.Equal(t, types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: vestedCoins},
+	}, types.Periods(va.LockupPeriods))
+	require.Equal(t, vestingPeriods[:1], types.Periods(va.VestingPeriods))
+	require.Equal(t, vestedCoins, va.LockedCoins(now.Add(7*time.Hour)))
+	require.Equal(t, sdk.NewCoins(), va.LockedCoins(now.Add(12*time.Hour)))
+	require.NoError(t, va.Validate())
+
+	// claw back an account which delegated all its stake
+	bacc, origCoins = initBaseAccount()
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	va.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, va.DelegatedVesting)
+
+	toClawBack = va.Clawback(now.Add(13*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)})
+	require.Equal(t, vestedCoins, toClawBack)
+	require.Equal(t, vestedCoins, va.OriginalVesting)
+
+	// the remaining delegation is vested
+	require.Equal(t, sdk.NewCoins(), va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedFree)
+	require.NoError(t, va.Validate())
+
+	// a tie goes to the clawback
+	bacc, origCoins = initBaseAccount()
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+	toClawBack = va.Clawback(now.Add(6*time.Hour), sdk.NewCoins(), origCoins)
+	require.Equal(t, origCoins, toClawBack)
+	require.Equal(t, sdk.NewCoins(), va.OriginalVesting)
+}
+
+func TestAddGrantClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	coins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	lockupPeriods := types.Periods{types.Period{Length: int64(12 * 60 * 60), Amount: coins}}
+	vestingPeriods := types.Periods{types.Period{Length: int64(24 * 60 * 60), Amount: coins}}
+
+	bacc, _ := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, coins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	// add a grant starting later and ending after the current schedules
+	grantCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	grantStart := now.Add(12 * time.Hour)
+	va.AddGrant(now, sdk.NewCoins(), grantStart.Unix(),
+		types.Periods{types.Period{Length: 0, Amount: grantCoins}},
+		types.Periods{types.Period{Length: int64(24 * 60 * 60), Amount: grantCoins}},
+		grantCoins)
+
+	require.Equal(t, now.Unix(), va.StartTime)
+	require.Equal(t, now.Add(36*time.Hour).Unix(), va.EndTime)
+	require.Equal(t, coins.Add(grantCoins...), va.OriginalVesting)
+	require.Equal(t, types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: coins.Add(grantCoins...)},
+	}, types.Periods(va.LockupPeriods))
+	require.Equal(t, types.Periods{
+		types.Period{Length: int64(24 * 60 * 60), Amount: coins},
+		types.Period{Length: int64(12 * 60 * 60), Amount: grantCoins},
+	}, types.Periods(va.VestingPeriods))
+	require.NoError(t, va.Validate())
+
+	require.Equal(t, coins, va.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Equal(t, coins.Add(grantCoins...), va.GetVestedCoins(now.Add(36*time.Hour)))
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -742,6 +897,40 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback vesting funder",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				FunderAddress:      "invalid",
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
+		{
+			"invalid clawback lockup period amounts",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(50), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}}),
+			true,
+		},
+		{
+			"invalid clawback vesting schedule end",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				FunderAddress:      addr.String(),
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				VestingPeriods:     types.Periods{types.Period{Length: int64(150), Amount: initialVesting}},
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -830,6 +1019,26 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	app := s.app
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(), types.Periods{types.Period{1800, coins}}, types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
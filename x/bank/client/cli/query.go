@@ -33,6 +33,7 @@ func GetQueryCmd() *cobra.Command {
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdQuerySendEnabled(),
 	)
 
 	return cmd
@@ -213,3 +214,48 @@ To query for the total supply of a specific coin denomination use:
 
 	return cmd
 }
+
+// GetCmdQuerySendEnabled defines the cobra command to query the send enabled
+// entries of coin denominations.
+func GetCmdQuerySendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [denom1 ...]",
+		Short: "Query for send enabled entries",
+		Long: strings.TrimSpace(`Query for send enabled entries that have been specifically set.
+
+To look up one or more specific denoms, supply them as arguments to this command.
+To look up all denoms, do not provide any arguments.
+`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reqPag, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySendEnabledRequest{
+				Denoms:     args,
+				Pagination: reqPag,
+			}
+
+			res, err := queryClient.SendEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send enabled entries")
+
+	return cmd
+}
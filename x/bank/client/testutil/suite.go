@@ -78,6 +78,11 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		},
 	}
 
+	bankGenesis.SendEnabled = []types.SendEnabled{
+		{Denom: "eth", Enabled: false},
+		{Denom: "uatom", Enabled: true},
+	}
+
 	bankGenesisBz, err := s.cfg.Codec.MarshalJSON(&bankGenesis)
 	s.Require().NoError(err)
 	genesisState[types.ModuleName] = bankGenesisBz
@@ -365,6 +370,59 @@ func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQuerySendEnabled() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected *types.QuerySendEnabledResponse
+	}{
+		{
+			name: "all send enabled entries",
+			args: []string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expected: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("eth", false),
+					types.NewSendEnabled("uatom", true),
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name: "specific denoms",
+			args: []string{
+				"eth",
+				"foobar",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expected: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("eth", false),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySendEnabled()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var resp types.QuerySendEnabledResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			s.Require().Equal(tc.expected, &resp)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestNewSendTxCmdGenOnly() {
 	val := s.network.Validators[0]
 
@@ -12,6 +12,10 @@ import (
 func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 
+	for _, se := range genState.GetAllSendEnabled() {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
 	totalSupply := sdk.Coins{}
 	genState.Balances = types.SanitizeGenesisBalances(genState.Balances)
 
@@ -50,5 +54,6 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
+		k.GetAllSendEnabledEntries(ctx),
 	)
 }
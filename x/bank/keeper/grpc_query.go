@@ -249,3 +249,34 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// SendEnabled implements the Query/SendEnabled gRPC method
+func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QuerySendEnabledResponse{}
+	if len(req.Denoms) > 0 {
+		store := ctx.KVStore(k.storeKey)
+		for _, denom := range req.Denoms {
+			if se, ok := k.getSendEnabled(store, denom); ok {
+				resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(denom, se))
+			}
+		}
+	} else {
+		seStore := k.getSendEnabledPrefixStore(ctx)
+		pageRes, err := query.Paginate(seStore, req.Pagination, func(key []byte, value []byte) error {
+			resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(string(key), types.IsTrueB(value)))
+			return nil
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		resp.Pagination = pageRes
+	}
+
+	return resp, nil
+}
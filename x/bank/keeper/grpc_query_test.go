@@ -453,3 +453,65 @@ func (suite *IntegrationTestSuite) TestGRPCDenomOwners() {
 
 	suite.Require().True(true)
 }
+
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	app, ctx := suite.app, suite.ctx
+
+	app.BankKeeper.SetSendEnabled(ctx, "falsestcoin", false)
+	app.BankKeeper.SetSendEnabled(ctx, "truestcoin", true)
+	// Note: DefaultSendEnabled is true in the suite params, so these are
+	// the only two denoms with specific entries.
+
+	testCases := map[string]struct {
+		req     *types.QuerySendEnabledRequest
+		exp     []*types.SendEnabled
+		expPage bool
+	}{
+		"okay": {
+			req: &types.QuerySendEnabledRequest{},
+			exp: []*types.SendEnabled{
+				types.NewSendEnabled("falsestcoin", false),
+				types.NewSendEnabled("truestcoin", true),
+			},
+			expPage: true,
+		},
+		"limit 1": {
+			req: &types.QuerySendEnabledRequest{
+				Pagination: &query.PageRequest{Limit: 1},
+			},
+			exp: []*types.SendEnabled{
+				types.NewSendEnabled("falsestcoin", false),
+			},
+			expPage: true,
+		},
+		"just truestcoin": {
+			req: &types.QuerySendEnabledRequest{
+				Denoms: []string{"truestcoin"},
+			},
+			exp: []*types.SendEnabled{
+				types.NewSendEnabled("truestcoin", true),
+			},
+		},
+		"unknown denoms are omitted": {
+			req: &types.QuerySendEnabledRequest{
+				Denoms: []string{"falsestcoin", "unknowncoin"},
+			},
+			exp: []*types.SendEnabled{
+				types.NewSendEnabled("falsestcoin", false),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			resp, err := suite.queryClient.SendEnabled(gocontext.Background(), tc.req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.exp, resp.SendEnabled)
+			if tc.expPage {
+				suite.Require().NotNil(resp.Pagination)
+			} else {
+				suite.Require().Nil(resp.Pagination)
+			}
+		})
+	}
+}
@@ -93,13 +93,15 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.P
 // store and fetch module parameters. The BaseKeeper also accepts a
 // blocklist map. This blocklist describes the set of addresses that are not allowed
 // to receive funds through direct and explicit actions, for example, by using a MsgSend or
-// by using a SendCoinsFromModuleToAccount execution.
+// by using a SendCoinsFromModuleToAccount execution. The authority is the address
+// allowed to execute MsgSetSendEnabled, usually the x/gov module account.
 func NewBaseKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	ak types.AccountKeeper,
 	paramSpace paramtypes.Subspace,
 	blockedAddrs map[string]bool,
+	authority string,
 ) BaseKeeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -107,7 +109,7 @@ func NewBaseKeeper(
 	}
 
 	return BaseKeeper{
-		BaseSendKeeper:         NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
+		BaseSendKeeper:         NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs, authority),
 		ak:                     ak,
 		cdc:                    cdc,
 		storeKey:               storeKey,
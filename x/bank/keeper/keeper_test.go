@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -88,6 +89,7 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
 		app.GetSubspace(types.ModuleName), blockedAddrs,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return authKeeper, &keeper
@@ -1054,7 +1056,7 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	)
 
 	bankKeeper := keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 	suite.app.BankKeeper = &bankKeeper
 
 	// set account with multiple permissions
@@ -1216,7 +1218,7 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 
 	for _, test := range tests {
 		bankKeeper := keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
+			suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, authtypes.NewModuleAddress(govtypes.ModuleName).String()).WithMintCoinsRestriction(keeper.MintingRestrictionFn(test.restrictionFn))
 		suite.app.BankKeeper = &bankKeeper
 		for _, testCase := range test.testCases {
 			if testCase.expectPass {
@@ -1240,6 +1242,162 @@ func (suite *IntegrationTestSuite) TestMintCoinRestrictions() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestSendRestrictions() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	escrow := sdk.AccAddress("escrow______________")
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100), newBarCoin(100))))
+
+	// bar coins cannot be sent to addr3, and foo coins are rerouted to the escrow
+	var calls []string
+	rejectBar := func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "rejectBar")
+		if toAddr.Equals(addr3) && !amt.AmountOf(barDenom).IsZero() {
+			return nil, fmt.Errorf("%s cannot receive %s", toAddr, barDenom)
+		}
+		return toAddr, nil
+	}
+	escrowFoo := func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "escrowFoo")
+		if !amt.AmountOf(fooDenom).IsZero() {
+			return escrow, nil
+		}
+		return toAddr, nil
+	}
+	app.BankKeeper.AppendSendRestriction(escrowFoo)
+	app.BankKeeper.PrependSendRestriction(rejectBar)
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal([]string{"rejectBar", "escrowFoo"}, calls)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, escrow))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+
+	suite.Require().Error(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// the restrictions apply to each output of a multi-send
+	inputs := []types.Input{{Address: addr1.String(), Coins: sdk.NewCoins(newFooCoin(5), newBarCoin(5))}}
+	outputs := []types.Output{
+		{Address: addr2.String(), Coins: sdk.NewCoins(newFooCoin(5))},
+		{Address: addr3.String(), Coins: sdk.NewCoins(newBarCoin(5))},
+	}
+	suite.Require().Error(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+
+	outputs[1].Address = addr2.String()
+	suite.Require().NoError(app.BankKeeper.InputOutputCoins(ctx, inputs, outputs))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(15)), app.BankKeeper.GetAllBalances(ctx, escrow))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(15)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(addr2.String(), outputs[0].Address, "the outputs of the caller must not be modified")
+
+	// and to sends from module accounts
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(25)), app.BankKeeper.GetAllBalances(ctx, escrow))
+
+	// once cleared, sends are not restricted anymore
+	app.BankKeeper.ClearSendRestriction()
+	calls = nil
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newFooCoin(10), newBarCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().Empty(calls)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
+
+func (suite *IntegrationTestSuite) TestSendEnabledEntries() {
+	app, ctx := suite.app, suite.ctx
+	bankKeeper := app.BankKeeper
+
+	_, found := bankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().False(found)
+	suite.Require().Empty(bankKeeper.GetAllSendEnabledEntries(ctx))
+
+	bankKeeper.SetSendEnabled(ctx, fooDenom, false)
+	bankKeeper.SetAllSendEnabled(ctx, []*types.SendEnabled{
+		types.NewSendEnabled(barDenom, true),
+		types.NewSendEnabled("bazcoin", false),
+	})
+
+	se, found := bankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SendEnabled{Denom: fooDenom, Enabled: false}, se)
+	suite.Require().False(bankKeeper.IsSendEnabledDenom(ctx, fooDenom))
+	suite.Require().True(bankKeeper.IsSendEnabledDenom(ctx, barDenom))
+	suite.Require().True(bankKeeper.IsSendEnabledDenom(ctx, "unknowncoin"))
+
+	expected := []types.SendEnabled{
+		{Denom: barDenom, Enabled: true},
+		{Denom: "bazcoin", Enabled: false},
+		{Denom: fooDenom, Enabled: false},
+	}
+	suite.Require().Equal(expected, bankKeeper.GetAllSendEnabledEntries(ctx))
+
+	var iterated []string
+	bankKeeper.IterateSendEnabledEntries(ctx, func(denom string, _ bool) bool {
+		iterated = append(iterated, denom)
+		return len(iterated) == 2
+	})
+	suite.Require().Equal([]string{barDenom, "bazcoin"}, iterated)
+
+	bankKeeper.DeleteSendEnabled(ctx, fooDenom)
+	_, found = bankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().False(found)
+	suite.Require().True(bankKeeper.IsSendEnabledDenom(ctx, fooDenom))
+
+	// entries provided through the deprecated params are moved into the store
+	params := types.NewParams(false, types.SendEnabledParams{types.NewSendEnabled(fooDenom, true)})
+	bankKeeper.SetParams(ctx, params)
+	suite.Require().Empty(bankKeeper.GetParams(ctx).SendEnabled)
+	suite.Require().True(bankKeeper.IsSendEnabledDenom(ctx, fooDenom))
+	suite.Require().False(bankKeeper.IsSendEnabledDenom(ctx, "unknowncoin"))
+}
+
+func (suite *IntegrationTestSuite) TestMsgSetSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := app.BankKeeper.GetAuthority()
+
+	app.BankKeeper.SetSendEnabled(ctx, "bazcoin", false)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetSendEnabled
+		expErr string
+	}{
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgSetSendEnabled(authtypes.NewModuleAddress("other").String(), nil, nil),
+			expErr: "expected " + authority,
+		},
+		{
+			name: "set and delete entries",
+			msg: types.NewMsgSetSendEnabled(
+				authority,
+				[]*types.SendEnabled{types.NewSendEnabled(fooDenom, false), types.NewSendEnabled(barDenom, true)},
+				[]string{"bazcoin", "unknowncoin"},
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := msgServer.SetSendEnabled(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErr != "" {
+				suite.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: barDenom, Enabled: true},
+		{Denom: fooDenom, Enabled: false},
+	}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -26,11 +28,25 @@ type SendKeeper interface {
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
 
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
+	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
+	SetSendEnabled(ctx sdk.Context, denom string, value bool)
+	SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled)
+	DeleteSendEnabled(ctx sdk.Context, denom string)
+	IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool))
+	GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled
+
+	GetAuthority() string
+
 	BlockedAddr(addr sdk.AccAddress) bool
 
 	types.SendHooks
 
 	SetHooks(sh types.SendHooks) *BaseSendKeeper
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -48,18 +64,32 @@ type BaseSendKeeper struct {
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
 	hooks        types.SendHooks
+
+	// the address capable of executing a MsgSetSendEnabled message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	// the send restriction is shared by all the copies of the keeper, so that
+	// it can be set after the keeper is passed to other modules
+	sendRestriction *sendRestriction
 }
 
 func NewBaseSendKeeper(
-	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool, authority string,
 ) BaseSendKeeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Errorf("invalid bank authority address: %w", err))
+	}
+
 	return BaseSendKeeper{
-		BaseViewKeeper: NewBaseViewKeeper(cdc, storeKey, ak),
-		cdc:            cdc,
-		ak:             ak,
-		storeKey:       storeKey,
-		paramSpace:     paramSpace,
-		blockedAddrs:   blockedAddrs,
+		BaseViewKeeper:  NewBaseViewKeeper(cdc, storeKey, ak),
+		cdc:             cdc,
+		ak:              ak,
+		storeKey:        storeKey,
+		paramSpace:      paramSpace,
+		blockedAddrs:    blockedAddrs,
+		authority:       authority,
+		sendRestriction: newSendRestriction(),
 	}
 }
 
@@ -74,6 +104,28 @@ func (keeper *BaseSendKeeper) SetHooks(sh types.SendHooks) *BaseSendKeeper {
 	return keeper
 }
 
+// GetAuthority returns the x/bank module's authority.
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
+// AppendSendRestriction adds the provided SendRestrictionFn to run after the
+// previously provided restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.append(restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before the
+// previously provided restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendRestriction.prepend(restriction)
+}
+
+// ClearSendRestriction removes the send restrictions (if there are any).
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendRestriction.clear()
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -81,7 +133,15 @@ func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
 }
 
 // SetParams sets the total set of bank parameters.
+//
+// Note: params.SendEnabled is deprecated but it should be here regardless.
+// Any entries it contains are moved into the bank store and the params are
+// saved without them.
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
+	if len(params.SendEnabled) > 0 { //nolint:staticcheck // SA1019: deprecated field kept for migration
+		k.SetAllSendEnabled(ctx, params.SendEnabled) //nolint:staticcheck // SA1019: deprecated field kept for migration
+		params = types.NewParams(params.DefaultSendEnabled, nil)
+	}
 	k.paramSpace.SetParamSet(ctx, &params)
 }
 
@@ -94,6 +154,14 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 	if err := types.ValidateInputsOutputs(inputs, outputs); err != nil {
 		return err
 	}
+
+	// The send restrictions are applied to every output for each input, and
+	// can reroute the output. The hooks are called with the rerouted outputs.
+	outputs, err := k.restrictOutputs(ctx, inputs, outputs)
+	if err != nil {
+		return err
+	}
+
 	if err := k.BeforeMultiSend(ctx, inputs, outputs); err != nil {
 		return err
 	}
@@ -162,7 +230,13 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 // Creates new account only if there is no mapping available for the recipient address AND recipient address account absent
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
 	fromAddr, toAddr = k.ak.GetMergedAccountAddressIfExists(ctx, fromAddr), k.ak.GetMergedAccountAddressIfExists(ctx, toAddr)
-	err := k.BeforeSend(ctx, fromAddr, toAddr, amt)
+
+	toAddr, err := k.sendRestriction.apply(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	err = k.BeforeSend(ctx, fromAddr, toAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -210,6 +284,43 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 	return nil
 }
 
+// restrictOutputs applies the send restriction to the outputs of a multi-send,
+// once for each input, and returns the outputs with their rerouted addresses.
+func (k BaseSendKeeper) restrictOutputs(ctx sdk.Context, inputs []types.Input, outputs []types.Output) ([]types.Output, error) {
+	if k.sendRestriction == nil || k.sendRestriction.fn == nil {
+		return outputs, nil
+	}
+
+	inAddresses := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
+		inAddress, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return nil, err
+		}
+		inAddresses[i] = k.ak.GetMergedAccountAddressIfExists(ctx, inAddress)
+	}
+
+	restricted := make([]types.Output, len(outputs))
+	for i, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return nil, err
+		}
+		outAddress = k.ak.GetMergedAccountAddressIfExists(ctx, outAddress)
+
+		for _, inAddress := range inAddresses {
+			outAddress, err = k.sendRestriction.apply(ctx, inAddress, outAddress, out.Coins)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		restricted[i] = types.NewOutput(outAddress, out.Coins)
+	}
+
+	return restricted, nil
+}
+
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
 // returned if the resulting balance is negative or the initial amount is invalid.
 // A coin_spent event is emitted after.
@@ -343,8 +454,15 @@ func (k BaseSendKeeper) setBalance(ctx sdk.Context, addr sdk.AccAddress, balance
 // any of the coins are not configured for sending.  Returns nil if sending is enabled
 // for all provided coin
 func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error {
+	if len(coins) == 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	defaultVal := k.getDefaultSendEnabled(ctx)
+
 	for _, coin := range coins {
-		if !k.IsSendEnabledCoin(ctx, coin) {
+		if !k.getSendEnabledOrDefault(store, coin.Denom, defaultVal) {
 			return sdkerrors.Wrapf(types.ErrSendDisabled, "%s transfers are currently disabled", coin.Denom)
 		}
 	}
@@ -353,7 +471,121 @@ func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) e
 
 // IsSendEnabledCoin returns the current SendEnabled status of the provided coin's denom
 func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
-	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+	return k.IsSendEnabledDenom(ctx, coin.Denom)
+}
+
+// IsSendEnabledDenom returns the current SendEnabled status of the provided denom.
+func (k BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	return k.getSendEnabledOrDefault(ctx.KVStore(k.storeKey), denom, k.getDefaultSendEnabled(ctx))
+}
+
+// GetSendEnabledEntry gets a SendEnabled entry for the given denom.
+// The second return argument is true iff a specific entry exists for the given denom.
+func (k BaseSendKeeper) GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool) {
+	sendEnabled, found := k.getSendEnabled(ctx.KVStore(k.storeKey), denom)
+	if !found {
+		return types.SendEnabled{}, false
+	}
+
+	return types.SendEnabled{Denom: denom, Enabled: sendEnabled}, true
+}
+
+// SetSendEnabled sets the SendEnabled flag for a denom to the provided value.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, value bool) {
+	store := ctx.KVStore(k.storeKey)
+	k.setSendEnabledEntry(store, denom, value)
+}
+
+// SetAllSendEnabled sets all the provided SendEnabled entries in the bank store.
+func (k BaseSendKeeper) SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled) {
+	store := ctx.KVStore(k.storeKey)
+	for _, se := range sendEnableds {
+		k.setSendEnabledEntry(store, se.Denom, se.Enabled)
+	}
+}
+
+// setSendEnabledEntry sets SendEnabled for the given denom to the give value in the provided store.
+func (k BaseSendKeeper) setSendEnabledEntry(store sdk.KVStore, denom string, value bool) {
+	key := types.CreateSendEnabledKey(denom)
+	store.Set(key, types.ToBoolB(value))
+}
+
+// DeleteSendEnabled deletes the SendEnabled flag for a denom.
+func (k BaseSendKeeper) DeleteSendEnabled(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CreateSendEnabledKey(denom))
+}
+
+// getSendEnabledPrefixStore gets a prefix store for the SendEnabled entries.
+func (k BaseSendKeeper) getSendEnabledPrefixStore(ctx sdk.Context) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+}
+
+// IterateSendEnabledEntries iterates over all the SendEnabled entries.
+func (k BaseSendKeeper) IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) bool) {
+	seStore := k.getSendEnabledPrefixStore(ctx)
+
+	iterator := seStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key())
+		val := types.IsTrueB(iterator.Value())
+		if cb(denom, val) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabledEntries gets all the SendEnabled entries that are stored.
+// Any denominations not returned use the default value (set in Params).
+func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled {
+	var rv []types.SendEnabled
+	k.IterateSendEnabledEntries(ctx, func(denom string, sendEnabled bool) bool {
+		rv = append(rv, types.SendEnabled{Denom: denom, Enabled: sendEnabled})
+		return false
+	})
+
+	return rv
+}
+
+// getDefaultSendEnabled gets the default send enabled value from the params.
+func (k BaseSendKeeper) getDefaultSendEnabled(ctx sdk.Context) bool {
+	var defaultSendEnabled bool
+	k.paramSpace.Get(ctx, types.KeyDefaultSendEnabled, &defaultSendEnabled)
+	return defaultSendEnabled
+}
+
+// getSendEnabled returns whether send is enabled and whether that flag was set
+// for a denom.
+//
+// Example usage:
+//
+//	store := ctx.KVStore(k.storeKey)
+//	sendEnabled, found := getSendEnabled(store, "atom")
+//	if !found {
+//	    sendEnabled = DefaultSendEnabled
+//	}
+func (k BaseSendKeeper) getSendEnabled(store sdk.KVStore, denom string) (bool, bool) {
+	key := types.CreateSendEnabledKey(denom)
+	if !store.Has(key) {
+		return false, false
+	}
+
+	bz := store.Get(key)
+
+	return types.IsTrueB(bz), true
+}
+
+// getSendEnabledOrDefault gets the SendEnabled value for a denom. If it's not
+// in the store, this will return defaultVal.
+func (k BaseSendKeeper) getSendEnabledOrDefault(store sdk.KVStore, denom string, defaultVal bool) bool {
+	sendEnabled, found := k.getSendEnabled(store, denom)
+	if found {
+		return sendEnabled
+	}
+
+	return defaultVal
 }
 
 // BlockedAddr checks if a given address is restricted from
@@ -361,3 +593,40 @@ func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
 func (k BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
 	return k.blockedAddrs[addr.String()]
 }
+
+// sendRestriction is a struct that houses a SendRestrictionFn.
+// It exists so that the SendRestrictionFn can be updated in the SendKeeper
+// without needing to have a pointer receiver on the keeper.
+type sendRestriction struct {
+	fn types.SendRestrictionFn
+}
+
+// newSendRestriction creates a new sendRestriction with nil send restriction.
+func newSendRestriction() *sendRestriction {
+	return &sendRestriction{
+		fn: nil,
+	}
+}
+
+// append adds the provided restriction to this, to be run after the existing function.
+func (r *sendRestriction) append(restriction types.SendRestrictionFn) {
+	r.fn = r.fn.Then(restriction)
+}
+
+// prepend adds the provided restriction to this, to be run before the existing function.
+func (r *sendRestriction) prepend(restriction types.SendRestrictionFn) {
+	r.fn = restriction.Then(r.fn)
+}
+
+// clear removes the send restriction (sets it to nil).
+func (r *sendRestriction) clear() {
+	r.fn = nil
+}
+
+// apply applies the send restriction if there is one. If not, it's a no-op.
+func (r *sendRestriction) apply(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if r == nil || r.fn == nil {
+		return toAddr, nil
+	}
+	return r.fn(ctx, fromAddr, toAddr, amt)
+}
@@ -0,0 +1,27 @@
+package v047
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4. The
+// migration includes:
+//
+// - Move the SendEnabled entries from the params to the bank store.
+// - Clear the (deprecated) SendEnabled params.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace) error {
+	var sendEnabled []*types.SendEnabled
+	paramSpace.GetIfExists(ctx, types.KeySendEnabled, &sendEnabled)
+
+	store := ctx.KVStore(storeKey)
+	for _, se := range sendEnabled {
+		store.Set(types.CreateSendEnabledKey(se.Denom), types.ToBoolB(se.Enabled))
+	}
+
+	paramSpace.Set(ctx, types.KeySendEnabled, []*types.SendEnabled{})
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	tBankKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bankKey, tBankKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, bankKey, tBankKey, "bank").
+		WithKeyTable(types.ParamKeyTable())
+
+	// set v3 params, with send enabled entries
+	sendEnabled := []*types.SendEnabled{
+		types.NewSendEnabled("foocoin", false),
+		types.NewSendEnabled("barcoin", true),
+	}
+	paramstore.Set(ctx, types.KeySendEnabled, sendEnabled)
+	paramstore.Set(ctx, types.KeyDefaultSendEnabled, true)
+
+	require.NoError(t, v047.MigrateStore(ctx, bankKey, paramstore))
+
+	var params types.Params
+	paramstore.GetParamSet(ctx, &params)
+	require.Empty(t, params.SendEnabled)
+	require.True(t, params.DefaultSendEnabled)
+
+	store := ctx.KVStore(bankKey)
+	for _, se := range sendEnabled {
+		bz := store.Get(types.CreateSendEnabledKey(se.Denom))
+		require.Equal(t, types.ToBoolB(se.Enabled), bz, se.Denom)
+	}
+}
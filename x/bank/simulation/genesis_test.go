@@ -40,7 +40,8 @@ func TestRandomizedGenState(t *testing.T) {
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &bankGenesis)
 
 	require.Equal(t, true, bankGenesis.Params.GetDefaultSendEnabled())
-	require.Len(t, bankGenesis.Params.GetSendEnabled(), 1)
+	require.Len(t, bankGenesis.Params.GetSendEnabled(), 0)
+	require.Len(t, bankGenesis.SendEnabled, 1)
 	require.Len(t, bankGenesis.Balances, 3)
 	require.Equal(t, "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r", bankGenesis.Balances[2].GetAddress().String())
 	require.Equal(t, "1000stake", bankGenesis.Balances[2].GetCoins().String())
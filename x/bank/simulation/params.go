@@ -3,7 +3,6 @@ package simulation
 // DONTCOVER
 
 import (
-	"encoding/json"
 	"fmt"
 	"math/rand"
 
@@ -17,15 +16,6 @@ import (
 // on the simulation
 func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeySendEnabled),
-			func(r *rand.Rand) string {
-				paramsBytes, err := json.Marshal(RandomGenesisSendParams(r))
-				if err != nil {
-					panic(err)
-				}
-				return string(paramsBytes)
-			},
-		),
 		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDefaultSendEnabled),
 			func(r *rand.Rand) string {
 				return fmt.Sprintf("%v", RandomGenesisDefaultSendParam(r))
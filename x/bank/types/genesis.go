@@ -15,9 +15,20 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	seenSendEnabled := make(map[string]bool)
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
 
+	for _, p := range gs.GetAllSendEnabled() {
+		if _, exists := seenSendEnabled[p.Denom]; exists {
+			return fmt.Errorf("duplicate send enabled found: '%s'", p.Denom)
+		}
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		seenSendEnabled[p.Denom] = true
+	}
+
 	totalSupply := sdk.Coins{}
 
 	for _, balance := range gs.Balances {
@@ -62,18 +73,19 @@ func (gs GenesisState) Validate() error {
 }
 
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata) *GenesisState {
+func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata, sendEnabled []SendEnabled) *GenesisState {
 	return &GenesisState{
 		Params:        params,
 		Balances:      balances,
 		Supply:        supply,
 		DenomMetadata: denomMetaData,
+		SendEnabled:   sendEnabled,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{})
+	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{}, []SendEnabled{})
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
@@ -87,3 +99,14 @@ func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.R
 
 	return &genesisState
 }
+
+// GetAllSendEnabled returns all the SendEnabled entries from both the SendEnabled
+// field and the (deprecated) Params.SendEnabled field.
+func (gs GenesisState) GetAllSendEnabled() []SendEnabled {
+	rv := make([]SendEnabled, len(gs.SendEnabled), len(gs.SendEnabled)+len(gs.Params.SendEnabled))
+	copy(rv, gs.SendEnabled)
+	for _, p := range gs.Params.SendEnabled { //nolint:staticcheck // SA1019: deprecated field kept for migration
+		rv = append(rv, *p)
+	}
+	return rv
+}
@@ -37,6 +37,8 @@ type GenesisState struct {
 	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
 	// denom_metadata defines the metadata of the differents coins.
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
+	// send_enabled defines the denoms where send is enabled or disabled.
+	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +102,13 @@ func (m *GenesisState) GetDenomMetadata() []Metadata {
 	return nil
 }
 
+func (m *GenesisState) GetSendEnabled() []SendEnabled {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -150,32 +159,34 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x4f, 0xfa, 0x40,
-	0x18, 0xc7, 0x5b, 0xe0, 0x07, 0xfc, 0x0e, 0x75, 0xa8, 0x0c, 0x05, 0xb5, 0x45, 0x26, 0x1c, 0x68,
-	0x05, 0x27, 0x1d, 0x4c, 0x2c, 0x83, 0x89, 0x89, 0x89, 0x81, 0xcd, 0x85, 0x5c, 0xdb, 0x4b, 0x6d,
-	0xa0, 0xbd, 0xa6, 0x77, 0x18, 0x79, 0x07, 0x8e, 0xbe, 0x04, 0x66, 0x66, 0x27, 0x5f, 0x01, 0x23,
-	0x71, 0x72, 0x52, 0x03, 0x8b, 0x2f, 0xc3, 0xf4, 0xee, 0xa8, 0x26, 0x12, 0x27, 0xa7, 0xfe, 0xf9,
-	0x7e, 0x3f, 0xdf, 0xe7, 0x79, 0xee, 0x1e, 0xb0, 0xef, 0x60, 0x12, 0x60, 0x62, 0xda, 0x30, 0x1c,
-	0x98, 0xb7, 0x2d, 0x1b, 0x51, 0xd8, 0x32, 0x3d, 0x14, 0x22, 0xe2, 0x13, 0x23, 0x8a, 0x31, 0xc5,
-	0xca, 0x36, 0xb7, 0x18, 0x89, 0xc5, 0x10, 0x96, 0x6a, 0xd9, 0xc3, 0x1e, 0x66, 0xba, 0x99, 0xbc,
-	0x71, 0x6b, 0x55, 0x4b, 0xd3, 0x08, 0x4a, 0xd3, 0x1c, 0xec, 0x87, 0x3f, 0xf4, 0x6f, 0xd5, 0x58,
-	0x2e, 0xd7, 0x2b, 0x5c, 0xef, 0xf3, 0x60, 0x51, 0x97, 0x7d, 0xd4, 0x9f, 0x32, 0x60, 0xe3, 0x9c,
-	0xf7, 0xd5, 0xa3, 0x90, 0x22, 0xe5, 0x18, 0xe4, 0x23, 0x18, 0xc3, 0x80, 0xa8, 0x72, 0x4d, 0x6e,
-	0x94, 0xda, 0x3b, 0xc6, 0x9a, 0x3e, 0x8d, 0x2b, 0x66, 0xb1, 0x72, 0xb3, 0x57, 0x5d, 0xea, 0x0a,
-	0x40, 0x39, 0x05, 0x45, 0x1b, 0x0e, 0x61, 0xe8, 0x20, 0xa2, 0x66, 0x6a, 0xd9, 0x46, 0xa9, 0xbd,
-	0xbb, 0x16, 0xb6, 0xb8, 0x49, 0xd0, 0x29, 0xa3, 0x38, 0x20, 0x4f, 0x46, 0x51, 0x34, 0x1c, 0xab,
-	0x59, 0x46, 0x57, 0xbe, 0x68, 0x82, 0x52, 0xba, 0x83, 0xfd, 0xd0, 0x3a, 0x4c, 0xd0, 0xe9, 0x9b,
-	0xde, 0xf0, 0x7c, 0x7a, 0x33, 0xb2, 0x0d, 0x07, 0x07, 0x62, 0x2e, 0xf1, 0x68, 0x12, 0x77, 0x60,
-	0xd2, 0x71, 0x84, 0x08, 0x03, 0x48, 0x57, 0x44, 0x2b, 0x17, 0x60, 0xcb, 0x45, 0x21, 0x0e, 0xfa,
-	0x01, 0xa2, 0xd0, 0x85, 0x14, 0xaa, 0x39, 0x56, 0x6c, 0x6f, 0x6d, 0xab, 0x97, 0xc2, 0x24, 0x7a,
-	0xdd, 0x64, 0xe8, 0xea, 0x67, 0x7d, 0x2a, 0x83, 0x82, 0x18, 0x46, 0x69, 0x83, 0x02, 0x74, 0xdd,
-	0x18, 0x11, 0x7e, 0x70, 0xff, 0x2d, 0xf5, 0xf9, 0xb1, 0x59, 0x16, 0x99, 0x67, 0x5c, 0xe9, 0xd1,
-	0xd8, 0x0f, 0xbd, 0xee, 0xca, 0xa8, 0x40, 0xf0, 0x2f, 0xb9, 0xc5, 0xd5, 0x69, 0xfd, 0xe9, 0xbc,
-	0x3c, 0xf9, 0xa4, 0x78, 0x3f, 0xd1, 0xa5, 0x8f, 0x89, 0x2e, 0x59, 0x9d, 0xd9, 0x42, 0x93, 0xe7,
-	0x0b, 0x4d, 0x7e, 0x5f, 0x68, 0xf2, 0xc3, 0x52, 0x93, 0xe6, 0x4b, 0x4d, 0x7a, 0x59, 0x6a, 0xd2,
-	0xf5, 0xc1, 0xaf, 0xa1, 0x77, 0x7c, 0xad, 0x58, 0xb6, 0x9d, 0x67, 0x5b, 0x73, 0xf4, 0x19, 0x00,
-	0x00, 0xff, 0xff, 0x78, 0xd8, 0xf3, 0x7f, 0xe0, 0x02, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0xae, 0xd3, 0x30,
+	0x14, 0xc6, 0x13, 0xfa, 0x17, 0xb7, 0x30, 0x98, 0x0e, 0x69, 0x81, 0xa4, 0x74, 0x2a, 0x43, 0x13,
+	0x5a, 0x26, 0x18, 0x90, 0x48, 0x85, 0x10, 0x48, 0x48, 0xa8, 0xdd, 0x58, 0x2a, 0x27, 0xb6, 0x42,
+	0xd4, 0xc6, 0x8e, 0x62, 0x17, 0xd1, 0x07, 0x40, 0x62, 0xe4, 0x11, 0x3a, 0x77, 0xe6, 0x21, 0x3a,
+	0x56, 0x4c, 0x4c, 0x80, 0xda, 0x85, 0xc7, 0x40, 0xb1, 0xdd, 0x14, 0xe9, 0x46, 0x77, 0xba, 0x53,
+	0x12, 0x7f, 0xdf, 0xf7, 0x3b, 0x27, 0xc7, 0x07, 0x3c, 0x0a, 0x19, 0x4f, 0x18, 0xf7, 0x02, 0x44,
+	0x97, 0xde, 0xa7, 0x71, 0x40, 0x04, 0x1a, 0x7b, 0x11, 0xa1, 0x84, 0xc7, 0xdc, 0x4d, 0x33, 0x26,
+	0x18, 0xbc, 0xa7, 0x2c, 0x6e, 0x6e, 0x71, 0xb5, 0xa5, 0xd7, 0x89, 0x58, 0xc4, 0xa4, 0xee, 0xe5,
+	0x6f, 0xca, 0xda, 0xb3, 0x0b, 0x1a, 0x27, 0x05, 0x2d, 0x64, 0x31, 0xbd, 0xa2, 0xff, 0x57, 0x4d,
+	0x72, 0x95, 0xde, 0x55, 0xfa, 0x42, 0x81, 0x75, 0x5d, 0xf9, 0x31, 0xf8, 0x52, 0x01, 0xed, 0xd7,
+	0xaa, 0xaf, 0xb9, 0x40, 0x82, 0xc0, 0x67, 0xa0, 0x9e, 0xa2, 0x0c, 0x25, 0xdc, 0x32, 0xfb, 0xe6,
+	0xb0, 0x35, 0xb9, 0xef, 0x96, 0xf4, 0xe9, 0xbe, 0x97, 0x16, 0xbf, 0xba, 0xff, 0xe5, 0x18, 0x33,
+	0x1d, 0x80, 0x2f, 0x40, 0x33, 0x40, 0x2b, 0x44, 0x43, 0xc2, 0xad, 0x5b, 0xfd, 0xca, 0xb0, 0x35,
+	0x79, 0x50, 0x1a, 0xf6, 0x95, 0x49, 0xa7, 0x8b, 0x0c, 0x0c, 0x41, 0x9d, 0xaf, 0xd3, 0x74, 0xb5,
+	0xb1, 0x2a, 0x32, 0xdd, 0xbd, 0xa4, 0x39, 0x29, 0xd2, 0x53, 0x16, 0x53, 0xff, 0x49, 0x1e, 0xdd,
+	0xfd, 0x76, 0x86, 0x51, 0x2c, 0x3e, 0xae, 0x03, 0x37, 0x64, 0x89, 0xfe, 0x2f, 0xfd, 0x18, 0x71,
+	0xbc, 0xf4, 0xc4, 0x26, 0x25, 0x5c, 0x06, 0xf8, 0x4c, 0xa3, 0xe1, 0x5b, 0x70, 0x17, 0x13, 0xca,
+	0x92, 0x45, 0x42, 0x04, 0xc2, 0x48, 0x20, 0xab, 0x2a, 0x8b, 0x3d, 0x2c, 0x6d, 0xf5, 0x9d, 0x36,
+	0xe9, 0x5e, 0xef, 0xc8, 0xe8, 0xf9, 0x10, 0xbe, 0x01, 0x6d, 0x4e, 0x28, 0x5e, 0x10, 0x8a, 0x82,
+	0x15, 0xc1, 0x56, 0x4d, 0x92, 0xfa, 0xa5, 0xa4, 0x39, 0xa1, 0xf8, 0x95, 0xf2, 0x69, 0x58, 0x8b,
+	0x5f, 0x8e, 0x06, 0x3b, 0x13, 0x34, 0xf4, 0x5c, 0xe0, 0x04, 0x34, 0x10, 0xc6, 0x19, 0xe1, 0xea,
+	0x0e, 0x6e, 0xfb, 0xd6, 0x8f, 0xef, 0xa3, 0x8e, 0x86, 0xbe, 0x54, 0xca, 0x5c, 0x64, 0x31, 0x8d,
+	0x66, 0x67, 0x23, 0x44, 0xa0, 0x96, 0x2f, 0xc4, 0x79, 0xf0, 0x37, 0x3a, 0x3a, 0x45, 0x7e, 0xde,
+	0xfc, 0xba, 0x75, 0x8c, 0xbf, 0x5b, 0xc7, 0xf0, 0xa7, 0xfb, 0xa3, 0x6d, 0x1e, 0x8e, 0xb6, 0xf9,
+	0xe7, 0x68, 0x9b, 0xdf, 0x4e, 0xb6, 0x71, 0x38, 0xd9, 0xc6, 0xcf, 0x93, 0x6d, 0x7c, 0x78, 0x7c,
+	0x2d, 0xf4, 0xb3, 0xda, 0x50, 0xc9, 0x0e, 0xea, 0x72, 0x01, 0x9f, 0xfe, 0x1b, 0x00, 0x80, 0xd6,
+	0xfd, 0xac, 0x2b, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +209,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenomMetadata) > 0 {
 		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -334,6 +359,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -526,6 +557,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -54,6 +54,23 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid send enabled",
+			GenesisState{
+				SendEnabled: []SendEnabled{{"0FOO", true}},
+			},
+			true,
+		},
+		{
+			"dup send enabled across params and genesis",
+			GenesisState{
+				Params: Params{
+					SendEnabled: []*SendEnabled{{"foocoin", true}},
+				},
+				SendEnabled: []SendEnabled{{"foocoin", false}},
+			},
+			true,
+		},
 		{
 			"dup balances",
 			GenesisState{
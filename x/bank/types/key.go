@@ -26,11 +26,21 @@ var (
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
 
+	// SendEnabledPrefix is the prefix for the SendDisabled flags for a Denom.
+	SendEnabledPrefix = []byte{0x04}
+
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
 	BalancesPrefix = []byte{0x02}
 )
 
+const (
+	// TrueB is a byte with value 1 that represents true.
+	TrueB = byte(0x01)
+	// FalseB is a byte with value 0 that represents false.
+	FalseB = byte(0x00)
+)
+
 // AddressAndDenomFromBalancesStore returns an account address and denom from a balances prefix
 // store. The key must not contain the prefix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -73,3 +83,24 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateSendEnabledKey creates the key of the SendDisabled flag for a denom.
+func CreateSendEnabledKey(denom string) []byte {
+	key := make([]byte, len(SendEnabledPrefix)+len(denom))
+	copy(key, SendEnabledPrefix)
+	copy(key[len(SendEnabledPrefix):], denom)
+	return key
+}
+
+// IsTrueB returns true if the provided byte slice has exactly one byte, and it is equal to TrueB.
+func IsTrueB(bz []byte) bool {
+	return len(bz) == 1 && bz[0] == TrueB
+}
+
+// ToBoolB returns TrueB if v is true, and FalseB if it's false.
+func ToBoolB(v bool) []byte {
+	if v {
+		return []byte{TrueB}
+	}
+	return []byte{FalseB}
+}
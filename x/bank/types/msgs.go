@@ -7,8 +7,9 @@ import (
 
 // bank message types
 const (
-	TypeMsgSend      = "send"
-	TypeMsgMultiSend = "multisend"
+	TypeMsgSend           = "send"
+	TypeMsgMultiSend      = "multisend"
+	TypeMsgSetSendEnabled = "set_send_enabled"
 )
 
 var _ sdk.Msg = &MsgSend{}
@@ -184,3 +185,65 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
 
 	return nil
 }
+
+var _ sdk.Msg = &MsgSetSendEnabled{}
+
+// NewMsgSetSendEnabled - construct a msg to update send enabled entries.
+func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefaultFor []string) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{
+		Authority:     authority,
+		SendEnabled:   sendEnabled,
+		UseDefaultFor: useDefaultFor,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetSendEnabled) Type() string { return TypeMsgSetSendEnabled }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners returns the expected signers for MsgSetSendEnabled.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic runs basic validation on this MsgSetSendEnabled.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	seen := map[string]bool{}
+	for _, se := range msg.SendEnabled {
+		if se == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("nil send enabled entry")
+		}
+		if _, alreadySeen := seen[se.Denom]; alreadySeen {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", se.Denom)
+		}
+
+		seen[se.Denom] = true
+
+		if err := validateSendEnabled(*se); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid SendEnabled denom %q: %s", se.Denom, err)
+		}
+	}
+
+	for _, denom := range msg.UseDefaultFor {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid UseDefaultFor denom %q: %s", denom, err)
+		}
+		if seen[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("denom %q cannot be both set and use the default", denom)
+		}
+	}
+
+	return nil
+}
@@ -278,3 +278,48 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgSetSendEnabledValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority")).String()
+
+	cases := []struct {
+		name   string
+		msg    *MsgSetSendEnabled
+		expErr string
+	}{
+		{"valid", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", false)}, []string{"barcoin"}), ""},
+		{"empty", NewMsgSetSendEnabled(authority, nil, nil), ""},
+		{"bad authority", NewMsgSetSendEnabled("", nil, nil), "invalid authority address"},
+		{"bad denom", NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("0FOO", true)}, nil), "invalid SendEnabled denom"},
+		{
+			"duplicate denom",
+			NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", true), NewSendEnabled("foocoin", false)}, nil),
+			"duplicate denom entries found",
+		},
+		{"bad default denom", NewMsgSetSendEnabled(authority, nil, []string{"0FOO"}), "invalid UseDefaultFor denom"},
+		{
+			"set and default",
+			NewMsgSetSendEnabled(authority, []*SendEnabled{NewSendEnabled("foocoin", true)}, []string{"foocoin"}),
+			"cannot be both set and use the default",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMsgSetSendEnabledGetSignBytes(t *testing.T) {
+	msg := NewMsgSetSendEnabled("cosmos1d9h8qat57ljhcm", []*SendEnabled{NewSendEnabled("atom", false)}, nil)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"cosmos-sdk/MsgSetSendEnabled","value":{"authority":"cosmos1d9h8qat57ljhcm","send_enabled":[{"denom":"atom"}]}}`
+	require.Equal(t, expected, string(res))
+}
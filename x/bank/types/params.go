@@ -84,7 +84,7 @@ func (p Params) SetSendEnabledParam(denom string, sendEnabled bool) Params {
 // ParamSetPairs implements params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateSendEnabledParams),
+		paramtypes.NewParamSetPair(KeySendEnabled, &p.SendEnabled, validateDeprecatedSendEnabledParams),
 		paramtypes.NewParamSetPair(KeyDefaultSendEnabled, &p.DefaultSendEnabled, validateIsBool),
 	}
 }
@@ -111,6 +111,19 @@ func validateSendEnabledParams(i interface{}) error {
 	return nil
 }
 
+// validateDeprecatedSendEnabledParams only allows an empty list to be stored in
+// the params, since send enabled entries now live in the bank store and are
+// updated through MsgSetSendEnabled.
+func validateDeprecatedSendEnabledParams(i interface{}) error {
+	if err := validateSendEnabledParams(i); err != nil {
+		return err
+	}
+	if params := i.([]*SendEnabled); len(params) > 0 {
+		return fmt.Errorf("send enabled params are deprecated, use MsgSetSendEnabled instead")
+	}
+	return nil
+}
+
 // NewSendEnabled creates a new SendEnabled object
 // The denom may be left empty to control the global default setting of send_enabled
 func NewSendEnabled(denom string, sendEnabled bool) *SendEnabled {
@@ -135,6 +148,11 @@ func (se SendEnabled) String() string {
 	return string(out)
 }
 
+// Validate gets any errors with this SendEnabled entry.
+func (se SendEnabled) Validate() error {
+	return sdk.ValidateDenom(se.Denom)
+}
+
 func validateSendEnabled(i interface{}) error {
 	param, ok := i.(SendEnabled)
 	if !ok {
@@ -112,3 +112,9 @@ func Test_validateParams(t *testing.T) {
 
 	require.Error(t, validateSendEnabledParams(SendEnabledParams{NewSendEnabled("INVALIDDENOM", true)}))
 }
+
+func Test_validateDeprecatedSendEnabledParams(t *testing.T) {
+	require.NoError(t, validateDeprecatedSendEnabledParams([]*SendEnabled{}))
+	require.Error(t, validateDeprecatedSendEnabledParams([]*SendEnabled{NewSendEnabled("foocoin", true)}))
+	require.Error(t, validateDeprecatedSendEnabledParams(true))
+}
@@ -960,6 +960,117 @@ func (m *QueryDenomOwnersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QuerySendEnabledRequest defines the RPC request for looking up SendEnabled entries.
+type QuerySendEnabledRequest struct {
+	// denoms is the specific denoms you want look up. Leave empty to get all entries.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// pagination defines an optional pagination for the request. This field is
+	// only read if the denoms field is empty.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySendEnabledRequest) Reset()         { *m = QuerySendEnabledRequest{} }
+func (m *QuerySendEnabledRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySendEnabledRequest) ProtoMessage()    {}
+func (*QuerySendEnabledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{19}
+}
+func (m *QuerySendEnabledRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendEnabledRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySendEnabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendEnabledRequest.Merge(m, src)
+}
+func (m *QuerySendEnabledRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendEnabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendEnabledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySendEnabledRequest proto.InternalMessageInfo
+
+func (m *QuerySendEnabledRequest) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QuerySendEnabledRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySendEnabledResponse defines the RPC response of a SendEnable query.
+type QuerySendEnabledResponse struct {
+	SendEnabled []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// pagination defines the pagination in the response. This field is only
+	// populated if the denoms field in the request is empty.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySendEnabledResponse) Reset()         { *m = QuerySendEnabledResponse{} }
+func (m *QuerySendEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySendEnabledResponse) ProtoMessage()    {}
+func (*QuerySendEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c6fc1939682df13, []int{20}
+}
+func (m *QuerySendEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySendEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySendEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySendEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySendEnabledResponse.Merge(m, src)
+}
+func (m *QuerySendEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySendEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySendEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySendEnabledResponse proto.InternalMessageInfo
+
+func (m *QuerySendEnabledResponse) GetSendEnabled() []*SendEnabled {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return nil
+}
+
+func (m *QuerySendEnabledResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.bank.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceResponse)(nil), "cosmos.bank.v1beta1.QueryBalanceResponse")
@@ -980,75 +1091,83 @@ func init() {
 	proto.RegisterType((*QueryDenomOwnersRequest)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersRequest")
 	proto.RegisterType((*DenomOwner)(nil), "cosmos.bank.v1beta1.DenomOwner")
 	proto.RegisterType((*QueryDenomOwnersResponse)(nil), "cosmos.bank.v1beta1.QueryDenomOwnersResponse")
+	proto.RegisterType((*QuerySendEnabledRequest)(nil), "cosmos.bank.v1beta1.QuerySendEnabledRequest")
+	proto.RegisterType((*QuerySendEnabledResponse)(nil), "cosmos.bank.v1beta1.QuerySendEnabledResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/query.proto", fileDescriptor_9c6fc1939682df13) }
 
 var fileDescriptor_9c6fc1939682df13 = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xbf, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0x04, 0xce, 0xc9, 0x3d, 0x03, 0x12, 0x73, 0x46, 0x24, 0x1b, 0x62, 0xa3, 0x05, 0x5d,
-	0x92, 0x23, 0xd9, 0x8d, 0x1d, 0x24, 0x08, 0x0d, 0x8a, 0x0f, 0x41, 0x81, 0xd0, 0x05, 0x87, 0x0a,
-	0x09, 0x59, 0x63, 0x7b, 0x59, 0xac, 0xd8, 0x3b, 0x7b, 0x9e, 0x35, 0x47, 0x14, 0x9d, 0x84, 0xa8,
-	0xa8, 0x38, 0x24, 0x1a, 0x24, 0x84, 0x38, 0x0a, 0x40, 0x50, 0x23, 0xf1, 0x2f, 0xa4, 0xa0, 0x38,
-	0x1d, 0x0d, 0x15, 0xa0, 0x84, 0x82, 0x3f, 0x03, 0x79, 0xe6, 0x8d, 0xbd, 0x6b, 0x8f, 0x37, 0x0b,
-	0x18, 0x09, 0xaa, 0x78, 0x67, 0xde, 0x8f, 0xef, 0xfb, 0xe6, 0xcd, 0x7b, 0x13, 0x28, 0xb7, 0xb8,
-	0xe8, 0x71, 0xe1, 0x36, 0x59, 0x70, 0xe4, 0xbe, 0x5b, 0x69, 0x7a, 0x11, 0xab, 0xb8, 0x37, 0x07,
-	0x5e, 0xff, 0xd8, 0x09, 0xfb, 0x3c, 0xe2, 0xf4, 0x8a, 0x32, 0x70, 0x86, 0x06, 0x0e, 0x1a, 0x58,
-	0xd7, 0x46, 0x5e, 0xc2, 0x53, 0xd6, 0x23, 0xdf, 0x90, 0xf9, 0x9d, 0x80, 0x45, 0x1d, 0x1e, 0xa8,
-	0x00, 0x56, 0xd1, 0xe7, 0x3e, 0x97, 0x3f, 0xdd, 0xe1, 0x2f, 0x5c, 0x7d, 0xc2, 0xe7, 0xdc, 0xef,
-	0x7a, 0x2e, 0x0b, 0x3b, 0x2e, 0x0b, 0x02, 0x1e, 0x49, 0x17, 0x81, 0xbb, 0xa5, 0x78, 0x7c, 0x1d,
-	0xb9, 0xc5, 0x3b, 0xc1, 0xd4, 0x7e, 0x0c, 0xb5, 0x44, 0xa8, 0xf6, 0x57, 0xd4, 0x7e, 0x43, 0xa5,
-	0x45, 0x06, 0xf2, 0xc3, 0xee, 0xc0, 0x95, 0xd7, 0x87, 0x80, 0x6b, 0xac, 0xcb, 0x82, 0x96, 0x57,
-	0xf7, 0x6e, 0x0e, 0x3c, 0x11, 0xd1, 0x2a, 0x2c, 0xb2, 0x76, 0xbb, 0xef, 0x09, 0xb1, 0x4c, 0x9e,
-	0x24, 0x1b, 0x97, 0x6b, 0xcb, 0xf7, 0xbf, 0xdf, 0x2e, 0xa2, 0xe7, 0xbe, 0xda, 0x39, 0x8c, 0xfa,
-	0x9d, 0xc0, 0xaf, 0x6b, 0x43, 0x5a, 0x84, 0x4b, 0x6d, 0x2f, 0xe0, 0xbd, 0xe5, 0x85, 0xa1, 0x47,
-	0x5d, 0x7d, 0xbc, 0xb0, 0xf4, 0xe1, 0xdd, 0x72, 0xee, 0x8f, 0xbb, 0xe5, 0x9c, 0xfd, 0x2a, 0x14,
-	0x93, 0xa9, 0x44, 0xc8, 0x03, 0xe1, 0xd1, 0x5d, 0x58, 0x6c, 0xaa, 0x25, 0x99, 0xab, 0x50, 0x5d,
-	0x71, 0x46, 0x22, 0x0b, 0x4f, 0x8b, 0xec, 0x5c, 0xe7, 0x9d, 0xa0, 0xae, 0x2d, 0xed, 0x2f, 0x08,
-	0x3c, 0x2e, 0xa3, 0xed, 0x77, 0xbb, 0x18, 0x50, 0xfc, 0x13, 0xf0, 0x2f, 0x03, 0x8c, 0x8f, 0x4a,
-	0x32, 0x28, 0x54, 0xaf, 0x26, 0x70, 0xa8, 0x2a, 0xd0, 0x68, 0x0e, 0x98, 0xaf, 0xc5, 0xaa, 0xc7,
-	0x3c, 0x63, 0x74, 0x7f, 0x24, 0xb0, 0x3c, 0x8d, 0x10, 0x39, 0xfb, 0xb0, 0x84, 0x4c, 0x86, 0x18,
-	0x1f, 0x48, 0x25, 0x5d, 0xdb, 0x39, 0xfd, 0xa5, 0x9c, 0xfb, 0xee, 0xd7, 0xf2, 0x86, 0xdf, 0x89,
-	0xde, 0x19, 0x34, 0x9d, 0x16, 0xef, 0xe1, 0x21, 0xe2, 0x9f, 0x6d, 0xd1, 0x3e, 0x72, 0xa3, 0xe3,
-	0xd0, 0x13, 0xd2, 0x41, 0xd4, 0x47, 0xc1, 0xe9, 0x2b, 0x06, 0x5e, 0xeb, 0x17, 0xf2, 0x52, 0x28,
-	0xe3, 0xc4, 0xec, 0xaf, 0x08, 0xac, 0x49, 0x3a, 0x87, 0xa1, 0x17, 0xb4, 0x59, 0xb3, 0xeb, 0xfd,
-	0x37, 0x65, 0xbf, 0x4f, 0xa0, 0x34, 0x0b, 0xe7, 0xff, 0x56, 0xfc, 0x23, 0x2c, 0xf6, 0x37, 0x78,
-	0xc4, 0xba, 0x87, 0x83, 0x30, 0xec, 0x1e, 0x6b, 0xd5, 0x93, 0x0a, 0x92, 0x39, 0x28, 0x78, 0xaa,
-	0x0b, 0x37, 0x91, 0x0d, 0xb5, 0x6b, 0x41, 0x5e, 0xc8, 0x95, 0x7f, 0x43, 0x39, 0x0c, 0x3d, 0x3f,
-	0xdd, 0xb6, 0xb0, 0xe5, 0x28, 0x12, 0x37, 0xde, 0xd6, 0xa2, 0x8d, 0x5a, 0x15, 0x89, 0xb5, 0x2a,
-	0xfb, 0x00, 0x1e, 0x9b, 0xb0, 0x46, 0xd2, 0xcf, 0x41, 0x9e, 0xf5, 0xf8, 0x20, 0x88, 0x2e, 0x6c,
-	0x50, 0xb5, 0x07, 0x87, 0xa4, 0xeb, 0x68, 0x6e, 0x17, 0x81, 0xca, 0x88, 0x07, 0xac, 0xcf, 0x7a,
-	0xfa, 0xa2, 0xd8, 0x07, 0xd8, 0x73, 0xf5, 0x2a, 0x66, 0xd9, 0x83, 0x7c, 0x28, 0x57, 0x30, 0xcb,
-	0xaa, 0x63, 0x98, 0x35, 0x8e, 0x72, 0xd2, 0x79, 0x94, 0x83, 0xdd, 0x06, 0x4b, 0x46, 0x7c, 0x69,
-	0xc8, 0x43, 0xbc, 0xe6, 0x45, 0xac, 0xcd, 0x22, 0x36, 0xe7, 0x12, 0xb1, 0xbf, 0x25, 0xb0, 0x6a,
-	0x4c, 0x83, 0x04, 0xf6, 0xe1, 0x72, 0x0f, 0xd7, 0xf4, 0xc5, 0x5a, 0x33, 0x72, 0xd0, 0x9e, 0xc8,
-	0x62, 0xec, 0x35, 0xbf, 0x93, 0xaf, 0xc0, 0xca, 0x18, 0xea, 0xa4, 0x20, 0xe6, 0xe3, 0x7f, 0x2b,
-	0x2e, 0xe2, 0x14, 0xb9, 0x17, 0x61, 0x49, 0xc3, 0x44, 0x09, 0x33, 0x71, 0x1b, 0x39, 0xd9, 0xb7,
-	0xf0, 0x0e, 0xcb, 0xf0, 0x37, 0x6e, 0x05, 0x5e, 0x5f, 0xa4, 0xe2, 0x99, 0x57, 0x6f, 0xb4, 0x4f,
-	0x00, 0xc6, 0x39, 0xff, 0x56, 0x97, 0xde, 0x1b, 0x4f, 0xe8, 0x85, 0x6c, 0x17, 0x60, 0x34, 0xa7,
-	0xbf, 0xd1, 0xcd, 0x24, 0x41, 0x1b, 0x35, 0xad, 0xc1, 0x43, 0x92, 0x6a, 0x83, 0xcb, 0x75, 0xac,
-	0x99, 0xb2, 0x51, 0xd7, 0xb1, 0x7f, 0xbd, 0xd0, 0x1e, 0xc7, 0x9a, 0x5b, 0xc5, 0x54, 0x3f, 0x2a,
-	0xc0, 0x25, 0x89, 0x94, 0x7e, 0x4a, 0x60, 0x11, 0x87, 0x06, 0xdd, 0x30, 0x82, 0x31, 0x3c, 0x99,
-	0xac, 0xcd, 0x0c, 0x96, 0x2a, 0xad, 0xfd, 0xfc, 0x07, 0x3f, 0xfd, 0xfe, 0xc9, 0x42, 0x95, 0xee,
-	0xb8, 0xe6, 0x87, 0x9b, 0x1a, 0x1f, 0xee, 0x09, 0xea, 0x7f, 0xdb, 0x6d, 0x1e, 0x37, 0x54, 0x4d,
-	0x7c, 0x46, 0xa0, 0x10, 0x7b, 0x4f, 0xd0, 0xad, 0xd9, 0x49, 0xa7, 0x1f, 0x46, 0xd6, 0x76, 0x46,
-	0x6b, 0x84, 0xe9, 0x4a, 0x98, 0x9b, 0x74, 0x3d, 0x23, 0x4c, 0xfa, 0x03, 0x81, 0x47, 0xa7, 0xc6,
-	0x2e, 0xad, 0xce, 0xce, 0x3a, 0xeb, 0x2d, 0x61, 0xed, 0xfe, 0x25, 0x1f, 0xc4, 0xbb, 0x27, 0xf1,
-	0xee, 0xd2, 0x8a, 0x11, 0xaf, 0xd0, 0x7e, 0x0d, 0x03, 0xf2, 0x3b, 0x04, 0x0a, 0xb1, 0x71, 0x97,
-	0xa6, 0xeb, 0xf4, 0x0c, 0x4e, 0xd3, 0xd5, 0x30, 0x43, 0xed, 0xa7, 0x24, 0xce, 0x35, 0xba, 0x6a,
-	0xc6, 0xa9, 0x10, 0xdc, 0x21, 0xb0, 0xa4, 0x07, 0x11, 0x4d, 0xa9, 0xad, 0x89, 0xd1, 0x66, 0x5d,
-	0xcb, 0x62, 0x8a, 0x40, 0xb6, 0x24, 0x90, 0xab, 0xf4, 0xe9, 0x14, 0x20, 0xe3, 0xda, 0x7b, 0x9f,
-	0x40, 0x5e, 0x4d, 0x1f, 0xba, 0x3e, 0x3b, 0x49, 0x62, 0xd4, 0x59, 0x1b, 0x17, 0x1b, 0x66, 0x12,
-	0x45, 0xcd, 0x39, 0xfa, 0x35, 0x81, 0x87, 0x13, 0xed, 0x99, 0x3a, 0xb3, 0x13, 0x98, 0x5a, 0xbf,
-	0xe5, 0x66, 0xb6, 0x47, 0x5c, 0xcf, 0x4a, 0x5c, 0x0e, 0xdd, 0x32, 0xe2, 0x92, 0xca, 0x88, 0x86,
-	0x6e, 0xf2, 0xee, 0x89, 0x5c, 0xb8, 0x4d, 0xbf, 0x24, 0xf0, 0x48, 0x72, 0x4a, 0xd2, 0x8b, 0x32,
-	0x4f, 0x8e, 0x6d, 0x6b, 0x27, 0xbb, 0x43, 0xa6, 0xf3, 0x9c, 0xc0, 0x4a, 0x3f, 0x27, 0x50, 0x88,
-	0x75, 0xe5, 0xb4, 0x9a, 0x9f, 0x9e, 0x59, 0x69, 0x35, 0x6f, 0x68, 0xf5, 0x76, 0x45, 0x42, 0x7b,
-	0x86, 0x6e, 0xce, 0x86, 0x86, 0x53, 0x40, 0x6b, 0x58, 0xbb, 0x7e, 0x7a, 0x56, 0x22, 0xf7, 0xce,
-	0x4a, 0xe4, 0xb7, 0xb3, 0x12, 0xf9, 0xf8, 0xbc, 0x94, 0xbb, 0x77, 0x5e, 0xca, 0xfd, 0x7c, 0x5e,
-	0xca, 0xbd, 0xb9, 0x99, 0xfa, 0xa2, 0x7c, 0x4f, 0xc5, 0x96, 0x0f, 0xcb, 0x66, 0x5e, 0xfe, 0x9b,
-	0xbb, 0xfb, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xb5, 0x9c, 0x3a, 0xd9, 0x0f, 0x00, 0x00,
+	// 1099 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0xe3, 0xc4,
+	0x17, 0xcf, 0xf4, 0xfb, 0xdd, 0xb4, 0x7d, 0x29, 0x48, 0x4c, 0x03, 0xdb, 0xba, 0x34, 0x59, 0xbc,
+	0x68, 0xdb, 0x2c, 0x8d, 0xdd, 0xa4, 0x48, 0x50, 0x2e, 0xa8, 0x29, 0xb0, 0x07, 0x84, 0xb6, 0xa4,
+	0x9c, 0x90, 0x50, 0xe4, 0xc4, 0x43, 0x88, 0x9a, 0xd8, 0xd9, 0x8c, 0xc3, 0x12, 0x55, 0x2b, 0x21,
+	0x4e, 0xdc, 0x16, 0x09, 0x21, 0x21, 0x21, 0xc4, 0x72, 0x60, 0xf9, 0x71, 0x46, 0xe2, 0x5f, 0xe8,
+	0x81, 0xc3, 0x6a, 0xb9, 0x70, 0x02, 0xd4, 0x72, 0xe0, 0xcf, 0x40, 0x9e, 0x79, 0x13, 0xdb, 0x89,
+	0x93, 0x9a, 0x12, 0x24, 0x38, 0x35, 0x9e, 0x79, 0x3f, 0x3e, 0x9f, 0xcf, 0x3c, 0xcf, 0x7b, 0x2e,
+	0xe4, 0x1b, 0x2e, 0xef, 0xb8, 0xdc, 0xac, 0x5b, 0xce, 0x91, 0xf9, 0x6e, 0xa9, 0xce, 0x3c, 0xab,
+	0x64, 0xde, 0xea, 0xb3, 0xde, 0xc0, 0xe8, 0xf6, 0x5c, 0xcf, 0xa5, 0xcb, 0xd2, 0xc0, 0xf0, 0x0d,
+	0x0c, 0x34, 0xd0, 0xae, 0x0f, 0xbd, 0x38, 0x93, 0xd6, 0x43, 0xdf, 0xae, 0xd5, 0x6c, 0x39, 0x96,
+	0xd7, 0x72, 0x1d, 0x19, 0x40, 0xcb, 0x36, 0xdd, 0xa6, 0x2b, 0x7e, 0x9a, 0xfe, 0x2f, 0x5c, 0x7d,
+	0xb2, 0xe9, 0xba, 0xcd, 0x36, 0x33, 0xad, 0x6e, 0xcb, 0xb4, 0x1c, 0xc7, 0xf5, 0x84, 0x0b, 0xc7,
+	0xdd, 0x5c, 0x38, 0xbe, 0x8a, 0xdc, 0x70, 0x5b, 0xce, 0xd8, 0x7e, 0x08, 0xb5, 0xff, 0x80, 0xfb,
+	0xab, 0x72, 0xbf, 0x26, 0xd3, 0xca, 0x07, 0xb9, 0xa5, 0xb7, 0x60, 0xf9, 0x75, 0x1f, 0x70, 0xc5,
+	0x6a, 0x5b, 0x4e, 0x83, 0x55, 0xd9, 0xad, 0x3e, 0xe3, 0x1e, 0x2d, 0xc3, 0xbc, 0x65, 0xdb, 0x3d,
+	0xc6, 0xf9, 0x0a, 0xb9, 0x42, 0x36, 0x17, 0x2b, 0x2b, 0x0f, 0xbf, 0x2f, 0x66, 0xd1, 0x73, 0x4f,
+	0xee, 0x1c, 0x7a, 0xbd, 0x96, 0xd3, 0xac, 0x2a, 0x43, 0x9a, 0x85, 0x4b, 0x36, 0x73, 0xdc, 0xce,
+	0xca, 0x9c, 0xef, 0x51, 0x95, 0x0f, 0x2f, 0x2c, 0x7c, 0x78, 0x2f, 0x9f, 0xfa, 0xe3, 0x5e, 0x3e,
+	0xa5, 0xbf, 0x0a, 0xd9, 0x68, 0x2a, 0xde, 0x75, 0x1d, 0xce, 0xe8, 0x0e, 0xcc, 0xd7, 0xe5, 0x92,
+	0xc8, 0x95, 0x29, 0xaf, 0x1a, 0x43, 0x91, 0x39, 0x53, 0x22, 0x1b, 0xfb, 0x6e, 0xcb, 0xa9, 0x2a,
+	0x4b, 0xfd, 0x0b, 0x02, 0x97, 0x45, 0xb4, 0xbd, 0x76, 0x1b, 0x03, 0xf2, 0xbf, 0x03, 0xfe, 0x15,
+	0x80, 0xe0, 0xa8, 0x04, 0x83, 0x4c, 0xf9, 0x5a, 0x04, 0x87, 0xac, 0x02, 0x85, 0xe6, 0xc0, 0x6a,
+	0x2a, 0xb1, 0xaa, 0x21, 0xcf, 0x10, 0xdd, 0x1f, 0x09, 0xac, 0x8c, 0x23, 0x44, 0xce, 0x4d, 0x58,
+	0x40, 0x26, 0x3e, 0xc6, 0xff, 0x4d, 0x25, 0x5d, 0xd9, 0x3e, 0xf9, 0x25, 0x9f, 0xfa, 0xee, 0xd7,
+	0xfc, 0x66, 0xb3, 0xe5, 0xbd, 0xd3, 0xaf, 0x1b, 0x0d, 0xb7, 0x83, 0x87, 0x88, 0x7f, 0x8a, 0xdc,
+	0x3e, 0x32, 0xbd, 0x41, 0x97, 0x71, 0xe1, 0xc0, 0xab, 0xc3, 0xe0, 0xf4, 0x46, 0x0c, 0xaf, 0x8d,
+	0x73, 0x79, 0x49, 0x94, 0x61, 0x62, 0xfa, 0x57, 0x04, 0xd6, 0x05, 0x9d, 0xc3, 0x2e, 0x73, 0x6c,
+	0xab, 0xde, 0x66, 0xff, 0x4e, 0xd9, 0x1f, 0x12, 0xc8, 0x4d, 0xc2, 0xf9, 0x9f, 0x15, 0xff, 0x08,
+	0x8b, 0xfd, 0x0d, 0xd7, 0xb3, 0xda, 0x87, 0xfd, 0x6e, 0xb7, 0x3d, 0x50, 0xaa, 0x47, 0x15, 0x24,
+	0x33, 0x50, 0xf0, 0x44, 0x15, 0x6e, 0x24, 0x1b, 0x6a, 0xd7, 0x80, 0x34, 0x17, 0x2b, 0xff, 0x84,
+	0x72, 0x18, 0x7a, 0x76, 0xba, 0x6d, 0xe1, 0x95, 0x23, 0x49, 0xdc, 0x7c, 0x5b, 0x89, 0x36, 0xbc,
+	0xaa, 0x48, 0xe8, 0xaa, 0xd2, 0x0f, 0xe0, 0xf1, 0x11, 0x6b, 0x24, 0xfd, 0x1c, 0xa4, 0xad, 0x8e,
+	0xdb, 0x77, 0xbc, 0x73, 0x2f, 0xa8, 0xca, 0xff, 0x7d, 0xd2, 0x55, 0x34, 0xd7, 0xb3, 0x40, 0x45,
+	0xc4, 0x03, 0xab, 0x67, 0x75, 0xd4, 0x8b, 0xa2, 0x1f, 0xc0, 0x72, 0x64, 0x15, 0xb3, 0xec, 0x42,
+	0xba, 0x2b, 0x56, 0x30, 0xcb, 0x9a, 0x11, 0xd3, 0x6b, 0x0c, 0xe9, 0xa4, 0xf2, 0x48, 0x07, 0xdd,
+	0x06, 0x4d, 0x44, 0x7c, 0xc9, 0xe7, 0xc1, 0x5f, 0x63, 0x9e, 0x65, 0x5b, 0x9e, 0x35, 0xe3, 0x12,
+	0xd1, 0xbf, 0x25, 0xb0, 0x16, 0x9b, 0x06, 0x09, 0xec, 0xc1, 0x62, 0x07, 0xd7, 0xd4, 0x8b, 0xb5,
+	0x1e, 0xcb, 0x41, 0x79, 0x22, 0x8b, 0xc0, 0x6b, 0x76, 0x27, 0x5f, 0x82, 0xd5, 0x00, 0xea, 0xa8,
+	0x20, 0xf1, 0xc7, 0xff, 0x16, 0x68, 0x71, 0x2e, 0x48, 0xee, 0x45, 0x58, 0x50, 0x30, 0x51, 0xc2,
+	0x44, 0xdc, 0x86, 0x4e, 0xfa, 0x6d, 0xb8, 0x1c, 0x84, 0xbf, 0x79, 0xdb, 0x61, 0x3d, 0x3e, 0x15,
+	0xcf, 0xac, 0xee, 0x46, 0xfd, 0x18, 0x20, 0xc8, 0x79, 0xa1, 0x5b, 0x7a, 0x37, 0xe8, 0xd0, 0x73,
+	0xc9, 0x5e, 0x80, 0x61, 0x9f, 0xfe, 0x5a, 0x5d, 0x26, 0x11, 0xda, 0xa8, 0x69, 0x05, 0x96, 0x04,
+	0xd5, 0x9a, 0x2b, 0xd6, 0xb1, 0x66, 0xf2, 0xb1, 0xba, 0x06, 0xfe, 0xd5, 0x8c, 0x1d, 0xc4, 0x9a,
+	0x5d, 0xc5, 0x0c, 0xf0, 0x7c, 0x0e, 0x99, 0x63, 0xbf, 0xec, 0xf8, 0x8d, 0xc3, 0x56, 0xe7, 0xf3,
+	0x04, 0xa4, 0x45, 0x4a, 0x89, 0x70, 0xb1, 0x8a, 0x4f, 0x23, 0x27, 0xd4, 0xb8, 0xf0, 0x09, 0x7d,
+	0xa3, 0x44, 0x8a, 0xe4, 0x46, 0x91, 0xf6, 0x61, 0x89, 0x33, 0xc7, 0xae, 0x31, 0xb9, 0x8e, 0x22,
+	0x5d, 0x89, 0x15, 0x29, 0xec, 0x9f, 0xe1, 0xc1, 0x03, 0xbd, 0x11, 0x83, 0xf4, 0x22, 0x2a, 0x95,
+	0xef, 0x2f, 0xc1, 0x25, 0x01, 0x95, 0x7e, 0x4a, 0x60, 0x1e, 0x5b, 0x2b, 0xdd, 0x8c, 0x45, 0x13,
+	0x33, 0x58, 0x6a, 0x85, 0x04, 0x96, 0x32, 0xad, 0xfe, 0xfc, 0x07, 0x3f, 0xfd, 0xfe, 0xf1, 0x5c,
+	0x99, 0x6e, 0x9b, 0xf1, 0xe3, 0xad, 0xb0, 0xe6, 0xe6, 0x31, 0x56, 0xe9, 0x1d, 0xb3, 0x3e, 0xa8,
+	0xc9, 0x37, 0xe7, 0x33, 0x02, 0x99, 0xd0, 0xd4, 0x45, 0xb7, 0x26, 0x27, 0x1d, 0x1f, 0x1f, 0xb5,
+	0x62, 0x42, 0x6b, 0x84, 0x69, 0x0a, 0x98, 0x05, 0xba, 0x91, 0x10, 0x26, 0xfd, 0x81, 0xc0, 0x63,
+	0x63, 0xc3, 0x09, 0x2d, 0x4f, 0xce, 0x3a, 0x69, 0xe2, 0xd2, 0x76, 0xfe, 0x92, 0x0f, 0xe2, 0xdd,
+	0x15, 0x78, 0x77, 0x68, 0x29, 0x16, 0x2f, 0x57, 0x7e, 0xb5, 0x18, 0xe4, 0x77, 0x09, 0x64, 0x42,
+	0x43, 0xc1, 0x34, 0x5d, 0xc7, 0x27, 0x15, 0xad, 0x98, 0xd0, 0x1a, 0x71, 0x5e, 0x15, 0x38, 0xd7,
+	0xe9, 0x5a, 0x3c, 0x4e, 0x89, 0xe0, 0x2e, 0x81, 0x05, 0xd5, 0xae, 0xe9, 0x94, 0xda, 0x1a, 0x19,
+	0x00, 0xb4, 0xeb, 0x49, 0x4c, 0x11, 0xc8, 0x96, 0x00, 0x72, 0x8d, 0x3e, 0x3d, 0x05, 0x48, 0x50,
+	0x7b, 0xef, 0x13, 0x48, 0xcb, 0x1e, 0x4d, 0x37, 0x26, 0x27, 0x89, 0x0c, 0x04, 0xda, 0xe6, 0xf9,
+	0x86, 0x89, 0x44, 0x91, 0xd3, 0x00, 0xbd, 0x4f, 0xe0, 0x91, 0x48, 0x13, 0xa3, 0xc6, 0xe4, 0x04,
+	0x71, 0x0d, 0x52, 0x33, 0x13, 0xdb, 0x23, 0xae, 0x67, 0x05, 0x2e, 0x83, 0x6e, 0xc5, 0xe2, 0x92,
+	0xd7, 0x65, 0x4d, 0xb5, 0x42, 0xf3, 0x58, 0x2c, 0xdc, 0xa1, 0x5f, 0x12, 0x78, 0x34, 0x3a, 0x4b,
+	0xd0, 0xf3, 0x32, 0x8f, 0x0e, 0x37, 0xda, 0x76, 0x72, 0x87, 0x44, 0xe7, 0x39, 0x82, 0x95, 0x7e,
+	0x4e, 0x20, 0x13, 0xea, 0x5d, 0xd3, 0x6a, 0x7e, 0xbc, 0xb3, 0x6b, 0xc5, 0x84, 0xd6, 0x08, 0xad,
+	0x24, 0xa0, 0x3d, 0x43, 0x0b, 0x93, 0xa1, 0x61, 0xaf, 0x1c, 0x6a, 0xf8, 0x09, 0x81, 0x4c, 0xe8,
+	0xda, 0x9f, 0x86, 0x6f, 0xbc, 0xb3, 0x69, 0xc5, 0x84, 0xd6, 0x88, 0xaf, 0x20, 0xf0, 0x5d, 0xa5,
+	0x4f, 0xc5, 0xbf, 0x0a, 0xa1, 0x36, 0x55, 0xd9, 0x3f, 0x39, 0xcd, 0x91, 0x07, 0xa7, 0x39, 0xf2,
+	0xdb, 0x69, 0x8e, 0x7c, 0x74, 0x96, 0x4b, 0x3d, 0x38, 0xcb, 0xa5, 0x7e, 0x3e, 0xcb, 0xa5, 0xde,
+	0x2c, 0x4c, 0xfd, 0x1e, 0x78, 0x4f, 0xc6, 0x14, 0x9f, 0x05, 0xf5, 0xb4, 0xf8, 0x27, 0xc5, 0xce,
+	0x9f, 0x03, 0x00, 0x83, 0xa6, 0x47, 0x80, 0x97, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1088,6 +1207,12 @@ type QueryClient interface {
 	//
 	// Since: cosmos-sdk 0.46
 	DenomOwners(ctx context.Context, in *QueryDenomOwnersRequest, opts ...grpc.CallOption) (*QueryDenomOwnersResponse, error)
+	// SendEnabled queries for SendEnabled entries.
+	//
+	// This query only returns denominations that have specific SendEnabled settings.
+	// Any denomination that does not have a specific setting will use the default
+	// params.default_send_enabled, and will not be returned by this query.
+	SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error)
 }
 
 type queryClient struct {
@@ -1179,6 +1304,15 @@ func (c *queryClient) DenomOwners(ctx context.Context, in *QueryDenomOwnersReque
 	return out, nil
 }
 
+func (c *queryClient) SendEnabled(ctx context.Context, in *QuerySendEnabledRequest, opts ...grpc.CallOption) (*QuerySendEnabledResponse, error) {
+	out := new(QuerySendEnabledResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Query/SendEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the balance of a single coin for a single account.
@@ -1206,6 +1340,12 @@ type QueryServer interface {
 	//
 	// Since: cosmos-sdk 0.46
 	DenomOwners(context.Context, *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error)
+	// SendEnabled queries for SendEnabled entries.
+	//
+	// This query only returns denominations that have specific SendEnabled settings.
+	// Any denomination that does not have a specific setting will use the default
+	// params.default_send_enabled, and will not be returned by this query.
+	SendEnabled(context.Context, *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1239,6 +1379,9 @@ func (*UnimplementedQueryServer) DenomsMetadata(ctx context.Context, req *QueryD
 func (*UnimplementedQueryServer) DenomOwners(ctx context.Context, req *QueryDenomOwnersRequest) (*QueryDenomOwnersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomOwners not implemented")
 }
+func (*UnimplementedQueryServer) SendEnabled(ctx context.Context, req *QuerySendEnabledRequest) (*QuerySendEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEnabled not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1406,6 +1549,24 @@ func _Query_DenomOwners_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SendEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySendEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SendEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Query/SendEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SendEnabled(ctx, req.(*QuerySendEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1446,6 +1607,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomOwners",
 			Handler:    _Query_DenomOwners_Handler,
 		},
+		{
+			MethodName: "SendEnabled",
+			Handler:    _Query_SendEnabled_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/query.proto",
@@ -2186,6 +2351,103 @@ func (m *QueryDenomOwnersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QuerySendEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySendEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySendEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2482,6 +2744,44 @@ func (m *QueryDenomOwnersResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySendEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySendEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4392,6 +4692,244 @@ func (m *QueryDenomOwnersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySendEnabledRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySendEnabledRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySendEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySendEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySendEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SendRestrictionFn can restrict sends and/or provide a new receiver address.
+// It returns an error to reject the send, or the address the coins must be
+// sent to, which is toAddr unless the send is rerouted.
+type SendRestrictionFn func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (newToAddr sdk.AccAddress, err error)
+
+var _ SendRestrictionFn = NoOpSendRestrictionFn
+
+// NoOpSendRestrictionFn is a no-op SendRestrictionFn.
+func NoOpSendRestrictionFn(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	return toAddr, nil
+}
+
+// Then creates a composite restriction that runs this one then the provided
+// second one, passing it the address returned by this one. A nil restriction
+// is skipped.
+func (r SendRestrictionFn) Then(second SendRestrictionFn) SendRestrictionFn {
+	if r == nil {
+		return second
+	}
+	if second == nil {
+		return r
+	}
+	return func(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		newToAddr, err := r(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return newToAddr, err
+		}
+		return second(ctx, fromAddr, newToAddr, amt)
+	}
+}
+
+// ComposeSendRestrictions combines multiple send restrictions into one, run in
+// the order provided. Nil entries are ignored. It returns nil if there is no
+// restriction left.
+func ComposeSendRestrictions(restrictions ...SendRestrictionFn) SendRestrictionFn {
+	var composite SendRestrictionFn
+	for _, r := range restrictions {
+		composite = composite.Then(r)
+	}
+	return composite
+}
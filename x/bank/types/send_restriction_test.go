@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// recordingRestriction returns a SendRestrictionFn which appends its name to
+// calls, and rewrites the destination to newToAddr if it is not nil.
+func recordingRestriction(name string, calls *[]string, newToAddr sdk.AccAddress) types.SendRestrictionFn {
+	return func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		*calls = append(*calls, name+":"+string(toAddr))
+		if newToAddr != nil {
+			return newToAddr, nil
+		}
+		return toAddr, nil
+	}
+}
+
+func TestSendRestrictionFnThen(t *testing.T) {
+	fromAddr := sdk.AccAddress("from")
+	toAddr := sdk.AccAddress("to")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	ctx := sdk.Context{}
+
+	var calls []string
+	first := recordingRestriction("first", &calls, sdk.AccAddress("escrow"))
+	second := recordingRestriction("second", &calls, nil)
+
+	// the second restriction gets the address returned by the first one
+	newToAddr, err := first.Then(second)(ctx, fromAddr, toAddr, coins)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress("escrow"), newToAddr)
+	require.Equal(t, []string{"first:to", "second:escrow"}, calls)
+
+	// nil restrictions are skipped
+	calls = nil
+	newToAddr, err = types.SendRestrictionFn(nil).Then(second)(ctx, fromAddr, toAddr, coins)
+	require.NoError(t, err)
+	require.Equal(t, toAddr, newToAddr)
+	require.Equal(t, []string{"second:to"}, calls)
+	require.NotNil(t, first.Then(nil))
+	require.Nil(t, types.SendRestrictionFn(nil).Then(nil))
+
+	// an error stops the chain
+	calls = nil
+	reject := func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return toAddr, errors.New("rejected")
+	}
+	_, err = types.SendRestrictionFn(reject).Then(second)(ctx, fromAddr, toAddr, coins)
+	require.EqualError(t, err, "rejected")
+	require.Empty(t, calls)
+}
+
+func TestComposeSendRestrictions(t *testing.T) {
+	fromAddr := sdk.AccAddress("from")
+	toAddr := sdk.AccAddress("to")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	var calls []string
+	composite := types.ComposeSendRestrictions(
+		recordingRestriction("a", &calls, nil),
+		nil,
+		recordingRestriction("b", &calls, sdk.AccAddress("b")),
+		recordingRestriction("c", &calls, nil),
+	)
+	newToAddr, err := composite(sdk.Context{}, fromAddr, toAddr, coins)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress("b"), newToAddr)
+	require.Equal(t, []string{"a:to", "b:to", "c:b"}, calls)
+
+	newToAddr, err = types.NoOpSendRestrictionFn(sdk.Context{}, fromAddr, toAddr, coins)
+	require.NoError(t, err)
+	require.Equal(t, toAddr, newToAddr)
+}
@@ -199,46 +199,163 @@ func (m *MsgMultiSendResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMultiSendResponse proto.InternalMessageInfo
 
+// MsgSetSendEnabled is the Msg/SetSendEnabled request type.
+//
+// Only entries to add/update/delete need to be included.
+// Existing SendEnabled entries that are not included in this
+// message are left unchanged.
+type MsgSetSendEnabled struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// send_enabled is the list of entries to add or update.
+	SendEnabled []*SendEnabled `protobuf:"bytes,2,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// use_default_for is a list of denoms that should use the params.default_send_enabled value.
+	// Denoms listed here will have their SendEnabled entries deleted.
+	// If a denom is included that doesn't have a SendEnabled entry,
+	// it will be ignored.
+	UseDefaultFor []string `protobuf:"bytes,3,rep,name=use_default_for,json=useDefaultFor,proto3" json:"use_default_for,omitempty"`
+}
+
+func (m *MsgSetSendEnabled) Reset()         { *m = MsgSetSendEnabled{} }
+func (m *MsgSetSendEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSendEnabled) ProtoMessage()    {}
+func (*MsgSetSendEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{4}
+}
+func (m *MsgSetSendEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSendEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSendEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSendEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSendEnabled.Merge(m, src)
+}
+func (m *MsgSetSendEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSendEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSendEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSendEnabled proto.InternalMessageInfo
+
+func (m *MsgSetSendEnabled) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSendEnabled) GetSendEnabled() []*SendEnabled {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return nil
+}
+
+func (m *MsgSetSendEnabled) GetUseDefaultFor() []string {
+	if m != nil {
+		return m.UseDefaultFor
+	}
+	return nil
+}
+
+// MsgSetSendEnabledResponse defines the Msg/SetSendEnabled response type.
+type MsgSetSendEnabledResponse struct {
+}
+
+func (m *MsgSetSendEnabledResponse) Reset()         { *m = MsgSetSendEnabledResponse{} }
+func (m *MsgSetSendEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSendEnabledResponse) ProtoMessage()    {}
+func (*MsgSetSendEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8cb1613481f5b7, []int{5}
+}
+func (m *MsgSetSendEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSendEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSendEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSendEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSendEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetSendEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSendEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSendEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSendEnabledResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSend)(nil), "cosmos.bank.v1beta1.MsgSend")
 	proto.RegisterType((*MsgSendResponse)(nil), "cosmos.bank.v1beta1.MsgSendResponse")
 	proto.RegisterType((*MsgMultiSend)(nil), "cosmos.bank.v1beta1.MsgMultiSend")
 	proto.RegisterType((*MsgMultiSendResponse)(nil), "cosmos.bank.v1beta1.MsgMultiSendResponse")
+	proto.RegisterType((*MsgSetSendEnabled)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabled")
+	proto.RegisterType((*MsgSetSendEnabledResponse)(nil), "cosmos.bank.v1beta1.MsgSetSendEnabledResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/tx.proto", fileDescriptor_1d8cb1613481f5b7) }
 
 var fileDescriptor_1d8cb1613481f5b7 = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xf6, 0x35, 0x55, 0xaa, 0x5c, 0x2b, 0x55, 0x98, 0x08, 0x12, 0x53, 0x39, 0xc5, 0x62, 0x48,
-	0x91, 0x7a, 0x26, 0x65, 0x00, 0xb5, 0x13, 0xee, 0x04, 0x92, 0x85, 0xe4, 0x4e, 0xb0, 0x54, 0xfe,
-	0x38, 0x0e, 0xab, 0xf8, 0xce, 0xf2, 0x9d, 0xab, 0xb2, 0x32, 0x31, 0x32, 0x31, 0x77, 0x46, 0x0c,
-	0x0c, 0xfc, 0x88, 0x8e, 0x15, 0x13, 0x13, 0xa0, 0x64, 0x80, 0x7f, 0x01, 0xba, 0x0f, 0x9b, 0x22,
-	0xd2, 0x66, 0x3a, 0xcb, 0xcf, 0xc7, 0x3d, 0xef, 0xf3, 0x1e, 0xdc, 0x48, 0x19, 0x2f, 0x18, 0xf7,
-	0x93, 0x98, 0x1e, 0xf9, 0xc7, 0x93, 0x04, 0x8b, 0x78, 0xe2, 0x8b, 0x13, 0x54, 0x56, 0x4c, 0x30,
-	0xfb, 0xba, 0x46, 0x91, 0x44, 0x91, 0x41, 0x9d, 0x3e, 0x61, 0x84, 0x29, 0xdc, 0x97, 0x5f, 0x9a,
-	0xea, 0xb8, 0xad, 0x11, 0xc7, 0xad, 0x51, 0xca, 0x72, 0xfa, 0x1f, 0x7e, 0xe1, 0x22, 0xe5, 0xab,
-	0xf1, 0xa1, 0xc6, 0x0f, 0xb5, 0xb1, 0xb9, 0x57, 0x43, 0x37, 0x8d, 0xb4, 0xe0, 0xc4, 0x3f, 0x9e,
-	0xc8, 0x43, 0x03, 0xde, 0x6f, 0x00, 0x57, 0x42, 0x4e, 0x0e, 0x30, 0xcd, 0xec, 0x3d, 0xb8, 0xf6,
-	0xa2, 0x62, 0xc5, 0x61, 0x9c, 0x65, 0x15, 0xe6, 0x7c, 0x00, 0x36, 0xc1, 0xb8, 0x17, 0x0c, 0xbe,
-	0x7c, 0xde, 0xee, 0x1b, 0xb3, 0x47, 0x1a, 0x39, 0x10, 0x55, 0x4e, 0x49, 0xb4, 0x2a, 0xd9, 0xe6,
-	0x97, 0xfd, 0x00, 0x42, 0xc1, 0x5a, 0xe9, 0xd2, 0x02, 0x69, 0x4f, 0xb0, 0x46, 0x98, 0xc2, 0x6e,
-	0x5c, 0xb0, 0x9a, 0x8a, 0x41, 0x67, 0xb3, 0x33, 0x5e, 0xdd, 0x19, 0xa2, 0xb6, 0x31, 0x8e, 0x9b,
-	0xc6, 0xd0, 0x3e, 0xcb, 0x69, 0x70, 0xef, 0xec, 0xdb, 0xc8, 0xfa, 0xf0, 0x7d, 0x34, 0x26, 0xb9,
-	0x78, 0x59, 0x27, 0x28, 0x65, 0x85, 0x19, 0xd3, 0x1c, 0xdb, 0x3c, 0x3b, 0xf2, 0xc5, 0xeb, 0x12,
-	0x73, 0x25, 0xe0, 0x91, 0xb1, 0xde, 0x1d, 0xbe, 0x3d, 0x1d, 0x59, 0xbf, 0x4e, 0x47, 0xd6, 0x9b,
-	0x9f, 0x9f, 0xee, 0xfe, 0x33, 0xa5, 0x77, 0x0d, 0xae, 0x9b, 0x02, 0x22, 0xcc, 0x4b, 0x46, 0x39,
-	0xf6, 0xde, 0x03, 0xb8, 0x16, 0x72, 0x12, 0xd6, 0xaf, 0x44, 0xae, 0x9a, 0x79, 0x08, 0xbb, 0x39,
-	0x2d, 0x6b, 0x21, 0x3b, 0x91, 0x19, 0x1d, 0x34, 0x67, 0xab, 0xe8, 0xb1, 0xa4, 0x04, 0xcb, 0x32,
-	0x64, 0x64, 0xf8, 0xf6, 0x1e, 0x5c, 0x61, 0xb5, 0x50, 0xd2, 0x25, 0x25, 0xbd, 0x35, 0x57, 0xfa,
-	0x54, 0x71, 0x8c, 0xb6, 0x51, 0xec, 0xae, 0x37, 0x89, 0x8d, 0x9b, 0x77, 0x03, 0xf6, 0x2f, 0xe6,
-	0x6a, 0x02, 0xef, 0x7c, 0x04, 0xb0, 0x13, 0x72, 0x62, 0x3f, 0x81, 0xcb, 0x2a, 0xef, 0xc6, 0xdc,
-	0x4b, 0xcc, 0x98, 0xce, 0x9d, 0xab, 0xd0, 0xc6, 0xd3, 0x7e, 0x06, 0x7b, 0x7f, 0x0b, 0xb8, 0x7d,
-	0x99, 0xa4, 0xa5, 0x38, 0x5b, 0x0b, 0x29, 0x8d, 0x75, 0xb0, 0x7f, 0x36, 0x75, 0xc1, 0xf9, 0xd4,
-	0x05, 0x3f, 0xa6, 0x2e, 0x78, 0x37, 0x73, 0xad, 0xf3, 0x99, 0x6b, 0x7d, 0x9d, 0xb9, 0xd6, 0xf3,
-	0xad, 0x2b, 0x37, 0x7b, 0xa2, 0x9f, 0xbe, 0x5a, 0x70, 0xd2, 0x55, 0x0f, 0xf8, 0xfe, 0x9f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xa7, 0xd3, 0x87, 0x2b, 0x7f, 0x03, 0x00, 0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xb7, 0x93, 0x2a, 0x55, 0x5e, 0x42, 0xa3, 0x9a, 0x08, 0x12, 0xb7, 0x72, 0x42, 0x84, 0xaa,
+	0x14, 0xa9, 0x36, 0x29, 0x12, 0xa0, 0x74, 0x22, 0x01, 0x24, 0x90, 0x22, 0x24, 0x77, 0x82, 0x25,
+	0x72, 0xe2, 0x8b, 0x63, 0x35, 0xf1, 0x45, 0xbe, 0x73, 0xd5, 0xae, 0x4c, 0x8c, 0x4c, 0x9d, 0x3b,
+	0x33, 0x31, 0xf0, 0x21, 0x32, 0x56, 0x4c, 0x4c, 0x80, 0x92, 0x01, 0xbe, 0x05, 0xc8, 0x77, 0x67,
+	0xc7, 0xd0, 0xb4, 0xe9, 0x64, 0xeb, 0x7e, 0x7f, 0xde, 0xef, 0xbd, 0xa7, 0x3b, 0xd8, 0xee, 0x63,
+	0x32, 0xc6, 0xc4, 0xe8, 0x59, 0xde, 0x91, 0x71, 0xdc, 0xe8, 0x21, 0x6a, 0x35, 0x0c, 0x7a, 0xa2,
+	0x4f, 0x7c, 0x4c, 0xb1, 0x72, 0x9b, 0xa3, 0x7a, 0x88, 0xea, 0x02, 0x55, 0x8b, 0x0e, 0x76, 0x30,
+	0xc3, 0x8d, 0xf0, 0x8f, 0x53, 0x55, 0x2d, 0x36, 0x22, 0x28, 0x36, 0xea, 0x63, 0xd7, 0xbb, 0x84,
+	0x27, 0x0a, 0x31, 0x5f, 0x8e, 0x97, 0x39, 0xde, 0xe5, 0xc6, 0xa2, 0x2e, 0x87, 0xee, 0x0a, 0xe9,
+	0x98, 0x38, 0xc6, 0x71, 0x23, 0xfc, 0x70, 0xa0, 0xf6, 0x47, 0x86, 0xf5, 0x0e, 0x71, 0x0e, 0x91,
+	0x67, 0x2b, 0x07, 0x90, 0x1f, 0xf8, 0x78, 0xdc, 0xb5, 0x6c, 0xdb, 0x47, 0x84, 0x94, 0xe4, 0xaa,
+	0x5c, 0xcf, 0xb6, 0x4a, 0x5f, 0xbf, 0xec, 0x15, 0x85, 0xd9, 0x33, 0x8e, 0x1c, 0x52, 0xdf, 0xf5,
+	0x1c, 0x33, 0x17, 0xb2, 0xc5, 0x91, 0xf2, 0x04, 0x80, 0xe2, 0x58, 0x9a, 0x5a, 0x21, 0xcd, 0x52,
+	0x1c, 0x09, 0xfb, 0x90, 0xb1, 0xc6, 0x38, 0xf0, 0x68, 0x29, 0x5d, 0x4d, 0xd7, 0x73, 0xfb, 0x65,
+	0x3d, 0x9e, 0x18, 0x41, 0xd1, 0xc4, 0xf4, 0x36, 0x76, 0xbd, 0xd6, 0xc3, 0xe9, 0xf7, 0x8a, 0xf4,
+	0xe9, 0x47, 0xa5, 0xee, 0xb8, 0x74, 0x18, 0xf4, 0xf4, 0x3e, 0x1e, 0x8b, 0x36, 0xc5, 0x67, 0x8f,
+	0xd8, 0x47, 0x06, 0x3d, 0x9d, 0x20, 0xc2, 0x04, 0xc4, 0x14, 0xd6, 0xcd, 0xf2, 0x87, 0xf3, 0x8a,
+	0xf4, 0xfb, 0xbc, 0x22, 0xbd, 0xff, 0xf5, 0xf9, 0xc1, 0x3f, 0x5d, 0xd6, 0x36, 0xa1, 0x20, 0x06,
+	0x60, 0x22, 0x32, 0xc1, 0x1e, 0x41, 0xb5, 0x33, 0x19, 0xf2, 0x1d, 0xe2, 0x74, 0x82, 0x11, 0x75,
+	0xd9, 0x64, 0x9e, 0x42, 0xc6, 0xf5, 0x26, 0x01, 0x0d, 0x67, 0x12, 0x66, 0x54, 0xf5, 0x25, 0x5b,
+	0xd5, 0x5f, 0x85, 0x94, 0xd6, 0x5a, 0x18, 0xd2, 0x14, 0x7c, 0xe5, 0x00, 0xd6, 0x71, 0x40, 0x99,
+	0x34, 0xc5, 0xa4, 0x5b, 0x4b, 0xa5, 0x6f, 0x02, 0xba, 0xd0, 0x46, 0x8a, 0x66, 0x21, 0x4a, 0x2c,
+	0xdc, 0x6a, 0x77, 0xa0, 0x98, 0xcc, 0x15, 0x07, 0x9e, 0xca, 0xb0, 0xc9, 0x9a, 0xa0, 0xe1, 0xf1,
+	0x0b, 0xcf, 0xea, 0x8d, 0x90, 0xad, 0x3c, 0x86, 0xac, 0x15, 0xd0, 0x21, 0xf6, 0x5d, 0x7a, 0xba,
+	0x72, 0x99, 0x0b, 0xaa, 0xd2, 0x86, 0x3c, 0x41, 0x9e, 0xdd, 0x45, 0xdc, 0x47, 0x04, 0xaf, 0x2e,
+	0x0d, 0x9e, 0xa8, 0x67, 0xe6, 0x48, 0xa2, 0xf8, 0x0e, 0x14, 0x02, 0x82, 0xba, 0x36, 0x1a, 0x58,
+	0xc1, 0x88, 0x76, 0x07, 0xd8, 0x67, 0xfb, 0xcd, 0x9a, 0xb7, 0x02, 0x82, 0x9e, 0xf3, 0xd3, 0x97,
+	0xd8, 0x6f, 0x6e, 0x84, 0xfd, 0x2d, 0x8a, 0xd7, 0xb6, 0xa0, 0x7c, 0xa9, 0x93, 0xa8, 0xcf, 0xfd,
+	0xb3, 0x14, 0xa4, 0x3b, 0xc4, 0x51, 0x5e, 0xc3, 0x1a, 0xdb, 0xcb, 0xf6, 0xd2, 0x4c, 0x62, 0x9d,
+	0xea, 0xfd, 0xeb, 0xd0, 0xc8, 0x53, 0x79, 0x0b, 0xd9, 0xc5, 0xa2, 0xef, 0x5d, 0x25, 0x89, 0x29,
+	0xea, 0xee, 0x4a, 0x4a, 0x6c, 0x3d, 0x84, 0x8d, 0xff, 0x56, 0xb2, 0x73, 0x75, 0xa4, 0x24, 0x4f,
+	0xd5, 0x6f, 0xc6, 0x8b, 0x2a, 0xb5, 0xda, 0xd3, 0x99, 0x26, 0x5f, 0xcc, 0x34, 0xf9, 0xe7, 0x4c,
+	0x93, 0x3f, 0xce, 0x35, 0xe9, 0x62, 0xae, 0x49, 0xdf, 0xe6, 0x9a, 0xf4, 0x6e, 0xf7, 0xda, 0xbb,
+	0x72, 0xc2, 0x1f, 0x13, 0x76, 0x65, 0x7a, 0x19, 0xf6, 0x24, 0x3c, 0xfa, 0x3b, 0x00, 0xf9, 0xa6,
+	0xa2, 0xc4, 0xd1, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -257,6 +374,11 @@ type MsgClient interface {
 	Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error)
 	// MultiSend defines a method for sending coins from some accounts to other accounts.
 	MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grpc.CallOption) (*MsgMultiSendResponse, error)
+	// SetSendEnabled is a governance operation for setting the SendEnabled flag
+	// on any number of Denoms. Only the entries to add or update should be
+	// included. Entries that already exist in the store, but that aren't
+	// included in this message, will be left unchanged.
+	SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error)
 }
 
 type msgClient struct {
@@ -285,12 +407,26 @@ func (c *msgClient) MultiSend(ctx context.Context, in *MsgMultiSend, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) SetSendEnabled(ctx context.Context, in *MsgSetSendEnabled, opts ...grpc.CallOption) (*MsgSetSendEnabledResponse, error) {
+	out := new(MsgSetSendEnabledResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.bank.v1beta1.Msg/SetSendEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Send defines a method for sending coins from one account to another account.
 	Send(context.Context, *MsgSend) (*MsgSendResponse, error)
 	// MultiSend defines a method for sending coins from some accounts to other accounts.
 	MultiSend(context.Context, *MsgMultiSend) (*MsgMultiSendResponse, error)
+	// SetSendEnabled is a governance operation for setting the SendEnabled flag
+	// on any number of Denoms. Only the entries to add or update should be
+	// included. Entries that already exist in the store, but that aren't
+	// included in this message, will be left unchanged.
+	SetSendEnabled(context.Context, *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -303,6 +439,9 @@ func (*UnimplementedMsgServer) Send(ctx context.Context, req *MsgSend) (*MsgSend
 func (*UnimplementedMsgServer) MultiSend(ctx context.Context, req *MsgMultiSend) (*MsgMultiSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiSend not implemented")
 }
+func (*UnimplementedMsgServer) SetSendEnabled(ctx context.Context, req *MsgSetSendEnabled) (*MsgSetSendEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSendEnabled not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -344,6 +483,24 @@ func _Msg_MultiSend_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSendEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSendEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSendEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.bank.v1beta1.Msg/SetSendEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSendEnabled(ctx, req.(*MsgSetSendEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.bank.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -356,6 +513,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MultiSend",
 			Handler:    _Msg_MultiSend_Handler,
 		},
+		{
+			MethodName: "SetSendEnabled",
+			Handler:    _Msg_SetSendEnabled_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/bank/v1beta1/tx.proto",
@@ -509,6 +670,82 @@ func (m *MsgMultiSendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetSendEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSendEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSendEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UseDefaultFor) > 0 {
+		for iNdEx := len(m.UseDefaultFor) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UseDefaultFor[iNdEx])
+			copy(dAtA[i:], m.UseDefaultFor[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.UseDefaultFor[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSendEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSendEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSendEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -582,6 +819,40 @@ func (m *MsgMultiSendResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetSendEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UseDefaultFor) > 0 {
+		for _, s := range m.UseDefaultFor {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetSendEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -954,6 +1225,204 @@ func (m *MsgMultiSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetSendEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSendEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSendEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, &SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseDefaultFor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UseDefaultFor = append(m.UseDefaultFor, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSendEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSendEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,11 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 )
 
-// check all registered invariants
+// check the registered invariants which are due at this height
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
-	if k.InvCheckPeriod() == 0 || ctx.BlockHeight()%int64(k.InvCheckPeriod()) != 0 {
-		// skip running the invariant check
-		return
-	}
-	k.AssertInvariants(ctx)
+	k.CheckInvariants(ctx)
 }
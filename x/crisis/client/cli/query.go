@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// GetQueryCmd returns the cli query commands for the crisis module.
+func GetQueryCmd() *cobra.Command {
+	crisisQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the crisis module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	crisisQueryCmd.AddCommand(
+		GetCmdQueryInvariantResults(),
+	)
+
+	return crisisQueryCmd
+}
+
+// GetCmdQueryInvariantResults implements a command to return the results of
+// the last run of the invariants checked by the node.
+func GetCmdQueryInvariantResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "invariant-results [module-name]",
+		Short: "Query the results of the last run of the invariants checked by the node",
+		Long:  "Query the results of the last run of the invariants checked by the node, optionally restricted to the invariants of a module.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryInvariantResultsRequest{}
+			if len(args) > 0 {
+				req.ModuleName = args[0]
+			}
+			res, err := queryClient.InvariantResults(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,12 +5,14 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
 type IntegrationTestSuite struct {
@@ -105,3 +107,50 @@ func (s *IntegrationTestSuite) TestNewMsgVerifyInvariantTxCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryInvariantResults() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name       string
+		args       []string
+		expModules []string
+	}{
+		{
+			"all modules",
+			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			[]string{"bank", "distribution", "gov", "group", "staking"},
+		},
+		{
+			"bank module",
+			[]string{"bank", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			[]string{"bank"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryInvariantResults()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var res types.QueryInvariantResultsResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res), out.String())
+			s.Require().NotEmpty(res.Results)
+
+			modules := make(map[string]bool)
+			for _, result := range res.Results {
+				s.Require().False(result.Broken)
+				modules[result.ModuleName] = true
+			}
+			s.Require().Len(modules, len(tc.expModules))
+			for _, module := range tc.expModules {
+				s.Require().True(modules[module], module)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InvariantResults returns the results of the last run of the invariants
+// checked by this node.
+func (k Keeper) InvariantResults(_ context.Context, req *types.QueryInvariantResultsRequest) (*types.QueryInvariantResultsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryInvariantResultsResponse{Results: k.GetInvariantResults(req.ModuleName)}, nil
+}
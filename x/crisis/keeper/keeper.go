@@ -20,24 +20,33 @@ type Keeper struct {
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	config  types.Config
+	results *invariantResults
 }
 
 // NewKeeper creates a new Keeper object
 func NewKeeper(
 	paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
-	feeCollectorName string,
+	feeCollectorName string, config types.Config,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	if config.Async && config.MultiStore == nil {
+		panic("asynchronous invariant checks require a multistore")
+	}
+
 	return Keeper{
 		routes:           make([]types.InvarRoute, 0),
 		paramSpace:       paramSpace,
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
+		config:           config,
+		results:          newInvariantResults(),
 	}
 }
 
@@ -76,12 +85,10 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	n := len(invarRoutes)
 	for i, ir := range invarRoutes {
 		logger.Info("asserting crisis invariants", "inv", fmt.Sprint(i+1, "/", n), "name", ir.FullRoute())
-		if res, stop := ir.Invar(ctx); stop {
-			// TODO: Include app name as part of context to allow for this to be
-			// variable.
-			panic(fmt.Errorf("invariant broken: %s\n"+
-				"\tCRITICAL please submit the following transaction:\n"+
-				"\t\t tx crisis invariant-broken %s %s", res, ir.ModuleName, ir.Route))
+		res := k.runInvariant(ctx, ir, 0)
+		k.results.set(res)
+		if res.Broken {
+			panic(invariantBrokenError(res))
 		}
 	}
 
@@ -89,6 +96,188 @@ func (k Keeper) AssertInvariants(ctx sdk.Context) {
 	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
 }
 
+// CheckInvariants checks the invariants whose period divides the block height.
+// Depending on the keeper config, the invariants are checked synchronously or
+// asynchronously, and a broken invariant either panics or is only logged
+// until the configured halt height.
+func (k Keeper) CheckInvariants(ctx sdk.Context) {
+	// handle the invariants found broken by asynchronous checks since the
+	// previous block
+	for _, res := range k.results.popPending() {
+		k.handleBrokenInvariant(ctx, res)
+	}
+
+	var due []types.InvarRoute
+	for _, ir := range k.routes {
+		period := k.RoutePeriod(ir)
+		if period != 0 && ctx.BlockHeight()%int64(period) == 0 {
+			due = append(due, ir)
+		}
+	}
+
+	switch {
+	case len(due) == 0:
+	case k.config.Async:
+		k.checkInvariantsAsync(ctx, due)
+	default:
+		start := time.Now()
+		for _, ir := range due {
+			res := k.runInvariant(ctx, ir, k.config.GasLimit)
+			k.results.set(res)
+			if res.Broken {
+				k.handleBrokenInvariant(ctx, res)
+			}
+		}
+		k.Logger(ctx).Info("checked invariants", "count", len(due), "duration", time.Since(start), "height", ctx.BlockHeight())
+	}
+
+	if k.config.LogOnly && k.config.HaltHeight > 0 && ctx.BlockHeight() >= k.config.HaltHeight && k.results.isBroken() {
+		panic(fmt.Errorf("invariants broken, halting at height %d", ctx.BlockHeight()))
+	}
+}
+
+// checkInvariantsAsync checks the invariant routes in a goroutine, on a branch
+// of the last committed state. Routes whose previous check is still running
+// are skipped.
+func (k Keeper) checkInvariantsAsync(ctx sdk.Context, routes []types.InvarRoute) {
+	logger := k.Logger(ctx)
+
+	version := ctx.BlockHeight() - 1
+	if version < 1 {
+		return
+	}
+
+	var started []types.InvarRoute
+	for _, ir := range routes {
+		if !k.results.start(ir.FullRoute()) {
+			logger.Info("skipping invariant still being checked", "name", ir.FullRoute())
+			continue
+		}
+		started = append(started, ir)
+	}
+	if len(started) == 0 {
+		return
+	}
+
+	cms, err := k.config.MultiStore.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		logger.Error("failed to branch multistore for invariant checks", "version", version, "err", err)
+		for _, ir := range started {
+			k.results.abort(ir.FullRoute())
+		}
+		return
+	}
+
+	asyncCtx := ctx.WithMultiStore(cms).WithBlockHeight(version).WithEventManager(sdk.NewEventManager())
+	go func() {
+		for _, ir := range started {
+			res := k.runInvariantAsync(asyncCtx, ir, k.config.GasLimit)
+			if res.Broken {
+				logger.Error("invariant broken", "name", ir.FullRoute(), "height", version, "msg", res.Message)
+			}
+			k.results.finish(ir.FullRoute(), res)
+		}
+	}()
+}
+
+// runInvariantAsync runs the invariant off the consensus path. An invariant
+// panicking for another reason than running out of gas is reported as broken,
+// to be handled on the consensus path, instead of crashing the node.
+func (k Keeper) runInvariantAsync(ctx sdk.Context, ir types.InvarRoute, gasLimit uint64) (res types.InvariantResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = types.InvariantResult{
+				ModuleName:     ir.ModuleName,
+				InvariantRoute: ir.Route,
+				Height:         ctx.BlockHeight(),
+				Broken:         true,
+				Message:        fmt.Sprintf("invariant panicked: %v", r),
+				Error:          fmt.Sprint(r),
+			}
+		}
+		res.Async = true
+	}()
+
+	return k.runInvariant(ctx, ir, gasLimit)
+}
+
+// runInvariant runs the invariant on a branch of the state under a gas meter
+// bounded by gasLimit, or unbounded if gasLimit is zero. Running out of gas is
+// reported in the result error.
+func (k Keeper) runInvariant(ctx sdk.Context, ir types.InvarRoute, gasLimit uint64) (res types.InvariantResult) {
+	gasMeter := sdk.NewInfiniteGasMeter()
+	if gasLimit > 0 {
+		gasMeter = sdk.NewGasMeter(gasLimit)
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	res = types.InvariantResult{
+		ModuleName:     ir.ModuleName,
+		InvariantRoute: ir.Route,
+		Height:         ctx.BlockHeight(),
+	}
+
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			res.Error = fmt.Sprintf("out of gas in location: %v", oog.Descriptor)
+		}
+		res.Duration = time.Since(start)
+		res.GasUsed = gasMeter.GasConsumed()
+	}()
+
+	res.Message, res.Broken = ir.Invar(cacheCtx)
+	return res
+}
+
+// handleBrokenInvariant panics, or only logs the broken invariant and emits
+// an event in log only mode.
+func (k Keeper) handleBrokenInvariant(ctx sdk.Context, res types.InvariantResult) {
+	if !k.config.LogOnly {
+		panic(invariantBrokenError(res))
+	}
+
+	k.results.markBroken()
+	k.Logger(ctx).Error("invariant broken", "name", fullRoute(res), "height", res.Height, "msg", res.Message)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInvariantBroken,
+			sdk.NewAttribute(sdk.AttributeKeyModule, res.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyRoute, res.InvariantRoute),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(res.Height)),
+			sdk.NewAttribute(types.AttributeKeyMessage, res.Message),
+		),
+	)
+}
+
+func invariantBrokenError(res types.InvariantResult) error {
+	// TODO: Include app name as part of context to allow for this to be
+	// variable.
+	return fmt.Errorf("invariant broken: %s\n"+
+		"\tCRITICAL please submit the following transaction:\n"+
+		"\t\t tx crisis invariant-broken %s %s", res.Message, res.ModuleName, res.InvariantRoute)
+}
+
+// RoutePeriod returns the number of blocks between two periodic checks of the
+// invariant route. A zero period disables the periodic checks.
+func (k Keeper) RoutePeriod(ir types.InvarRoute) uint64 {
+	if period, ok := k.config.RoutePeriods[ir.FullRoute()]; ok {
+		return period
+	}
+	return uint64(k.invCheckPeriod)
+}
+
+// GetInvariantResults returns the results of the last run of the invariants
+// of the given module, or of all modules if moduleName is empty.
+func (k Keeper) GetInvariantResults(moduleName string) []types.InvariantResult {
+	return k.results.list(moduleName)
+}
+
 // InvCheckPeriod returns the invariant checks period.
 func (k Keeper) InvCheckPeriod() uint { return k.invCheckPeriod }
 
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -9,6 +10,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/keeper"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
@@ -48,3 +51,138 @@ func TestAssertInvariants(t *testing.T) {
 	app.CrisisKeeper.RegisterRoute("testModule", "testRoute2", func(sdk.Context) (string, bool) { return "", true })
 	require.Panics(t, func() { app.CrisisKeeper.AssertInvariants(ctx) })
 }
+
+func TestCheckInvariantsRoutePeriods(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+
+	_, err := types.ParseRoutePeriods([]string{"testModule/everyThree"})
+	require.Error(t, err)
+	_, err = types.ParseRoutePeriods([]string{"everyThree=3"})
+	require.Error(t, err)
+
+	config := types.DefaultConfig()
+	config.RoutePeriods, err = types.ParseRoutePeriods([]string{"testModule/everyThree=3", "testModule/disabled=0"})
+	require.NoError(t, err)
+	k := keeper.NewKeeper(app.GetSubspace(types.ModuleName), 2, app.BankKeeper, authtypes.FeeCollectorName, config)
+
+	k.RegisterRoute("testModule", "default", func(sdk.Context) (string, bool) { return "", false })
+	k.RegisterRoute("testModule", "everyThree", func(sdk.Context) (string, bool) { return "", false })
+	k.RegisterRoute("testModule", "disabled", func(sdk.Context) (string, bool) { return "", true })
+
+	ctx := app.NewContext(true, tmproto.Header{Height: 3})
+	require.NotPanics(t, func() { k.CheckInvariants(ctx) })
+
+	results := k.GetInvariantResults("testModule")
+	require.Len(t, results, 1)
+	require.Equal(t, "everyThree", results[0].InvariantRoute)
+	require.Equal(t, int64(3), results[0].Height)
+	require.False(t, results[0].Broken)
+
+	require.NotPanics(t, func() { k.CheckInvariants(ctx.WithBlockHeight(4)) })
+	require.Len(t, k.GetInvariantResults("testModule"), 2)
+	require.Empty(t, k.GetInvariantResults("otherModule"))
+}
+
+func TestCheckInvariantsLogOnly(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+
+	config := types.DefaultConfig()
+	config.LogOnly = true
+	config.HaltHeight = 10
+	k := keeper.NewKeeper(app.GetSubspace(types.ModuleName), 1, app.BankKeeper, authtypes.FeeCollectorName, config)
+	k.RegisterRoute("testModule", "broken", func(sdk.Context) (string, bool) { return "broken", true })
+
+	ctx := app.NewContext(true, tmproto.Header{Height: 5})
+	require.NotPanics(t, func() { k.CheckInvariants(ctx) })
+
+	results := k.GetInvariantResults("")
+	require.Len(t, results, 1)
+	require.True(t, results[0].Broken)
+	require.Equal(t, "broken", results[0].Message)
+
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeInvariantBroken {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	require.Panics(t, func() { k.CheckInvariants(ctx.WithBlockHeight(10)) })
+}
+
+func TestCheckInvariantsGasLimit(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+
+	config := types.DefaultConfig()
+	config.GasLimit = 10
+	k := keeper.NewKeeper(app.GetSubspace(types.ModuleName), 1, app.BankKeeper, authtypes.FeeCollectorName, config)
+	k.RegisterRoute("testModule", "expensive", func(ctx sdk.Context) (string, bool) {
+		ctx.GasMeter().ConsumeGas(100, "expensive")
+		return "", true
+	})
+
+	ctx := app.NewContext(true, tmproto.Header{Height: 1})
+	require.NotPanics(t, func() { k.CheckInvariants(ctx) })
+
+	results := k.GetInvariantResults("")
+	require.Len(t, results, 1)
+	require.False(t, results[0].Broken)
+	require.Contains(t, results[0].Error, "out of gas")
+}
+
+func TestCheckInvariantsAsync(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+
+	config := types.DefaultConfig()
+	config.Async = true
+	config.MultiStore = app.CommitMultiStore()
+	k := keeper.NewKeeper(app.GetSubspace(types.ModuleName), 1, app.BankKeeper, authtypes.FeeCollectorName, config)
+	k.RegisterRoute("testModule", "broken", func(sdk.Context) (string, bool) { return "broken", true })
+
+	height := app.LastBlockHeight() + 1
+	ctx := app.NewContext(true, tmproto.Header{Height: height})
+	require.NotPanics(t, func() { k.CheckInvariants(ctx) })
+
+	require.Eventually(t, func() bool { return len(k.GetInvariantResults("")) == 1 }, time.Second, 10*time.Millisecond)
+	results := k.GetInvariantResults("")
+	require.True(t, results[0].Async)
+	require.True(t, results[0].Broken)
+	require.Equal(t, height-1, results[0].Height)
+
+	// the broken invariant is handled by the next check
+	require.Panics(t, func() { k.CheckInvariants(ctx.WithBlockHeight(height + 1)) })
+}
+
+func TestCheckInvariantsAsyncPanic(t *testing.T) {
+	app := simapp.Setup(t, false)
+	app.Commit()
+
+	config := types.DefaultConfig()
+	config.Async = true
+	config.MultiStore = app.CommitMultiStore()
+	config.LogOnly = true
+	k := keeper.NewKeeper(app.GetSubspace(types.ModuleName), 1, app.BankKeeper, authtypes.FeeCollectorName, config)
+	k.RegisterRoute("testModule", "panicking", func(sdk.Context) (string, bool) { panic("unexpected") })
+
+	height := app.LastBlockHeight() + 1
+	ctx := app.NewContext(true, tmproto.Header{Height: height})
+	require.NotPanics(t, func() { k.CheckInvariants(ctx) })
+
+	// the panic is recovered in the goroutine and reported as broken
+	require.Eventually(t, func() bool { return len(k.GetInvariantResults("")) == 1 }, time.Second, 10*time.Millisecond)
+	results := k.GetInvariantResults("")
+	require.True(t, results[0].Async)
+	require.True(t, results[0].Broken)
+	require.Equal(t, "unexpected", results[0].Error)
+
+	// the broken invariant is handled by the next check, in log only mode
+	ctx = ctx.WithBlockHeight(height + 1).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { k.CheckInvariants(ctx) })
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeInvariantBroken, ctx.EventManager().Events()[0].Type)
+}
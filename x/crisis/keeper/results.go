@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+)
+
+// invariantResults holds the results of the invariant checks run by this
+// node. They are not part of the consensus state as asynchronous checks
+// complete at a non-deterministic time.
+type invariantResults struct {
+	mtx sync.Mutex
+
+	results map[string]types.InvariantResult
+	running map[string]bool
+	// pending holds the broken results of asynchronous checks which have not
+	// yet been handled by the end blocker.
+	pending []types.InvariantResult
+	// broken is set once a periodic check found an invariant broken.
+	broken bool
+}
+
+func newInvariantResults() *invariantResults {
+	return &invariantResults{
+		results: make(map[string]types.InvariantResult),
+		running: make(map[string]bool),
+	}
+}
+
+// set records the result of a synchronous check.
+func (r *invariantResults) set(res types.InvariantResult) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.results[fullRoute(res)] = res
+}
+
+// start marks the route as running asynchronously. It returns false if a
+// check of the route is already running.
+func (r *invariantResults) start(route string) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.running[route] {
+		return false
+	}
+	r.running[route] = true
+	return true
+}
+
+// finish records the result of an asynchronous check of the route.
+func (r *invariantResults) finish(route string, res types.InvariantResult) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	delete(r.running, route)
+	r.results[route] = res
+	if res.Broken {
+		r.pending = append(r.pending, res)
+	}
+}
+
+// abort clears the running mark of a route whose check could not be started.
+func (r *invariantResults) abort(route string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	delete(r.running, route)
+}
+
+// popPending returns and clears the broken results of asynchronous checks.
+func (r *invariantResults) popPending() []types.InvariantResult {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	pending := r.pending
+	r.pending = nil
+	return pending
+}
+
+func (r *invariantResults) markBroken() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.broken = true
+}
+
+func (r *invariantResults) isBroken() bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	return r.broken
+}
+
+// list returns the results of the given module, or of all modules if
+// moduleName is empty, ordered by full route.
+func (r *invariantResults) list(moduleName string) []types.InvariantResult {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	results := make([]types.InvariantResult, 0, len(r.results))
+	for _, res := range r.results {
+		if moduleName != "" && res.ModuleName != moduleName {
+			continue
+		}
+		results = append(results, res)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return fullRoute(results[i]) < fullRoute(results[j])
+	})
+	return results
+}
+
+func fullRoute(res types.InvariantResult) string {
+	return res.ModuleName + "/" + res.InvariantRoute
+}
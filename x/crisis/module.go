@@ -1,6 +1,7 @@
 package crisis
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -28,6 +29,11 @@ var (
 // Module init related flags
 const (
 	FlagSkipGenesisInvariants = "x-crisis-skip-assert-invariants"
+	FlagAsyncInvariants       = "x-crisis-async-invariants"
+	FlagLogOnlyInvariants     = "x-crisis-log-only-invariants"
+	FlagInvariantsHaltHeight  = "x-crisis-invariants-halt-height"
+	FlagInvariantsGasLimit    = "x-crisis-invariants-gas-limit"
+	FlagInvariantRoutePeriods = "x-crisis-invariant-route-periods"
 )
 
 // AppModuleBasic defines the basic application module used by the crisis module.
@@ -59,16 +65,22 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncod
 	return types.ValidateGenesis(&data)
 }
 
-// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the capability module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the crisis module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // GetTxCmd returns the root tx command for the crisis module.
 func (b AppModuleBasic) GetTxCmd() *cobra.Command {
 	return cli.NewTxCmd()
 }
 
-// GetQueryCmd returns no root query command for the crisis module.
-func (AppModuleBasic) GetQueryCmd() *cobra.Command { return nil }
+// GetQueryCmd returns the root query command for the crisis module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
 
 // RegisterInterfaces registers interfaces and implementations of the crisis
 // module.
@@ -104,6 +116,11 @@ func NewAppModule(keeper *keeper.Keeper, skipGenesisInvariants bool) AppModule {
 // AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
 func AddModuleInitFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Bool(FlagSkipGenesisInvariants, false, "Skip x/crisis invariants check on startup")
+	startCmd.Flags().Bool(FlagAsyncInvariants, false, "Run the periodic x/crisis invariants checks asynchronously on the last committed state")
+	startCmd.Flags().Bool(FlagLogOnlyInvariants, false, "Only log and emit an event when an x/crisis invariant is broken instead of panicking")
+	startCmd.Flags().Int64(FlagInvariantsHaltHeight, 0, "Height at which to halt if an x/crisis invariant was broken in log only mode (0 never halts)")
+	startCmd.Flags().Uint64(FlagInvariantsGasLimit, 0, "Gas limit of each periodic x/crisis invariant check (0 is unbounded)")
+	startCmd.Flags().StringSlice(FlagInvariantRoutePeriods, nil, "Periods of the x/crisis invariant routes checked with another period than the invariant check period, as module/route=period (0 disables the route)")
 }
 
 // Name returns the crisis module's name.
@@ -128,6 +145,7 @@ func (AppModule) LegacyQuerierHandler(*codec.LegacyAmino) sdk.Querier { return n
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
 // InitGenesis performs genesis initialization for the crisis module. It returns
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VersionedMultiStore branches the multistore committed at a given version.
+// It is implemented by the application's CommitMultiStore.
+type VersionedMultiStore interface {
+	CacheMultiStoreWithVersion(version int64) (sdk.CacheMultiStore, error)
+}
+
+// Config is a config struct used for intialising the crisis module to avoid
+// using globals.
+type Config struct {
+	// RoutePeriods overrides, per full invariant route ("module/route"), the
+	// number of blocks between two checks of the invariant. A zero period
+	// disables the periodic check of the route. Routes without an override are
+	// checked every invCheckPeriod blocks.
+	RoutePeriods map[string]uint64
+
+	// Async runs the periodic invariant checks off the consensus path, on a
+	// branch of the last committed state. It requires MultiStore to be set.
+	Async bool
+
+	// MultiStore is the store branched by asynchronous invariant checks.
+	MultiStore VersionedMultiStore
+
+	// LogOnly only logs and emits an event when an invariant is broken during
+	// a periodic check instead of panicking.
+	LogOnly bool
+
+	// HaltHeight is, in LogOnly mode, the height at which the node halts if
+	// an invariant was found broken. A zero HaltHeight never halts.
+	HaltHeight int64
+
+	// GasLimit bounds the gas an invariant may consume during a periodic check.
+	// An invariant running out of gas is reported as errored but not broken.
+	// A zero GasLimit does not bound the gas.
+	GasLimit uint64
+}
+
+// DefaultConfig returns the default config for crisis.
+func DefaultConfig() Config {
+	return Config{
+		RoutePeriods: map[string]uint64{},
+	}
+}
+
+// ParseRoutePeriods parses the invariant route periods overrides given as
+// "module/route=period" entries.
+func ParseRoutePeriods(entries []string) (map[string]uint64, error) {
+	routePeriods := make(map[string]uint64, len(entries))
+	for _, entry := range entries {
+		route, value, ok := strings.Cut(entry, "=")
+		if !ok || !strings.Contains(route, "/") {
+			return nil, fmt.Errorf("invalid invariant route period %q, expected module/route=period", entry)
+		}
+
+		period, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid invariant route period %q: %w", entry, err)
+		}
+		routePeriods[route] = period
+	}
+
+	return routePeriods, nil
+}
@@ -0,0 +1,701 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/crisis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InvariantResult defines the outcome of the last run of an invariant route.
+type InvariantResult struct {
+	// module_name is the module which registered the invariant.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// invariant_route is the route of the invariant within the module.
+	InvariantRoute string `protobuf:"bytes,2,opt,name=invariant_route,json=invariantRoute,proto3" json:"invariant_route,omitempty"`
+	// height is the block height of the state the invariant was checked against.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// broken is true when the invariant was broken.
+	Broken bool `protobuf:"varint,4,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message is the message returned by the invariant.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// duration is the time taken to run the invariant.
+	Duration time.Duration `protobuf:"bytes,6,opt,name=duration,proto3,stdduration" json:"duration"`
+	// gas_used is the gas consumed by the invariant.
+	GasUsed uint64 `protobuf:"varint,7,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// async is true when the invariant was run off the consensus path.
+	Async bool `protobuf:"varint,8,opt,name=async,proto3" json:"async,omitempty"`
+	// error is set when the invariant could not be run to completion, for
+	// instance because it ran out of gas.
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *InvariantResult) Reset()         { *m = InvariantResult{} }
+func (m *InvariantResult) String() string { return proto.CompactTextString(m) }
+func (*InvariantResult) ProtoMessage()    {}
+func (*InvariantResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4563994d65183ad5, []int{0}
+}
+func (m *InvariantResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InvariantResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InvariantResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InvariantResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvariantResult.Merge(m, src)
+}
+func (m *InvariantResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *InvariantResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvariantResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvariantResult proto.InternalMessageInfo
+
+func (m *InvariantResult) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetInvariantRoute() string {
+	if m != nil {
+		return m.InvariantRoute
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *InvariantResult) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
+}
+
+func (m *InvariantResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *InvariantResult) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *InvariantResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *InvariantResult) GetAsync() bool {
+	if m != nil {
+		return m.Async
+	}
+	return false
+}
+
+func (m *InvariantResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*InvariantResult)(nil), "cosmos.crisis.v1beta1.InvariantResult")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/crisis/v1beta1/crisis.proto", fileDescriptor_4563994d65183ad5)
+}
+
+var fileDescriptor_4563994d65183ad5 = []byte{
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x91, 0x3f, 0x6a, 0xe3, 0x40,
+	0x14, 0xc6, 0x35, 0xfe, 0x2b, 0x8f, 0x61, 0x0d, 0x83, 0x77, 0x19, 0xbb, 0x90, 0x85, 0x9b, 0x15,
+	0x2c, 0x2b, 0xe1, 0xdd, 0x03, 0x2c, 0x98, 0x4d, 0x91, 0x26, 0x85, 0x20, 0x4d, 0x1a, 0x33, 0x92,
+	0x5e, 0xc6, 0xc2, 0x96, 0xc6, 0xcc, 0x8c, 0x4c, 0x7c, 0x8b, 0x94, 0xb9, 0x44, 0xee, 0xe1, 0xd2,
+	0x65, 0xaa, 0x24, 0xd8, 0x17, 0x09, 0x1a, 0x49, 0xae, 0xa4, 0xdf, 0xa7, 0xdf, 0x13, 0xdf, 0xe3,
+	0xe1, 0x79, 0x2c, 0x54, 0x26, 0x54, 0x10, 0xcb, 0x54, 0xa5, 0x2a, 0xd8, 0x2f, 0x22, 0xd0, 0x6c,
+	0x51, 0xa3, 0xbf, 0x93, 0x42, 0x0b, 0xf2, 0xbd, 0x72, 0xfc, 0x3a, 0xac, 0x9d, 0xe9, 0x98, 0x0b,
+	0x2e, 0x8c, 0x11, 0x94, 0x6f, 0x95, 0x3c, 0x75, 0xb8, 0x10, 0x7c, 0x0b, 0x81, 0xa1, 0xa8, 0x78,
+	0x0c, 0x92, 0x42, 0x32, 0x9d, 0x8a, 0xbc, 0xfa, 0x3e, 0x7f, 0x6d, 0xe1, 0xd1, 0x6d, 0xbe, 0x67,
+	0x32, 0x65, 0xb9, 0x0e, 0x41, 0x15, 0x5b, 0x4d, 0x66, 0x78, 0x98, 0x89, 0xa4, 0xd8, 0xc2, 0x2a,
+	0x67, 0x19, 0x50, 0xe4, 0x22, 0x6f, 0x10, 0xe2, 0x2a, 0xba, 0x63, 0x19, 0x90, 0x9f, 0x78, 0x94,
+	0x36, 0x33, 0x2b, 0x29, 0x0a, 0x0d, 0xb4, 0x65, 0xa4, 0x6f, 0xd7, 0x38, 0x2c, 0x53, 0xf2, 0x03,
+	0xf7, 0xd6, 0x90, 0xf2, 0xb5, 0xa6, 0x6d, 0x17, 0x79, 0xed, 0xb0, 0xa6, 0x32, 0x8f, 0xa4, 0xd8,
+	0x40, 0x4e, 0x3b, 0x2e, 0xf2, 0xec, 0xb0, 0x26, 0x42, 0x71, 0x3f, 0x03, 0xa5, 0x18, 0x07, 0xda,
+	0x35, 0x3f, 0x6c, 0x90, 0xfc, 0xc3, 0x76, 0xd3, 0x9c, 0xf6, 0x5c, 0xe4, 0x0d, 0xff, 0x4c, 0xfc,
+	0x6a, 0x35, 0xbf, 0x59, 0xcd, 0xff, 0x5f, 0x0b, 0x4b, 0xfb, 0xf8, 0x3e, 0xb3, 0x5e, 0x3e, 0x66,
+	0x28, 0xbc, 0x0e, 0x91, 0x09, 0xb6, 0x39, 0x53, 0xab, 0x42, 0x41, 0x42, 0xfb, 0x2e, 0xf2, 0x3a,
+	0x61, 0x9f, 0x33, 0x75, 0xaf, 0x20, 0x21, 0x63, 0xdc, 0x65, 0xea, 0x90, 0xc7, 0xd4, 0x36, 0x65,
+	0x2a, 0x28, 0x53, 0x90, 0x52, 0x48, 0x3a, 0x30, 0x4d, 0x2a, 0x58, 0xde, 0x1c, 0xcf, 0x0e, 0x3a,
+	0x9d, 0x1d, 0xf4, 0x79, 0x76, 0xd0, 0xf3, 0xc5, 0xb1, 0x4e, 0x17, 0xc7, 0x7a, 0xbb, 0x38, 0xd6,
+	0xc3, 0x2f, 0x9e, 0xea, 0x75, 0x11, 0xf9, 0xb1, 0xc8, 0x82, 0xe6, 0x8a, 0xe6, 0xf1, 0x5b, 0x25,
+	0x9b, 0xe0, 0xa9, 0x39, 0xa9, 0x3e, 0xec, 0x40, 0x45, 0x3d, 0x53, 0xfa, 0xef, 0xd7, 0x00, 0xe5,
+	0x81, 0x19, 0x77, 0xf0, 0x01, 0x00, 0x00,
+}
+
+func (m *InvariantResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InvariantResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InvariantResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Async {
+		i--
+		if m.Async {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintCrisis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintCrisis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.InvariantRoute) > 0 {
+		i -= len(m.InvariantRoute)
+		copy(dAtA[i:], m.InvariantRoute)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.InvariantRoute)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintCrisis(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCrisis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCrisis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InvariantResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	l = len(m.InvariantRoute)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCrisis(uint64(m.Height))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovCrisis(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovCrisis(uint64(m.GasUsed))
+	}
+	if m.Async {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCrisis(uint64(l))
+	}
+	return n
+}
+
+func sovCrisis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCrisis(x uint64) (n int) {
+	return sovCrisis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InvariantResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvariantResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvariantResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvariantRoute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvariantRoute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Async", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Async = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrisis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrisis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCrisis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCrisis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrisis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCrisis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCrisis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCrisis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCrisis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCrisis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCrisis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,601 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/crisis/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryInvariantResultsRequest is the request type for the Query/InvariantResults RPC method.
+type QueryInvariantResultsRequest struct {
+	// module_name optionally restricts the results to the invariants of a module.
+	ModuleName string `protobuf:"bytes,1,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+}
+
+func (m *QueryInvariantResultsRequest) Reset()         { *m = QueryInvariantResultsRequest{} }
+func (m *QueryInvariantResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantResultsRequest) ProtoMessage()    {}
+func (*QueryInvariantResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{0}
+}
+func (m *QueryInvariantResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantResultsRequest.Merge(m, src)
+}
+func (m *QueryInvariantResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantResultsRequest proto.InternalMessageInfo
+
+func (m *QueryInvariantResultsRequest) GetModuleName() string {
+	if m != nil {
+		return m.ModuleName
+	}
+	return ""
+}
+
+// QueryInvariantResultsResponse is the response type for the Query/InvariantResults RPC method.
+type QueryInvariantResultsResponse struct {
+	// results are the last run results, ordered by invariant route.
+	Results []InvariantResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryInvariantResultsResponse) Reset()         { *m = QueryInvariantResultsResponse{} }
+func (m *QueryInvariantResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInvariantResultsResponse) ProtoMessage()    {}
+func (*QueryInvariantResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3ca16352ca9a50b9, []int{1}
+}
+func (m *QueryInvariantResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInvariantResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInvariantResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInvariantResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInvariantResultsResponse.Merge(m, src)
+}
+func (m *QueryInvariantResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInvariantResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInvariantResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInvariantResultsResponse proto.InternalMessageInfo
+
+func (m *QueryInvariantResultsResponse) GetResults() []InvariantResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryInvariantResultsRequest)(nil), "cosmos.crisis.v1beta1.QueryInvariantResultsRequest")
+	proto.RegisterType((*QueryInvariantResultsResponse)(nil), "cosmos.crisis.v1beta1.QueryInvariantResultsResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/crisis/v1beta1/query.proto", fileDescriptor_3ca16352ca9a50b9) }
+
+var fileDescriptor_3ca16352ca9a50b9 = []byte{
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x4f, 0x4b, 0xc3, 0x30,
+	0x18, 0x87, 0x1b, 0xff, 0x62, 0x76, 0x91, 0xa2, 0x30, 0xc6, 0xec, 0x66, 0x0f, 0x32, 0x14, 0x1b,
+	0xb7, 0x79, 0x17, 0x06, 0x0a, 0x5e, 0x04, 0x7b, 0xf4, 0x32, 0xb2, 0x2d, 0xd4, 0xe0, 0x9a, 0xb7,
+	0xeb, 0x9b, 0x0e, 0x77, 0xf5, 0x13, 0x08, 0x7e, 0x10, 0xcf, 0x7e, 0x83, 0x1d, 0x07, 0x5e, 0x3c,
+	0x89, 0x6c, 0x7e, 0x10, 0x59, 0xd3, 0x79, 0x18, 0x9d, 0xe0, 0x29, 0xe1, 0xcd, 0xf3, 0x3e, 0xbf,
+	0xbc, 0x09, 0x3d, 0xec, 0x02, 0x86, 0x80, 0xac, 0x1b, 0x4b, 0x94, 0xc8, 0x86, 0xf5, 0x8e, 0xd0,
+	0xbc, 0xce, 0x06, 0x89, 0x88, 0x47, 0x5e, 0x14, 0x83, 0x06, 0x7b, 0xdf, 0x20, 0x9e, 0x41, 0xbc,
+	0x0c, 0x29, 0xed, 0x05, 0x10, 0x40, 0x4a, 0xb0, 0xf9, 0xce, 0xc0, 0xa5, 0x72, 0x00, 0x10, 0xf4,
+	0x05, 0xe3, 0x91, 0x64, 0x5c, 0x29, 0xd0, 0x5c, 0x4b, 0x50, 0x98, 0x9d, 0xba, 0xf9, 0x69, 0x99,
+	0x39, 0x65, 0xdc, 0x0b, 0x5a, 0xbe, 0x9d, 0xa7, 0x5f, 0xab, 0x21, 0x8f, 0x25, 0x57, 0xda, 0x17,
+	0x98, 0xf4, 0x35, 0xfa, 0x62, 0x90, 0x08, 0xd4, 0x76, 0x85, 0x16, 0x42, 0xe8, 0x25, 0x7d, 0xd1,
+	0x56, 0x3c, 0x14, 0x45, 0x52, 0x25, 0xb5, 0x1d, 0x9f, 0x9a, 0xd2, 0x0d, 0x0f, 0x85, 0x1b, 0xd0,
+	0x83, 0x15, 0x02, 0x8c, 0x40, 0xa1, 0xb0, 0xaf, 0xe8, 0x76, 0x6c, 0x4a, 0x45, 0x52, 0x5d, 0xaf,
+	0x15, 0x1a, 0x47, 0x5e, 0xee, 0x88, 0xde, 0x92, 0xa1, 0xb5, 0x31, 0xfe, 0xac, 0x58, 0xfe, 0xa2,
+	0xb9, 0xf1, 0x46, 0xe8, 0x66, 0x9a, 0x64, 0xbf, 0x12, 0xba, 0xbb, 0x1c, 0x67, 0x37, 0x57, 0x58,
+	0xff, 0x9a, 0xae, 0x74, 0xfe, 0xbf, 0x26, 0x33, 0x91, 0x7b, 0xf6, 0xf4, 0xfe, 0xfd, 0xb2, 0x76,
+	0x6c, 0xd7, 0x58, 0xfe, 0x03, 0xcb, 0x45, 0x63, 0x3b, 0xbb, 0x7b, 0xeb, 0x72, 0x3c, 0x75, 0xc8,
+	0x64, 0xea, 0x90, 0xaf, 0xa9, 0x43, 0x9e, 0x67, 0x8e, 0x35, 0x99, 0x39, 0xd6, 0xc7, 0xcc, 0xb1,
+	0xee, 0x4e, 0x02, 0xa9, 0xef, 0x93, 0x8e, 0xd7, 0x85, 0xf0, 0xd7, 0x96, 0x2e, 0xa7, 0xd8, 0x7b,
+	0x60, 0x8f, 0x0b, 0xb5, 0x1e, 0x45, 0x02, 0x3b, 0x5b, 0xe9, 0x9f, 0x35, 0x7f, 0x06, 0x00, 0x0e,
+	0x68, 0xdf, 0xc4, 0x47, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// InvariantResults returns the results of the last run of every invariant
+	// route checked by this node.
+	InvariantResults(ctx context.Context, in *QueryInvariantResultsRequest, opts ...grpc.CallOption) (*QueryInvariantResultsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) InvariantResults(ctx context.Context, in *QueryInvariantResultsRequest, opts ...grpc.CallOption) (*QueryInvariantResultsResponse, error) {
+	out := new(QueryInvariantResultsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.crisis.v1beta1.Query/InvariantResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// InvariantResults returns the results of the last run of every invariant
+	// route checked by this node.
+	InvariantResults(context.Context, *QueryInvariantResultsRequest) (*QueryInvariantResultsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) InvariantResults(ctx context.Context, req *QueryInvariantResultsRequest) (*QueryInvariantResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvariantResults not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_InvariantResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInvariantResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InvariantResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.crisis.v1beta1.Query/InvariantResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InvariantResults(ctx, req.(*QueryInvariantResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.crisis.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InvariantResults",
+			Handler:    _Query_InvariantResults_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/crisis/v1beta1/query.proto",
+}
+
+func (m *QueryInvariantResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInvariantResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInvariantResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInvariantResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInvariantResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInvariantResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryInvariantResultsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantResultsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInvariantResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInvariantResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInvariantResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, InvariantResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -30,3 +30,8 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper)
 	consAddr := sdk.ConsAddress(req.Header.ProposerAddress)
 	k.SetPreviousProposerConsAddr(ctx, consAddr)
 }
+
+// EndBlocker restakes the rewards of the next batch of auto-compounding delegators
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	k.ProcessAutoCompounding(ctx)
+}
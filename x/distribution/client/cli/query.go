@@ -33,6 +33,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryDelegatorRewards(),
 		GetCmdQueryCommunityPool(),
 		GetCmdQueryLiquidityProviderRewards(),
+		GetCmdQueryDelegatorAutoCompound(),
+		GetCmdQueryAutoCompoundDelegators(),
 	)
 
 	return distQueryCmd
@@ -353,3 +355,92 @@ $ %s query distribution liquidity-provider-rewards-pool
 		},
 	}
 }
+
+// GetCmdQueryDelegatorAutoCompound implements the query delegator auto-compound command.
+func GetCmdQueryDelegatorAutoCompound() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "auto-compound [delegator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether the rewards of a delegator are auto-compounded",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the rewards of a delegator are auto-compounded.
+
+Example:
+$ %s query distribution auto-compound %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DelegatorAutoCompound(
+				cmd.Context(),
+				&types.QueryDelegatorAutoCompoundRequest{DelegatorAddress: delegatorAddr.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAutoCompoundDelegators implements the query auto-compounding delegators command.
+func GetCmdQueryAutoCompoundDelegators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-compound-delegators",
+		Args:  cobra.NoArgs,
+		Short: "Query the delegators auto-compounding their rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the delegators auto-compounding their rewards.
+
+Example:
+$ %s query distribution auto-compound-delegators
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AutoCompoundDelegators(
+				cmd.Context(),
+				&types.QueryAutoCompoundDelegatorsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auto-compound delegators")
+	return cmd
+}
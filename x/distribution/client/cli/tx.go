@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -41,6 +42,9 @@ func NewTxCmd() *cobra.Command {
 		NewWithdrawAllRewardsCmd(),
 		NewSetWithdrawAddrCmd(),
 		NewFundCommunityPoolCmd(),
+		NewWithdrawTokenizeShareRecordRewardCmd(),
+		NewWithdrawAllDelegatorRewardsCmd(),
+		NewSetAutoCompoundCmd(),
 	)
 
 	return distTxCmd
@@ -260,6 +264,110 @@ $ %s tx distribution fund-community-pool 100uatom --from mykey
 	return cmd
 }
 
+// NewWithdrawTokenizeShareRecordRewardCmd returns a CLI command handler for creating a MsgWithdrawTokenizeShareRecordReward transaction.
+func NewWithdrawTokenizeShareRecordRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-tokenize-share-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw the rewards of the owned tokenize share records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of the tokenize share records owned by the sender.
+
+Example:
+$ %s tx distribution withdraw-tokenize-share-rewards --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawTokenizeShareRecordReward(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewWithdrawAllDelegatorRewardsCmd returns a CLI command handler for creating a MsgWithdrawAllDelegatorRewards transaction.
+func NewWithdrawAllDelegatorRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-all-delegator-rewards",
+		Args:  cobra.NoArgs,
+		Short: "Withdraw all delegations rewards for a delegator in a single message",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw the rewards of all the delegations of the sender in a single message.
+Unlike withdraw-all-rewards, the delegations are not queried, so the transaction can be
+generated offline.
+
+Example:
+$ %s tx distribution withdraw-all-delegator-rewards --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawAllDelegatorRewards(clientCtx.GetFromAddress())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetAutoCompoundCmd returns a CLI command handler for creating a MsgSetAutoCompound transaction.
+func NewSetAutoCompoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound [true|false]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Enable or disable the auto-compounding of the delegations rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable or disable the auto-compounding of the rewards of the sender. The rewards
+are periodically withdrawn and delegated back to the same validators. Auto-compounding
+requires the rewards to be withdrawn to the delegator address.
+
+Example:
+$ %s tx distribution set-auto-compound true --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return fmt.Errorf("%s is not a valid bool, please input true or false", args[0])
+			}
+
+			msg := types.NewMsgSetAutoCompound(clientCtx.GetFromAddress(), enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdSubmitProposal implements the command to submit a community-pool-spend proposal
 func GetCmdSubmitProposal() *cobra.Command {
 	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
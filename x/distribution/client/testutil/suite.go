@@ -80,12 +80,14 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","liquidity_provider_reward":"0.000000000000000000","withdraw_addr_enabled":true}`,
+			`{"community_tax":"0.020000000000000000","base_proposer_reward":"0.010000000000000000","bonus_proposer_reward":"0.040000000000000000","liquidity_provider_reward":"0.000000000000000000","withdraw_addr_enabled":true,"auto_compound_batch_size":"100","auto_compound_max_gas":"10000000"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			`base_proposer_reward: "0.010000000000000000"
+			`auto_compound_batch_size: "100"
+auto_compound_max_gas: "10000000"
+base_proposer_reward: "0.010000000000000000"
 bonus_proposer_reward: "0.040000000000000000"
 community_tax: "0.020000000000000000"
 liquidity_provider_reward: "0.000000000000000000"
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the rewards of a delegator.
+// Auto-compounding can only be enabled while the rewards are withdrawn to the delegator.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, enabled bool) error {
+	if !enabled {
+		k.DeleteAutoCompoundDelegator(ctx, delAddr)
+		return nil
+	}
+
+	if !k.GetDelegatorWithdrawAddr(ctx, delAddr).Equals(delAddr) {
+		return types.ErrAutoCompoundWithdrawAddr
+	}
+
+	k.SetAutoCompoundDelegator(ctx, delAddr)
+	return nil
+}
+
+// GetAutoCompound returns whether the rewards of a delegator are auto-compounded
+func (k Keeper) GetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// SetAutoCompoundDelegator marks a delegator as auto-compounding its rewards
+func (k Keeper) SetAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAutoCompoundDelegatorKey(delAddr), []byte{})
+}
+
+// DeleteAutoCompoundDelegator stops the auto-compounding of the rewards of a delegator
+func (k Keeper) DeleteAutoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAutoCompoundDelegatorKey(delAddr))
+}
+
+// IterateAutoCompoundDelegators iterates over the auto-compounding delegators, by address
+func (k Keeper) IterateAutoCompoundDelegators(ctx sdk.Context, handler func(delAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundDelegatorPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(types.GetAutoCompoundDelegatorAddress(iter.Key())) {
+			break
+		}
+	}
+}
+
+// GetAutoCompoundCursor returns the next auto-compounding delegator to process, if any
+func (k Keeper) GetAutoCompoundCursor(ctx sdk.Context) (delAddr sdk.AccAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.AutoCompoundCursorKey)
+	if b == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(b), true
+}
+
+// SetAutoCompoundCursor sets the next auto-compounding delegator to process
+func (k Keeper) SetAutoCompoundCursor(ctx sdk.Context, delAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoCompoundCursorKey, delAddr.Bytes())
+}
+
+// DeleteAutoCompoundCursor restarts the auto-compounding from the first delegator
+func (k Keeper) DeleteAutoCompoundCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoCompoundCursorKey)
+}
+
+// ProcessAutoCompounding restakes the rewards of the next batch of auto-compounding delegators.
+// At most AutoCompoundBatchSize delegators are processed per block, and their processing may
+// consume at most AutoCompoundMaxGas gas. The delegator exhausting the gas budget is reverted
+// and retried first in the next block, unless it was the first one processed in this block,
+// in which case it is skipped so that a single delegator cannot stall the queue.
+func (k Keeper) ProcessAutoCompounding(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.AutoCompoundBatchSize == 0 || params.AutoCompoundMaxGas == 0 {
+		return
+	}
+
+	start := types.AutoCompoundDelegatorPrefix
+	if cursor, found := k.GetAutoCompoundCursor(ctx); found {
+		start = types.GetAutoCompoundDelegatorKey(cursor)
+	}
+
+	// collect the batch first, the processing writes to the store being iterated
+	var (
+		batch []sdk.AccAddress
+		next  sdk.AccAddress
+	)
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundDelegatorPrefix))
+	for ; iter.Valid(); iter.Next() {
+		delAddr := types.GetAutoCompoundDelegatorAddress(iter.Key())
+		if uint64(len(batch)) == params.AutoCompoundBatchSize {
+			next = delAddr
+			break
+		}
+		batch = append(batch, delAddr)
+	}
+	iter.Close()
+
+	gasUsed := uint64(0)
+	for i, delAddr := range batch {
+		gasLimit := params.AutoCompoundMaxGas - gasUsed
+		consumed, ok := k.autoCompoundDelegator(ctx, delAddr, gasLimit)
+		ctx.GasMeter().ConsumeGas(consumed, "auto-compound rewards")
+		// the gas of a skipped delegator counts against the budget as well
+		gasUsed += consumed
+		if !ok {
+			if i == 0 {
+				k.Logger(ctx).Error("auto-compounding exceeds the gas limit, skipping delegator", "delegator", delAddr.String())
+				continue
+			}
+			next = delAddr
+			break
+		}
+	}
+
+	if next == nil {
+		k.DeleteAutoCompoundCursor(ctx)
+		return
+	}
+	k.SetAutoCompoundCursor(ctx, next)
+}
+
+// autoCompoundDelegator withdraws the rewards of all the delegations of a delegator and
+// delegates them back to the same validators, within the given gas limit. The changes are
+// only committed if the gas limit is not exceeded.
+func (k Keeper) autoCompoundDelegator(ctx sdk.Context, delAddr sdk.AccAddress, gasLimit uint64) (gasUsed uint64, ok bool) {
+	cacheCtx, writeCache := ctx.WithGasMeter(sdk.NewGasMeter(gasLimit)).CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, isOutOfGas := r.(sdk.ErrorOutOfGas); !isOutOfGas {
+				panic(r)
+			}
+			gasUsed, ok = gasLimit, false
+		}
+	}()
+
+	// the rewards are restaked from the delegator account
+	if !k.GetDelegatorWithdrawAddr(cacheCtx, delAddr).Equals(delAddr) {
+		return cacheCtx.GasMeter().GasConsumed(), true
+	}
+
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(cacheCtx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+
+	bondDenom := k.stakingKeeper.BondDenom(cacheCtx)
+	compounded := sdk.Coins{}
+	for _, valAddr := range valAddrs {
+		// the withdrawal of the rewards of a delegation is reverted when they
+		// cannot be restaked
+		delCtx, writeDel := cacheCtx.CacheContext()
+		rewards, err := k.WithdrawDelegationRewards(delCtx, delAddr, valAddr)
+		if err != nil {
+			k.Logger(ctx).Error("failed to withdraw rewards for auto-compounding", "delegator", delAddr.String(), "validator", valAddr.String(), "err", err)
+			continue
+		}
+
+		amount := rewards.AmountOf(bondDenom)
+		if amount.IsPositive() {
+			validator, found := k.stakingKeeper.GetValidator(delCtx, valAddr)
+			if !found {
+				continue
+			}
+
+			if _, err := k.stakingKeeper.Delegate(delCtx, delAddr, amount, stakingtypes.Unbonded, validator, true); err != nil {
+				k.Logger(ctx).Error("failed to restake rewards for auto-compounding", "delegator", delAddr.String(), "validator", valAddr.String(), "err", err)
+				continue
+			}
+			compounded = compounded.Add(sdk.NewCoin(bondDenom, amount))
+		}
+		writeDel()
+	}
+
+	cacheCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.String()),
+		),
+	)
+
+	writeCache()
+	return cacheCtx.GasMeter().GasConsumed(), true
+}
@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	"bytes"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+)
+
+func TestSetAutoCompound(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[0], true))
+	require.True(t, app.DistrKeeper.GetAutoCompound(ctx, addr[0]))
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addr[1]))
+	require.Equal(t, []string{addr[0].String()}, app.DistrKeeper.ExportGenesis(ctx).AutoCompoundDelegators)
+
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, addr[0], false))
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addr[0]))
+
+	// the rewards must be withdrawn to the delegator to be restaked
+	require.NoError(t, app.DistrKeeper.SetWithdrawAddr(ctx, addr[0], addr[1]))
+	require.ErrorIs(t, app.DistrKeeper.SetAutoCompound(ctx, addr[0], true), types.ErrAutoCompoundWithdrawAddr)
+	require.False(t, app.DistrKeeper.GetAutoCompound(ctx, addr[0]))
+}
+
+func TestProcessAutoCompounding(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delegators := addr[2:]
+	// the delegators are processed by address
+	sort.Slice(delegators, func(i, j int) bool { return bytes.Compare(delegators[i], delegators[j]) < 0 })
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create two validators with no commission, each delegator delegates to both
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], valConsPk2, 100, true)
+	for _, delegator := range delegators {
+		tstaking.DelegateWithPower(delegator, valAddrs[0], 100)
+		tstaking.DelegateWithPower(delegator, valAddrs[1], 100)
+		require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, delegator, true))
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	allocate := func() {
+		initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+		for _, valAddr := range valAddrs[:2] {
+			val := app.StakingKeeper.Validator(ctx, valAddr)
+			app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+		}
+	}
+	delegatedTokens := func(delegator sdk.AccAddress) sdk.Dec {
+		total := sdk.ZeroDec()
+		for _, valAddr := range valAddrs[:2] {
+			val := app.StakingKeeper.Validator(ctx, valAddr)
+			del := app.StakingKeeper.Delegation(ctx, delegator, valAddr)
+			total = total.Add(val.TokensFromShares(del.GetShares()))
+		}
+		return total
+	}
+	initialTokens := delegatedTokens(delegators[0])
+	balances := []sdk.Coin{
+		app.BankKeeper.GetBalance(ctx, delegators[0], sdk.DefaultBondDenom),
+		app.BankKeeper.GetBalance(ctx, delegators[1], sdk.DefaultBondDenom),
+	}
+
+	// process one delegator per block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundBatchSize = 1
+	app.DistrKeeper.SetParams(ctx, params)
+	allocate()
+
+	distribution.EndBlocker(ctx, app.DistrKeeper)
+	require.True(t, delegatedTokens(delegators[0]).GT(initialTokens))
+	require.True(t, delegatedTokens(delegators[1]).Equal(initialTokens))
+	cursor, found := app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+	require.Equal(t, delegators[1], cursor)
+
+	// the next block processes the last delegator and restarts from the first one
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	distribution.EndBlocker(ctx, app.DistrKeeper)
+	require.True(t, delegatedTokens(delegators[1]).Equal(delegatedTokens(delegators[0])))
+	_, found = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+
+	// the rewards are restaked, not sent to the delegators
+	for i, delegator := range delegators {
+		require.Equal(t, balances[i], app.BankKeeper.GetBalance(ctx, delegator, sdk.DefaultBondDenom))
+	}
+
+	// a delegator exceeding the gas budget on its own is skipped without changes
+	params.AutoCompoundMaxGas = 1
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	allocate()
+	compoundedTokens := delegatedTokens(delegators[0])
+
+	distribution.EndBlocker(ctx, app.DistrKeeper)
+	require.True(t, delegatedTokens(delegators[0]).Equal(compoundedTokens))
+	cursor, found = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+	require.Equal(t, delegators[1], cursor)
+
+	// disabled delegators are not processed
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, delegators[1], false))
+	params.AutoCompoundMaxGas = types.DefaultAutoCompoundMaxGas
+	app.DistrKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	compoundedTokens = delegatedTokens(delegators[1])
+
+	distribution.EndBlocker(ctx, app.DistrKeeper)
+	require.True(t, delegatedTokens(delegators[1]).Equal(compoundedTokens))
+	_, found = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+}
+
+func TestProcessAutoCompoundingGasBudget(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delegators := addr[1:]
+	// the delegators are processed by address
+	sort.Slice(delegators, func(i, j int) bool { return bytes.Compare(delegators[i], delegators[j]) < 0 })
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	for _, delegator := range delegators {
+		tstaking.DelegateWithPower(delegator, valAddrs[0], 100)
+		require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, delegator, true))
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	shares := []sdk.Dec{
+		app.StakingKeeper.Delegation(ctx, delegators[0], valAddrs[0]).GetShares(),
+		app.StakingKeeper.Delegation(ctx, delegators[1], valAddrs[0]).GetShares(),
+	}
+
+	// measure the gas used to compound the first delegator
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(cacheCtx, delegators[1], false))
+	distribution.EndBlocker(cacheCtx, app.DistrKeeper)
+	gasUsed := cacheCtx.GasMeter().GasConsumed()
+	require.Positive(t, gasUsed)
+
+	// the budget only covers the first delegator, the second one is retried in the next block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundMaxGas = gasUsed + gasUsed/2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	distribution.EndBlocker(ctx, app.DistrKeeper)
+	require.True(t, app.StakingKeeper.Delegation(ctx, delegators[0], valAddrs[0]).GetShares().GT(shares[0]))
+	require.True(t, app.StakingKeeper.Delegation(ctx, delegators[1], valAddrs[0]).GetShares().Equal(shares[1]))
+	cursor, found := app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+	require.Equal(t, delegators[1], cursor)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	distribution.EndBlocker(ctx, app.DistrKeeper)
+	require.True(t, app.StakingKeeper.Delegation(ctx, delegators[1], valAddrs[0]).GetShares().GT(shares[1]))
+	_, found = app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.False(t, found)
+}
+
+func TestProcessAutoCompoundingSkippedDelegatorGas(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simapp.AddTestAddrs(app, ctx, 4, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delegators := addr[2:]
+	// the delegators are processed by address
+	sort.Slice(delegators, func(i, j int) bool { return bytes.Compare(delegators[i], delegators[j]) < 0 })
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// the first delegator delegates to both validators, the second one to a single validator
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], valConsPk2, 100, true)
+	tstaking.DelegateWithPower(delegators[0], valAddrs[0], 100)
+	tstaking.DelegateWithPower(delegators[0], valAddrs[1], 100)
+	tstaking.DelegateWithPower(delegators[1], valAddrs[0], 100)
+	for _, delegator := range delegators {
+		require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, delegator, true))
+	}
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 30)
+	for _, valAddr := range valAddrs[:2] {
+		val := app.StakingKeeper.Validator(ctx, valAddr)
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	}
+	shares := app.StakingKeeper.Delegation(ctx, delegators[1], valAddrs[0]).GetShares()
+
+	// measure the gas used to compound each delegator on its own
+	measureGas := func(disabled sdk.AccAddress) uint64 {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		require.NoError(t, app.DistrKeeper.SetAutoCompound(cacheCtx, disabled, false))
+		distribution.EndBlocker(cacheCtx, app.DistrKeeper)
+		return cacheCtx.GasMeter().GasConsumed()
+	}
+	gasUsed := []uint64{
+		measureGas(delegators[1]),
+		measureGas(delegators[0]),
+	}
+	require.Greater(t, gasUsed[0], gasUsed[1])
+
+	// the budget covers the second delegator only, the first one is skipped and
+	// exhausts the budget of the block
+	params := app.DistrKeeper.GetParams(ctx)
+	params.AutoCompoundMaxGas = (gasUsed[0] + gasUsed[1]) / 2
+	app.DistrKeeper.SetParams(ctx, params)
+
+	distribution.EndBlocker(ctx, app.DistrKeeper)
+	require.True(t, app.StakingKeeper.Delegation(ctx, delegators[1], valAddrs[0]).GetShares().Equal(shares))
+	cursor, found := app.DistrKeeper.GetAutoCompoundCursor(ctx)
+	require.True(t, found)
+	require.Equal(t, delegators[1], cursor)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	distribution.EndBlocker(ctx, app.DistrKeeper)
+	require.True(t, app.StakingKeeper.Delegation(ctx, delegators[1], valAddrs[0]).GetShares().GT(shares))
+}
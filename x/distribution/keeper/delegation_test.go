@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -704,3 +705,104 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simapp.AddTestAddrs(app, ctx, 2, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	owner := addr[1]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// create validator with no commission, and tokenize half of its self-delegation
+	valTokens := tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	record, shareToken, err := app.StakingKeeper.TokenizeShares(
+		ctx, addr[0], valAddrs[0], sdk.NewCoin(sdk.DefaultBondDenom, valTokens.QuoRaw(2)), owner,
+	)
+	require.NoError(t, err)
+	ownerBalance := app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+	// the record earns half of the rewards, withdrawn by its owner
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	val := app.StakingKeeper.Validator(ctx, valAddrs[0])
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+
+	rewards, err := app.DistrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial.QuoRaw(2))), rewards)
+	ownerBalance = ownerBalance.AddAmount(initial.QuoRaw(2))
+	require.Equal(t, ownerBalance, app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+
+	// the rewards left when the record is removed are sent to its owner
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	_, err = app.StakingKeeper.RedeemTokensForShares(ctx, addr[0], shareToken)
+	require.NoError(t, err)
+
+	_, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.False(t, found)
+	ownerBalance = ownerBalance.AddAmount(initial.QuoRaw(2))
+	require.Equal(t, ownerBalance, app.BankKeeper.GetBalance(ctx, owner, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, record.GetModuleAddress()).IsZero())
+}
+
+func TestWithdrawAllDelegationRewards(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	balanceTokens := app.StakingKeeper.TokensFromConsensusPower(ctx, 1000)
+	addr := simapp.AddTestAddrs(app, ctx, 3, sdk.NewInt(1000000000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addr)
+	delegator := addr[2]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	// set module account coins
+	distrAcc := app.DistrKeeper.GetDistributionAccount(ctx)
+	require.NoError(t, testutil.FundModuleAccount(app.BankKeeper, ctx, distrAcc.GetName(), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, balanceTokens))))
+	app.AccountKeeper.SetModuleAccount(ctx, distrAcc)
+
+	// no delegations to withdraw from
+	_, err := app.DistrKeeper.WithdrawAllDelegationRewards(ctx, delegator)
+	require.ErrorIs(t, err, types.ErrNoDelegationExists)
+
+	// create two validators with no commission, the delegator holds half of each
+	tstaking.CreateValidatorWithValPower(valAddrs[0], valConsPk1, 100, true)
+	tstaking.CreateValidatorWithValPower(valAddrs[1], valConsPk2, 100, true)
+	tstaking.DelegateWithPower(delegator, valAddrs[0], 100)
+	tstaking.DelegateWithPower(delegator, valAddrs[1], 100)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	balance := app.BankKeeper.GetBalance(ctx, delegator, sdk.DefaultBondDenom)
+
+	// allocate rewards to both validators
+	initial := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	for _, valAddr := range valAddrs[:2] {
+		val := app.StakingKeeper.Validator(ctx, valAddr)
+		app.DistrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoin(sdk.DefaultBondDenom, initial)})
+	}
+
+	// the rewards of both delegations are withdrawn at once
+	rewards, err := app.DistrKeeper.WithdrawAllDelegationRewards(ctx, delegator)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, initial)), rewards)
+	require.Equal(t, balance.AddAmount(initial), app.BankKeeper.GetBalance(ctx, delegator, sdk.DefaultBondDenom))
+
+	for _, valAddr := range valAddrs[:2] {
+		val := app.StakingKeeper.Validator(ctx, valAddr)
+		del := app.StakingKeeper.Delegation(ctx, delegator, valAddr)
+		endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, val)
+		require.True(t, app.DistrKeeper.CalculateDelegationRewards(ctx, val, del, endingPeriod).IsZero())
+	}
+}
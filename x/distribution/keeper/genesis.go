@@ -76,6 +76,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		}
 		k.SetValidatorSlashEvent(ctx, valAddr, evt.Height, evt.Period, evt.ValidatorSlashEvent)
 	}
+	for _, delegator := range data.AutoCompoundDelegators {
+		k.SetAutoCompoundDelegator(ctx, sdk.MustAccAddressFromBech32(delegator))
+	}
 
 	moduleHoldings = moduleHoldings.Add(data.FeePool.CommunityPool...)
 	moduleHoldings = moduleHoldings.Add(data.FeePool.LiquidityProviderPool...)
@@ -182,5 +185,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		},
 	)
 
-	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes)
+	autoCompound := make([]string, 0)
+	k.IterateAutoCompoundDelegators(ctx, func(del sdk.AccAddress) (stop bool) {
+		autoCompound = append(autoCompound, del.String())
+		return false
+	})
+
+	return types.NewGenesisState(params, feePool, dwi, pp, outstanding, acc, his, cur, dels, slashes, autoCompound)
 }
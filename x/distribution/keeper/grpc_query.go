@@ -251,3 +251,44 @@ func (k Keeper) LiquidityProviderRewards(c context.Context, req *types.QueryLiqu
 
 	return &types.QueryLiquidityProviderRewardsResponse{Pool: pool}, nil
 }
+
+// DelegatorAutoCompound queries whether the rewards of a delegator are auto-compounded
+func (k Keeper) DelegatorAutoCompound(c context.Context, req *types.QueryDelegatorAutoCompoundRequest) (*types.QueryDelegatorAutoCompoundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryDelegatorAutoCompoundResponse{Enabled: k.GetAutoCompound(ctx, delAdr)}, nil
+}
+
+// AutoCompoundDelegators queries the delegators auto-compounding their rewards
+func (k Keeper) AutoCompoundDelegators(c context.Context, req *types.QueryAutoCompoundDelegatorsRequest) (*types.QueryAutoCompoundDelegatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoCompoundDelegatorPrefix)
+
+	var delegators []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		// the key is the length-prefixed delegator address
+		delegators = append(delegators, sdk.AccAddress(key[1:]).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAutoCompoundDelegatorsResponse{Delegators: delegators, Pagination: pageRes}, nil
+}
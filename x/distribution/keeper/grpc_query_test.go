@@ -656,6 +656,46 @@ func (suite *KeeperTestSuite) TestGRPCCommunityPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCDelegatorAutoCompound() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	suite.Require().NoError(app.DistrKeeper.SetAutoCompound(ctx, addrs[0], true))
+
+	_, err := queryClient.DelegatorAutoCompound(gocontext.Background(), &types.QueryDelegatorAutoCompoundRequest{})
+	suite.Require().Error(err)
+
+	res, err := queryClient.DelegatorAutoCompound(gocontext.Background(), &types.QueryDelegatorAutoCompoundRequest{DelegatorAddress: addrs[0].String()})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Enabled)
+
+	res, err = queryClient.DelegatorAutoCompound(gocontext.Background(), &types.QueryDelegatorAutoCompoundRequest{DelegatorAddress: addrs[1].String()})
+	suite.Require().NoError(err)
+	suite.Require().False(res.Enabled)
+}
+
+func (suite *KeeperTestSuite) TestGRPCAutoCompoundDelegators() {
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	res, err := queryClient.AutoCompoundDelegators(gocontext.Background(), &types.QueryAutoCompoundDelegatorsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Delegators)
+
+	for _, addr := range addrs {
+		suite.Require().NoError(app.DistrKeeper.SetAutoCompound(ctx, addr, true))
+	}
+
+	res, err = queryClient.AutoCompoundDelegators(gocontext.Background(), &types.QueryAutoCompoundDelegatorsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Delegators, 1)
+	suite.Require().Equal(uint64(len(addrs)), res.Pagination.Total)
+
+	res, err = queryClient.AutoCompoundDelegators(gocontext.Background(), &types.QueryAutoCompoundDelegatorsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]string{addrs[0].String(), addrs[1].String()}, res.Delegators)
+}
+
 func TestDistributionTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -121,3 +122,19 @@ func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _
 func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
 	return nil
 }
+
+// BeforeTokenizeShareRecordRemoved sends the rewards withdrawn to the module account of the
+// tokenize share record to its owner
+func (h Hooks) BeforeTokenizeShareRecordRemoved(ctx sdk.Context, recordID uint64) error {
+	record, found := h.k.stakingKeeper.GetTokenizeShareRecord(ctx, recordID)
+	if !found {
+		return stakingtypes.ErrTokenizeShareRecordNotExists
+	}
+
+	_, err := h.k.sendTokenizeShareRecordBalance(ctx, record)
+	return err
+}
+
+func (h Hooks) AfterConsensusPubKeyUpdate(_ sdk.Context, _, _ cryptotypes.PubKey, _ sdk.Coin) error {
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // Keeper of the distribution store
@@ -109,6 +110,31 @@ func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddres
 	return rewards, nil
 }
 
+// WithdrawAllDelegationRewards withdraws the rewards of all the delegations of a delegator
+// and returns the total amount withdrawn.
+func (k Keeper) WithdrawAllDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.Coins, error) {
+	// collect the validators first, the withdrawals modify the delegations being iterated
+	var valAddrs []sdk.ValAddress
+	k.stakingKeeper.IterateDelegations(ctx, delAddr, func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+		valAddrs = append(valAddrs, del.GetValidatorAddr())
+		return false
+	})
+	if len(valAddrs) == 0 {
+		return nil, types.ErrNoDelegationExists
+	}
+
+	totalRewards := sdk.Coins{}
+	for _, valAddr := range valAddrs {
+		rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		if err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	return totalRewards, nil
+}
+
 // withdraw validator commission
 func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	// fetch validator accumulated commission
@@ -143,6 +169,62 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	return commission, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegations of the tokenize share
+// records of the owner, and sends them to the owner along with the rewards already withdrawn to
+// the module accounts of the records.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx sdk.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	totalRewards := sdk.Coins{}
+
+	for _, record := range k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr) {
+		valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		recordAddr := record.GetModuleAddress()
+		if k.stakingKeeper.Validator(ctx, valAddr) != nil && k.stakingKeeper.Delegation(ctx, recordAddr, valAddr) != nil {
+			if _, err := k.WithdrawDelegationRewards(ctx, recordAddr, valAddr); err != nil {
+				return nil, err
+			}
+		}
+
+		rewards, err := k.sendTokenizeShareRecordBalance(ctx, record)
+		if err != nil {
+			return nil, err
+		}
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawTokenizeShareReward,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, ownerAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+		),
+	)
+
+	return totalRewards, nil
+}
+
+// sendTokenizeShareRecordBalance sends the balance of the module account of the tokenize share
+// record, which holds the rewards withdrawn from its delegation, to the record owner.
+func (k Keeper) sendTokenizeShareRecordBalance(ctx sdk.Context, record stakingtypes.TokenizeShareRecord) (sdk.Coins, error) {
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	balance := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+	if balance.IsZero() {
+		return balance, nil
+	}
+	if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, balance); err != nil {
+		return nil, err
+	}
+	return balance, nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	k.IterateValidatorOutstandingRewards(ctx,
@@ -0,0 +1,23 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateStore performs in-place store migrations for the rewards
+// auto-compounding. The migration includes:
+//
+// - Setting the AutoCompoundBatchSize and AutoCompoundMaxGas params in the
+// paramstore
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.ParamStoreKeyAutoCompoundBatchSize, types.DefaultAutoCompoundBatchSize)
+	paramstore.Set(ctx, types.ParamStoreKeyAutoCompoundMaxGas, types.DefaultAutoCompoundMaxGas)
+
+	return nil
+}
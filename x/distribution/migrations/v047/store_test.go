@@ -0,0 +1,38 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v047distribution "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	distributionKey := sdk.NewKVStoreKey("distribution")
+	tDistributionKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(distributionKey, tDistributionKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, distributionKey, tDistributionKey, "distribution").
+		WithKeyTable(types.ParamKeyTable())
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyAutoCompoundBatchSize))
+	require.False(t, paramstore.Has(ctx, types.ParamStoreKeyAutoCompoundMaxGas))
+
+	// Run migrations.
+	err := v047distribution.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	var batchSize, maxGas uint64
+	paramstore.Get(ctx, types.ParamStoreKeyAutoCompoundBatchSize, &batchSize)
+	paramstore.Get(ctx, types.ParamStoreKeyAutoCompoundMaxGas, &maxGas)
+	require.Equal(t, types.DefaultAutoCompoundBatchSize, batchSize)
+	require.Equal(t, types.DefaultAutoCompoundMaxGas, maxGas)
+}
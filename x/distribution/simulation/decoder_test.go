@@ -46,6 +46,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 			{Key: types.GetValidatorCurrentRewardsKey(valAddr1), Value: cdc.MustMarshal(&currentRewards)},
 			{Key: types.GetValidatorAccumulatedCommissionKey(valAddr1), Value: cdc.MustMarshal(&commission)},
 			{Key: types.GetValidatorSlashEventKeyPrefix(valAddr1, 13), Value: cdc.MustMarshal(&slashEvent)},
+			{Key: types.GetAutoCompoundDelegatorKey(delAddr1), Value: []byte{}},
+			{Key: types.AutoCompoundCursorKey, Value: delAddr1.Bytes()},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -63,6 +65,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"ValidatorCurrentRewards", fmt.Sprintf("%v\n%v", currentRewards, currentRewards)},
 		{"ValidatorAccumulatedCommission", fmt.Sprintf("%v\n%v", commission, commission)},
 		{"ValidatorSlashEvent", fmt.Sprintf("%v\n%v", slashEvent, slashEvent)},
+		{"AutoCompoundDelegator", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
+		{"AutoCompoundCursor", fmt.Sprintf("%v\n%v", delAddr1, delAddr1)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
@@ -18,6 +19,8 @@ const (
 	BaseProposerReward  = "base_proposer_reward"
 	BonusProposerReward = "bonus_proposer_reward"
 	WithdrawEnabled     = "withdraw_enabled"
+	AutoCompoundBatch   = "auto_compound_batch_size"
+	AutoCompoundMaxGas  = "auto_compound_max_gas"
 )
 
 // GenCommunityTax randomized CommunityTax
@@ -40,6 +43,16 @@ func GenWithdrawEnabled(r *rand.Rand) bool {
 	return r.Int63n(101) <= 95 // 95% chance of withdraws being enabled
 }
 
+// GenAutoCompoundBatchSize randomized AutoCompoundBatchSize
+func GenAutoCompoundBatchSize(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 200))
+}
+
+// GenAutoCompoundMaxGas randomized AutoCompoundMaxGas
+func GenAutoCompoundMaxGas(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1_000_000, 20_000_000))
+}
+
 // RandomizedGenState generates a random GenesisState for distribution
 func RandomizedGenState(simState *module.SimulationState) {
 	var communityTax sdk.Dec
@@ -66,13 +79,27 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { withdrawEnabled = GenWithdrawEnabled(r) },
 	)
 
+	var autoCompoundBatchSize uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundBatch, &autoCompoundBatchSize, simState.Rand,
+		func(r *rand.Rand) { autoCompoundBatchSize = GenAutoCompoundBatchSize(r) },
+	)
+
+	var autoCompoundMaxGas uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AutoCompoundMaxGas, &autoCompoundMaxGas, simState.Rand,
+		func(r *rand.Rand) { autoCompoundMaxGas = GenAutoCompoundMaxGas(r) },
+	)
+
 	distrGenesis := types.GenesisState{
 		FeePool: types.InitialFeePool(),
 		Params: types.Params{
-			CommunityTax:        communityTax,
-			BaseProposerReward:  baseProposerReward,
-			BonusProposerReward: bonusProposerReward,
-			WithdrawAddrEnabled: withdrawEnabled,
+			CommunityTax:          communityTax,
+			BaseProposerReward:    baseProposerReward,
+			BonusProposerReward:   bonusProposerReward,
+			WithdrawAddrEnabled:   withdrawEnabled,
+			AutoCompoundBatchSize: autoCompoundBatchSize,
+			AutoCompoundMaxGas:    autoCompoundMaxGas,
 		},
 	}
 
@@ -18,6 +18,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgWithdrawValidatorCommission{}, "cosmos-sdk/MsgWithdrawValCommission")
 	legacy.RegisterAminoMsg(cdc, &MsgSetWithdrawAddress{}, "cosmos-sdk/MsgModifyWithdrawAddress")
 	legacy.RegisterAminoMsg(cdc, &MsgFundCommunityPool{}, "cosmos-sdk/MsgFundCommunityPool")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawTokenizeShareRecordReward{}, "cosmos-sdk/MsgWithdrawTokenizeReward")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawAllDelegatorRewards{}, "cosmos-sdk/MsgWithdrawAllRewards")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAutoCompound{}, "cosmos-sdk/MsgSetAutoCompound")
 	cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "cosmos-sdk/CommunityPoolSpendProposal", nil)
 }
 
@@ -28,6 +31,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawValidatorCommission{},
 		&MsgSetWithdrawAddress{},
 		&MsgFundCommunityPool{},
+		&MsgWithdrawTokenizeShareRecordReward{},
+		&MsgWithdrawAllDelegatorRewards{},
+		&MsgSetAutoCompound{},
 	)
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
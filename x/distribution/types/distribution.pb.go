@@ -33,6 +33,12 @@ type Params struct {
 	BonusProposerReward     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bonus_proposer_reward"`
 	LiquidityProviderReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=liquidity_provider_reward,json=liquidityProviderReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_provider_reward"`
 	WithdrawAddrEnabled     bool                                   `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_batch_size is the maximum number of auto-compounding
+	// delegators whose rewards are restaked per block.
+	AutoCompoundBatchSize uint64 `protobuf:"varint,6,opt,name=auto_compound_batch_size,json=autoCompoundBatchSize,proto3" json:"auto_compound_batch_size,omitempty"`
+	// auto_compound_max_gas is the gas budget per block for restaking the
+	// rewards of the auto-compounding delegators.
+	AutoCompoundMaxGas uint64 `protobuf:"varint,7,opt,name=auto_compound_max_gas,json=autoCompoundMaxGas,proto3" json:"auto_compound_max_gas,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -74,6 +80,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundBatchSize() uint64 {
+	if m != nil {
+		return m.AutoCompoundBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetAutoCompoundMaxGas() uint64 {
+	if m != nil {
+		return m.AutoCompoundMaxGas
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -81,11 +101,12 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 // The reference count indicates the number of objects
 // which might need to reference this historical entry at any point.
 // ReferenceCount =
-//    number of outstanding delegations which ended the associated period (and
-//    might need to read that record)
-//  + number of slashes which ended the associated period (and might need to
-//  read that record)
-//  + one per validator for the zeroeth period, set on initialization
+//
+//	  number of outstanding delegations which ended the associated period (and
+//	  might need to read that record)
+//	+ number of slashes which ended the associated period (and might need to
+//	read that record)
+//	+ one per validator for the zeroeth period, set on initialization
 type ValidatorHistoricalRewards struct {
 	CumulativeRewardRatio github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_reward_ratio,json=cumulativeRewardRatio,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_reward_ratio"`
 	ReferenceCount        uint32                                      `protobuf:"varint,2,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
@@ -637,69 +658,73 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 983 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xa6, 0x8e, 0x93, 0xbe, 0xd2, 0x04, 0x26, 0x4e, 0xe2, 0xb8, 0x95, 0x1d, 0x59, 0xa2,
-	0x04, 0xaa, 0x38, 0x4d, 0x7b, 0x8b, 0xb8, 0xc4, 0x4e, 0x10, 0x9c, 0x6a, 0x6d, 0x10, 0x20, 0x2e,
-	0xab, 0xf1, 0xee, 0xc4, 0x1e, 0x65, 0x3d, 0xb3, 0x9d, 0x99, 0x75, 0xdc, 0x23, 0xe2, 0x02, 0x5c,
-	0x40, 0xe2, 0x82, 0x38, 0xa0, 0x1c, 0x11, 0xe7, 0x7c, 0x01, 0x6e, 0x15, 0xa7, 0xd2, 0x0b, 0x88,
-	0x43, 0x40, 0xc9, 0x05, 0xf1, 0x29, 0xd0, 0xec, 0x8c, 0x77, 0x1d, 0x6a, 0xa2, 0x1c, 0x6c, 0xf5,
-	0x64, 0xcf, 0x7b, 0xbb, 0xbf, 0x3f, 0x6f, 0xdf, 0xbc, 0x19, 0xa8, 0xfb, 0x5c, 0xf6, 0xb8, 0xdc,
-	0x0a, 0xa8, 0x54, 0x82, 0xb6, 0x63, 0x45, 0x39, 0xdb, 0xea, 0x6f, 0xb7, 0x89, 0xc2, 0xdb, 0x97,
-	0x82, 0xf5, 0x48, 0x70, 0xc5, 0xd1, 0x1d, 0xf3, 0x7c, 0xfd, 0x52, 0xca, 0x3e, 0x5f, 0x2e, 0x76,
-	0x78, 0x87, 0x27, 0xcf, 0x6d, 0xe9, 0x7f, 0xe6, 0x95, 0x72, 0xc5, 0x52, 0xb4, 0xb1, 0x24, 0x29,
-	0xb4, 0xcf, 0xa9, 0x85, 0x2c, 0xaf, 0x99, 0xbc, 0x67, 0x5e, 0xb4, 0xf8, 0xc9, 0xa2, 0xf6, 0x59,
-	0x1e, 0x0a, 0x2d, 0x2c, 0x70, 0x4f, 0x22, 0x0c, 0xb7, 0x7d, 0xde, 0xeb, 0xc5, 0x8c, 0xaa, 0xa7,
-	0x9e, 0xc2, 0x83, 0x92, 0xb3, 0xee, 0x6c, 0xdc, 0x6c, 0xbc, 0xfb, 0xec, 0xac, 0x9a, 0xfb, 0xe3,
-	0xac, 0x7a, 0xaf, 0x43, 0x55, 0x37, 0x6e, 0xd7, 0x7d, 0xde, 0xb3, 0x10, 0xf6, 0x67, 0x53, 0x06,
-	0x47, 0x5b, 0xea, 0x69, 0x44, 0x64, 0x7d, 0x8f, 0xf8, 0x2f, 0x4e, 0x37, 0xc1, 0x32, 0xec, 0x11,
-	0xdf, 0x7d, 0x2d, 0x85, 0xfc, 0x10, 0x0f, 0x10, 0x83, 0xa2, 0xd6, 0xa8, 0x85, 0x44, 0x5c, 0x12,
-	0xe1, 0x09, 0x72, 0x8c, 0x45, 0x50, 0x9a, 0x99, 0x00, 0x13, 0xd2, 0xc8, 0x2d, 0x0b, 0xec, 0x26,
-	0xb8, 0x28, 0x82, 0xe5, 0x36, 0x67, 0xb1, 0x7c, 0x89, 0xf0, 0xc6, 0x04, 0x08, 0x97, 0x12, 0xe8,
-	0xff, 0x30, 0x0e, 0x60, 0x2d, 0xa4, 0x4f, 0x62, 0x1a, 0xe8, 0x22, 0x46, 0x82, 0xf7, 0x69, 0x90,
-	0xb1, 0xce, 0x4e, 0x80, 0x75, 0x35, 0x85, 0x6f, 0x59, 0x74, 0xcb, 0xfc, 0x10, 0x96, 0x8f, 0xa9,
-	0xea, 0x06, 0x02, 0x1f, 0x7b, 0x38, 0x08, 0x84, 0x47, 0x18, 0x6e, 0x87, 0x24, 0x28, 0xe5, 0xd7,
-	0x9d, 0x8d, 0x79, 0x77, 0x69, 0x98, 0xdc, 0x0d, 0x02, 0xb1, 0x6f, 0x52, 0x3b, 0xf9, 0xef, 0x4e,
-	0xaa, 0xb9, 0xda, 0xaf, 0x0e, 0x94, 0x3f, 0xc2, 0x21, 0x0d, 0xb0, 0xe2, 0xe2, 0x7d, 0x2a, 0x15,
-	0x17, 0xd4, 0xc7, 0xa1, 0xc1, 0x95, 0xe8, 0x4b, 0x07, 0x56, 0xfd, 0xb8, 0x17, 0x87, 0x58, 0xd1,
-	0x3e, 0xb1, 0x5e, 0x3c, 0x81, 0x15, 0xe5, 0x25, 0x67, 0xfd, 0xc6, 0xc6, 0xad, 0x87, 0x77, 0x6d,
-	0x8f, 0xd7, 0xf5, 0x27, 0x18, 0xf6, 0xaa, 0x56, 0xdb, 0xe4, 0x94, 0x35, 0x1e, 0x69, 0xbf, 0x3f,
-	0xfd, 0x59, 0xbd, 0x7f, 0x3d, 0xbf, 0xfa, 0x1d, 0xe9, 0x2e, 0x67, 0x8c, 0x46, 0x87, 0xab, 0xf9,
-	0xd0, 0x5b, 0xb0, 0x28, 0xc8, 0x21, 0x11, 0x84, 0xf9, 0xc4, 0xf3, 0x79, 0xcc, 0x54, 0xd2, 0x3b,
-	0xb7, 0xdd, 0x85, 0x34, 0xdc, 0xd4, 0xd1, 0xda, 0x0f, 0x0e, 0xac, 0xa6, 0x9e, 0x9a, 0xb1, 0x10,
-	0x84, 0xa9, 0xa1, 0xa1, 0x23, 0x98, 0x33, 0x26, 0xe4, 0xf4, 0xf4, 0x0f, 0x19, 0xd0, 0x0a, 0x14,
-	0x22, 0x22, 0x28, 0x37, 0x4d, 0x9e, 0x77, 0xed, 0xaa, 0xf6, 0xad, 0x03, 0x95, 0x54, 0xe0, 0xae,
-	0x6f, 0xed, 0x92, 0xa0, 0xc9, 0x7b, 0x3d, 0x2a, 0x25, 0xe5, 0x0c, 0x3d, 0x01, 0xf0, 0xd3, 0xd5,
-	0xf4, 0xa4, 0x8e, 0x90, 0xd4, 0xbe, 0x72, 0xe0, 0x4e, 0xaa, 0xea, 0x71, 0xac, 0xa4, 0xc2, 0x2c,
-	0xa0, 0xac, 0xf3, 0x2a, 0x4a, 0x57, 0xfb, 0xde, 0x81, 0xa5, 0x54, 0xcc, 0x41, 0x88, 0x65, 0x77,
-	0xbf, 0x4f, 0x98, 0x42, 0x6f, 0xc3, 0xeb, 0xfd, 0x61, 0xd8, 0xb3, 0xc5, 0x75, 0x92, 0xe2, 0x2e,
-	0xa6, 0xf1, 0x56, 0x12, 0x46, 0x9f, 0xc0, 0xfc, 0xa1, 0xc0, 0xbe, 0x9e, 0xa1, 0x13, 0x19, 0x32,
-	0x29, 0x9a, 0xae, 0x54, 0x71, 0x8c, 0x38, 0x89, 0x42, 0x58, 0xc9, 0xd4, 0x49, 0x9d, 0xf0, 0x48,
-	0x92, 0xb1, 0x15, 0x7b, 0x50, 0xbf, 0x62, 0xc0, 0xd7, 0xc7, 0x40, 0x36, 0xf2, 0x5a, 0xb2, 0x5b,
-	0xec, 0x8f, 0x61, 0xb3, 0x3b, 0xf8, 0xeb, 0x19, 0x98, 0x7b, 0x8f, 0x90, 0x16, 0xe7, 0x21, 0x1a,
-	0xc0, 0x42, 0x36, 0xc6, 0x23, 0xce, 0xc3, 0xe9, 0x7d, 0xa9, 0xec, 0xbc, 0x48, 0x98, 0xf5, 0xa0,
-	0x18, 0x33, 0xfc, 0x12, 0x0d, 0x33, 0x53, 0x1b, 0x14, 0x2f, 0xcd, 0x43, 0xad, 0xa5, 0xf6, 0xf9,
-	0x0c, 0x94, 0x9b, 0xa3, 0xea, 0x0e, 0x22, 0xc2, 0x02, 0x33, 0xac, 0x71, 0x88, 0x8a, 0x30, 0xab,
-	0xa8, 0x0a, 0x89, 0x39, 0xe3, 0x5c, 0xb3, 0x40, 0xeb, 0x70, 0x2b, 0x20, 0xd2, 0x17, 0x34, 0xca,
-	0x1a, 0xc6, 0x1d, 0x0d, 0xa1, 0xbb, 0x70, 0x53, 0x10, 0x9f, 0x46, 0x94, 0x30, 0x65, 0x0e, 0x11,
-	0x37, 0x0b, 0x20, 0x1f, 0x0a, 0xb8, 0x97, 0x0c, 0xa5, 0x7c, 0x62, 0x77, 0x6d, 0xac, 0xdd, 0xc4,
-	0xeb, 0x03, 0xeb, 0x75, 0xe3, 0x1a, 0x5e, 0x8d, 0x51, 0x0b, 0xbd, 0xf3, 0xce, 0x17, 0x27, 0xd5,
-	0x9c, 0xfe, 0xea, 0x7f, 0x9f, 0x54, 0x73, 0xbf, 0x9c, 0x6e, 0x96, 0x2d, 0x47, 0x87, 0xf7, 0x47,
-	0x28, 0x98, 0x22, 0x4c, 0xd5, 0x7e, 0x76, 0x60, 0x79, 0x8f, 0x84, 0xa4, 0x93, 0xb4, 0x8d, 0xc2,
-	0x42, 0x51, 0xd6, 0xf9, 0x80, 0x1d, 0x26, 0x83, 0x34, 0x12, 0xa4, 0x4f, 0xb9, 0x3e, 0x1c, 0x47,
-	0xb7, 0xd0, 0xc2, 0x30, 0x6c, 0x77, 0x90, 0x0b, 0xb3, 0x52, 0xe1, 0x23, 0x32, 0x91, 0xed, 0x63,
-	0xa0, 0xd0, 0x7d, 0x28, 0x74, 0x09, 0xed, 0x74, 0x4d, 0x09, 0xf3, 0x8d, 0xa5, 0x7f, 0xce, 0xaa,
-	0x8b, 0xbe, 0x20, 0x7a, 0xc4, 0x33, 0xcf, 0xa4, 0x5c, 0xfb, 0x48, 0xed, 0x37, 0x07, 0xd6, 0xac,
-	0x07, 0xca, 0x59, 0xea, 0xc6, 0x9e, 0x7a, 0xfb, 0xf0, 0x46, 0xb6, 0xdb, 0xf4, 0xb1, 0x47, 0xa4,
-	0xb4, 0x17, 0x97, 0xd2, 0x8b, 0xd3, 0xcd, 0xa2, 0x25, 0xdf, 0x35, 0x99, 0x03, 0x25, 0xf4, 0x30,
-	0xcb, 0xc6, 0x87, 0x8d, 0x23, 0x0a, 0x85, 0xf4, 0x2a, 0x32, 0xa5, 0x46, 0xb5, 0x04, 0x3b, 0xf3,
-	0xf6, 0xfb, 0x39, 0xda, 0xd9, 0x9b, 0xff, 0xdf, 0xa3, 0x1f, 0x53, 0xd5, 0xdd, 0x23, 0x11, 0x97,
-	0x54, 0x4d, 0xa9, 0x5d, 0x57, 0x46, 0xda, 0x55, 0xa7, 0xec, 0x0a, 0x95, 0x60, 0x2e, 0x30, 0xc4,
-	0xe6, 0xc6, 0xe2, 0x0e, 0x97, 0x3b, 0xf7, 0x86, 0xda, 0xaf, 0xee, 0xbb, 0xc6, 0xe3, 0x1f, 0xcf,
-	0x2b, 0xce, 0xb3, 0xf3, 0x8a, 0xf3, 0xfc, 0xbc, 0xe2, 0xfc, 0x75, 0x5e, 0x71, 0xbe, 0xb9, 0xa8,
-	0xe4, 0x9e, 0x5f, 0x54, 0x72, 0xbf, 0x5f, 0x54, 0x72, 0x9f, 0x6e, 0x5f, 0x59, 0xb6, 0xc1, 0xe5,
-	0x9b, 0x72, 0x52, 0xc5, 0x76, 0x21, 0xb9, 0xad, 0x3e, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0xfc,
-	0xce, 0xc2, 0xb1, 0x4d, 0x0b, 0x00, 0x00,
+	// 1046 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xa6, 0x8e, 0x93, 0x4e, 0x69, 0x02, 0x13, 0x3b, 0x71, 0xdc, 0xca, 0xb6, 0x2c, 0x51,
+	0x0c, 0x55, 0x9c, 0xa6, 0x3d, 0x20, 0x45, 0x5c, 0x62, 0x27, 0xfc, 0x38, 0xa0, 0x5a, 0x1b, 0x04,
+	0x88, 0xcb, 0x6a, 0x3c, 0x3b, 0xb1, 0x47, 0xd9, 0x9d, 0xd9, 0xce, 0xcc, 0x3a, 0x6e, 0xaf, 0x5c,
+	0x80, 0x0b, 0x48, 0x5c, 0x10, 0x07, 0x94, 0x23, 0xe2, 0x9c, 0x7f, 0x80, 0x5b, 0xc5, 0xa9, 0xf4,
+	0x02, 0xe2, 0x10, 0x50, 0x72, 0x41, 0x48, 0xfc, 0x0f, 0x68, 0x76, 0xc6, 0x6b, 0x87, 0x9a, 0x28,
+	0x07, 0x5b, 0x9c, 0xec, 0x79, 0x6f, 0xe7, 0xfb, 0xbe, 0xf7, 0x66, 0xde, 0x7b, 0x03, 0x1a, 0x98,
+	0xcb, 0x90, 0xcb, 0x4d, 0x9f, 0x4a, 0x25, 0x68, 0x27, 0x56, 0x94, 0xb3, 0xcd, 0xfe, 0x56, 0x87,
+	0x28, 0xb4, 0x75, 0xc1, 0xd8, 0x88, 0x04, 0x57, 0x1c, 0xde, 0x32, 0xdf, 0x37, 0x2e, 0xb8, 0xec,
+	0xf7, 0xa5, 0x7c, 0x97, 0x77, 0x79, 0xf2, 0xdd, 0xa6, 0xfe, 0x67, 0xb6, 0x94, 0xca, 0x96, 0xa2,
+	0x83, 0x24, 0x49, 0xa1, 0x31, 0xa7, 0x16, 0xb2, 0xb4, 0x6e, 0xfc, 0x9e, 0xd9, 0x68, 0xf1, 0x93,
+	0x45, 0xed, 0xef, 0x2c, 0xc8, 0xb5, 0x91, 0x40, 0xa1, 0x84, 0x08, 0xdc, 0xc4, 0x3c, 0x0c, 0x63,
+	0x46, 0xd5, 0x63, 0x4f, 0xa1, 0x41, 0xd1, 0xa9, 0x3a, 0xf5, 0xeb, 0xcd, 0xb7, 0x9e, 0x9e, 0x56,
+	0x32, 0xbf, 0x9d, 0x56, 0xee, 0x74, 0xa9, 0xea, 0xc5, 0x9d, 0x06, 0xe6, 0xa1, 0x85, 0xb0, 0x3f,
+	0x1b, 0xd2, 0x3f, 0xdc, 0x54, 0x8f, 0x23, 0x22, 0x1b, 0xbb, 0x04, 0x3f, 0x3f, 0xd9, 0x00, 0x96,
+	0x61, 0x97, 0x60, 0xf7, 0xa5, 0x14, 0xf2, 0x03, 0x34, 0x80, 0x0c, 0xe4, 0xb5, 0x46, 0x2d, 0x24,
+	0xe2, 0x92, 0x08, 0x4f, 0x90, 0x23, 0x24, 0xfc, 0xe2, 0xdc, 0x14, 0x98, 0xa0, 0x46, 0x6e, 0x5b,
+	0x60, 0x37, 0xc1, 0x85, 0x11, 0x28, 0x74, 0x38, 0x8b, 0xe5, 0x0b, 0x84, 0xd7, 0xa6, 0x40, 0xb8,
+	0x92, 0x40, 0xff, 0x8b, 0x71, 0x00, 0xd6, 0x03, 0xfa, 0x28, 0xa6, 0xbe, 0x4e, 0x62, 0x24, 0x78,
+	0x9f, 0xfa, 0x23, 0xd6, 0xf9, 0x29, 0xb0, 0xae, 0xa5, 0xf0, 0x6d, 0x8b, 0x6e, 0x99, 0xef, 0x83,
+	0xc2, 0x11, 0x55, 0x3d, 0x5f, 0xa0, 0x23, 0x0f, 0xf9, 0xbe, 0xf0, 0x08, 0x43, 0x9d, 0x80, 0xf8,
+	0xc5, 0x6c, 0xd5, 0xa9, 0x2f, 0xba, 0x2b, 0x43, 0xe7, 0x8e, 0xef, 0x8b, 0x3d, 0xe3, 0x82, 0x6f,
+	0x82, 0x22, 0x8a, 0x15, 0xf7, 0x30, 0x0f, 0x23, 0x1e, 0x33, 0xdf, 0xeb, 0x20, 0x85, 0x7b, 0x9e,
+	0xa4, 0x4f, 0x48, 0x31, 0x57, 0x75, 0xea, 0x59, 0xb7, 0xa0, 0xfd, 0x2d, 0xeb, 0x6e, 0x6a, 0xef,
+	0x3e, 0x7d, 0x42, 0xe0, 0x16, 0x28, 0x5c, 0xdc, 0x18, 0xa2, 0x81, 0xd7, 0x45, 0xb2, 0xb8, 0x90,
+	0xec, 0x82, 0xe3, 0xbb, 0xde, 0x47, 0x83, 0x77, 0x90, 0xdc, 0xce, 0x7e, 0x73, 0x5c, 0xc9, 0xd4,
+	0x7e, 0x76, 0x40, 0xe9, 0x43, 0x14, 0x50, 0x1f, 0x29, 0x2e, 0xde, 0xa5, 0x52, 0x71, 0x41, 0x31,
+	0x0a, 0x4c, 0x0c, 0x12, 0x7e, 0xee, 0x80, 0x35, 0x1c, 0x87, 0x71, 0x80, 0x14, 0xed, 0x13, 0x9b,
+	0x37, 0x4f, 0x20, 0x45, 0x79, 0xd1, 0xa9, 0x5e, 0xab, 0xdf, 0xb8, 0x7f, 0xdb, 0xd6, 0x53, 0x43,
+	0x1f, 0xf7, 0xb0, 0x2e, 0x74, 0x66, 0x5a, 0x9c, 0xb2, 0xe6, 0x03, 0x9d, 0xdb, 0x1f, 0x7e, 0xaf,
+	0xdc, 0xbd, 0x5a, 0x6e, 0xf5, 0x1e, 0xe9, 0x16, 0x46, 0x8c, 0x46, 0x87, 0xab, 0xf9, 0xe0, 0x6b,
+	0x60, 0x59, 0x90, 0x03, 0x22, 0x08, 0xc3, 0xc4, 0xc3, 0x3c, 0x66, 0x2a, 0xb9, 0xa7, 0x37, 0xdd,
+	0xa5, 0xd4, 0xdc, 0xd2, 0xd6, 0xda, 0x77, 0x0e, 0x58, 0x4b, 0x63, 0x6a, 0xc5, 0x42, 0x10, 0xa6,
+	0x86, 0x01, 0x1d, 0x82, 0x05, 0x13, 0x84, 0x9c, 0x9d, 0xfe, 0x21, 0x03, 0x5c, 0x05, 0xb9, 0x88,
+	0x08, 0xca, 0x4d, 0x41, 0x65, 0x5d, 0xbb, 0xaa, 0x7d, 0xed, 0x80, 0x72, 0x2a, 0x70, 0x07, 0xdb,
+	0x70, 0x89, 0xdf, 0xe2, 0x61, 0x48, 0xa5, 0xa4, 0x9c, 0xc1, 0x47, 0x00, 0xe0, 0x74, 0x35, 0x3b,
+	0xa9, 0x63, 0x24, 0xb5, 0x2f, 0x1c, 0x70, 0x2b, 0x55, 0xf5, 0x30, 0x56, 0x52, 0x21, 0xe6, 0x53,
+	0xd6, 0xfd, 0x3f, 0x52, 0x57, 0xfb, 0xd6, 0x01, 0x2b, 0xa9, 0x98, 0xfd, 0x00, 0xc9, 0xde, 0x5e,
+	0x9f, 0x30, 0x05, 0x5f, 0x07, 0x2f, 0xf7, 0x87, 0x66, 0xcf, 0x26, 0xd7, 0x49, 0x92, 0xbb, 0x9c,
+	0xda, 0xdb, 0x89, 0x19, 0x7e, 0x0c, 0x16, 0x0f, 0x04, 0xc2, 0xba, 0x5f, 0x4f, 0xa5, 0xa1, 0xa5,
+	0x68, 0x3a, 0x53, 0xf9, 0x09, 0xe2, 0x24, 0x0c, 0xc0, 0xea, 0x48, 0x9d, 0xd4, 0x0e, 0x8f, 0x24,
+	0x1e, 0x9b, 0xb1, 0x7b, 0x8d, 0x4b, 0x86, 0x49, 0x63, 0x02, 0x64, 0x33, 0xab, 0x25, 0xbb, 0xf9,
+	0xfe, 0x04, 0x36, 0x5b, 0xc1, 0x5f, 0xce, 0x81, 0x85, 0xb7, 0x09, 0x69, 0x73, 0x1e, 0xc0, 0x01,
+	0x58, 0x1a, 0x8d, 0x8c, 0x88, 0xf3, 0x60, 0x76, 0x27, 0x35, 0x9a, 0x4d, 0x09, 0xb3, 0x6e, 0x14,
+	0x13, 0x1a, 0x6d, 0xa2, 0x61, 0x6e, 0x66, 0x8d, 0xe2, 0x85, 0xde, 0xab, 0xb5, 0xd4, 0x3e, 0x9d,
+	0x03, 0xa5, 0xd6, 0xb8, 0xba, 0xfd, 0x88, 0x30, 0xdf, 0x0c, 0x06, 0x14, 0xc0, 0x3c, 0x98, 0x57,
+	0x54, 0x05, 0xc4, 0xcc, 0x53, 0xd7, 0x2c, 0x60, 0x15, 0xdc, 0xf0, 0x89, 0xc4, 0x82, 0x46, 0xa3,
+	0x0b, 0xe3, 0x8e, 0x9b, 0xe0, 0x6d, 0x70, 0x5d, 0x10, 0x4c, 0x23, 0x4a, 0x98, 0x32, 0x03, 0xcb,
+	0x1d, 0x19, 0x20, 0x06, 0x39, 0x14, 0x26, 0x4d, 0x29, 0x9b, 0x84, 0xbb, 0x3e, 0x31, 0xdc, 0x24,
+	0xd6, 0x7b, 0x36, 0xd6, 0xfa, 0x15, 0x62, 0x35, 0x81, 0x5a, 0xe8, 0xed, 0x37, 0x3e, 0x3b, 0xae,
+	0x64, 0xf4, 0xa9, 0xff, 0x79, 0x5c, 0xc9, 0xfc, 0x74, 0xb2, 0x51, 0xb2, 0x1c, 0x5d, 0xde, 0x1f,
+	0xa3, 0x60, 0x8a, 0x30, 0x55, 0xfb, 0xd1, 0x01, 0x85, 0x5d, 0x12, 0x90, 0x6e, 0x72, 0x6d, 0x14,
+	0x12, 0x8a, 0xb2, 0xee, 0x7b, 0xec, 0x20, 0x69, 0xa4, 0x91, 0x20, 0x7d, 0xca, 0xf5, 0x20, 0x1e,
+	0x2f, 0xa1, 0xa5, 0xa1, 0xd9, 0x56, 0x90, 0x0b, 0xe6, 0xa5, 0x42, 0x87, 0x64, 0x2a, 0xe5, 0x63,
+	0xa0, 0xe0, 0x5d, 0x90, 0xeb, 0x11, 0xda, 0xed, 0x99, 0x14, 0x66, 0x9b, 0x2b, 0x7f, 0x9d, 0x56,
+	0x96, 0xb1, 0x20, 0xba, 0xc5, 0x33, 0xcf, 0xb8, 0x5c, 0xfb, 0x49, 0xed, 0x17, 0x07, 0xac, 0xdb,
+	0x18, 0x28, 0x67, 0x69, 0x34, 0x76, 0xc2, 0xee, 0x81, 0x57, 0x46, 0xd5, 0xa6, 0x47, 0x2c, 0x91,
+	0xd2, 0x3e, 0x92, 0x8a, 0xcf, 0x4f, 0x36, 0xf2, 0x96, 0x7c, 0xc7, 0x78, 0xf6, 0x95, 0xd0, 0xcd,
+	0x6c, 0xd4, 0x3e, 0xac, 0x1d, 0x52, 0x90, 0x4b, 0x9f, 0x3d, 0x33, 0xba, 0xa8, 0x96, 0x60, 0x7b,
+	0xd1, 0x9e, 0x9f, 0xa3, 0x23, 0x7b, 0xf5, 0xbf, 0xef, 0xe8, 0x47, 0x54, 0xf5, 0x76, 0x49, 0xc4,
+	0x25, 0x55, 0x33, 0xba, 0xae, 0xab, 0x63, 0xd7, 0x55, 0xbb, 0xec, 0x0a, 0x16, 0xc1, 0x82, 0x6f,
+	0x88, 0xcd, 0xeb, 0xc8, 0x1d, 0x2e, 0xb7, 0xef, 0x0c, 0xb5, 0x5f, 0x7e, 0xef, 0x9a, 0x0f, 0xbf,
+	0x3f, 0x2b, 0x3b, 0x4f, 0xcf, 0xca, 0xce, 0xb3, 0xb3, 0xb2, 0xf3, 0xc7, 0x59, 0xd9, 0xf9, 0xea,
+	0xbc, 0x9c, 0x79, 0x76, 0x5e, 0xce, 0xfc, 0x7a, 0x5e, 0xce, 0x7c, 0xb2, 0x75, 0x69, 0xda, 0x06,
+	0x17, 0x5f, 0xe5, 0x49, 0x16, 0x3b, 0xb9, 0xe4, 0x65, 0xfc, 0xe0, 0x9f, 0x01, 0x00, 0xad, 0xbc,
+	0xe4, 0x56, 0xb9, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -736,6 +761,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundBatchSize != that1.AutoCompoundBatchSize {
+		return false
+	}
+	if this.AutoCompoundMaxGas != that1.AutoCompoundMaxGas {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1071,6 +1102,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundMaxGas != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundMaxGas))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AutoCompoundBatchSize != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.AutoCompoundBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size := m.LiquidityProviderReward.Size()
 		i -= size
@@ -1639,6 +1680,12 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.LiquidityProviderReward.Size()
 	n += 1 + l + sovDistribution(uint64(l))
+	if m.AutoCompoundBatchSize != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundBatchSize))
+	}
+	if m.AutoCompoundMaxGas != 0 {
+		n += 1 + sovDistribution(uint64(m.AutoCompoundMaxGas))
+	}
 	return n
 }
 
@@ -2041,6 +2088,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundBatchSize", wireType)
+			}
+			m.AutoCompoundBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundMaxGas", wireType)
+			}
+			m.AutoCompoundMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoCompoundMaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -9,8 +9,13 @@ const (
 	EventTypeWithdrawCommission = "withdraw_commission"
 	EventTypeProposerReward     = "proposer_reward"
 
+	EventTypeWithdrawTokenizeShareReward = "withdraw_tokenize_share_reward"
+	EventTypeSetAutoCompound             = "set_auto_compound"
+	EventTypeAutoCompound                = "auto_compound"
+
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyEnabled         = "enabled"
 	AttributeValueCategory      = ModuleName
 )
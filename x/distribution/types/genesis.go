@@ -9,6 +9,7 @@ func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord, slashes []ValidatorSlashEventRecord,
+	autoCompound []string,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                          params,
@@ -21,6 +22,7 @@ func NewGenesisState(
 		ValidatorCurrentRewards:         cur,
 		DelegatorStartingInfos:          dels,
 		ValidatorSlashEvents:            slashes,
+		AutoCompoundDelegators:          autoCompound,
 	}
 }
 
@@ -37,6 +39,7 @@ func DefaultGenesisState() *GenesisState {
 		ValidatorCurrentRewards:         []ValidatorCurrentRewardsRecord{},
 		DelegatorStartingInfos:          []DelegatorStartingInfoRecord{},
 		ValidatorSlashEvents:            []ValidatorSlashEventRecord{},
+		AutoCompoundDelegators:          []string{},
 	}
 }
 
@@ -45,5 +48,10 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	for _, delegator := range gs.AutoCompoundDelegators {
+		if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+			return err
+		}
+	}
 	return gs.FeePool.ValidateGenesis()
 }
@@ -42,6 +42,10 @@ const (
 // - 0x07<valAddrLen (1 Byte)><valAddr_Bytes>: ValidatorCurrentCommission
 //
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
+//
+// - 0x09<accAddrLen (1 Byte)><accAddr_Bytes>: []byte{}
+//
+// - 0x0A: sdk.AccAddress
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -53,6 +57,9 @@ var (
 	ValidatorCurrentRewardsPrefix        = []byte{0x06} // key for current validator rewards
 	ValidatorAccumulatedCommissionPrefix = []byte{0x07} // key for accumulated validator commission
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
+
+	AutoCompoundDelegatorPrefix = []byte{0x09} // key for auto-compounding delegators
+	AutoCompoundCursorKey       = []byte{0x0A} // key for the next auto-compounding delegator to process
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -213,3 +220,21 @@ func GetValidatorSlashEventKey(v sdk.ValAddress, height, period uint64) []byte {
 
 	return append(prefix, periodBz...)
 }
+
+// GetAutoCompoundDelegatorKey creates the key for an auto-compounding delegator.
+func GetAutoCompoundDelegatorKey(delAddr sdk.AccAddress) []byte {
+	return append(AutoCompoundDelegatorPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
+}
+
+// GetAutoCompoundDelegatorAddress creates an address from an auto-compounding delegator key.
+func GetAutoCompoundDelegatorAddress(key []byte) (delAddr sdk.AccAddress) {
+	// key is in the format:
+	// 0x09<accAddrLen (1 Byte)><accAddr_Bytes>
+
+	// Remove prefix and address length.
+	kv.AssertKeyAtLeastLength(key, 3)
+	addr := key[2:]
+	kv.AssertKeyLength(addr, int(key[1]))
+
+	return sdk.AccAddress(addr)
+}
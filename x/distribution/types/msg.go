@@ -11,10 +11,18 @@ const (
 	TypeMsgWithdrawDelegatorReward     = "withdraw_delegator_reward"
 	TypeMsgWithdrawValidatorCommission = "withdraw_validator_commission"
 	TypeMsgFundCommunityPool           = "fund_community_pool"
+
+	TypeMsgWithdrawTokenizeShareRecordReward = "withdraw_tokenize_share_record_reward"
+	TypeMsgWithdrawAllDelegatorRewards       = "withdraw_all_delegator_rewards"
+	TypeMsgSetAutoCompound                   = "set_auto_compound"
 )
 
 // Verify interface at compile time
-var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+var (
+	_, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+	_       sdk.Msg = &MsgWithdrawTokenizeShareRecordReward{}
+	_, _    sdk.Msg = &MsgWithdrawAllDelegatorRewards{}, &MsgSetAutoCompound{}
+)
 
 func NewMsgSetWithdrawAddress(delAddr, withdrawAddr sdk.AccAddress) *MsgSetWithdrawAddress {
 	return &MsgSetWithdrawAddress{
@@ -151,3 +159,114 @@ func (msg MsgFundCommunityPool) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgWithdrawTokenizeShareRecordReward returns a new MsgWithdrawTokenizeShareRecordReward
+// withdrawing the rewards of the tokenize share records of the owner.
+func NewMsgWithdrawTokenizeShareRecordReward(ownerAddr sdk.AccAddress) *MsgWithdrawTokenizeShareRecordReward {
+	return &MsgWithdrawTokenizeShareRecordReward{
+		OwnerAddress: ownerAddr.String(),
+	}
+}
+
+// Route returns the MsgWithdrawTokenizeShareRecordReward message route.
+func (msg MsgWithdrawTokenizeShareRecordReward) Route() string { return ModuleName }
+
+// Type returns the MsgWithdrawTokenizeShareRecordReward message type.
+func (msg MsgWithdrawTokenizeShareRecordReward) Type() string {
+	return TypeMsgWithdrawTokenizeShareRecordReward
+}
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(msg.OwnerAddress)
+	return []sdk.AccAddress{owner}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawTokenizeShareRecordReward
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawTokenizeShareRecordReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgWithdrawTokenizeShareRecordReward message validation.
+func (msg MsgWithdrawTokenizeShareRecordReward) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OwnerAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgWithdrawAllDelegatorRewards returns a new MsgWithdrawAllDelegatorRewards
+// withdrawing the rewards of the delegator from all its validators.
+func NewMsgWithdrawAllDelegatorRewards(delAddr sdk.AccAddress) *MsgWithdrawAllDelegatorRewards {
+	return &MsgWithdrawAllDelegatorRewards{
+		DelegatorAddress: delAddr.String(),
+	}
+}
+
+// Route returns the MsgWithdrawAllDelegatorRewards message route.
+func (msg MsgWithdrawAllDelegatorRewards) Route() string { return ModuleName }
+
+// Type returns the MsgWithdrawAllDelegatorRewards message type.
+func (msg MsgWithdrawAllDelegatorRewards) Type() string { return TypeMsgWithdrawAllDelegatorRewards }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgWithdrawAllDelegatorRewards) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgWithdrawAllDelegatorRewards
+// message that the expected signer needs to sign.
+func (msg MsgWithdrawAllDelegatorRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgWithdrawAllDelegatorRewards message validation.
+func (msg MsgWithdrawAllDelegatorRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	return nil
+}
+
+// NewMsgSetAutoCompound returns a new MsgSetAutoCompound enabling or disabling
+// the auto-compounding of the rewards of the delegator.
+func NewMsgSetAutoCompound(delAddr sdk.AccAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		DelegatorAddress: delAddr.String(),
+		Enabled:          enabled,
+	}
+}
+
+// Route returns the MsgSetAutoCompound message route.
+func (msg MsgSetAutoCompound) Route() string { return ModuleName }
+
+// Type returns the MsgSetAutoCompound message type.
+func (msg MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+
+// GetSigners returns the signer addresses that are expected to sign the result
+// of GetSignBytes.
+func (msg MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes returns the raw bytes for a MsgSetAutoCompound message that the
+// expected signer needs to sign.
+func (msg MsgSetAutoCompound) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic performs basic MsgSetAutoCompound message validation.
+func (msg MsgSetAutoCompound) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	return nil
+}
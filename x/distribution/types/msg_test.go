@@ -73,6 +73,46 @@ func TestMsgWithdrawValidatorCommission(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgWithdrawAllDelegatorRewards
+func TestMsgWithdrawAllDelegatorRewards(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		expectPass    bool
+	}{
+		{delAddr1, true},
+		{emptyDelAddr, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgWithdrawAllDelegatorRewards(tc.delegatorAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for MsgSetAutoCompound
+func TestMsgSetAutoCompound(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		enabled       bool
+		expectPass    bool
+	}{
+		{delAddr1, true, true},
+		{delAddr1, false, true},
+		{emptyDelAddr, true, false},
+	}
+	for i, tc := range tests {
+		msg := NewMsgSetAutoCompound(tc.delegatorAddr, tc.enabled)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDepositIntoCommunityPool
 func TestMsgDepositIntoCommunityPool(t *testing.T) {
 	tests := []struct {
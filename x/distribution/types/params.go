@@ -16,6 +16,14 @@ var (
 	ParamStoreKeyBonusProposerReward     = []byte("bonusproposerreward")
 	ParamStoreKeyLiquidityProviderReward = []byte("liquidityproviderreward")
 	ParamStoreKeyWithdrawAddrEnabled     = []byte("withdrawaddrenabled")
+	ParamStoreKeyAutoCompoundBatchSize   = []byte("autocompoundbatchsize")
+	ParamStoreKeyAutoCompoundMaxGas      = []byte("autocompoundmaxgas")
+)
+
+// Default auto-compounding parameters
+const (
+	DefaultAutoCompoundBatchSize = uint64(100)
+	DefaultAutoCompoundMaxGas    = uint64(10_000_000)
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -31,6 +39,8 @@ func DefaultParams() Params {
 		BonusProposerReward:     sdk.NewDecWithPrec(4, 2), // 4%
 		LiquidityProviderReward: sdk.ZeroDec(),            // 0%
 		WithdrawAddrEnabled:     true,
+		AutoCompoundBatchSize:   DefaultAutoCompoundBatchSize,
+		AutoCompoundMaxGas:      DefaultAutoCompoundMaxGas,
 	}
 }
 
@@ -47,6 +57,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyBonusProposerReward, &p.BonusProposerReward, validateBonusProposerReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyLiquidityProviderReward, &p.LiquidityProviderReward, validateLiquidityProviderReward),
 		paramtypes.NewParamSetPair(ParamStoreKeyWithdrawAddrEnabled, &p.WithdrawAddrEnabled, validateWithdrawAddrEnabled),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundBatchSize, &p.AutoCompoundBatchSize, validateAutoCompoundBatchSize),
+		paramtypes.NewParamSetPair(ParamStoreKeyAutoCompoundMaxGas, &p.AutoCompoundMaxGas, validateAutoCompoundMaxGas),
 	}
 }
 
@@ -165,3 +177,21 @@ func validateWithdrawAddrEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoCompoundBatchSize(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAutoCompoundMaxGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -924,6 +924,196 @@ func (m *QueryLiquidityProviderRewardsResponse) GetPool() github_com_cosmos_cosm
 	return nil
 }
 
+// QueryDelegatorAutoCompoundRequest is the request type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundRequest) Reset()         { *m = QueryDelegatorAutoCompoundRequest{} }
+func (m *QueryDelegatorAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundRequest proto.InternalMessageInfo
+
+// QueryDelegatorAutoCompoundResponse is the response type for the
+// Query/DelegatorAutoCompound RPC method.
+type QueryDelegatorAutoCompoundResponse struct {
+	// enabled defines whether the rewards of the delegator are auto-compounded.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Reset()         { *m = QueryDelegatorAutoCompoundResponse{} }
+func (m *QueryDelegatorAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegatorAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryDelegatorAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegatorAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegatorAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegatorAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryDelegatorAutoCompoundResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// QueryAutoCompoundDelegatorsRequest is the request type for the
+// Query/AutoCompoundDelegators RPC method.
+type QueryAutoCompoundDelegatorsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) Reset()         { *m = QueryAutoCompoundDelegatorsRequest{} }
+func (m *QueryAutoCompoundDelegatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundDelegatorsRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundDelegatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{22}
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundDelegatorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundDelegatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundDelegatorsRequest proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundDelegatorsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAutoCompoundDelegatorsResponse is the response type for the
+// Query/AutoCompoundDelegators RPC method.
+type QueryAutoCompoundDelegatorsResponse struct {
+	// delegators defines the delegators whose rewards are auto-compounded.
+	Delegators []string `protobuf:"bytes,1,rep,name=delegators,proto3" json:"delegators,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) Reset()         { *m = QueryAutoCompoundDelegatorsResponse{} }
+func (m *QueryAutoCompoundDelegatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundDelegatorsResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundDelegatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{23}
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundDelegatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundDelegatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundDelegatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundDelegatorsResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundDelegatorsResponse) GetDelegators() []string {
+	if m != nil {
+		return m.Delegators
+	}
+	return nil
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -945,6 +1135,10 @@ func init() {
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
 	proto.RegisterType((*QueryLiquidityProviderRewardsRequest)(nil), "cosmos.distribution.v1beta1.QueryLiquidityProviderRewardsRequest")
 	proto.RegisterType((*QueryLiquidityProviderRewardsResponse)(nil), "cosmos.distribution.v1beta1.QueryLiquidityProviderRewardsResponse")
+	proto.RegisterType((*QueryDelegatorAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundRequest")
+	proto.RegisterType((*QueryDelegatorAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorAutoCompoundResponse")
+	proto.RegisterType((*QueryAutoCompoundDelegatorsRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundDelegatorsRequest")
+	proto.RegisterType((*QueryAutoCompoundDelegatorsResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundDelegatorsResponse")
 }
 
 func init() {
@@ -952,82 +1146,91 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6c, 0x13, 0xc7,
-	0x17, 0xf6, 0x98, 0x10, 0x7e, 0x3c, 0x7e, 0x94, 0x64, 0x88, 0x2a, 0xb3, 0x49, 0xed, 0x68, 0x53,
-	0x48, 0xd4, 0x28, 0x5e, 0x92, 0x48, 0x50, 0xa0, 0x15, 0xcd, 0x3f, 0x8a, 0x44, 0x04, 0xc1, 0x20,
-	0x42, 0x7b, 0xb1, 0x36, 0xde, 0xd1, 0x7a, 0x85, 0xbd, 0xe3, 0xec, 0x8e, 0x93, 0x46, 0x88, 0x4b,
-	0x29, 0x52, 0x2f, 0xad, 0x2a, 0xf5, 0xc2, 0x31, 0xe7, 0x9e, 0x5b, 0x55, 0xaa, 0xd4, 0x3b, 0x47,
-	0xd4, 0x4a, 0x55, 0x4f, 0x6d, 0x95, 0x54, 0x15, 0x97, 0x9e, 0x7b, 0xad, 0x76, 0xfe, 0xd8, 0xde,
-	0x78, 0xbd, 0x5e, 0x27, 0xb8, 0x27, 0xcc, 0xdb, 0xf7, 0xbe, 0xf7, 0xbe, 0xf7, 0xe6, 0xcd, 0x7c,
-	0x0a, 0x4c, 0x96, 0xa8, 0x5f, 0xa5, 0xbe, 0x61, 0x39, 0x3e, 0xf3, 0x9c, 0x8d, 0x3a, 0x73, 0xa8,
-	0x6b, 0x6c, 0xcd, 0x6e, 0x10, 0x66, 0xce, 0x1a, 0x9b, 0x75, 0xe2, 0xed, 0xe4, 0x6b, 0x1e, 0x65,
-	0x14, 0x8f, 0x0a, 0xc7, 0x7c, 0xab, 0x63, 0x5e, 0x3a, 0x6a, 0xef, 0x48, 0x94, 0x0d, 0xd3, 0x27,
-	0x22, 0xaa, 0x81, 0x51, 0x33, 0x6d, 0xc7, 0x35, 0xb9, 0x37, 0x07, 0xd2, 0x46, 0x6c, 0x6a, 0x53,
-	0xfe, 0xd3, 0x08, 0x7e, 0x49, 0xeb, 0x98, 0x4d, 0xa9, 0x5d, 0x21, 0x86, 0x59, 0x73, 0x0c, 0xd3,
-	0x75, 0x29, 0xe3, 0x21, 0xbe, 0xfc, 0x9a, 0x6d, 0xc5, 0x57, 0xc8, 0x25, 0xea, 0x28, 0xcc, 0x7c,
-	0x1c, 0x8b, 0x50, 0xc5, 0xc2, 0xff, 0x9c, 0xf0, 0x2f, 0x8a, 0x32, 0x24, 0x33, 0xfe, 0x1f, 0x7d,
-	0x04, 0xf0, 0xdd, 0x80, 0xc0, 0x9a, 0xe9, 0x99, 0x55, 0xbf, 0x40, 0x36, 0xeb, 0xc4, 0x67, 0xfa,
-	0x43, 0x38, 0x1b, 0xb2, 0xfa, 0x35, 0xea, 0xfa, 0x04, 0x2f, 0xc0, 0x60, 0x8d, 0x5b, 0x32, 0x68,
-	0x1c, 0x4d, 0x9d, 0x9a, 0x9b, 0xc8, 0xc7, 0x74, 0x29, 0x2f, 0x82, 0x17, 0x07, 0x5e, 0xfc, 0x96,
-	0x4b, 0x15, 0x64, 0xa0, 0x5e, 0x83, 0x49, 0x8e, 0xfc, 0xc0, 0xac, 0x38, 0x96, 0xc9, 0xa8, 0x77,
-	0xa7, 0xce, 0x7c, 0x66, 0xba, 0x96, 0xe3, 0xda, 0x05, 0xb2, 0x6d, 0x7a, 0x96, 0x2a, 0x02, 0xaf,
-	0xc0, 0xf0, 0x96, 0xf2, 0x2a, 0x9a, 0x96, 0xe5, 0x11, 0x5f, 0x24, 0x3e, 0xb9, 0x98, 0xf9, 0xe9,
-	0xdb, 0x99, 0x11, 0x99, 0x7b, 0x41, 0x7c, 0xb9, 0xc7, 0xbc, 0x00, 0x62, 0xa8, 0x11, 0x22, 0xed,
-	0xfa, 0x67, 0x08, 0xa6, 0xba, 0xa7, 0x94, 0x0c, 0x1f, 0xc2, 0x09, 0x4f, 0x98, 0x24, 0xc5, 0x77,
-	0x63, 0x29, 0xc6, 0x40, 0x4a, 0xde, 0x0a, 0x4e, 0x2f, 0x43, 0x2e, 0x5c, 0xc5, 0x12, 0xad, 0x56,
-	0x1d, 0xdf, 0x77, 0xa8, 0xfb, 0x9a, 0x09, 0x3f, 0x43, 0x30, 0xde, 0x39, 0x95, 0x24, 0x6a, 0x02,
-	0x94, 0x1a, 0x56, 0xc9, 0xf5, 0x5a, 0x32, 0xae, 0x0b, 0xa5, 0x52, 0xbd, 0x5a, 0xaf, 0x98, 0x8c,
-	0x58, 0x4d, 0x60, 0x49, 0xb7, 0x05, 0x54, 0x7f, 0x96, 0x86, 0xb1, 0x70, 0x1d, 0xf7, 0x2a, 0xa6,
-	0x5f, 0x26, 0xaf, 0x79, 0xc0, 0x78, 0x12, 0xce, 0xf8, 0xcc, 0xf4, 0x98, 0xe3, 0xda, 0xc5, 0x32,
-	0x71, 0xec, 0x32, 0xcb, 0xa4, 0xc7, 0xd1, 0xd4, 0x40, 0xe1, 0x0d, 0x65, 0xbe, 0xc9, 0xad, 0x78,
-	0x02, 0x4e, 0x13, 0x3e, 0x22, 0xe5, 0x76, 0x8c, 0xbb, 0xfd, 0x5f, 0x18, 0xa5, 0xd3, 0x0d, 0x80,
-	0xe6, 0x0e, 0x67, 0x06, 0x78, 0x63, 0x2e, 0xa8, 0xc6, 0x04, 0x0b, 0x99, 0x17, 0xd7, 0x44, 0xf3,
-	0x94, 0xdb, 0x44, 0x12, 0x2a, 0xb4, 0x44, 0x5e, 0xfd, 0xdf, 0xe7, 0xbb, 0xb9, 0xd4, 0xf3, 0xdd,
-	0x1c, 0xd2, 0x7f, 0x40, 0xf0, 0x56, 0x87, 0x3e, 0xc8, 0x61, 0xac, 0xc1, 0x09, 0x5f, 0x98, 0x32,
-	0x68, 0xfc, 0xd8, 0xd4, 0xa9, 0xb9, 0x8b, 0xc9, 0x26, 0xc1, 0x71, 0x56, 0xb6, 0x88, 0xcb, 0xd4,
-	0x69, 0x93, 0x30, 0xf8, 0xc3, 0x10, 0x8b, 0x34, 0x67, 0x31, 0xd9, 0x95, 0x85, 0x28, 0xa7, 0x95,
-	0x86, 0xfe, 0xbd, 0x2a, 0x7e, 0x99, 0x54, 0x88, 0xcd, 0x6d, 0xed, 0x6b, 0x6a, 0x89, 0x6f, 0xbd,
-	0x4c, 0xb1, 0x11, 0xa2, 0xa6, 0x18, 0x79, 0x18, 0xd2, 0xbd, 0x1e, 0x06, 0xd1, 0xf6, 0x57, 0xbb,
-	0xb9, 0x94, 0xfe, 0x05, 0x82, 0x6c, 0xa7, 0xca, 0x65, 0xdf, 0x1f, 0xb5, 0x6e, 0x7b, 0xd0, 0xf7,
-	0xb1, 0x50, 0x8b, 0x54, 0x73, 0x96, 0x49, 0x69, 0x89, 0x3a, 0xee, 0xe2, 0x7c, 0xd0, 0xe3, 0x6f,
-	0x7e, 0xcf, 0x4d, 0xdb, 0x0e, 0x2b, 0xd7, 0x37, 0xf2, 0x25, 0x5a, 0x95, 0x97, 0xa9, 0xfc, 0x67,
-	0xc6, 0xb7, 0x1e, 0x19, 0x6c, 0xa7, 0x46, 0x7c, 0x15, 0xe3, 0x37, 0x2f, 0x80, 0x3a, 0xe8, 0x07,
-	0xca, 0xb9, 0x4f, 0x99, 0x59, 0xe9, 0x4b, 0x37, 0x5b, 0xda, 0xf0, 0x17, 0x82, 0x89, 0xd8, 0xbc,
-	0xb2, 0x17, 0x0f, 0x0e, 0xf6, 0xe2, 0x52, 0xec, 0x19, 0x6c, 0xa2, 0x2d, 0xab, 0xdc, 0x02, 0xf1,
-	0xc0, 0xbd, 0x87, 0x6d, 0x38, 0xce, 0x82, 0x7c, 0x99, 0x74, 0xbf, 0x3a, 0x2c, 0xf0, 0x75, 0x4f,
-	0x5e, 0xb0, 0x8d, 0x7a, 0x1a, 0x6b, 0xd2, 0xbf, 0xe6, 0xae, 0xca, 0x9b, 0x36, 0x32, 0xa7, 0x6c,
-	0x6c, 0x16, 0xa0, 0x71, 0x4a, 0x45, 0x6f, 0x4f, 0x16, 0x5a, 0x2c, 0x2d, 0x68, 0xdb, 0xf0, 0x76,
-	0x18, 0x6d, 0xdd, 0x61, 0x65, 0xcb, 0x33, 0xb7, 0x65, 0xe2, 0xbe, 0xd1, 0xd8, 0x82, 0xf3, 0x5d,
-	0x12, 0x4b, 0x2e, 0x4b, 0x30, 0xb4, 0x2d, 0x3f, 0x25, 0x4e, 0x7c, 0x66, 0x3b, 0x0c, 0xd6, 0x92,
-	0x77, 0x14, 0xce, 0xf1, 0xbc, 0xc1, 0x33, 0x52, 0x77, 0x1d, 0xb6, 0xb3, 0x46, 0x69, 0x45, 0x69,
-	0x90, 0xa7, 0x08, 0xb4, 0xa8, 0xaf, 0xb2, 0x14, 0x02, 0x03, 0x35, 0x4a, 0x2b, 0xfd, 0x5b, 0x5c,
-	0x0e, 0xaf, 0x5f, 0x90, 0x33, 0x59, 0x75, 0x36, 0xeb, 0x8e, 0x15, 0x14, 0xe1, 0xd1, 0x2d, 0xc7,
-	0x22, 0x5e, 0x78, 0x6f, 0xf5, 0x2f, 0x91, 0xec, 0x61, 0x67, 0xc7, 0xff, 0xb4, 0xf0, 0xb9, 0x1f,
-	0x87, 0xe1, 0x38, 0x2f, 0x08, 0x3f, 0x47, 0x30, 0x28, 0xb4, 0x18, 0x36, 0x62, 0x77, 0xba, 0x5d,
-	0x08, 0x6a, 0x17, 0x93, 0x07, 0x08, 0x7a, 0xfa, 0xf4, 0xa7, 0x3f, 0xff, 0xf9, 0x75, 0xfa, 0x3c,
-	0x9e, 0x30, 0xe2, 0x44, 0xaa, 0x50, 0x83, 0xf8, 0x69, 0x1a, 0x46, 0x63, 0x34, 0x14, 0x5e, 0xee,
-	0x9e, 0xbe, 0xbb, 0x90, 0xd4, 0x56, 0x8e, 0x88, 0x22, 0x99, 0xad, 0x73, 0x66, 0x77, 0xf1, 0x9d,
-	0x58, 0x66, 0xcd, 0xcd, 0x36, 0x1e, 0xb7, 0x3d, 0x68, 0x4f, 0x0c, 0xda, 0xc4, 0x2f, 0xaa, 0x2b,
-	0x72, 0x0f, 0xc1, 0xd9, 0x08, 0xad, 0x86, 0xdf, 0xeb, 0xa1, 0xee, 0x36, 0x35, 0xa9, 0xbd, 0x7f,
-	0xc8, 0x68, 0xc9, 0xf6, 0x36, 0x67, 0x7b, 0x13, 0xdf, 0x38, 0x0a, 0xdb, 0xa6, 0x1a, 0xc4, 0xbf,
-	0x20, 0x18, 0x3a, 0x28, 0x80, 0xf0, 0x95, 0x1e, 0x6a, 0x0c, 0x8b, 0x47, 0xed, 0xea, 0x61, 0x42,
-	0x25, 0xb7, 0x5b, 0x9c, 0xdb, 0x0a, 0x5e, 0x3a, 0x0a, 0x37, 0x25, 0xb5, 0xfe, 0x46, 0x30, 0xdc,
-	0x26, 0x31, 0x70, 0x82, 0xf2, 0x3a, 0x29, 0x2a, 0xed, 0xda, 0xa1, 0x62, 0x25, 0xb7, 0x22, 0xe7,
-	0xf6, 0x11, 0x5e, 0x8f, 0xe5, 0xd6, 0x78, 0x0c, 0x7c, 0xe3, 0x71, 0xdb, 0x5b, 0xf2, 0xc4, 0x90,
-	0x27, 0x33, 0x8a, 0x37, 0x7e, 0x85, 0xe0, 0xcd, 0x68, 0x2d, 0x81, 0xaf, 0xf7, 0x52, 0x78, 0x84,
-	0xfa, 0xd1, 0x3e, 0x38, 0x3c, 0x40, 0x4f, 0xa3, 0x4d, 0x46, 0x9f, 0x2f, 0x66, 0xc4, 0xd3, 0x9e,
-	0x64, 0x31, 0x3b, 0xab, 0x90, 0x24, 0x8b, 0x19, 0xa3, 0x27, 0x12, 0x2e, 0x66, 0x17, 0x86, 0xcd,
-	0xb3, 0x8d, 0xff, 0x41, 0x90, 0xe9, 0xf4, 0xf0, 0xe3, 0x85, 0x1e, 0x6a, 0x8d, 0x56, 0x2b, 0xda,
-	0xe2, 0x51, 0x20, 0x24, 0xe7, 0xfb, 0x9c, 0xf3, 0x6d, 0xbc, 0x7a, 0x14, 0xce, 0x07, 0x95, 0x0b,
-	0xfe, 0x0e, 0xc1, 0xe9, 0x90, 0xb8, 0xc0, 0x97, 0xba, 0xd7, 0x1a, 0xa5, 0x55, 0xb4, 0xcb, 0x3d,
-	0xc7, 0x49, 0x62, 0xf3, 0x9c, 0xd8, 0x0c, 0x9e, 0x8e, 0x25, 0x56, 0x52, 0xb1, 0xc5, 0xe0, 0x69,
-	0x0f, 0x8e, 0x65, 0xa6, 0x93, 0xcc, 0x48, 0x32, 0xb1, 0x2e, 0x5a, 0x26, 0xc9, 0xc4, 0xba, 0xa9,
-	0x1c, 0xfd, 0x3a, 0x27, 0x76, 0x05, 0x5f, 0x8e, 0x25, 0x56, 0x51, 0x30, 0xc5, 0x9a, 0xc4, 0x51,
-	0x8f, 0xe2, 0xe2, 0xad, 0x17, 0x7b, 0x59, 0xf4, 0x72, 0x2f, 0x8b, 0xfe, 0xd8, 0xcb, 0xa2, 0xaf,
-	0xf6, 0xb3, 0xa9, 0x97, 0xfb, 0xd9, 0xd4, 0xaf, 0xfb, 0xd9, 0xd4, 0xc7, 0xb3, 0xb1, 0x62, 0xe8,
-	0x93, 0x70, 0x26, 0xae, 0x8d, 0x36, 0x06, 0xf9, 0x1f, 0xbb, 0xe6, 0xff, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0xb0, 0x8b, 0x1d, 0xfc, 0xff, 0x13, 0x00, 0x00,
+	// 1331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0xcd, 0x6f, 0x1b, 0x45,
+	0x18, 0xc6, 0x3d, 0x6e, 0xfa, 0xf5, 0x96, 0xd2, 0x76, 0xfa, 0x21, 0x77, 0x5b, 0xec, 0xb0, 0xa1,
+	0x4d, 0x44, 0x14, 0x6f, 0x93, 0x88, 0xa6, 0x1f, 0xa4, 0x69, 0x9c, 0xa4, 0x14, 0x25, 0x6a, 0x13,
+	0xb7, 0x6a, 0x0a, 0x17, 0x6b, 0xed, 0x1d, 0xad, 0x57, 0xb5, 0x77, 0x9c, 0xdd, 0xd9, 0x84, 0xa8,
+	0xea, 0x85, 0x52, 0xa9, 0x17, 0x10, 0x12, 0x97, 0x1e, 0x73, 0x44, 0x9c, 0x41, 0x48, 0xfc, 0x05,
+	0x3d, 0x56, 0x20, 0x21, 0xc4, 0x01, 0x50, 0x82, 0x50, 0x25, 0xc4, 0x99, 0x2b, 0xf2, 0xec, 0xac,
+	0xbd, 0x1b, 0xaf, 0xd7, 0x5f, 0x31, 0xa7, 0xc6, 0xb3, 0xf3, 0x3e, 0xef, 0xfb, 0x7b, 0xe7, 0x63,
+	0x9f, 0x2d, 0x0c, 0x17, 0xa8, 0x5d, 0xa6, 0xb6, 0xa2, 0x19, 0x36, 0xb3, 0x8c, 0xbc, 0xc3, 0x0c,
+	0x6a, 0x2a, 0xeb, 0xe3, 0x79, 0xc2, 0xd4, 0x71, 0x65, 0xcd, 0x21, 0xd6, 0x66, 0xba, 0x62, 0x51,
+	0x46, 0xf1, 0x39, 0x77, 0x62, 0xda, 0x3f, 0x31, 0x2d, 0x26, 0x4a, 0xef, 0x0a, 0x95, 0xbc, 0x6a,
+	0x13, 0x37, 0xaa, 0xa6, 0x51, 0x51, 0x75, 0xc3, 0x54, 0xf9, 0x6c, 0x2e, 0x24, 0x9d, 0xd2, 0xa9,
+	0x4e, 0xf9, 0x9f, 0x4a, 0xf5, 0x2f, 0x31, 0x7a, 0x5e, 0xa7, 0x54, 0x2f, 0x11, 0x45, 0xad, 0x18,
+	0x8a, 0x6a, 0x9a, 0x94, 0xf1, 0x10, 0x5b, 0x3c, 0x4d, 0xfa, 0xf5, 0x3d, 0xe5, 0x02, 0x35, 0x3c,
+	0xcd, 0x74, 0x14, 0x45, 0xa0, 0x62, 0x77, 0xfe, 0x59, 0x77, 0x7e, 0xce, 0x2d, 0x43, 0x90, 0xf1,
+	0x1f, 0xf2, 0x29, 0xc0, 0x2b, 0x55, 0x80, 0x65, 0xd5, 0x52, 0xcb, 0x76, 0x96, 0xac, 0x39, 0xc4,
+	0x66, 0xf2, 0x43, 0x38, 0x19, 0x18, 0xb5, 0x2b, 0xd4, 0xb4, 0x09, 0x9e, 0x85, 0x03, 0x15, 0x3e,
+	0x92, 0x40, 0x83, 0x68, 0xe4, 0xc8, 0xc4, 0x50, 0x3a, 0xa2, 0x4b, 0x69, 0x37, 0x38, 0x33, 0xf0,
+	0xf2, 0xb7, 0x54, 0x2c, 0x2b, 0x02, 0xe5, 0x0a, 0x0c, 0x73, 0xe5, 0x07, 0x6a, 0xc9, 0xd0, 0x54,
+	0x46, 0xad, 0xbb, 0x0e, 0xb3, 0x99, 0x6a, 0x6a, 0x86, 0xa9, 0x67, 0xc9, 0x86, 0x6a, 0x69, 0x5e,
+	0x11, 0x78, 0x01, 0x4e, 0xac, 0x7b, 0xb3, 0x72, 0xaa, 0xa6, 0x59, 0xc4, 0x76, 0x13, 0x1f, 0xce,
+	0x24, 0x7e, 0xfc, 0x76, 0xec, 0x94, 0xc8, 0x3d, 0xeb, 0x3e, 0xb9, 0xc7, 0xac, 0xaa, 0xc4, 0xf1,
+	0x5a, 0x88, 0x18, 0x97, 0x3f, 0x43, 0x30, 0xd2, 0x3a, 0xa5, 0x20, 0x7c, 0x08, 0x07, 0x2d, 0x77,
+	0x48, 0x20, 0x5e, 0x89, 0x44, 0x8c, 0x90, 0x14, 0xdc, 0x9e, 0x9c, 0x5c, 0x84, 0x54, 0xb0, 0x8a,
+	0x39, 0x5a, 0x2e, 0x1b, 0xb6, 0x6d, 0x50, 0x73, 0x8f, 0x81, 0x9f, 0x21, 0x18, 0x6c, 0x9e, 0x4a,
+	0x80, 0xaa, 0x00, 0x85, 0xda, 0xa8, 0x60, 0xbd, 0xde, 0x1e, 0xeb, 0x6c, 0xa1, 0xe0, 0x94, 0x9d,
+	0x92, 0xca, 0x88, 0x56, 0x17, 0x16, 0xb8, 0x3e, 0x51, 0xf9, 0x59, 0x1c, 0xce, 0x07, 0xeb, 0xb8,
+	0x57, 0x52, 0xed, 0x22, 0xd9, 0xe3, 0x05, 0xc6, 0xc3, 0x70, 0xcc, 0x66, 0xaa, 0xc5, 0x0c, 0x53,
+	0xcf, 0x15, 0x89, 0xa1, 0x17, 0x59, 0x22, 0x3e, 0x88, 0x46, 0x06, 0xb2, 0x6f, 0x7a, 0xc3, 0xb7,
+	0xf9, 0x28, 0x1e, 0x82, 0xa3, 0xc4, 0xd4, 0x7c, 0xd3, 0xf6, 0xf1, 0x69, 0x6f, 0xb8, 0x83, 0x62,
+	0xd2, 0x2d, 0x80, 0xfa, 0x19, 0x4e, 0x0c, 0xf0, 0xc6, 0x5c, 0xf4, 0x1a, 0x53, 0x3d, 0x90, 0x69,
+	0xf7, 0x9a, 0xa8, 0xef, 0x72, 0x9d, 0x08, 0xa0, 0xac, 0x2f, 0xf2, 0xda, 0xa1, 0xe7, 0x5b, 0xa9,
+	0xd8, 0x8b, 0xad, 0x14, 0x92, 0x7f, 0x40, 0xf0, 0x56, 0x93, 0x3e, 0x88, 0xc5, 0x58, 0x86, 0x83,
+	0xb6, 0x3b, 0x94, 0x40, 0x83, 0xfb, 0x46, 0x8e, 0x4c, 0x5c, 0x6a, 0x6f, 0x25, 0xb8, 0xce, 0xc2,
+	0x3a, 0x31, 0x99, 0xb7, 0xdb, 0x84, 0x0c, 0xfe, 0x20, 0x40, 0x11, 0xe7, 0x14, 0xc3, 0x2d, 0x29,
+	0xdc, 0x72, 0xfc, 0x18, 0xf2, 0xf7, 0x5e, 0xf1, 0xf3, 0xa4, 0x44, 0x74, 0x3e, 0xd6, 0x78, 0x4c,
+	0x35, 0xf7, 0x59, 0x27, 0xab, 0x58, 0x0b, 0xf1, 0x56, 0x31, 0x74, 0x33, 0xc4, 0x3b, 0xdd, 0x0c,
+	0x6e, 0xdb, 0x5f, 0x6f, 0xa5, 0x62, 0xf2, 0xe7, 0x08, 0x92, 0xcd, 0x2a, 0x17, 0x7d, 0x7f, 0xe4,
+	0x3f, 0xed, 0xd5, 0xbe, 0x9f, 0x0f, 0xb4, 0xc8, 0x6b, 0xce, 0x3c, 0x29, 0xcc, 0x51, 0xc3, 0xcc,
+	0x4c, 0x56, 0x7b, 0xfc, 0xcd, 0xef, 0xa9, 0x51, 0xdd, 0x60, 0x45, 0x27, 0x9f, 0x2e, 0xd0, 0xb2,
+	0xb8, 0x4c, 0xc5, 0x3f, 0x63, 0xb6, 0xf6, 0x48, 0x61, 0x9b, 0x15, 0x62, 0x7b, 0x31, 0x76, 0xfd,
+	0x02, 0x70, 0x40, 0xde, 0x55, 0xce, 0x7d, 0xca, 0xd4, 0x52, 0x5f, 0xba, 0xe9, 0x6b, 0xc3, 0x5f,
+	0x08, 0x86, 0x22, 0xf3, 0x8a, 0x5e, 0x3c, 0xd8, 0xdd, 0x8b, 0xcb, 0x91, 0x7b, 0xb0, 0xae, 0x36,
+	0xef, 0xe5, 0x76, 0x15, 0x77, 0xdd, 0x7b, 0x58, 0x87, 0xfd, 0xac, 0x9a, 0x2f, 0x11, 0xef, 0x57,
+	0x87, 0x5d, 0x7d, 0xd9, 0x12, 0x17, 0x6c, 0xad, 0x9e, 0xda, 0x31, 0xe9, 0x5f, 0x73, 0x97, 0x60,
+	0xb0, 0x79, 0x4e, 0xd1, 0xd8, 0x24, 0x40, 0x6d, 0x97, 0xba, 0xbd, 0x3d, 0x9c, 0xf5, 0x8d, 0xf8,
+	0xd4, 0x36, 0xe0, 0x9d, 0xa0, 0xda, 0xaa, 0xc1, 0x8a, 0x9a, 0xa5, 0x6e, 0x88, 0xc4, 0x7d, 0xc3,
+	0x58, 0x87, 0x0b, 0x2d, 0x12, 0x0b, 0x96, 0x39, 0x38, 0xbe, 0x21, 0x1e, 0xb5, 0x9d, 0xf8, 0xd8,
+	0x46, 0x50, 0xcc, 0x97, 0xf7, 0x1c, 0x9c, 0xe5, 0x79, 0xab, 0xaf, 0x11, 0xc7, 0x34, 0xd8, 0xe6,
+	0x32, 0xa5, 0x25, 0xcf, 0x83, 0x3c, 0x45, 0x20, 0x85, 0x3d, 0x15, 0xa5, 0x10, 0x18, 0xa8, 0x50,
+	0x5a, 0xea, 0xdf, 0xc1, 0xe5, 0xf2, 0xf2, 0x45, 0xb1, 0x26, 0x4b, 0xc6, 0x9a, 0x63, 0x68, 0xd5,
+	0x22, 0x2c, 0xba, 0x6e, 0x68, 0xc4, 0x0a, 0x9e, 0x5b, 0xf9, 0x0b, 0x04, 0x17, 0x5a, 0x4c, 0xfc,
+	0x7f, 0x0b, 0x67, 0xf0, 0x76, 0x70, 0x4d, 0x67, 0x1d, 0x46, 0xe7, 0x68, 0xb9, 0x42, 0x1d, 0x53,
+	0xeb, 0xdb, 0x4e, 0xba, 0x01, 0x72, 0x54, 0x56, 0xd1, 0x82, 0x04, 0x1c, 0x24, 0xa6, 0x9a, 0x2f,
+	0x11, 0x8d, 0x27, 0x3b, 0x94, 0xf5, 0x7e, 0xca, 0x25, 0x11, 0xef, 0x0f, 0xab, 0x69, 0xd5, 0x0e,
+	0x40, 0xf0, 0x1d, 0x8d, 0xba, 0x7d, 0x47, 0xcb, 0x5f, 0x7b, 0x77, 0x63, 0xb3, 0x74, 0xa2, 0xde,
+	0x2b, 0x00, 0x35, 0x66, 0x71, 0x84, 0x23, 0xfa, 0xe3, 0x9b, 0xbb, 0x67, 0xef, 0xe1, 0x89, 0xe7,
+	0xa7, 0x61, 0x3f, 0x2f, 0x15, 0xbf, 0x40, 0x70, 0xc0, 0xb5, 0xd6, 0x58, 0x89, 0xbc, 0xa2, 0x1b,
+	0x7d, 0xbd, 0x74, 0xa9, 0xfd, 0x00, 0xb7, 0x06, 0x79, 0xf4, 0xd3, 0x9f, 0xfe, 0xfc, 0x2a, 0x7e,
+	0x01, 0x0f, 0x29, 0x51, 0xdf, 0x1c, 0xae, 0xb9, 0xc7, 0x4f, 0xe3, 0x70, 0x2e, 0xc2, 0x12, 0xe3,
+	0xf9, 0xd6, 0xe9, 0x5b, 0x7f, 0x17, 0x48, 0x0b, 0x3d, 0xaa, 0x08, 0xb2, 0x55, 0x4e, 0xb6, 0x82,
+	0xef, 0x46, 0x92, 0xd5, 0x2f, 0x6a, 0xe5, 0x71, 0x83, 0x3f, 0x79, 0xa2, 0xd0, 0xba, 0x7e, 0xce,
+	0x7b, 0xe3, 0x6d, 0x23, 0x38, 0x19, 0x62, 0xbd, 0xf1, 0xfb, 0x1d, 0xd4, 0xdd, 0xf0, 0x71, 0x20,
+	0x4d, 0x77, 0x19, 0x2d, 0x68, 0xef, 0x70, 0xda, 0xdb, 0xf8, 0x56, 0x2f, 0xb4, 0x75, 0x73, 0x8f,
+	0x7f, 0x46, 0x70, 0x7c, 0xb7, 0x9f, 0xc5, 0x57, 0x3b, 0xa8, 0x31, 0xf8, 0x2d, 0x20, 0x5d, 0xeb,
+	0x26, 0x54, 0xb0, 0x2d, 0x72, 0xb6, 0x05, 0x3c, 0xd7, 0x0b, 0x9b, 0xe7, 0x9c, 0xff, 0x41, 0x70,
+	0xa2, 0xc1, 0x31, 0xe2, 0x36, 0xca, 0x6b, 0x66, 0x90, 0xa5, 0xeb, 0x5d, 0xc5, 0x0a, 0xb6, 0x1c,
+	0x67, 0xfb, 0x08, 0xaf, 0x46, 0xb2, 0xd5, 0x6f, 0x1c, 0xe5, 0x71, 0xc3, 0x85, 0xfe, 0x44, 0x11,
+	0x3b, 0x33, 0x8c, 0x1b, 0xbf, 0x46, 0x70, 0x26, 0xdc, 0x1a, 0xe2, 0x99, 0x4e, 0x0a, 0x0f, 0x31,
+	0xb3, 0xd2, 0xcd, 0xee, 0x05, 0x3a, 0x5a, 0xda, 0xf6, 0xf0, 0xf9, 0xc1, 0x0c, 0x71, 0x6a, 0xed,
+	0x1c, 0xcc, 0xe6, 0xa6, 0x52, 0x9a, 0xee, 0x32, 0xba, 0xa3, 0x83, 0xd9, 0x82, 0xb0, 0xbe, 0xb7,
+	0xf1, 0xbf, 0x08, 0x12, 0xcd, 0x7c, 0x1c, 0x9e, 0xed, 0xa0, 0xd6, 0x70, 0xf3, 0x29, 0x65, 0x7a,
+	0x91, 0x10, 0xcc, 0xf7, 0x39, 0xf3, 0x1d, 0xbc, 0xd4, 0x0b, 0xf3, 0x6e, 0x23, 0x8a, 0xbf, 0x43,
+	0x70, 0x34, 0xe0, 0x15, 0xf1, 0xe5, 0xd6, 0xb5, 0x86, 0x59, 0x4f, 0x69, 0xaa, 0xe3, 0x38, 0x01,
+	0x36, 0xc9, 0xc1, 0xc6, 0xf0, 0x68, 0x24, 0x58, 0xc1, 0x8b, 0xcd, 0x55, 0x9d, 0x5a, 0x75, 0x5b,
+	0x26, 0x9a, 0xb9, 0xc6, 0x76, 0x56, 0xac, 0x85, 0x35, 0x95, 0x32, 0xbd, 0x48, 0x08, 0xb0, 0x19,
+	0x0e, 0x76, 0x15, 0x4f, 0x45, 0x82, 0x95, 0x3c, 0x99, 0x5c, 0x45, 0xe8, 0xd4, 0x5e, 0x8a, 0x7f,
+	0x23, 0x38, 0x1d, 0x6a, 0x0a, 0xf1, 0x8d, 0x0e, 0x36, 0x54, 0x88, 0x87, 0x95, 0x66, 0xba, 0x8e,
+	0x17, 0x6c, 0x2b, 0x9c, 0x6d, 0x11, 0x7f, 0xd8, 0xcb, 0x6e, 0x54, 0x1d, 0x46, 0x73, 0x05, 0x8f,
+	0xe9, 0x57, 0x04, 0x67, 0xc2, 0x3d, 0x65, 0x3b, 0x97, 0x6a, 0xa4, 0xf9, 0x95, 0x6e, 0x76, 0x2f,
+	0x20, 0x80, 0xa7, 0x39, 0xf0, 0x14, 0x7e, 0x2f, 0x12, 0x38, 0x40, 0x94, 0xab, 0xe3, 0x67, 0x16,
+	0x5f, 0x6e, 0x27, 0xd1, 0xab, 0xed, 0x24, 0xfa, 0x63, 0x3b, 0x89, 0xbe, 0xdc, 0x49, 0xc6, 0x5e,
+	0xed, 0x24, 0x63, 0xbf, 0xec, 0x24, 0x63, 0x1f, 0x8f, 0x47, 0x7e, 0xa6, 0x7c, 0x12, 0xcc, 0xc3,
+	0xbf, 0x5a, 0xf2, 0x07, 0xf8, 0x7f, 0x43, 0x4f, 0xfe, 0x37, 0x00, 0x86, 0xbe, 0xf7, 0x6a, 0x99,
+	0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1063,6 +1266,12 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// LiquidityProviderRewards queries the outstanding liquidity provider reward coins.
 	LiquidityProviderRewards(ctx context.Context, in *QueryLiquidityProviderRewardsRequest, opts ...grpc.CallOption) (*QueryLiquidityProviderRewardsResponse, error)
+	// DelegatorAutoCompound queries whether the rewards of a delegator are
+	// auto-compounded.
+	DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error)
+	// AutoCompoundDelegators queries the delegators whose rewards are
+	// auto-compounded.
+	AutoCompoundDelegators(ctx context.Context, in *QueryAutoCompoundDelegatorsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegatorsResponse, error)
 }
 
 type queryClient struct {
@@ -1163,6 +1372,24 @@ func (c *queryClient) LiquidityProviderRewards(ctx context.Context, in *QueryLiq
 	return out, nil
 }
 
+func (c *queryClient) DelegatorAutoCompound(ctx context.Context, in *QueryDelegatorAutoCompoundRequest, opts ...grpc.CallOption) (*QueryDelegatorAutoCompoundResponse, error) {
+	out := new(QueryDelegatorAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AutoCompoundDelegators(ctx context.Context, in *QueryAutoCompoundDelegatorsRequest, opts ...grpc.CallOption) (*QueryAutoCompoundDelegatorsResponse, error) {
+	out := new(QueryAutoCompoundDelegatorsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompoundDelegators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1186,6 +1413,12 @@ type QueryServer interface {
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// LiquidityProviderRewards queries the outstanding liquidity provider reward coins.
 	LiquidityProviderRewards(context.Context, *QueryLiquidityProviderRewardsRequest) (*QueryLiquidityProviderRewardsResponse, error)
+	// DelegatorAutoCompound queries whether the rewards of a delegator are
+	// auto-compounded.
+	DelegatorAutoCompound(context.Context, *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error)
+	// AutoCompoundDelegators queries the delegators whose rewards are
+	// auto-compounded.
+	AutoCompoundDelegators(context.Context, *QueryAutoCompoundDelegatorsRequest) (*QueryAutoCompoundDelegatorsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1222,6 +1455,12 @@ func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCo
 func (*UnimplementedQueryServer) LiquidityProviderRewards(ctx context.Context, req *QueryLiquidityProviderRewardsRequest) (*QueryLiquidityProviderRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidityProviderRewards not implemented")
 }
+func (*UnimplementedQueryServer) DelegatorAutoCompound(ctx context.Context, req *QueryDelegatorAutoCompoundRequest) (*QueryDelegatorAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegatorAutoCompound not implemented")
+}
+func (*UnimplementedQueryServer) AutoCompoundDelegators(ctx context.Context, req *QueryAutoCompoundDelegatorsRequest) (*QueryAutoCompoundDelegatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompoundDelegators not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1407,6 +1646,42 @@ func _Query_LiquidityProviderRewards_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DelegatorAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegatorAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/DelegatorAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegatorAutoCompound(ctx, req.(*QueryDelegatorAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoCompoundDelegators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundDelegatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompoundDelegators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompoundDelegators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompoundDelegators(ctx, req.(*QueryAutoCompoundDelegatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1451,6 +1726,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidityProviderRewards",
 			Handler:    _Query_LiquidityProviderRewards_Handler,
 		},
+		{
+			MethodName: "DelegatorAutoCompound",
+			Handler:    _Query_DelegatorAutoCompound_Handler,
+		},
+		{
+			MethodName: "AutoCompoundDelegators",
+			Handler:    _Query_AutoCompoundDelegators_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2136,90 +2419,232 @@ func (m *QueryLiquidityProviderRewardsResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegatorAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDelegatorAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDelegatorAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegatorAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Rewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorCommissionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDelegatorAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidatorCommissionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAutoCompoundDelegatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Commission.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidatorSlashesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryAutoCompoundDelegatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegators) > 0 {
+		for iNdEx := len(m.Delegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Delegators[iNdEx])
+			copy(dAtA[i:], m.Delegators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegators[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorCommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.ValidatorAddress)
 	if l > 0 {
@@ -2425,6 +2850,63 @@ func (m *QueryLiquidityProviderRewardsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDelegatorAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegatorAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundDelegatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundDelegatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegators) > 0 {
+		for _, s := range m.Delegators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4164,6 +4646,362 @@ func (m *QueryLiquidityProviderRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDelegatorAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegatorAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegatorAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundDelegatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundDelegatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundDelegatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegators = append(m.Delegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
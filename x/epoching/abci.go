@@ -0,0 +1,35 @@
+package epoching
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// EndBlocker executes the queued actions at the last block of every epoch and
+// starts the next epoch.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if !k.IsEpochEnd(ctx) {
+		return
+	}
+
+	epochNumber := k.GetEpochNumber(ctx)
+	numActions := k.ExecuteEpochActions(ctx)
+
+	k.IncreaseEpochNumber(ctx)
+	k.SetEpochStartHeight(ctx, ctx.BlockHeight()+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochEnd,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeyNumActions, strconv.Itoa(numActions)),
+		),
+	)
+}
@@ -0,0 +1,56 @@
+package epoching
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// StakingMsgFilterDecorator is an AnteDecorator that rejects the unwrapped
+// staking messages when the RejectUnwrappedStakingMsgs param is set, so that
+// the transactions which would fail when executed are not added to the
+// mempool. The messages nested in an authz MsgExec are checked as well. The
+// rule itself is enforced by the message router, see Keeper.FilterStakingMsg.
+type StakingMsgFilterDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewStakingMsgFilterDecorator creates a new StakingMsgFilterDecorator.
+func NewStakingMsgFilterDecorator(k keeper.Keeper) StakingMsgFilterDecorator {
+	return StakingMsgFilterDecorator{keeper: k}
+}
+
+var _ sdk.AnteDecorator = StakingMsgFilterDecorator{}
+
+// AnteHandle implements the AnteDecorator.AnteHandle method
+func (d StakingMsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if d.keeper.RejectUnwrappedStakingMsgs(ctx) {
+		if err := checkStakingMsgs(tx.GetMsgs()); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func checkStakingMsgs(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if types.IsUnwrappedStakingMsg(msg) {
+			return sdkerrors.Wrapf(types.ErrUnwrappedStakingMsg, "%s must be wrapped in an epoching message", sdk.MsgTypeURL(msg))
+		}
+
+		if exec, ok := msg.(*authz.MsgExec); ok {
+			nested, err := exec.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := checkStakingMsgs(nested); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package epoching_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStakingMsgFilterDecorator(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.Setup(t, false)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EpochingKeeper.SetParams(app.BaseApp.NewContext(false, header), types.NewParams(types.DefaultEpochLength, true))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	priv := secp256k1.GenPrivKey()
+	delAddr := sdk.AccAddress(priv.PubKey().Address())
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, delAddr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	require.NoError(t, banktestutil.FundAccount(app.BankKeeper, ctx, delAddr, sdk.NewCoins(amount)))
+	valAddr := app.StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+
+	delegate := stakingtypes.NewMsgDelegate(delAddr, valAddr, amount)
+	exec := authz.NewMsgExec(delAddr, []sdk.Msg{delegate})
+	cancel := stakingtypes.NewMsgCancelUnbondingDelegation(delAddr, valAddr, 1, amount)
+
+	checkTx := func(msg sdk.Msg) abci.ResponseCheckTx {
+		acc := app.AccountKeeper.GetAccount(ctx, delAddr)
+		tx, err := helpers.GenSignedMockTx(
+			rand.New(rand.NewSource(1)), encCfg.TxConfig, []sdk.Msg{msg}, sdk.Coins{},
+			helpers.DefaultGenTxGas, "", []uint64{acc.GetAccountNumber()}, []uint64{acc.GetSequence()}, priv,
+		)
+		require.NoError(t, err)
+		bz, err := encCfg.TxConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		return app.CheckTx(abci.RequestCheckTx{Tx: bz})
+	}
+
+	// the unwrapped staking messages are rejected, even when executed through authz
+	for _, msg := range []sdk.Msg{delegate, &exec, cancel} {
+		res := checkTx(msg)
+		require.Equal(t, types.ModuleName, res.Codespace)
+		require.Equal(t, types.ErrUnwrappedStakingMsg.ABCICode(), res.Code)
+	}
+
+	// the staking messages queued through the epoching module are accepted
+	res := checkTx(types.NewMsgWrappedDelegate(delegate))
+	require.True(t, res.IsOK(), res.Log)
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// GetQueryCmd returns the cli query commands for the epoching module.
+func GetQueryCmd() *cobra.Command {
+	epochingQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the epoching module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochingQueryCmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryCurrentEpoch(),
+		GetCmdQueryPendingActions(),
+	)
+
+	return epochingQueryCmd
+}
+
+// GetCmdQueryParams implements a command to return the current epoching
+// parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current epoching parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryCurrentEpoch implements a command to return the current epoch.
+func GetCmdQueryCurrentEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "current-epoch",
+		Short: "Query the current epoch and its estimated end",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CurrentEpoch(cmd.Context(), &types.QueryCurrentEpochRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPendingActions implements a command to return the actions queued
+// for execution at the end of an epoch.
+func GetCmdQueryPendingActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-actions",
+		Short: "Query the actions queued for execution at the end of the epoch",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PendingActions(cmd.Context(), &types.QueryPendingActionsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-actions")
+
+	return cmd
+}
@@ -0,0 +1,237 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingcli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/epoching transaction commands.
+func NewTxCmd() *cobra.Command {
+	epochingTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Epoching transaction subcommands, executed at the end of the current epoch",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	epochingTxCmd.AddCommand(
+		NewDelegateCmd(),
+		NewRedelegateCmd(),
+		NewUnbondCmd(),
+		NewEditValidatorCmd(),
+	)
+
+	return epochingTxCmd
+}
+
+// NewDelegateCmd returns a CLI command handler for creating a MsgWrappedDelegate transaction.
+func NewDelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "delegate [validator-addr] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Queue a delegation of liquid tokens to a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queue a delegation of an amount of liquid coins to a validator from your wallet.
+The coins are escrowed immediately and delegated at the end of the current epoch.
+
+Example:
+$ %s tx epoching delegate %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, valAddr, amount))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRedelegateCmd returns a CLI command handler for creating a MsgWrappedBeginRedelegate transaction.
+func NewRedelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "redelegate [src-validator-addr] [dst-validator-addr] [amount]",
+		Short: "Queue a redelegation of illiquid tokens from one validator to another",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queue a redelegation of an amount of illiquid staking tokens from one validator to another.
+The redelegation is executed at the end of the current epoch.
+
+Example:
+$ %s tx epoching redelegate %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 100stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valSrcAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			valDstAddr, err := sdk.ValAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWrappedBeginRedelegate(stakingtypes.NewMsgBeginRedelegate(delAddr, valSrcAddr, valDstAddr, amount))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUnbondCmd returns a CLI command handler for creating a MsgWrappedUndelegate transaction.
+func NewUnbondCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "unbond [validator-addr] [amount]",
+		Short: "Queue an unbonding of shares from a validator",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Queue an unbonding of an amount of bonded shares from a validator.
+The unbonding starts at the end of the current epoch.
+
+Example:
+$ %s tx epoching unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr, valAddr, amount))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewEditValidatorCmd returns a CLI command handler for creating a MsgWrappedEditValidator transaction.
+func NewEditValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-validator",
+		Short: "Queue an edit of an existing validator account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+			moniker, _ := cmd.Flags().GetString(stakingcli.FlagEditMoniker)
+			identity, _ := cmd.Flags().GetString(stakingcli.FlagIdentity)
+			website, _ := cmd.Flags().GetString(stakingcli.FlagWebsite)
+			security, _ := cmd.Flags().GetString(stakingcli.FlagSecurityContact)
+			details, _ := cmd.Flags().GetString(stakingcli.FlagDetails)
+			description := stakingtypes.NewDescription(moniker, identity, website, security, details)
+
+			var newRate *sdk.Dec
+
+			commissionRate, _ := cmd.Flags().GetString(stakingcli.FlagCommissionRate)
+			if commissionRate != "" {
+				rate, err := sdk.NewDecFromStr(commissionRate)
+				if err != nil {
+					return fmt.Errorf("invalid new commission rate: %v", err)
+				}
+
+				newRate = &rate
+			}
+
+			var newMinSelfDelegation *math.Int
+
+			minSelfDelegationString, _ := cmd.Flags().GetString(stakingcli.FlagMinSelfDelegation)
+			if minSelfDelegationString != "" {
+				msb, ok := sdk.NewIntFromString(minSelfDelegationString)
+				if !ok {
+					return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "minimum self delegation must be a positive integer")
+				}
+
+				newMinSelfDelegation = &msb
+			}
+
+			msg := types.NewMsgWrappedEditValidator(
+				stakingtypes.NewMsgEditValidator(sdk.ValAddress(valAddr), description, newRate, newMinSelfDelegation),
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(stakingcli.FlagSetDescriptionEdit())
+	cmd.Flags().AddFlagSet(stakingcli.FlagSetCommissionUpdate())
+	cmd.Flags().AddFlagSet(stakingcli.FlagSetMinSelfDelegation())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ExecuteEpochActions executes, in queue order, every action queued on the
+// current or a previous epoch and removes it from the queue. Each action runs
+// on its own branch of the state so that a failing action does not affect the
+// others; the error is reported through an event instead. It returns the
+// number of actions processed.
+func (k Keeper) ExecuteEpochActions(ctx sdk.Context) int {
+	store := ctx.KVStore(k.storeKey)
+	end := types.EpochActionsPrefix(k.GetEpochNumber(ctx) + 1)
+	iterator := store.Iterator(types.EpochActionQueuePrefix, end)
+
+	var (
+		keys    [][]byte
+		actions []types.QueuedAction
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		actions = append(actions, k.GetEpochActionByIterator(iterator))
+	}
+	iterator.Close()
+
+	for i, action := range actions {
+		err := k.executeAction(ctx, action)
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(action.EpochNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(action.ActionId, 10)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, action.Msg.GetTypeUrl()),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		}
+		if err != nil {
+			k.Logger(ctx).Info("failed to execute epoch action", "action_id", action.ActionId, "err", err)
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExecuteAction, attrs...))
+
+		store.Delete(keys[i])
+	}
+
+	return len(actions)
+}
+
+// executeAction releases any coins escrowed for the action and then executes
+// the queued message on a cached context, which is only written on success.
+func (k Keeper) executeAction(ctx sdk.Context, action types.QueuedAction) error {
+	msg, err := action.UnpackMsg()
+	if err != nil {
+		return err
+	}
+
+	// Escrowed delegation coins are returned to the delegator whether or not
+	// the delegation itself succeeds.
+	if delegate, ok := msg.(*stakingtypes.MsgDelegate); ok {
+		delAddr, err := sdk.AccAddressFromBech32(delegate.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(delegate.Amount)); err != nil {
+			return err
+		}
+	}
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return sdkerrors.ErrUnknownRequest.Wrapf("unrecognized message route: %s", sdk.MsgTypeURL(msg))
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithValue(epochActionKey{}, true)
+	res, err := safeExecute(cacheCtx, handler, msg)
+	if err != nil {
+		return err
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return nil
+}
+
+// safeExecute runs the handler and converts a panic into an error so that a
+// single faulty action cannot halt the chain.
+func safeExecute(ctx sdk.Context, handler func(sdk.Context, sdk.Msg) (*sdk.Result, error), msg sdk.Msg) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("epoch action panicked: %v", r)
+		}
+	}()
+
+	return handler(ctx, msg)
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// epochActionKey is the context key marking the execution of the queued
+// actions at the end of an epoch.
+type epochActionKey struct{}
+
+// FilterStakingMsg is a baseapp.MsgFilter rejecting the unwrapped staking
+// messages when the RejectUnwrappedStakingMsgs param is set, unless they are
+// executed as queued actions at the end of an epoch. Being run by the router,
+// it also applies to the messages executed through authz, gov or group.
+func (k Keeper) FilterStakingMsg(ctx sdk.Context, msg sdk.Msg) error {
+	if !types.IsUnwrappedStakingMsg(msg) || ctx.Value(epochActionKey{}) != nil || !k.RejectUnwrappedStakingMsgs(ctx) {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrUnwrappedStakingMsg, "%s must be wrapped in an epoching message", sdk.MsgTypeURL(msg))
+}
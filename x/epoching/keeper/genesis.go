@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// InitGenesis initializes the epoching module's state from a given genesis
+// state. Queued actions are restored on the current epoch.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetEpochNumber(ctx, data.EpochNumber)
+	// InitChain runs at height zero unless the chain sets an initial height,
+	// in both cases the first epoch starts with the first block.
+	startHeight := ctx.BlockHeight()
+	if startHeight < 1 {
+		startHeight = 1
+	}
+	k.SetEpochStartHeight(ctx, startHeight)
+	k.authKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, action := range data.Actions {
+		k.RestoreEpochAction(ctx, data.EpochNumber, action)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	actions := []*codectypes.Any{}
+	for _, action := range k.GetEpochActions(ctx) {
+		actions = append(actions, action.Msg)
+	}
+
+	return types.NewGenesisState(k.GetParams(ctx), k.GetEpochNumber(ctx), actions)
+}
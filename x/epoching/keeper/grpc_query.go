@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns params of the epoching module.
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// CurrentEpoch returns the current epoch and its estimated end.
+func (k Keeper) CurrentEpoch(c context.Context, _ *types.QueryCurrentEpochRequest) (*types.QueryCurrentEpochResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryCurrentEpochResponse{
+		Epoch:            k.GetCurrentEpoch(ctx),
+		EndHeight:        k.GetNextEpochHeight(ctx),
+		EstimatedEndTime: k.GetNextEpochTime(ctx),
+	}, nil
+}
+
+// PendingActions returns the actions waiting in the epoch queue.
+func (k Keeper) PendingActions(c context.Context, req *types.QueryPendingActionsRequest) (*types.QueryPendingActionsResponse, error) {
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.EpochActionQueuePrefix)
+
+	var actions []types.QueuedAction
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var action types.QueuedAction
+		if err := k.cdc.Unmarshal(value, &action); err != nil {
+			return err
+		}
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingActionsResponse{Actions: actions, Pagination: pageRes}, nil
+}
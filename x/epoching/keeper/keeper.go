@@ -1,120 +1,172 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	db "github.com/tendermint/tm-db"
 )
 
-const (
-	DefaultEpochActionID = 1
-	DefaultEpochNumber   = 0
-)
-
-var (
-	NextEpochActionID      = []byte{0x11}
-	EpochNumberID          = []byte{0x12}
-	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
-)
-
 // Keeper of the store
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	cdc           codec.BinaryCodec
+	paramSpace    paramtypes.Subspace
+	router        *baseapp.MsgServiceRouter
+	authKeeper    types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	stakingKeeper types.StakingKeeper
 	// Used to calculate the estimated next epoch time.
 	// This is local to every node
 	// TODO: remove in favor of consensus param when its added
 	commitTimeout time.Duration
 }
 
-// NewKeeper creates a epoch queue manager
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, commitTimeout time.Duration) Keeper {
-	return Keeper{
+// NewKeeper creates a epoch queue manager. Queued messages are executed
+// through the provided router at the end of every epoch. The router rejects
+// the unwrapped staking messages when the RejectUnwrappedStakingMsgs param is
+// set.
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper,
+	router *baseapp.MsgServiceRouter, commitTimeout time.Duration,
+) Keeper {
+	// ensure the epoching module account is set, it escrows queued delegations
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	k := Keeper{
 		storeKey:      key,
 		cdc:           cdc,
+		paramSpace:    paramSpace,
+		router:        router,
+		authKeeper:    ak,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
 		commitTimeout: commitTimeout,
 	}
+	router.AddMsgFilter(k.FilterStakingMsg)
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of epoching parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// RejectUnwrappedStakingMsgs returns whether the staking messages which are
+// not queued through the epoching module are rejected.
+func (k Keeper) RejectUnwrappedStakingMsgs(ctx sdk.Context) (res bool) {
+	k.paramSpace.GetIfExists(ctx, types.KeyRejectUnwrappedStakingMsgs, &res)
+	return
+}
+
+// SetParams sets the total set of epoching parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
 }
 
 // GetNewActionID returns ID to be used for next epoch
 func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(NextEpochActionID)
-	if bz == nil {
-		// return default action ID to 1
-		return DefaultEpochActionID
+	id := uint64(types.DefaultEpochActionID)
+	if bz := store.Get(types.NextEpochActionID); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
 	}
-	id := sdk.BigEndianToUint64(bz)
 
 	// increment next action ID
-	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
+	store.Set(types.NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
 
 	return id
 }
 
-// ActionStoreKey returns action store key from ID
-func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
-	return append(EpochActionQueuePrefix, byte(epochNumber), byte(actionID))
-}
-
 // QueueMsgForEpoch save the actions that need to be executed on next epoch
-func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) {
-	store := ctx.KVStore(k.storeKey)
-
-	bz, err := k.cdc.MarshalInterface(msg)
+// and returns the ID of the queued action.
+func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) uint64 {
+	action, err := codectypes.NewAnyWithValue(msg)
 	if err != nil {
 		panic(err)
 	}
 
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	return k.RestoreEpochAction(ctx, epochNumber, action)
 }
 
 // RestoreEpochAction restore the actions that need to be executed on next epoch
-func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *codectypes.Any) {
+// and returns the ID of the queued action.
+func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *codectypes.Any) uint64 {
 	store := ctx.KVStore(k.storeKey)
 
-	// reference from TestMarshalAny(t *testing.T)
-	bz, err := k.cdc.MarshalInterface(action)
-	if err != nil {
-		panic(err)
+	actionID := k.GetNewActionID(ctx)
+	queued := types.QueuedAction{
+		EpochNumber: epochNumber,
+		ActionId:    actionID,
+		Height:      ctx.BlockHeight(),
+		Msg:         action,
 	}
+	store.Set(types.ActionStoreKey(epochNumber, actionID), k.cdc.MustMarshal(&queued))
 
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	return actionID
 }
 
-// GetEpochMsg gets a msg by ID
-func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) sdk.Msg {
+// GetEpochAction gets a queued action by ID
+func (k Keeper) GetEpochAction(ctx sdk.Context, epochNumber int64, actionID uint64) (action types.QueuedAction, found bool) {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(ActionStoreKey(epochNumber, actionID))
+	bz := store.Get(types.ActionStoreKey(epochNumber, actionID))
 	if bz == nil {
+		return action, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &action)
+	return action, true
+}
+
+// GetEpochMsg gets a msg by ID
+func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) sdk.Msg {
+	action, found := k.GetEpochAction(ctx, epochNumber, actionID)
+	if !found {
 		return nil
 	}
 
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+	msg, err := action.UnpackMsg()
+	if err != nil {
+		return nil
+	}
 
-	return action
+	return msg
 }
 
 // GetEpochActions get all actions
-func (k Keeper) GetEpochActions(ctx sdk.Context) []sdk.Msg {
-	actions := []sdk.Msg{}
+func (k Keeper) GetEpochActions(ctx sdk.Context) []types.QueuedAction {
+	actions := []types.QueuedAction{}
 	iterator := k.GetEpochActionsIterator(ctx)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var action sdk.Msg
-		bz := iterator.Value()
-		k.cdc.UnmarshalInterface(bz, &action)
-		actions = append(actions, action)
+		actions = append(actions, k.GetEpochActionByIterator(iterator))
 	}
 
 	return actions
@@ -122,17 +174,21 @@ func (k Keeper) GetEpochActions(ctx sdk.Context) []sdk.Msg {
 
 // GetEpochActionsIterator returns iterator for EpochActions
 func (k Keeper) GetEpochActionsIterator(ctx sdk.Context) db.Iterator {
-	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
+	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.EpochActionQueuePrefix)
 }
 
 // DequeueEpochActions dequeue all the actions store on epoch
 func (k Keeper) DequeueEpochActions(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, EpochActionQueuePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.EpochActionQueuePrefix)
 	defer iterator.Close()
 
+	var keys [][]byte
 	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
 		store.Delete(key)
 	}
 }
@@ -144,11 +200,9 @@ func (k Keeper) DeleteByKey(ctx sdk.Context, key []byte) {
 }
 
 // GetEpochActionByIterator get action by iterator
-func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) sdk.Msg {
-	bz := iterator.Value()
-
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) types.QueuedAction {
+	var action types.QueuedAction
+	k.cdc.MustUnmarshal(iterator.Value(), &action)
 
 	return action
 }
@@ -156,16 +210,16 @@ func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) sdk.Msg {
 // SetEpochNumber set epoch number
 func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber int64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
+	store.Set(types.EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
 }
 
 // GetEpochNumber fetches epoch number
 func (k Keeper) GetEpochNumber(ctx sdk.Context) int64 {
 	store := ctx.KVStore(k.storeKey)
 
-	bz := store.Get(EpochNumberID)
+	bz := store.Get(types.EpochNumberID)
 	if bz == nil {
-		return DefaultEpochNumber
+		return types.DefaultEpochNumber
 	}
 
 	return int64(sdk.BigEndianToUint64(bz))
@@ -177,16 +231,54 @@ func (k Keeper) IncreaseEpochNumber(ctx sdk.Context) {
 	k.SetEpochNumber(ctx, epochNumber+1)
 }
 
-// GetNextEpochHeight returns next epoch block height
-func (k Keeper) GetNextEpochHeight(ctx sdk.Context, epochInterval int64) int64 {
-	currentHeight := ctx.BlockHeight()
-	return currentHeight + (epochInterval - currentHeight%epochInterval)
+// SetEpochStartHeight sets the first block height of the current epoch
+func (k Keeper) SetEpochStartHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochStartHeightID, sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetEpochStartHeight fetches the first block height of the current epoch
+func (k Keeper) GetEpochStartHeight(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.EpochStartHeightID)
+	if bz == nil {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// GetCurrentEpoch returns the current epoch
+func (k Keeper) GetCurrentEpoch(ctx sdk.Context) types.Epoch {
+	return types.Epoch{
+		Number:      k.GetEpochNumber(ctx),
+		StartHeight: k.GetEpochStartHeight(ctx),
+	}
+}
+
+// GetNextEpochHeight returns the height of the last block of the current
+// epoch, at the end of which queued actions are executed. If the epoch length
+// has been shortened so that the epoch should already have ended, the current
+// height is returned.
+func (k Keeper) GetNextEpochHeight(ctx sdk.Context) int64 {
+	end := k.GetEpochStartHeight(ctx) + k.GetParams(ctx).EpochLength - 1
+	if end < ctx.BlockHeight() {
+		return ctx.BlockHeight()
+	}
+
+	return end
+}
+
+// IsEpochEnd returns true if the current block is the last block of the epoch
+func (k Keeper) IsEpochEnd(ctx sdk.Context) bool {
+	return ctx.BlockHeight() >= k.GetNextEpochHeight(ctx)
 }
 
 // GetNextEpochTime returns estimated next epoch time
-func (k Keeper) GetNextEpochTime(ctx sdk.Context, epochInterval int64) time.Time {
+func (k Keeper) GetNextEpochTime(ctx sdk.Context) time.Time {
 	currentTime := ctx.BlockTime()
 	currentHeight := ctx.BlockHeight()
 
-	return currentTime.Add(k.commitTimeout * time.Duration(k.GetNextEpochHeight(ctx, epochInterval)-currentHeight))
+	return currentTime.Add(k.commitTimeout * time.Duration(k.GetNextEpochHeight(ctx)-currentHeight))
 }
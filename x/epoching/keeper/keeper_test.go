@@ -0,0 +1,263 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	msgServer   types.MsgServer
+	queryClient types.QueryClient
+	addrs       []sdk.AccAddress
+	valAddr     sdk.ValAddress
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(suite.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.EpochingKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.msgServer = keeper.NewMsgServerImpl(app.EpochingKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+	suite.addrs = simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	suite.Require().Len(validators, 1)
+	suite.valAddr = validators[0].GetOperator()
+}
+
+// endEpoch advances the context to the last block of the current epoch and
+// runs the epoching EndBlocker.
+func (suite *KeeperTestSuite) endEpoch() {
+	height := suite.app.EpochingKeeper.GetNextEpochHeight(suite.ctx)
+	suite.ctx = suite.ctx.WithBlockHeight(height)
+	epoching.EndBlocker(suite.ctx, suite.app.EpochingKeeper)
+}
+
+func (suite *KeeperTestSuite) TestGetNewActionID() {
+	k := suite.app.EpochingKeeper
+
+	suite.Require().Equal(uint64(1), k.GetNewActionID(suite.ctx))
+	suite.Require().Equal(uint64(2), k.GetNewActionID(suite.ctx))
+	suite.Require().Equal(uint64(3), k.GetNewActionID(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestQueueAndDequeue() {
+	k := suite.app.EpochingKeeper
+	msg := stakingtypes.NewMsgDelegate(suite.addrs[0], suite.valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	id := k.QueueMsgForEpoch(suite.ctx, 0, msg)
+	suite.Require().Equal(msg, k.GetEpochMsg(suite.ctx, 0, id))
+	suite.Require().Nil(k.GetEpochMsg(suite.ctx, 1, id))
+	suite.Require().Len(k.GetEpochActions(suite.ctx), 1)
+
+	k.DequeueEpochActions(suite.ctx)
+	suite.Require().Empty(k.GetEpochActions(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestEpochTransition() {
+	k := suite.app.EpochingKeeper
+	length := k.GetParams(suite.ctx).EpochLength
+
+	suite.Require().Equal(int64(0), k.GetEpochNumber(suite.ctx))
+	suite.Require().Equal(length, k.GetNextEpochHeight(suite.ctx))
+	suite.Require().False(k.IsEpochEnd(suite.ctx))
+
+	suite.endEpoch()
+	suite.Require().Equal(int64(1), k.GetEpochNumber(suite.ctx))
+	suite.Require().Equal(length+1, k.GetEpochStartHeight(suite.ctx))
+	suite.Require().Equal(2*length, k.GetNextEpochHeight(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestWrappedDelegate() {
+	k, bk, sk := suite.app.EpochingKeeper, suite.app.BankKeeper, suite.app.StakingKeeper
+	delAddr := suite.addrs[0]
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	balance := bk.GetBalance(suite.ctx, delAddr, sdk.DefaultBondDenom)
+
+	res, err := suite.msgServer.WrappedDelegate(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, suite.valAddr, amount)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(0), res.EpochNumber)
+
+	// coins are escrowed but not delegated yet
+	suite.Require().Equal(balance.Sub(amount), bk.GetBalance(suite.ctx, delAddr, sdk.DefaultBondDenom))
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(amount, bk.GetBalance(suite.ctx, moduleAddr, sdk.DefaultBondDenom))
+	_, found := sk.GetDelegation(suite.ctx, delAddr, suite.valAddr)
+	suite.Require().False(found)
+
+	suite.endEpoch()
+
+	_, found = sk.GetDelegation(suite.ctx, delAddr, suite.valAddr)
+	suite.Require().True(found)
+	suite.Require().True(bk.GetBalance(suite.ctx, moduleAddr, sdk.DefaultBondDenom).IsZero())
+	suite.Require().Equal(balance.Sub(amount), bk.GetBalance(suite.ctx, delAddr, sdk.DefaultBondDenom))
+	suite.Require().Empty(k.GetEpochActions(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestWrappedMsgValidation() {
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	unknownVal := sdk.ValAddress(suite.addrs[1])
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	_, err := suite.msgServer.WrappedDelegate(goCtx,
+		types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(suite.addrs[0], unknownVal, amount)))
+	suite.Require().ErrorIs(err, types.ErrUnknownValidator)
+
+	_, err = suite.msgServer.WrappedDelegate(goCtx,
+		types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(suite.addrs[0], suite.valAddr, sdk.NewInt64Coin("foo", 1))))
+	suite.Require().ErrorIs(err, types.ErrBadDenom)
+
+	_, err = suite.msgServer.WrappedUndelegate(goCtx,
+		types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(suite.addrs[1], suite.valAddr, amount)))
+	suite.Require().ErrorIs(err, types.ErrNoDelegation)
+
+	_, err = suite.msgServer.WrappedEditValidator(goCtx,
+		types.NewMsgWrappedEditValidator(stakingtypes.NewMsgEditValidator(unknownVal, stakingtypes.Description{}, nil, nil)))
+	suite.Require().ErrorIs(err, types.ErrUnknownValidator)
+}
+
+func (suite *KeeperTestSuite) TestFailedActionDoesNotHalt() {
+	k, sk := suite.app.EpochingKeeper, suite.app.StakingKeeper
+	delAddr := suite.addrs[0]
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.msgServer.WrappedDelegate(goCtx,
+		types.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delAddr, suite.valAddr, amount)))
+	suite.Require().NoError(err)
+	suite.endEpoch()
+
+	// undelegating more than delegated fails at execution time only
+	_, err = suite.msgServer.WrappedUndelegate(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr, suite.valAddr, amount.Add(amount))))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.WrappedUndelegate(sdk.WrapSDKContext(suite.ctx),
+		types.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delAddr, suite.valAddr, amount)))
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.endEpoch()
+
+	var results []string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != types.EventTypeExecuteAction {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeySuccess {
+				results = append(results, string(attr.Value))
+			}
+		}
+	}
+	suite.Require().Equal([]string{"false", "true"}, results)
+
+	_, found := sk.GetDelegation(suite.ctx, delAddr, suite.valAddr)
+	suite.Require().False(found)
+	suite.Require().Empty(k.GetEpochActions(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestFilterStakingMsg() {
+	k, sk := suite.app.EpochingKeeper, suite.app.StakingKeeper
+	router := suite.app.MsgServiceRouter()
+	delAddr := suite.addrs[0]
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	delegate := stakingtypes.NewMsgDelegate(delAddr, suite.valAddr, amount)
+	cancel := stakingtypes.NewMsgCancelUnbondingDelegation(delAddr, suite.valAddr, 1, amount)
+
+	// the unwrapped staking messages are routed while the param is not set
+	cacheCtx, _ := suite.ctx.CacheContext()
+	_, err := router.Handler(delegate)(cacheCtx, delegate)
+	suite.Require().NoError(err)
+
+	k.SetParams(suite.ctx, types.NewParams(types.DefaultEpochLength, true))
+
+	// they are rejected by the router once set, whatever the module routing
+	// them, e.g. gov or authz
+	for _, msg := range []sdk.Msg{delegate, cancel} {
+		_, err = router.Handler(msg)(suite.ctx, msg)
+		suite.Require().ErrorIs(err, types.ErrUnwrappedStakingMsg)
+	}
+
+	// the queued epoch actions are still executed
+	msg := types.NewMsgWrappedDelegate(delegate)
+	_, err = router.Handler(msg)(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.endEpoch()
+
+	_, found := sk.GetDelegation(suite.ctx, delAddr, suite.valAddr)
+	suite.Require().True(found)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueries() {
+	k := suite.app.EpochingKeeper
+
+	params, err := suite.queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(k.GetParams(suite.ctx), params.Params)
+
+	epoch, err := suite.queryClient.CurrentEpoch(gocontext.Background(), &types.QueryCurrentEpochRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(k.GetCurrentEpoch(suite.ctx), epoch.Epoch)
+	suite.Require().Equal(k.GetNextEpochHeight(suite.ctx), epoch.EndHeight)
+
+	msg := stakingtypes.NewMsgUndelegate(suite.addrs[0], suite.valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+	k.QueueMsgForEpoch(suite.ctx, 0, msg)
+	k.QueueMsgForEpoch(suite.ctx, 0, msg)
+
+	actions, err := suite.queryClient.PendingActions(gocontext.Background(), &types.QueryPendingActionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(actions.Actions, 2)
+	suite.Require().Equal(uint64(2), actions.Actions[1].ActionId)
+
+	queued, err := actions.Actions[0].UnpackMsg()
+	suite.Require().NoError(err)
+	suite.Require().Equal(msg, queued)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	k := suite.app.EpochingKeeper
+	msg := stakingtypes.NewMsgUndelegate(suite.addrs[0], suite.valAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+
+	k.SetEpochNumber(suite.ctx, 3)
+	k.QueueMsgForEpoch(suite.ctx, 2, msg)
+	k.QueueMsgForEpoch(suite.ctx, 3, msg)
+
+	exported := k.ExportGenesis(suite.ctx)
+	suite.Require().Equal(int64(3), exported.EpochNumber)
+	suite.Require().Len(exported.Actions, 2)
+	suite.Require().NoError(types.ValidateGenesis(exported))
+
+	k.DequeueEpochActions(suite.ctx)
+	k.InitGenesis(suite.ctx, exported)
+
+	// all actions are restored on the current epoch
+	for _, action := range k.GetEpochActions(suite.ctx) {
+		suite.Require().Equal(int64(3), action.EpochNumber)
+	}
+	suite.Require().Equal(exported, k.ExportGenesis(suite.ctx))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
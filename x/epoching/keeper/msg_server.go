@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the epoching MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// WrappedDelegate escrows the delegated coins and queues the delegation for
+// the end of the current epoch.
+func (k msgServer) WrappedDelegate(goCtx context.Context, msg *types.MsgWrappedDelegate) (*types.MsgWrappedDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.Msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(msg.Msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	epochNumber, actionID := k.queue(ctx, msg.Msg)
+	return &types.MsgWrappedDelegateResponse{EpochNumber: epochNumber, ActionId: actionID}, nil
+}
+
+// WrappedUndelegate queues the undelegation for the end of the current epoch.
+func (k msgServer) WrappedUndelegate(goCtx context.Context, msg *types.MsgWrappedUndelegate) (*types.MsgWrappedUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateDelegation(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	epochNumber, actionID := k.queue(ctx, msg.Msg)
+	return &types.MsgWrappedUndelegateResponse{EpochNumber: epochNumber, ActionId: actionID}, nil
+}
+
+// WrappedBeginRedelegate queues the redelegation for the end of the current
+// epoch.
+func (k msgServer) WrappedBeginRedelegate(goCtx context.Context, msg *types.MsgWrappedBeginRedelegate) (*types.MsgWrappedBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorSrcAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorDstAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateDelegation(ctx, msg.Msg.DelegatorAddress, msg.Msg.ValidatorSrcAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	epochNumber, actionID := k.queue(ctx, msg.Msg)
+	return &types.MsgWrappedBeginRedelegateResponse{EpochNumber: epochNumber, ActionId: actionID}, nil
+}
+
+// WrappedEditValidator queues the validator edit for the end of the current
+// epoch.
+func (k msgServer) WrappedEditValidator(goCtx context.Context, msg *types.MsgWrappedEditValidator) (*types.MsgWrappedEditValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	epochNumber, actionID := k.queue(ctx, msg.Msg)
+	return &types.MsgWrappedEditValidatorResponse{EpochNumber: epochNumber, ActionId: actionID}, nil
+}
+
+// queue adds msg to the queue of the current epoch and emits the
+// corresponding event.
+func (k msgServer) queue(ctx sdk.Context, msg sdk.Msg) (int64, uint64) {
+	epochNumber := k.GetEpochNumber(ctx)
+	actionID := k.QueueMsgForEpoch(ctx, epochNumber, msg)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeQueueAction,
+			sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+			sdk.NewAttribute(types.AttributeKeyActionID, strconv.FormatUint(actionID, 10)),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, sdk.MsgTypeURL(msg)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.GetSigners()[0].String()),
+		),
+	})
+
+	return epochNumber, actionID
+}
+
+func (k msgServer) validateValidator(ctx sdk.Context, valAddrStr string) error {
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		return err
+	}
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return types.ErrUnknownValidator.Wrap(valAddrStr)
+	}
+
+	return nil
+}
+
+func (k msgServer) validateDelegation(ctx sdk.Context, delAddrStr, valAddrStr string) error {
+	delAddr, err := sdk.AccAddressFromBech32(delAddrStr)
+	if err != nil {
+		return err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+	if err != nil {
+		return err
+	}
+	if _, found := k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr); !found {
+		return types.ErrNoDelegation
+	}
+
+	return nil
+}
+
+func (k msgServer) validateBondDenom(ctx sdk.Context, coin sdk.Coin) error {
+	if bondDenom := k.stakingKeeper.BondDenom(ctx); coin.Denom != bondDenom {
+		return types.ErrBadDenom.Wrapf("got %s, expected %s", coin.Denom, bondDenom)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/epoching/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding epoching type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.NextEpochActionID),
+			bytes.Equal(kvA.Key, types.EpochNumberID),
+			bytes.Equal(kvA.Key, types.EpochStartHeightID):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, types.EpochActionQueuePrefix):
+			var actionA, actionB types.QueuedAction
+			cdc.MustUnmarshal(kvA.Value, &actionA)
+			cdc.MustUnmarshal(kvB.Value, &actionB)
+			return fmt.Sprintf("%v\n%v", actionA, actionB)
+
+		default:
+			panic(fmt.Sprintf("invalid epoching key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/epoching interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedDelegate{}, "cosmos-sdk/MsgWrappedDelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedUndelegate{}, "cosmos-sdk/MsgWrappedUndelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedBeginRedelegate{}, "cosmos-sdk/MsgWrappedBeginRedelegate")
+	legacy.RegisterAminoMsg(cdc, &MsgWrappedEditValidator{}, "cosmos-sdk/MsgWrappedEditValidator")
+}
+
+// RegisterInterfaces registers the x/epoching interfaces types with the interface registry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWrappedDelegate{},
+		&MsgWrappedUndelegate{},
+		&MsgWrappedBeginRedelegate{},
+		&MsgWrappedEditValidator{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register all Amino interfaces and concrete types on the authz Amino codec so that this can later be
+	// used to properly serialize MsgGrant and MsgExec instances
+	RegisterLegacyAminoCodec(authzcodec.Amino)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrInvalidWrappedMsg   = sdkerrors.Register(ModuleName, 2, "invalid wrapped message")
+	ErrUnknownValidator    = sdkerrors.Register(ModuleName, 3, "validator does not exist")
+	ErrNoDelegation        = sdkerrors.Register(ModuleName, 4, "no delegation for (address, validator) tuple")
+	ErrBadDenom            = sdkerrors.Register(ModuleName, 5, "invalid coin denomination")
+	ErrUnwrappedStakingMsg = sdkerrors.Register(ModuleName, 6, "staking message must be queued through the epoching module")
+)
@@ -0,0 +1,17 @@
+package types
+
+// epoching module event types
+const (
+	EventTypeQueueAction   = "queue_action"
+	EventTypeExecuteAction = "execute_action"
+	EventTypeEpochEnd      = "epoch_end"
+
+	AttributeKeyEpochNumber = "epoch_number"
+	AttributeKeyActionID    = "action_id"
+	AttributeKeyMsgTypeURL  = "msg_type_url"
+	AttributeKeySuccess     = "success"
+	AttributeKeyError       = "error"
+	AttributeKeyNumActions  = "num_actions"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, epochNumber int64, actions []*codectypes.Any) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		EpochNumber: epochNumber,
+		Actions:     actions,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultEpochNumber, []*codectypes.Any{})
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data *GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.EpochNumber < 0 {
+		return fmt.Errorf("epoch number cannot be negative: %d", data.EpochNumber)
+	}
+
+	for i, action := range data.Actions {
+		msg, ok := action.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return fmt.Errorf("action %d is not a sdk.Msg: %T", i, action.GetCachedValue())
+		}
+		if err := ValidateQueuedMsg(msg); err != nil {
+			return fmt.Errorf("invalid action %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, action := range data.Actions {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(action, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a QueuedAction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(a.Msg, &msg)
+}
+
+// UnpackMsg returns the queued sdk.Msg.
+func (a QueuedAction) UnpackMsg() (sdk.Msg, error) {
+	msg, ok := a.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("expected sdk.Msg, got %T", a.Msg.GetCachedValue())
+	}
+	return msg, nil
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the epoching module
+	ModuleName = "epoching"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the epoching module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the epoching module
+	QuerierRoute = ModuleName
+)
+
+const (
+	DefaultEpochActionID = 1
+	DefaultEpochNumber   = 0
+)
+
+// KVStore keys
+var (
+	NextEpochActionID      = []byte{0x11}
+	EpochNumberID          = []byte{0x12}
+	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
+	EpochStartHeightID     = []byte{0x14}
+)
+
+// ActionStoreKey returns the action store key from an epoch number and an
+// action ID. Both are big endian encoded so that iteration over the queue
+// yields actions in epoch then insertion order.
+func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
+	key := make([]byte, 0, len(EpochActionQueuePrefix)+16)
+	key = append(key, EpochActionQueuePrefix...)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+	return append(key, sdk.Uint64ToBigEndian(actionID)...)
+}
+
+// EpochActionsPrefix returns the prefix of all actions queued on an epoch.
+func EpochActionsPrefix(epochNumber int64) []byte {
+	return append(append([]byte{}, EpochActionQueuePrefix...), sdk.Uint64ToBigEndian(uint64(epochNumber))...)
+}
+
+// SplitActionStoreKey returns the epoch number and action ID encoded in an
+// action store key.
+func SplitActionStoreKey(key []byte) (int64, uint64) {
+	key = key[len(EpochActionQueuePrefix):]
+	return int64(sdk.BigEndianToUint64(key[:8])), sdk.BigEndianToUint64(key[8:16])
+}
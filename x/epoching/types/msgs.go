@@ -0,0 +1,170 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// epoching message types
+const (
+	TypeMsgWrappedDelegate        = "wrapped_delegate"
+	TypeMsgWrappedUndelegate      = "wrapped_begin_unbonding"
+	TypeMsgWrappedBeginRedelegate = "wrapped_begin_redelegate"
+	TypeMsgWrappedEditValidator   = "wrapped_edit_validator"
+)
+
+var (
+	_ sdk.Msg            = &MsgWrappedDelegate{}
+	_ sdk.Msg            = &MsgWrappedUndelegate{}
+	_ sdk.Msg            = &MsgWrappedBeginRedelegate{}
+	_ sdk.Msg            = &MsgWrappedEditValidator{}
+	_ legacytx.LegacyMsg = &MsgWrappedDelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedUndelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedBeginRedelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedEditValidator{}
+)
+
+// ValidateQueuedMsg checks that msg is a staking message which can be queued
+// by the epoching module, and that it passes its own stateless validation.
+func ValidateQueuedMsg(msg sdk.Msg) error {
+	switch msg.(type) {
+	case *stakingtypes.MsgDelegate, *stakingtypes.MsgUndelegate,
+		*stakingtypes.MsgBeginRedelegate, *stakingtypes.MsgEditValidator:
+	default:
+		return ErrInvalidWrappedMsg.Wrapf("message type %s cannot be queued", sdk.MsgTypeURL(msg))
+	}
+
+	return msg.ValidateBasic()
+}
+
+// IsUnwrappedStakingMsg returns true if msg is a staking message changing the
+// validator set, which must be queued through the epoching module when the
+// RejectUnwrappedStakingMsgs param is set. MsgCancelUnbondingDelegation, which
+// bonds the tokens again, cannot be queued and is then rejected.
+func IsUnwrappedStakingMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *stakingtypes.MsgDelegate, *stakingtypes.MsgUndelegate,
+		*stakingtypes.MsgBeginRedelegate, *stakingtypes.MsgEditValidator,
+		*stakingtypes.MsgCancelUnbondingDelegation:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewMsgWrappedDelegate creates a new MsgWrappedDelegate instance.
+func NewMsgWrappedDelegate(msg *stakingtypes.MsgDelegate) *MsgWrappedDelegate {
+	return &MsgWrappedDelegate{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) Type() string { return TypeMsgWrappedDelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) GetSigners() []sdk.AccAddress {
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedDelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidWrappedMsg.Wrap("empty wrapped message")
+	}
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedUndelegate creates a new MsgWrappedUndelegate instance.
+func NewMsgWrappedUndelegate(msg *stakingtypes.MsgUndelegate) *MsgWrappedUndelegate {
+	return &MsgWrappedUndelegate{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) Type() string { return TypeMsgWrappedUndelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) GetSigners() []sdk.AccAddress {
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedUndelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidWrappedMsg.Wrap("empty wrapped message")
+	}
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedBeginRedelegate creates a new MsgWrappedBeginRedelegate instance.
+func NewMsgWrappedBeginRedelegate(msg *stakingtypes.MsgBeginRedelegate) *MsgWrappedBeginRedelegate {
+	return &MsgWrappedBeginRedelegate{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) Type() string { return TypeMsgWrappedBeginRedelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) GetSigners() []sdk.AccAddress {
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedBeginRedelegate) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidWrappedMsg.Wrap("empty wrapped message")
+	}
+	return msg.Msg.ValidateBasic()
+}
+
+// NewMsgWrappedEditValidator creates a new MsgWrappedEditValidator instance.
+func NewMsgWrappedEditValidator(msg *stakingtypes.MsgEditValidator) *MsgWrappedEditValidator {
+	return &MsgWrappedEditValidator{Msg: msg}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgWrappedEditValidator) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgWrappedEditValidator) Type() string { return TypeMsgWrappedEditValidator }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgWrappedEditValidator) GetSigners() []sdk.AccAddress {
+	return msg.Msg.GetSigners()
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgWrappedEditValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgWrappedEditValidator) ValidateBasic() error {
+	if msg.Msg == nil {
+		return ErrInvalidWrappedMsg.Wrap("empty wrapped message")
+	}
+	return msg.Msg.ValidateBasic()
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// DefaultEpochLength is the default number of blocks in an epoch.
+const DefaultEpochLength int64 = 10
+
+// Parameter store keys
+var (
+	KeyEpochLength                = []byte("EpochLength")
+	KeyRejectUnwrappedStakingMsgs = []byte("RejectUnwrappedStakingMsgs")
+)
+
+// ParamKeyTable returns the parameter key table for the epoching module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(epochLength int64, rejectUnwrappedStakingMsgs bool) Params {
+	return Params{
+		EpochLength:                epochLength,
+		RejectUnwrappedStakingMsgs: rejectUnwrappedStakingMsgs,
+	}
+}
+
+// DefaultParams returns the default epoching module parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultEpochLength, false)
+}
+
+// Validate performs basic validation on epoching parameters.
+func (p Params) Validate() error {
+	if err := validateEpochLength(p.EpochLength); err != nil {
+		return err
+	}
+
+	return validateRejectUnwrappedStakingMsgs(p.RejectUnwrappedStakingMsgs)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEpochLength, &p.EpochLength, validateEpochLength),
+		paramtypes.NewParamSetPair(KeyRejectUnwrappedStakingMsgs, &p.RejectUnwrappedStakingMsgs, validateRejectUnwrappedStakingMsgs),
+	}
+}
+
+func validateEpochLength(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("epoch length must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateRejectUnwrappedStakingMsgs(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &QueryPendingActionsResponse{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (r QueryPendingActionsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, action := range r.Actions {
+		if err := action.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
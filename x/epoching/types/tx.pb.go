@@ -0,0 +1,1832 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/epoching/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgWrappedDelegate defines a message for queueing a delegation.
+type MsgWrappedDelegate struct {
+	Msg *types.MsgDelegate `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *MsgWrappedDelegate) Reset()         { *m = MsgWrappedDelegate{} }
+func (m *MsgWrappedDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgWrappedDelegate) ProtoMessage()    {}
+func (*MsgWrappedDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0612cf0cb97b6f93, []int{0}
+}
+func (m *MsgWrappedDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrappedDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrappedDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrappedDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrappedDelegate.Merge(m, src)
+}
+func (m *MsgWrappedDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrappedDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrappedDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrappedDelegate proto.InternalMessageInfo
+
+// MsgWrappedDelegateResponse defines the Msg/WrappedDelegate response type.
+type MsgWrappedDelegateResponse struct {
+	// epoch_number is the epoch at the end of which the delegation is executed.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// action_id is the identifier of the queued action.
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+}
+
+func (m *MsgWrappedDelegateResponse) Reset()         { *m = MsgWrappedDelegateResponse{} }
+func (m *MsgWrappedDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWrappedDelegateResponse) ProtoMessage()    {}
+func (*MsgWrappedDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0612cf0cb97b6f93, []int{1}
+}
+func (m *MsgWrappedDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrappedDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrappedDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrappedDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrappedDelegateResponse.Merge(m, src)
+}
+func (m *MsgWrappedDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrappedDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrappedDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrappedDelegateResponse proto.InternalMessageInfo
+
+func (m *MsgWrappedDelegateResponse) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *MsgWrappedDelegateResponse) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
+// MsgWrappedUndelegate defines a message for queueing an undelegation.
+type MsgWrappedUndelegate struct {
+	Msg *types.MsgUndelegate `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *MsgWrappedUndelegate) Reset()         { *m = MsgWrappedUndelegate{} }
+func (m *MsgWrappedUndelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgWrappedUndelegate) ProtoMessage()    {}
+func (*MsgWrappedUndelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0612cf0cb97b6f93, []int{2}
+}
+func (m *MsgWrappedUndelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrappedUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrappedUndelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrappedUndelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrappedUndelegate.Merge(m, src)
+}
+func (m *MsgWrappedUndelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrappedUndelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrappedUndelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrappedUndelegate proto.InternalMessageInfo
+
+// MsgWrappedUndelegateResponse defines the Msg/WrappedUndelegate response type.
+type MsgWrappedUndelegateResponse struct {
+	// epoch_number is the epoch at the end of which the undelegation is executed.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// action_id is the identifier of the queued action.
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+}
+
+func (m *MsgWrappedUndelegateResponse) Reset()         { *m = MsgWrappedUndelegateResponse{} }
+func (m *MsgWrappedUndelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWrappedUndelegateResponse) ProtoMessage()    {}
+func (*MsgWrappedUndelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0612cf0cb97b6f93, []int{3}
+}
+func (m *MsgWrappedUndelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrappedUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrappedUndelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrappedUndelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrappedUndelegateResponse.Merge(m, src)
+}
+func (m *MsgWrappedUndelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrappedUndelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrappedUndelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrappedUndelegateResponse proto.InternalMessageInfo
+
+func (m *MsgWrappedUndelegateResponse) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *MsgWrappedUndelegateResponse) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
+// MsgWrappedBeginRedelegate defines a message for queueing a redelegation.
+type MsgWrappedBeginRedelegate struct {
+	Msg *types.MsgBeginRedelegate `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *MsgWrappedBeginRedelegate) Reset()         { *m = MsgWrappedBeginRedelegate{} }
+func (m *MsgWrappedBeginRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgWrappedBeginRedelegate) ProtoMessage()    {}
+func (*MsgWrappedBeginRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0612cf0cb97b6f93, []int{4}
+}
+func (m *MsgWrappedBeginRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrappedBeginRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrappedBeginRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrappedBeginRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrappedBeginRedelegate.Merge(m, src)
+}
+func (m *MsgWrappedBeginRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrappedBeginRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrappedBeginRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrappedBeginRedelegate proto.InternalMessageInfo
+
+// MsgWrappedBeginRedelegateResponse defines the Msg/WrappedBeginRedelegate
+// response type.
+type MsgWrappedBeginRedelegateResponse struct {
+	// epoch_number is the epoch at the end of which the redelegation is executed.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// action_id is the identifier of the queued action.
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+}
+
+func (m *MsgWrappedBeginRedelegateResponse) Reset()         { *m = MsgWrappedBeginRedelegateResponse{} }
+func (m *MsgWrappedBeginRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWrappedBeginRedelegateResponse) ProtoMessage()    {}
+func (*MsgWrappedBeginRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0612cf0cb97b6f93, []int{5}
+}
+func (m *MsgWrappedBeginRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrappedBeginRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrappedBeginRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrappedBeginRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrappedBeginRedelegateResponse.Merge(m, src)
+}
+func (m *MsgWrappedBeginRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrappedBeginRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrappedBeginRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrappedBeginRedelegateResponse proto.InternalMessageInfo
+
+func (m *MsgWrappedBeginRedelegateResponse) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *MsgWrappedBeginRedelegateResponse) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
+// MsgWrappedEditValidator defines a message for queueing a validator edit.
+type MsgWrappedEditValidator struct {
+	Msg *types.MsgEditValidator `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *MsgWrappedEditValidator) Reset()         { *m = MsgWrappedEditValidator{} }
+func (m *MsgWrappedEditValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgWrappedEditValidator) ProtoMessage()    {}
+func (*MsgWrappedEditValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0612cf0cb97b6f93, []int{6}
+}
+func (m *MsgWrappedEditValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrappedEditValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrappedEditValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrappedEditValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrappedEditValidator.Merge(m, src)
+}
+func (m *MsgWrappedEditValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrappedEditValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrappedEditValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrappedEditValidator proto.InternalMessageInfo
+
+// MsgWrappedEditValidatorResponse defines the Msg/WrappedEditValidator
+// response type.
+type MsgWrappedEditValidatorResponse struct {
+	// epoch_number is the epoch at the end of which the edit is executed.
+	EpochNumber int64 `protobuf:"varint,1,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// action_id is the identifier of the queued action.
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+}
+
+func (m *MsgWrappedEditValidatorResponse) Reset()         { *m = MsgWrappedEditValidatorResponse{} }
+func (m *MsgWrappedEditValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWrappedEditValidatorResponse) ProtoMessage()    {}
+func (*MsgWrappedEditValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0612cf0cb97b6f93, []int{7}
+}
+func (m *MsgWrappedEditValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWrappedEditValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWrappedEditValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWrappedEditValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWrappedEditValidatorResponse.Merge(m, src)
+}
+func (m *MsgWrappedEditValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWrappedEditValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWrappedEditValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWrappedEditValidatorResponse proto.InternalMessageInfo
+
+func (m *MsgWrappedEditValidatorResponse) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *MsgWrappedEditValidatorResponse) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgWrappedDelegate)(nil), "cosmos.epoching.v1beta1.MsgWrappedDelegate")
+	proto.RegisterType((*MsgWrappedDelegateResponse)(nil), "cosmos.epoching.v1beta1.MsgWrappedDelegateResponse")
+	proto.RegisterType((*MsgWrappedUndelegate)(nil), "cosmos.epoching.v1beta1.MsgWrappedUndelegate")
+	proto.RegisterType((*MsgWrappedUndelegateResponse)(nil), "cosmos.epoching.v1beta1.MsgWrappedUndelegateResponse")
+	proto.RegisterType((*MsgWrappedBeginRedelegate)(nil), "cosmos.epoching.v1beta1.MsgWrappedBeginRedelegate")
+	proto.RegisterType((*MsgWrappedBeginRedelegateResponse)(nil), "cosmos.epoching.v1beta1.MsgWrappedBeginRedelegateResponse")
+	proto.RegisterType((*MsgWrappedEditValidator)(nil), "cosmos.epoching.v1beta1.MsgWrappedEditValidator")
+	proto.RegisterType((*MsgWrappedEditValidatorResponse)(nil), "cosmos.epoching.v1beta1.MsgWrappedEditValidatorResponse")
+}
+
+func init() { proto.RegisterFile("cosmos/epoching/v1beta1/tx.proto", fileDescriptor_0612cf0cb97b6f93) }
+
+var fileDescriptor_0612cf0cb97b6f93 = []byte{
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6a, 0xd4, 0x40,
+	0x18, 0xcf, 0xb8, 0x45, 0xf4, 0xab, 0xa0, 0x86, 0xc5, 0xd6, 0x28, 0xc9, 0x76, 0x45, 0x58, 0x2a,
+	0x3b, 0xb1, 0x5b, 0x8a, 0xb2, 0x78, 0x2a, 0x8a, 0x78, 0x58, 0x0f, 0x01, 0x15, 0x44, 0x2d, 0x93,
+	0x64, 0x98, 0x86, 0x6d, 0x32, 0x21, 0x33, 0x95, 0xf6, 0x5a, 0x10, 0x7a, 0xf4, 0x11, 0xfa, 0x08,
+	0x3e, 0x86, 0xc7, 0x1e, 0x3d, 0xca, 0xee, 0x41, 0x1f, 0xa3, 0xe4, 0xff, 0x76, 0x77, 0xb3, 0x6c,
+	0x60, 0x4f, 0x09, 0xdf, 0xfc, 0xfe, 0xc2, 0x37, 0x03, 0x2d, 0x87, 0x0b, 0x9f, 0x0b, 0x93, 0x86,
+	0xdc, 0x39, 0xf4, 0x02, 0x66, 0x7e, 0xdf, 0xb1, 0xa9, 0x24, 0x3b, 0xa6, 0x3c, 0xc1, 0x61, 0xc4,
+	0x25, 0x57, 0x37, 0x52, 0x04, 0xce, 0x11, 0x38, 0x43, 0x68, 0x4d, 0xc6, 0x19, 0x4f, 0x30, 0x66,
+	0xfc, 0x97, 0xc2, 0xb5, 0x0c, 0x6e, 0xfa, 0x22, 0xd6, 0x8a, 0x3f, 0xd9, 0x81, 0x91, 0x1d, 0x08,
+	0x49, 0x86, 0xf3, 0x8c, 0xda, 0x5f, 0x41, 0x1d, 0x08, 0xf6, 0x29, 0x22, 0x61, 0x48, 0xdd, 0xd7,
+	0xf4, 0x88, 0x32, 0x22, 0xa9, 0xba, 0x07, 0x0d, 0x5f, 0xb0, 0x4d, 0xd4, 0x42, 0x9d, 0xf5, 0xde,
+	0x13, 0x9c, 0x85, 0xc9, 0x44, 0xf2, 0x2c, 0x78, 0x20, 0x58, 0xce, 0xb0, 0x62, 0x7c, 0xff, 0xde,
+	0xf9, 0x85, 0xa1, 0xfc, 0xbf, 0x30, 0x94, 0xb3, 0x7f, 0xbf, 0xb6, 0xe3, 0x49, 0xfb, 0x0b, 0x68,
+	0xb3, 0xf2, 0x16, 0x15, 0x21, 0x0f, 0x04, 0x55, 0xb7, 0xe0, 0x4e, 0x52, 0xf0, 0x20, 0x38, 0xf6,
+	0x6d, 0x1a, 0x25, 0x7e, 0x0d, 0x6b, 0x3d, 0x99, 0xbd, 0x4f, 0x46, 0xea, 0x23, 0xb8, 0x4d, 0x1c,
+	0xe9, 0xf1, 0xe0, 0xc0, 0x73, 0x37, 0x6f, 0xb4, 0x50, 0x67, 0xcd, 0xba, 0x95, 0x0e, 0xde, 0xb9,
+	0x6d, 0x02, 0xcd, 0x52, 0xfd, 0x43, 0xe0, 0xe6, 0xf1, 0x5f, 0x4c, 0xc6, 0x7f, 0xba, 0x20, 0x7e,
+	0xc9, 0xa9, 0x2a, 0xf0, 0x0d, 0x1e, 0xcf, 0xb3, 0x58, 0x59, 0x85, 0x21, 0x3c, 0x2c, 0xf5, 0xf7,
+	0x29, 0xf3, 0x02, 0x8b, 0x16, 0x3d, 0x5e, 0x4d, 0xf6, 0xd8, 0x5e, 0xd0, 0x63, 0x8a, 0x58, 0x55,
+	0xc6, 0x81, 0xad, 0x4a, 0xb3, 0x95, 0x35, 0x62, 0xb0, 0x51, 0x9a, 0xbc, 0x71, 0x3d, 0xf9, 0x91,
+	0x1c, 0x79, 0x2e, 0x91, 0x3c, 0x52, 0xfb, 0x93, 0x7d, 0x3a, 0x0b, 0xfa, 0x5c, 0xa3, 0x55, 0xb5,
+	0x21, 0x60, 0x54, 0x18, 0xad, 0xaa, 0x4b, 0xef, 0xc7, 0x1a, 0x34, 0x06, 0x82, 0xa9, 0x02, 0xee,
+	0x4e, 0x5f, 0x91, 0x67, 0xb8, 0xe2, 0x8a, 0xe2, 0xd9, 0x85, 0xd7, 0x76, 0x6b, 0x80, 0x8b, 0xf0,
+	0xa7, 0x70, 0x7f, 0x76, 0xb5, 0xbb, 0x4b, 0x28, 0x95, 0x70, 0x6d, 0xaf, 0x16, 0xbc, 0xb0, 0x3e,
+	0x47, 0xf0, 0xa0, 0x62, 0x27, 0x7b, 0x4b, 0x28, 0x4e, 0x71, 0xb4, 0x7e, 0x7d, 0x4e, 0x11, 0xe5,
+	0x0c, 0x41, 0x73, 0xee, 0x32, 0x3d, 0x5f, 0x42, 0xf4, 0x1a, 0x43, 0x7b, 0x59, 0x97, 0x91, 0x87,
+	0xd8, 0x7f, 0xfb, 0x7b, 0xa4, 0xa3, 0xcb, 0x91, 0x8e, 0xfe, 0x8e, 0x74, 0xf4, 0x73, 0xac, 0x2b,
+	0x97, 0x63, 0x5d, 0xf9, 0x33, 0xd6, 0x95, 0xcf, 0x5d, 0xe6, 0xc9, 0xc3, 0x63, 0x1b, 0x3b, 0xdc,
+	0x37, 0xb3, 0xb7, 0x36, 0xfd, 0x74, 0x85, 0x3b, 0x34, 0x4f, 0xca, 0x27, 0x5e, 0x9e, 0x86, 0x54,
+	0xd8, 0x37, 0x93, 0x57, 0x77, 0xf7, 0x6a, 0x00, 0xbf, 0x00, 0xa8, 0x60, 0x02, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// WrappedDelegate queues a MsgDelegate. The delegated coins are escrowed by
+	// the epoching module until the end of the epoch.
+	WrappedDelegate(ctx context.Context, in *MsgWrappedDelegate, opts ...grpc.CallOption) (*MsgWrappedDelegateResponse, error)
+	// WrappedUndelegate queues a MsgUndelegate.
+	WrappedUndelegate(ctx context.Context, in *MsgWrappedUndelegate, opts ...grpc.CallOption) (*MsgWrappedUndelegateResponse, error)
+	// WrappedBeginRedelegate queues a MsgBeginRedelegate.
+	WrappedBeginRedelegate(ctx context.Context, in *MsgWrappedBeginRedelegate, opts ...grpc.CallOption) (*MsgWrappedBeginRedelegateResponse, error)
+	// WrappedEditValidator queues a MsgEditValidator.
+	WrappedEditValidator(ctx context.Context, in *MsgWrappedEditValidator, opts ...grpc.CallOption) (*MsgWrappedEditValidatorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) WrappedDelegate(ctx context.Context, in *MsgWrappedDelegate, opts ...grpc.CallOption) (*MsgWrappedDelegateResponse, error) {
+	out := new(MsgWrappedDelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epoching.v1beta1.Msg/WrappedDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WrappedUndelegate(ctx context.Context, in *MsgWrappedUndelegate, opts ...grpc.CallOption) (*MsgWrappedUndelegateResponse, error) {
+	out := new(MsgWrappedUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epoching.v1beta1.Msg/WrappedUndelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WrappedBeginRedelegate(ctx context.Context, in *MsgWrappedBeginRedelegate, opts ...grpc.CallOption) (*MsgWrappedBeginRedelegateResponse, error) {
+	out := new(MsgWrappedBeginRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epoching.v1beta1.Msg/WrappedBeginRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WrappedEditValidator(ctx context.Context, in *MsgWrappedEditValidator, opts ...grpc.CallOption) (*MsgWrappedEditValidatorResponse, error) {
+	out := new(MsgWrappedEditValidatorResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.epoching.v1beta1.Msg/WrappedEditValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// WrappedDelegate queues a MsgDelegate. The delegated coins are escrowed by
+	// the epoching module until the end of the epoch.
+	WrappedDelegate(context.Context, *MsgWrappedDelegate) (*MsgWrappedDelegateResponse, error)
+	// WrappedUndelegate queues a MsgUndelegate.
+	WrappedUndelegate(context.Context, *MsgWrappedUndelegate) (*MsgWrappedUndelegateResponse, error)
+	// WrappedBeginRedelegate queues a MsgBeginRedelegate.
+	WrappedBeginRedelegate(context.Context, *MsgWrappedBeginRedelegate) (*MsgWrappedBeginRedelegateResponse, error)
+	// WrappedEditValidator queues a MsgEditValidator.
+	WrappedEditValidator(context.Context, *MsgWrappedEditValidator) (*MsgWrappedEditValidatorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) WrappedDelegate(ctx context.Context, req *MsgWrappedDelegate) (*MsgWrappedDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedDelegate not implemented")
+}
+func (*UnimplementedMsgServer) WrappedUndelegate(ctx context.Context, req *MsgWrappedUndelegate) (*MsgWrappedUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedUndelegate not implemented")
+}
+func (*UnimplementedMsgServer) WrappedBeginRedelegate(ctx context.Context, req *MsgWrappedBeginRedelegate) (*MsgWrappedBeginRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedBeginRedelegate not implemented")
+}
+func (*UnimplementedMsgServer) WrappedEditValidator(ctx context.Context, req *MsgWrappedEditValidator) (*MsgWrappedEditValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WrappedEditValidator not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_WrappedDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWrappedDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WrappedDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epoching.v1beta1.Msg/WrappedDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WrappedDelegate(ctx, req.(*MsgWrappedDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WrappedUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWrappedUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WrappedUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epoching.v1beta1.Msg/WrappedUndelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WrappedUndelegate(ctx, req.(*MsgWrappedUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WrappedBeginRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWrappedBeginRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WrappedBeginRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epoching.v1beta1.Msg/WrappedBeginRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WrappedBeginRedelegate(ctx, req.(*MsgWrappedBeginRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WrappedEditValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWrappedEditValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WrappedEditValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.epoching.v1beta1.Msg/WrappedEditValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WrappedEditValidator(ctx, req.(*MsgWrappedEditValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.epoching.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WrappedDelegate",
+			Handler:    _Msg_WrappedDelegate_Handler,
+		},
+		{
+			MethodName: "WrappedUndelegate",
+			Handler:    _Msg_WrappedUndelegate_Handler,
+		},
+		{
+			MethodName: "WrappedBeginRedelegate",
+			Handler:    _Msg_WrappedBeginRedelegate_Handler,
+		},
+		{
+			MethodName: "WrappedEditValidator",
+			Handler:    _Msg_WrappedEditValidator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/epoching/v1beta1/tx.proto",
+}
+
+func (m *MsgWrappedDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrappedDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrappedDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrappedDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrappedDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrappedDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrappedUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrappedUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrappedUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrappedUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrappedUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrappedUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrappedBeginRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrappedBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrappedBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrappedBeginRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrappedBeginRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrappedBeginRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrappedEditValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrappedEditValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrappedEditValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWrappedEditValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWrappedEditValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWrappedEditValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgWrappedDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWrappedDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovTx(uint64(m.EpochNumber))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgWrappedUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWrappedUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovTx(uint64(m.EpochNumber))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgWrappedBeginRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWrappedBeginRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovTx(uint64(m.EpochNumber))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgWrappedEditValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWrappedEditValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNumber != 0 {
+		n += 1 + sovTx(uint64(m.EpochNumber))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgWrappedDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrappedDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrappedDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.MsgDelegate{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrappedDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrappedDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrappedDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrappedUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrappedUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrappedUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.MsgUndelegate{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrappedUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrappedUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrappedUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrappedBeginRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrappedBeginRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrappedBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.MsgBeginRedelegate{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrappedBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrappedBeginRedelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrappedBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrappedEditValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrappedEditValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrappedEditValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.MsgEditValidator{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWrappedEditValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWrappedEditValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWrappedEditValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
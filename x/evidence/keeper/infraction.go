@@ -24,7 +24,10 @@ import (
 // in the case of a lunatic attack.
 func (k Keeper) HandleEquivocationEvidence(ctx sdk.Context, evidence *types.Equivocation) {
 	logger := k.Logger(ctx)
-	consAddr := evidence.GetConsensusAddress()
+
+	// Resolve the old consensus address of a validator which rotated its
+	// consensus public key within the unbonding period to its current one.
+	consAddr := k.stakingKeeper.ValidatorIdentifier(ctx, evidence.GetConsensusAddress())
 
 	if _, err := k.slashingKeeper.GetPubkey(ctx, consAddr.Bytes()); err != nil {
 		// Ignore evidence that cannot be handled.
@@ -13,6 +13,7 @@ type (
 	// evidence module.
 	StakingKeeper interface {
 		ValidatorByConsAddr(sdk.Context, sdk.ConsAddress) stakingtypes.ValidatorI
+		ValidatorIdentifier(sdk.Context, sdk.ConsAddress) sdk.ConsAddress
 	}
 
 	// SlashingKeeper defines the slashing module interface contract needed by the
@@ -15,7 +15,13 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	// delete dead proposals from store and returns theirs deposits. A proposal is dead when it's inactive and didn't get enough deposit on time to get into voting phase.
 	keeper.IterateInactiveProposalsQueue(ctx, ctx.BlockHeader().Time, func(proposal v1.Proposal) bool {
 		keeper.DeleteProposal(ctx, proposal.Id)
-		keeper.RefundAndDeleteDeposits(ctx, proposal.Id) // refund deposit if proposal got removed without getting 100% of the proposal
+
+		// burn or refund the deposits of a proposal removed without reaching the minimum deposit
+		if keeper.GetDepositParams(ctx).BurnProposalDepositPrevote {
+			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
+		} else {
+			keeper.RefundAndDeleteDeposits(ctx, proposal.Id)
+		}
 
 		// called when proposal become inactive
 		keeper.AfterProposalFailedMinDeposit(ctx, proposal.Id)
@@ -31,7 +37,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.Id,
-			"min_deposit", sdk.NewCoins(keeper.GetDepositParams(ctx).MinDeposit...).String(),
+			"min_deposit", sdk.NewCoins(keeper.GetDepositParams(ctx).ProposalMinDeposit(proposal.Expedited)...).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
@@ -44,6 +50,30 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// an expedited proposal which does not pass is converted to a regular
+		// proposal, keeping its deposits and votes until the end of the
+		// regular voting period
+		if proposal.Expedited && !passes {
+			proposal = keeper.ConvertExpeditedProposal(ctx, proposal)
+
+			logger.Info(
+				"expedited proposal converted to regular",
+				"proposal", proposal.Id,
+				"voting_end_time", proposal.VotingEndTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.Id)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
+		keeper.DeleteVotes(ctx, proposal.Id)
+
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.Id)
 		} else {
@@ -74,6 +74,62 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 	inactiveQueue.Close()
 }
 
+func TestTickExpiredDepositPeriodBurnDeposits(t *testing.T) {
+	testCases := []struct {
+		name    string
+		burn    bool
+		expBurn bool
+	}{
+		{"deposits are refunded", false, false},
+		{"deposits are burnt", true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			depositParams.BurnProposalDepositPrevote = tc.burn
+			app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+			deposit := sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)}
+			initialBalance := app.BankKeeper.GetAllBalances(ctx, addrs[0])
+
+			newProposalMsg, err := v1.NewMsgSubmitProposal(
+				[]sdk.Msg{mkTestLegacyContent(t)},
+				deposit,
+				addrs[0].String(),
+				"",
+			)
+			require.NoError(t, err)
+
+			_, err = govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), newProposalMsg)
+			require.NoError(t, err)
+			supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			if tc.expBurn {
+				require.Equal(t, initialBalance.Sub(deposit...), app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+				require.Equal(t, supply.Sub(deposit[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+			} else {
+				require.Equal(t, initialBalance, app.BankKeeper.GetAllBalances(ctx, addrs[0]))
+				require.Equal(t, supply, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+			}
+		})
+	}
+}
+
 func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -295,7 +351,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0])
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -343,7 +399,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	staking.EndBlocker(ctx, app.StakingKeeper)
 
 	msg := banktypes.NewMsgSend(authtypes.NewModuleAddress(types.ModuleName), addrs[0], sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000))))
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msg}, "", addrs[0])
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -369,6 +425,180 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	require.Equal(t, v1.StatusFailed, proposal.Status)
 }
 
+func TestExpeditedProposalPassAndConversionToRegular(t *testing.T) {
+	testcases := []struct {
+		name            string
+		expeditedPasses bool
+		regularPasses   bool
+	}{
+		{
+			name:            "expedited passes",
+			expeditedPasses: true,
+		},
+		{
+			name:          "expedited fails, converted to regular which passes",
+			regularPasses: true,
+		},
+		{
+			name: "expedited fails, converted to regular which fails",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			depositTokens := sdk.NewCoins(app.GovKeeper.GetDepositParams(ctx).ExpeditedMinDeposit...)
+			addrs := simapp.AddTestAddrs(app, ctx, 2, depositTokens.AmountOf(sdk.DefaultBondDenom).Add(valTokens))
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			// a deposit below the expedited minimum deposit does not activate the voting period
+			proposalCoins := app.GovKeeper.GetDepositParams(ctx).MinDeposit
+			newProposalMsg, err := v1.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), "")
+			require.NoError(t, err)
+			newProposalMsg.Expedited = true
+
+			res, err := govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), newProposalMsg)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			proposalID := res.ProposalId
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.True(t, proposal.Expedited)
+			require.Equal(t, v1.StatusDepositPeriod, proposal.Status)
+
+			newDepositMsg := v1.NewMsgDeposit(addrs[1], proposalID, depositTokens.Sub(proposalCoins...))
+			_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), newDepositMsg)
+			require.NoError(t, err)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+			require.Equal(t, proposal.VotingStartTime.Add(*votingParams.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+			if tc.expeditedPasses {
+				err = app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+				require.NoError(t, err)
+			}
+
+			// the expedited voting period ends
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+
+			if tc.expeditedPasses {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+				return
+			}
+
+			// the proposal is converted to a regular proposal with a regular voting period
+			require.False(t, proposal.Expedited)
+			require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+			require.Equal(t, proposal.VotingStartTime.Add(*votingParams.VotingPeriod), *proposal.VotingEndTime)
+
+			activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, ctx.BlockHeader().Time)
+			require.False(t, activeQueue.Valid())
+			activeQueue.Close()
+
+			if tc.regularPasses {
+				err = app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+				require.NoError(t, err)
+			}
+
+			// the regular voting period ends
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+
+			if tc.regularPasses {
+				require.Equal(t, v1.StatusPassed, proposal.Status)
+			} else {
+				require.Equal(t, v1.StatusRejected, proposal.Status)
+			}
+
+			_, found := app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+			require.False(t, found)
+		})
+	}
+}
+
+func TestExpeditedProposalKeepsVotesOnConversion(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+	SortAddresses(addrs)
+
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{sdk.ValAddress(addrs[0]), sdk.ValAddress(addrs[1])}, []int64{6, 4})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposal, err := app.GovKeeper.SubmitExpeditedProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, "", addrs[0])
+	require.NoError(t, err)
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	// 60% of yes votes passes a regular proposal but not an expedited one
+	err = app.GovKeeper.AddVote(ctx, proposal.Id, addrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), "")
+	require.NoError(t, err)
+	err = app.GovKeeper.AddVote(ctx, proposal.Id, addrs[1], v1.NewNonSplitVoteOption(v1.OptionNo), "")
+	require.NoError(t, err)
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.False(t, proposal.Expedited)
+	require.Equal(t, v1.StatusVotingPeriod, proposal.Status)
+
+	_, found := app.GovKeeper.GetVote(ctx, proposal.Id, addrs[0])
+	require.True(t, found)
+
+	newHeader = ctx.BlockHeader()
+	newHeader.Time = proposal.VotingEndTime.Add(time.Second)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	gov.EndBlocker(ctx, app.GovKeeper)
+
+	proposal, ok = app.GovKeeper.GetProposal(ctx, proposal.Id)
+	require.True(t, ok)
+	require.Equal(t, v1.StatusPassed, proposal.Status)
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -71,6 +71,7 @@ func NewTxCmd(legacyPropCmds []*cobra.Command) *cobra.Command {
 		NewCmdWeightedVote(),
 		NewCmdSubmitProposal(),
 		NewCmdDraftProposal(),
+		NewCmdCancelProposal(),
 
 		// Deprecated
 		cmdSubmitLegacyProp,
@@ -105,7 +106,8 @@ Where proposal.json contains:
     }
   ],
   "metadata: "4pIMOgIGx1vZGU=", // base64-encoded metadata
-  "deposit": "10stake"
+  "deposit": "10stake",
+  "expedited": false // optional, expedited proposals have a shorter voting period and a higher threshold
 }
 `,
 				version.AppName,
@@ -117,15 +119,16 @@ Where proposal.json contains:
 				return err
 			}
 
-			msgs, metadata, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
+			proposal, msgs, deposit, err := parseSubmitProposal(clientCtx.Codec, args[0])
 			if err != nil {
 				return err
 			}
 
-			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), metadata)
+			msg, err := v1.NewMsgSubmitProposal(msgs, deposit, clientCtx.GetFromAddress().String(), proposal.Metadata)
 			if err != nil {
 				return fmt.Errorf("invalid message: %w", err)
 			}
+			msg.Expedited = proposal.Expedited
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -253,6 +256,49 @@ $ %s tx gov deposit 1 10stake --from mykey
 	return cmd
 }
 
+// NewCmdCancelProposal implements canceling a proposal by its proposer.
+func NewCmdCancelProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-proposal [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel a proposal before its voting period ends",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a proposal in its deposit or voting period. Only the proposer
+of the proposal can cancel it. A part of the deposits, defined by the proposal
+cancel ratio param, is charged and the rest is refunded to the depositors.
+
+Example:
+$ %s tx gov cancel-proposal 1 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid uint, please input a valid proposal-id", args[0])
+			}
+
+			// Get proposer address
+			from := clientCtx.GetFromAddress()
+
+			msg := v1.NewMsgCancelProposal(from, proposalID)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewCmdVote implements creating a new vote command.
 func NewCmdVote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,22 +24,29 @@ func (s *IntegrationTestSuite) TestCmdParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"voting_params":{"voting_period":"172800000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}}`,
+			`{"voting_params":{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"},"tally_params":{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000"},"deposit_params":{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"burn_vote_veto":true,"proposal_cancel_ratio":"0.500000000000000000"}}`,
 		},
 		{
 			"text output",
 			[]string{},
 			`
 deposit_params:
+  burn_vote_veto: true
+  expedited_min_deposit:
+  - amount: "50000000"
+    denom: stake
   max_deposit_period: "172800000000000"
   min_deposit:
   - amount: "10000000"
     denom: stake
+  proposal_cancel_ratio: "0.500000000000000000"
 tally_params:
+  expedited_threshold: "0.667000000000000000"
   quorum: "0.334000000000000000"
   threshold: "0.500000000000000000"
   veto_threshold: "0.334000000000000000"
 voting_params:
+  expedited_voting_period: "86400000000000"
   voting_period: "172800000000000"
 	`,
 		},
@@ -73,7 +80,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"voting",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"voting_period":"172800000000000"}`,
+			`{"voting_period":"172800000000000","expedited_voting_period":"86400000000000"}`,
 		},
 		{
 			"tally params",
@@ -81,7 +88,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"tallying",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000"}`,
+			`{"quorum":"0.334000000000000000","threshold":"0.500000000000000000","veto_threshold":"0.334000000000000000","expedited_threshold":"0.667000000000000000"}`,
 		},
 		{
 			"deposit params",
@@ -89,7 +96,7 @@ func (s *IntegrationTestSuite) TestCmdParam() {
 				"deposit",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000"}`,
+			`{"min_deposit":[{"denom":"stake","amount":"10000000"}],"max_deposit_period":"172800000000000","expedited_min_deposit":[{"denom":"stake","amount":"50000000"}],"burn_vote_veto":true,"proposal_cancel_ratio":"0.500000000000000000"}`,
 		},
 	}
 
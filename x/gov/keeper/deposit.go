@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 )
@@ -131,7 +132,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == v1.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetDepositParams(ctx).ProposalMinDeposit(proposal.Expedited)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -178,3 +179,60 @@ func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64)
 		return false
 	})
 }
+
+// ChargeDeposit charges the proposal cancel ratio of all the deposits on a
+// specific proposal, refunds the rest to the depositors and deletes the
+// deposits. The charged amount is burnt if destAddress is empty, sent to the
+// community pool if destAddress is the distribution module address, and sent
+// to destAddress otherwise.
+func (keeper Keeper) ChargeDeposit(ctx sdk.Context, proposalID uint64, destAddress string, proposalCancelRatio sdk.Dec) error {
+	store := ctx.KVStore(keeper.storeKey)
+	var cancellationCharges sdk.Coins
+
+	var err error
+	keeper.IterateDeposits(ctx, proposalID, func(deposit v1.Deposit) bool {
+		depositor := sdk.MustAccAddressFromBech32(deposit.Depositor)
+
+		var charges sdk.Coins
+		for _, coin := range deposit.Amount {
+			charge := sdk.NewDecFromInt(coin.Amount).Mul(proposalCancelRatio).TruncateInt()
+			if charge.IsPositive() {
+				charges = charges.Add(sdk.NewCoin(coin.Denom, charge))
+			}
+		}
+		cancellationCharges = cancellationCharges.Add(charges...)
+
+		remaining := sdk.NewCoins(deposit.Amount...).Sub(charges...)
+		if !remaining.IsZero() {
+			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, remaining)
+			if err != nil {
+				return true
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if cancellationCharges.IsZero() {
+		return nil
+	}
+
+	switch destAddress {
+	case "":
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, cancellationCharges)
+
+	case keeper.authKeeper.GetModuleAddress(disttypes.ModuleName).String():
+		return keeper.distrKeeper.FundCommunityPool(ctx, cancellationCharges, keeper.GetGovernanceAccount(ctx).GetAddress())
+
+	default:
+		dest, err := sdk.AccAddressFromBech32(destAddress)
+		if err != nil {
+			return err
+		}
+		return keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest, cancellationCharges)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func TestDeposits(t *testing.T) {
@@ -17,7 +18,7 @@ func TestDeposits(t *testing.T) {
 	TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 
@@ -101,7 +102,7 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, addr1Initial, app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
 
 	// Test delete and burn deposits
-	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID = proposal.Id
 	_, err = app.GovKeeper.AddDeposit(ctx, proposalID, TestAddrs[0], fourStake)
@@ -111,3 +112,85 @@ func TestDeposits(t *testing.T) {
 	require.Len(t, deposits, 0)
 	require.Equal(t, addr0Initial.Sub(fourStake...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
 }
+
+func TestChargeDeposit(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dest     func(app *simapp.SimApp, ctx sdk.Context) string
+		expBurnt bool
+		expPool  bool
+	}{
+		{
+			"charges are burnt",
+			func(*simapp.SimApp, sdk.Context) string { return "" },
+			true,
+			false,
+		},
+		{
+			"charges are sent to the community pool",
+			func(app *simapp.SimApp, ctx sdk.Context) string {
+				return app.AccountKeeper.GetModuleAddress(disttypes.ModuleName).String()
+			},
+			false,
+			true,
+		},
+		{
+			"charges are sent to an address",
+			func(app *simapp.SimApp, ctx sdk.Context) string {
+				return sdk.AccAddress("dest________________").String()
+			},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			TestAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000000))
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", TestAddrs[0])
+			require.NoError(t, err)
+
+			fourStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 4)))
+			twoStake := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 2)))
+			addr0Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[0])
+			addr1Initial := app.BankKeeper.GetAllBalances(ctx, TestAddrs[1])
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[0], fourStake)
+			require.NoError(t, err)
+			_, err = app.GovKeeper.AddDeposit(ctx, proposal.Id, TestAddrs[1], fourStake)
+			require.NoError(t, err)
+
+			dest := tc.dest(app, ctx)
+			supplyBefore := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+			poolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+			err = app.GovKeeper.ChargeDeposit(ctx, proposal.Id, dest, sdk.NewDecWithPrec(5, 1))
+			require.NoError(t, err)
+
+			// half of each deposit is refunded and the deposits are deleted
+			require.Equal(t, addr0Initial.Sub(twoStake...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[0]))
+			require.Equal(t, addr1Initial.Sub(twoStake...), app.BankKeeper.GetAllBalances(ctx, TestAddrs[1]))
+			require.Empty(t, app.GovKeeper.GetDeposits(ctx, proposal.Id))
+
+			charges := twoStake.Add(twoStake...)
+			if tc.expBurnt {
+				require.Equal(t, supplyBefore.Sub(charges[0]), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+			} else {
+				require.Equal(t, supplyBefore, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+			}
+
+			if tc.expPool {
+				require.Equal(t, poolBefore.Add(sdk.NewDecCoinsFromCoins(charges...)...), app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+			} else {
+				require.Equal(t, poolBefore, app.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+			}
+
+			if !tc.expBurnt && !tc.expPool {
+				destAddr := sdk.MustAccAddressFromBech32(dest)
+				require.Equal(t, charges, app.BankKeeper.GetAllBalances(ctx, destAddr))
+			}
+		})
+	}
+}
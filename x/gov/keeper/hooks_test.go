@@ -66,7 +66,7 @@ func TestHooks(t *testing.T) {
 	require.False(t, govHooksReceiver.AfterProposalVotingPeriodEndedValid)
 
 	tp := TestProposal
-	_, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	require.True(t, govHooksReceiver.AfterProposalSubmissionValid)
 
@@ -77,7 +77,7 @@ func TestHooks(t *testing.T) {
 
 	require.True(t, govHooksReceiver.AfterProposalFailedMinDepositValid)
 
-	p2, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	p2, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 
 	activated, err := app.GovKeeper.AddDeposit(ctx, p2.Id, addrs[0], minDeposit)
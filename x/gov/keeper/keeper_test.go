@@ -64,17 +64,17 @@ func TestIncrementProposalNumber(t *testing.T) {
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
 
 	tp := TestProposal
-	_, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
-	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "")
+	_, err = app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
-	proposal6, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal6, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 
 	require.Equal(t, uint64(6), proposal6.Id)
@@ -86,7 +86,7 @@ func TestProposalQueues(t *testing.T) {
 
 	// create test proposals
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 
 	inactiveIterator := app.GovKeeper.InactiveProposalQueueIterator(ctx, *proposal.DepositEndTime)
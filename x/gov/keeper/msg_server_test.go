@@ -410,6 +410,67 @@ func (suite *KeeperTestSuite) TestDepositReq() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposalReq() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	addrs := suite.addrs
+	proposer := addrs[0]
+
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100)))
+	bankMsg := &banktypes.MsgSend{
+		FromAddress: govAcct.String(),
+		ToAddress:   proposer.String(),
+		Amount:      coins,
+	}
+
+	msg, err := v1.NewMsgSubmitProposal(
+		[]sdk.Msg{bankMsg},
+		coins,
+		proposer.String(),
+		"",
+	)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgSrvr.SubmitProposal(suite.ctx, msg)
+	suite.Require().NoError(err)
+	pId := res.ProposalId
+
+	cases := map[string]struct {
+		proposalId uint64
+		proposer   sdk.AccAddress
+		expErr     bool
+	}{
+		"wrong proposal id": {
+			proposalId: 0,
+			proposer:   proposer,
+			expErr:     true,
+		},
+		"wrong proposer": {
+			proposalId: pId,
+			proposer:   addrs[1],
+			expErr:     true,
+		},
+		"all good": {
+			proposalId: pId,
+			proposer:   proposer,
+			expErr:     false,
+		},
+	}
+
+	for _, name := range []string{"wrong proposal id", "wrong proposer", "all good"} {
+		tc := cases[name]
+		suite.Run(name, func() {
+			cancelReq := v1.NewMsgCancelProposal(tc.proposer, tc.proposalId)
+			res, err := suite.msgSrvr.CancelProposal(suite.ctx, cancelReq)
+			if tc.expErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.proposalId, res.ProposalId)
+			}
+		})
+	}
+}
+
 // legacy msg server tests
 func (suite *KeeperTestSuite) TestLegacyMsgSubmitProposal() {
 	addrs := suite.addrs
@@ -12,7 +12,19 @@ import (
 )
 
 // SubmitProposal creates a new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string) (v1.Proposal, error) {
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, metadata, proposer, false)
+}
+
+// SubmitExpeditedProposal creates a new expedited proposal given an array of
+// messages. An expedited proposal requires a higher deposit, has a shorter
+// voting period and a higher threshold, and is converted to a regular proposal
+// if it does not pass.
+func (keeper Keeper) SubmitExpeditedProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress) (v1.Proposal, error) {
+	return keeper.submitProposal(ctx, messages, metadata, proposer, true)
+}
+
+func (keeper Keeper) submitProposal(ctx sdk.Context, messages []sdk.Msg, metadata string, proposer sdk.AccAddress, expedited bool) (v1.Proposal, error) {
 	err := keeper.assertMetadataLength(metadata)
 	if err != nil {
 		return v1.Proposal{}, err
@@ -76,6 +88,8 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	if err != nil {
 		return v1.Proposal{}, err
 	}
+	proposal.Expedited = expedited
+	proposal.Proposer = proposer.String()
 
 	keeper.SetProposal(ctx, proposal)
 	keeper.InsertInactiveProposalQueue(ctx, proposalID, *proposal.DepositEndTime)
@@ -89,6 +103,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 			types.EventTypeSubmitProposal,
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 			sdk.NewAttribute(types.AttributeKeyProposalMessages, msgsStr),
+			sdk.NewAttribute(types.AttributeKeyProposalExpedited, fmt.Sprintf("%t", expedited)),
 		),
 	)
 
@@ -241,8 +256,8 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
-	endTime := proposal.VotingStartTime.Add(*votingPeriod)
+	votingPeriod := keeper.GetVotingParams(ctx).ProposalVotingPeriod(proposal.Expedited)
+	endTime := proposal.VotingStartTime.Add(votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1.StatusVotingPeriod
 	keeper.SetProposal(ctx, proposal)
@@ -251,6 +266,72 @@ func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1.Proposal)
 	keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
 }
 
+// ConvertExpeditedProposal converts an expedited proposal whose expedited voting
+// period ended without passing into a regular proposal. Its voting period is
+// extended to the regular voting period, counted from its voting start time,
+// and the votes already cast are kept.
+func (keeper Keeper) ConvertExpeditedProposal(ctx sdk.Context, proposal v1.Proposal) v1.Proposal {
+	keeper.RemoveFromActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+
+	proposal.Expedited = false
+	endTime := proposal.VotingStartTime.Add(keeper.GetVotingParams(ctx).ProposalVotingPeriod(false))
+	proposal.VotingEndTime = &endTime
+	keeper.SetProposal(ctx, proposal)
+
+	keeper.InsertActiveProposalQueue(ctx, proposal.Id, *proposal.VotingEndTime)
+	return proposal
+}
+
+// CancelProposal cancels a proposal in its deposit or voting period on behalf
+// of its proposer. The proposal cancel ratio of its deposits is burnt or sent
+// to the proposal cancel destination and the rest is refunded to the
+// depositors, then the proposal and its votes are deleted.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != proposer {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "expected %s got %s", proposal.Proposer, proposer)
+	}
+
+	if proposal.Status != v1.StatusDepositPeriod && proposal.Status != v1.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if proposal.VotingEndTime != nil && proposal.VotingEndTime.Before(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "%d", proposalID)
+	}
+
+	depositParams := keeper.GetDepositParams(ctx)
+	if err := keeper.ChargeDeposit(ctx, proposalID, depositParams.ProposalCancelDest, sdk.MustNewDecFromStr(depositParams.ProposalCancelRatio)); err != nil {
+		return err
+	}
+
+	if proposal.VotingStartTime != nil {
+		keeper.DeleteVotes(ctx, proposalID)
+	}
+
+	keeper.DeleteProposal(ctx, proposalID)
+
+	keeper.Logger(ctx).Info(
+		"proposal canceled",
+		"proposal", proposalID,
+		"proposer", proposer,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposer, proposer),
+		),
+	)
+
+	return nil
+}
+
 func (keeper Keeper) MarshalProposal(proposal v1.Proposal) ([]byte, error) {
 	bz, err := keeper.cdc.Marshal(&proposal)
 	if err != nil {
@@ -18,7 +18,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", addr)
 	suite.Require().NoError(err)
 	proposalID := proposal.Id
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -30,7 +30,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "")
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, "", addr)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -81,11 +81,102 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata)
+		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, addr)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress().String()
+	tp := v1beta1.TextProposal{Title: "title", Description: "description"}
+	prop, err := v1.NewLegacyContent(&tp, govAcct)
+	suite.Require().NoError(err)
+	proposer := suite.addrs[0]
+
+	testCases := []struct {
+		name        string
+		malleate    func() (proposalID uint64, proposer string)
+		expectedErr error
+	}{
+		{
+			"unknown proposal",
+			func() (uint64, string) {
+				return 100, proposer.String()
+			},
+			types.ErrUnknownProposal,
+		},
+		{
+			"invalid proposer",
+			func() (uint64, string) {
+				proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, "", proposer)
+				suite.Require().NoError(err)
+				return proposal.Id, suite.addrs[1].String()
+			},
+			types.ErrInvalidProposer,
+		},
+		{
+			"inactive proposal",
+			func() (uint64, string) {
+				proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, "", proposer)
+				suite.Require().NoError(err)
+				proposal.Status = v1.StatusPassed
+				suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
+				return proposal.Id, proposer.String()
+			},
+			types.ErrInactiveProposal,
+		},
+		{
+			"cancel in deposit period",
+			func() (uint64, string) {
+				proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, "", proposer)
+				suite.Require().NoError(err)
+				return proposal.Id, proposer.String()
+			},
+			nil,
+		},
+		{
+			"cancel in voting period",
+			func() (uint64, string) {
+				proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, "", proposer)
+				suite.Require().NoError(err)
+				suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+				suite.Require().NoError(suite.app.GovKeeper.AddVote(suite.ctx, proposal.Id, proposer, v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+				return proposal.Id, proposer.String()
+			},
+			nil,
+		},
+		// keep last, the block time is moved past the voting end time
+		{
+			"voting period ended",
+			func() (uint64, string) {
+				proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, "", proposer)
+				suite.Require().NoError(err)
+				suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+				proposal, _ = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.Id)
+				suite.ctx = suite.ctx.WithBlockTime(proposal.VotingEndTime.Add(time.Second))
+				return proposal.Id, proposer.String()
+			},
+			types.ErrVotingPeriodEnded,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposalID, proposer := tc.malleate()
+			err := suite.app.GovKeeper.CancelProposal(suite.ctx, proposalID, proposer)
+			if tc.expectedErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			_, found := suite.app.GovKeeper.GetProposal(suite.ctx, proposalID)
+			suite.Require().False(found)
+			suite.Require().Empty(suite.app.GovKeeper.GetVotes(suite.ctx, proposalID))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []v1.ProposalStatus{v1.StatusDepositPeriod, v1.StatusVotingPeriod}
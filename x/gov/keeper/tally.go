@@ -9,7 +9,7 @@ import (
 // TODO: Break into several smaller functions for clarity
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// voters. The votes are left in the store and are deleted by the EndBlocker once the proposal is final.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool, burnDeposits bool, tallyResults v1.TallyResult) {
 	results := make(map[v1.VoteOption]sdk.Dec)
 	results[v1.OptionYes] = sdk.ZeroDec()
@@ -67,7 +67,6 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
@@ -88,7 +87,8 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
-	tallyParams := keeper.GetTallyParams(ctx)
+	quorum, threshold, vetoThreshold := keeper.ProposalTallyParams(ctx, proposal)
+	depositParams := keeper.GetDepositParams(ctx)
 	tallyResults = v1.NewTallyResultFromMap(results)
 
 	// TODO: Upgrade the spec to cover all of these cases & remove pseudocode.
@@ -99,9 +99,8 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 
 	// If there is not enough quorum of votes, the proposal fails
 	percentVoting := totalVotingPower.Quo(sdk.NewDecFromInt(keeper.sk.TotalBondedTokens(ctx)))
-	quorum, _ := sdk.NewDecFromStr(tallyParams.Quorum)
 	if percentVoting.LT(quorum) {
-		return false, false, tallyResults
+		return false, depositParams.BurnVoteQuorum, tallyResults
 	}
 
 	// If no one votes (everyone abstains), proposal fails
@@ -110,13 +109,11 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	}
 
 	// If more than 1/3 of voters veto, proposal fails
-	vetoThreshold, _ := sdk.NewDecFromStr(tallyParams.VetoThreshold)
 	if results[v1.OptionNoWithVeto].Quo(totalVotingPower).GT(vetoThreshold) {
-		return false, true, tallyResults
+		return false, depositParams.BurnVoteVeto, tallyResults
 	}
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
-	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
 	if results[v1.OptionYes].Quo(totalVotingPower.Sub(results[v1.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
@@ -124,3 +121,20 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1.Proposal) (passes bool,
 	// If more than 1/2 of non-abstaining voters vote No, proposal fails
 	return false, false, tallyResults
 }
+
+// ProposalTallyParams returns the quorum, threshold and veto threshold applying
+// to the proposal, taking into account whether it is expedited and the message
+// tally params of its messages, including the content of legacy proposals.
+func (keeper Keeper) ProposalTallyParams(ctx sdk.Context, proposal v1.Proposal) (quorum, threshold, vetoThreshold sdk.Dec) {
+	msgTypeURLs := make([]string, 0, len(proposal.Messages))
+	for _, msg := range proposal.Messages {
+		msgTypeURLs = append(msgTypeURLs, msg.TypeUrl)
+
+		var legacyMsg v1.MsgExecLegacyContent
+		if msg.TypeUrl == sdk.MsgTypeURL(&legacyMsg) && keeper.cdc.Unmarshal(msg.Value, &legacyMsg) == nil && legacyMsg.Content != nil {
+			msgTypeURLs = append(msgTypeURLs, legacyMsg.Content.TypeUrl)
+		}
+	}
+
+	return keeper.GetTallyParams(ctx).ProposalTallyParams(msgTypeURLs, proposal.Expedited)
+}
@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -20,7 +22,7 @@ func TestTallyNoOneVotes(t *testing.T) {
 	createValidators(t, ctx, app, []int64{5, 5, 5})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -44,7 +46,7 @@ func TestTallyNoQuorum(t *testing.T) {
 	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000000))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -67,7 +69,7 @@ func TestTallyOnlyValidatorsAllYes(t *testing.T) {
 	addrs, _ := createValidators(t, ctx, app, []int64{5, 5, 5})
 	tp := TestProposal
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -93,7 +95,7 @@ func TestTallyOnlyValidators51No(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -117,7 +119,7 @@ func TestTallyOnlyValidators51Yes(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -142,7 +144,7 @@ func TestTallyOnlyValidatorsVetoed(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -168,7 +170,7 @@ func TestTallyOnlyValidatorsAbstainPasses(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -194,7 +196,7 @@ func TestTallyOnlyValidatorsAbstainFails(t *testing.T) {
 	valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -221,7 +223,7 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	valAccAddr1, valAccAddr2 := valAccAddrs[0], valAccAddrs[1]
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -255,7 +257,7 @@ func TestTallyDelgatorOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -291,7 +293,7 @@ func TestTallyDelgatorInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -330,7 +332,7 @@ func TestTallyDelgatorMultipleOverride(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -372,7 +374,7 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	_ = staking.EndBlocker(ctx, app.StakingKeeper)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -415,7 +417,7 @@ func TestTallyJailedValidator(t *testing.T) {
 	app.StakingKeeper.Jail(ctx, sdk.ConsAddress(consAddr.Bytes()))
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -448,7 +450,7 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 	require.NoError(t, err)
 
 	tp := TestProposal
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "")
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
 	require.NoError(t, err)
 	proposalID := proposal.Id
 	proposal.Status = v1.StatusVotingPeriod
@@ -473,3 +475,108 @@ func TestTallyValidatorMultipleDelegations(t *testing.T) {
 
 	require.True(t, tallyResults.Equals(expectedTallyResult))
 }
+
+func TestTallyExpeditedThreshold(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitExpeditedProposal(ctx, tp, "", addr)
+	require.NoError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+
+	// votes are kept so that the proposal can be tallied again once converted
+	_, found := app.GovKeeper.GetVote(ctx, proposalID, valAccAddrs[1])
+	require.True(t, found)
+}
+
+func TestTallyMessageTallyParams(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	valAccAddrs, _ := createValidators(t, ctx, app, []int64{5, 6, 0})
+
+	// the content of a legacy proposal is matched against the message tally params
+	tallyParams := app.GovKeeper.GetTallyParams(ctx)
+	tallyParams.MessageTallyParams = []v1.MessageTallyParams{
+		v1.NewMessageTallyParams("/"+proto.MessageName(&v1beta1.TextProposal{}), v1.DefaultQuorum, sdk.NewDecWithPrec(6, 1), v1.DefaultVetoThreshold),
+	}
+	app.GovKeeper.SetTallyParams(ctx, tallyParams)
+
+	tp := TestProposal
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, tp, "", addr)
+	require.NoError(t, err)
+	proposalID := proposal.Id
+	proposal.Status = v1.StatusVotingPeriod
+	app.GovKeeper.SetProposal(ctx, proposal)
+
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionNo), ""))
+	require.NoError(t, app.GovKeeper.AddVote(ctx, proposalID, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+	require.True(t, ok)
+	passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+
+	require.False(t, passes)
+	require.False(t, burnDeposits)
+}
+
+func TestTallyBurnDepositParams(t *testing.T) {
+	testCases := []struct {
+		name           string
+		burnVoteQuorum bool
+		burnVoteVeto   bool
+		vetoed         bool
+		expBurn        bool
+	}{
+		{"no quorum, burn on quorum failure disabled", false, true, false, false},
+		{"no quorum, burn on quorum failure enabled", true, true, false, true},
+		{"vetoed, burn on veto enabled", false, true, true, true},
+		{"vetoed, burn on veto disabled", false, false, true, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+			valAccAddrs, _ := createValidators(t, ctx, app, []int64{6, 6, 7})
+
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			depositParams.BurnVoteQuorum = tc.burnVoteQuorum
+			depositParams.BurnVoteVeto = tc.burnVoteVeto
+			app.GovKeeper.SetDepositParams(ctx, depositParams)
+
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, TestProposal, "", addr)
+			require.NoError(t, err)
+			proposal.Status = v1.StatusVotingPeriod
+			app.GovKeeper.SetProposal(ctx, proposal)
+
+			if tc.vetoed {
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, valAccAddrs[0], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, valAccAddrs[1], v1.NewNonSplitVoteOption(v1.OptionYes), ""))
+				require.NoError(t, app.GovKeeper.AddVote(ctx, proposal.Id, valAccAddrs[2], v1.NewNonSplitVoteOption(v1.OptionNoWithVeto), ""))
+			}
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.Id)
+			require.True(t, ok)
+			passes, burnDeposits, _ := app.GovKeeper.Tally(ctx, proposal)
+			require.False(t, passes)
+			require.Equal(t, tc.expBurn, burnDeposits)
+		})
+	}
+}
@@ -118,6 +118,14 @@ func (keeper Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vo
 	}
 }
 
+// DeleteVotes deletes all the votes of a given proposalID from the store
+func (keeper Keeper) DeleteVotes(ctx sdk.Context, proposalID uint64) {
+	keeper.IterateVotes(ctx, proposalID, func(vote v1.Vote) bool {
+		keeper.deleteVote(ctx, proposalID, sdk.MustAccAddressFromBech32(vote.Voter))
+		return false
+	})
+}
+
 // deleteVote deletes a vote from a given proposalID and voter from the store
 func (keeper Keeper) deleteVote(ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -76,18 +76,25 @@ func TestMigrateJSON(t *testing.T) {
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
 	"deposit_params": {
+		"burn_proposal_deposit_prevote": false,
+		"burn_vote_quorum": false,
+		"burn_vote_veto": false,
+		"expedited_min_deposit": [],
 		"max_deposit_period": "172800s",
 		"min_deposit": [
 			{
 				"amount": "10000000",
 				"denom": "stake"
 			}
-		]
+		],
+		"proposal_cancel_dest": "",
+		"proposal_cancel_ratio": ""
 	},
 	"deposits": [],
 	"proposals": [
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"expedited": false,
 			"final_tally_result": {
 				"abstain_count": "0",
 				"no_count": "0",
@@ -107,6 +114,7 @@ func TestMigrateJSON(t *testing.T) {
 				}
 			],
 			"metadata": "",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
 			"total_deposit": [
@@ -121,6 +129,8 @@ func TestMigrateJSON(t *testing.T) {
 	],
 	"starting_proposal_id": "1",
 	"tally_params": {
+		"expedited_threshold": "",
+		"message_tally_params": [],
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000"
@@ -150,6 +160,7 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"expedited_voting_period": null,
 		"voting_period": "172800s"
 	}
 }`
@@ -0,0 +1,53 @@
+package v4
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4. The
+// migration sets the expedited proposal, deposit burning and proposal
+// cancellation params introduced in v4 to their default values, keeping the
+// other params unchanged:
+//
+// - DepositParams.ExpeditedMinDeposit, five times the minimum deposit
+// - DepositParams burning options, burning deposits on veto only as before
+// - DepositParams.ProposalCancelRatio, burning half of the canceled deposits
+// - VotingParams.ExpeditedVotingPeriod, capped by the current voting period
+// - TallyParams.ExpeditedThreshold, raised above the current threshold
+func MigrateStore(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	var depositParams v1.DepositParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	expeditedMinDeposit := sdk.NewCoins()
+	for _, coin := range depositParams.MinDeposit {
+		expeditedMinDeposit = expeditedMinDeposit.Add(sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(5)))
+	}
+	depositParams.ExpeditedMinDeposit = expeditedMinDeposit
+	depositParams.BurnVoteQuorum = v1.DefaultBurnVoteQuorum
+	depositParams.BurnProposalDepositPrevote = v1.DefaultBurnProposalDepositPrevote
+	depositParams.BurnVoteVeto = v1.DefaultBurnVoteVeto
+	depositParams.ProposalCancelRatio = v1.DefaultProposalCancelRatio.String()
+	depositParams.ProposalCancelDest = ""
+	paramSpace.Set(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+
+	var votingParams v1.VotingParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	expeditedVotingPeriod := v1.DefaultExpeditedPeriod
+	if expeditedVotingPeriod >= *votingParams.VotingPeriod {
+		expeditedVotingPeriod = *votingParams.VotingPeriod / 2
+	}
+	votingParams.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	paramSpace.Set(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+
+	var tallyParams v1.TallyParams
+	paramSpace.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	expeditedThreshold := v1.DefaultExpeditedThreshold
+	if threshold := sdk.MustNewDecFromStr(tallyParams.Threshold); expeditedThreshold.LTE(threshold) {
+		expeditedThreshold = threshold.Add(sdk.OneDec()).QuoInt64(2)
+	}
+	tallyParams.ExpeditedThreshold = expeditedThreshold.String()
+	paramSpace.Set(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+
+	return nil
+}
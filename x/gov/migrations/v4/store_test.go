@@ -0,0 +1,54 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v4gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v4"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	govKey := sdk.NewKVStoreKey("gov")
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, govKey, tGovKey, "gov").
+		WithKeyTable(v1.ParamKeyTable())
+
+	// set v3 params, without the expedited, burning and cancellation params
+	votingPeriod := 12 * time.Hour
+	depositPeriod := v1.DefaultPeriod
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	paramstore.Set(ctx, v1.ParamStoreKeyDepositParams, &v1.DepositParams{MinDeposit: minDeposit, MaxDepositPeriod: &depositPeriod})
+	paramstore.Set(ctx, v1.ParamStoreKeyVotingParams, &v1.VotingParams{VotingPeriod: &votingPeriod})
+	paramstore.Set(ctx, v1.ParamStoreKeyTallyParams, &v1.TallyParams{Quorum: "0.4", Threshold: "0.7", VetoThreshold: "0.334"})
+
+	require.NoError(t, v4gov.MigrateStore(ctx, paramstore))
+
+	var depositParams v1.DepositParams
+	paramstore.Get(ctx, v1.ParamStoreKeyDepositParams, &depositParams)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)), sdk.NewCoins(depositParams.ExpeditedMinDeposit...))
+	require.Equal(t, minDeposit, sdk.NewCoins(depositParams.MinDeposit...))
+	require.False(t, depositParams.BurnVoteQuorum)
+	require.False(t, depositParams.BurnProposalDepositPrevote)
+	require.True(t, depositParams.BurnVoteVeto)
+	require.Equal(t, v1.DefaultProposalCancelRatio.String(), depositParams.ProposalCancelRatio)
+	require.Empty(t, depositParams.ProposalCancelDest)
+
+	var votingParams v1.VotingParams
+	paramstore.Get(ctx, v1.ParamStoreKeyVotingParams, &votingParams)
+	require.Equal(t, 6*time.Hour, *votingParams.ExpeditedVotingPeriod)
+	require.Equal(t, votingPeriod, *votingParams.VotingPeriod)
+
+	var tallyParams v1.TallyParams
+	paramstore.Get(ctx, v1.ParamStoreKeyTallyParams, &tallyParams)
+	require.Equal(t, sdk.MustNewDecFromStr("0.85").String(), tallyParams.ExpeditedThreshold)
+	require.Equal(t, "0.7", tallyParams.Threshold)
+}
@@ -17,12 +17,19 @@ import (
 
 // Simulation parameter constants
 const (
-	DepositParamsMinDeposit    = "deposit_params_min_deposit"
-	DepositParamsDepositPeriod = "deposit_params_deposit_period"
-	VotingParamsVotingPeriod   = "voting_params_voting_period"
-	TallyParamsQuorum          = "tally_params_quorum"
-	TallyParamsThreshold       = "tally_params_threshold"
-	TallyParamsVeto            = "tally_params_veto"
+	DepositParamsMinDeposit          = "deposit_params_min_deposit"
+	DepositParamsDepositPeriod       = "deposit_params_deposit_period"
+	DepositParamsExpeditedMinDeposit = "deposit_params_expedited_min_deposit"
+	VotingParamsVotingPeriod         = "voting_params_voting_period"
+	VotingParamsExpeditedPeriod      = "voting_params_expedited_voting_period"
+	TallyParamsQuorum                = "tally_params_quorum"
+	TallyParamsThreshold             = "tally_params_threshold"
+	TallyParamsVeto                  = "tally_params_veto"
+	TallyParamsExpeditedThreshold    = "tally_params_expedited_threshold"
+	DepositParamsBurnVoteQuorum      = "deposit_params_burn_vote_quorum"
+	DepositParamsBurnPrevote         = "deposit_params_burn_proposal_deposit_prevote"
+	DepositParamsBurnVoteVeto        = "deposit_params_burn_vote_veto"
+	DepositParamsProposalCancelRatio = "deposit_params_proposal_cancel_ratio"
 )
 
 // GenDepositParamsDepositPeriod randomized DepositParamsDepositPeriod
@@ -35,9 +42,21 @@ func GenDepositParamsMinDeposit(r *rand.Rand) sdk.Coins {
 	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1, 1e3))))
 }
 
+// GenDepositParamsExpeditedMinDeposit randomized DepositParamsExpeditedMinDeposit,
+// always greater than the randomized DepositParamsMinDeposit
+func GenDepositParamsExpeditedMinDeposit(r *rand.Rand) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simulation.RandIntBetween(r, 1e3, 5e3))))
+}
+
 // GenVotingParamsVotingPeriod randomized VotingParamsVotingPeriod
 func GenVotingParamsVotingPeriod(r *rand.Rand) time.Duration {
-	return time.Duration(simulation.RandIntBetween(r, 1, 2*60*60*24*2)) * time.Second
+	return time.Duration(simulation.RandIntBetween(r, 2, 2*60*60*24*2)) * time.Second
+}
+
+// GenVotingParamsExpeditedVotingPeriod randomized VotingParamsExpeditedPeriod,
+// strictly less than the given voting period
+func GenVotingParamsExpeditedVotingPeriod(r *rand.Rand, votingPeriod time.Duration) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, int(votingPeriod/time.Second))) * time.Second
 }
 
 // GenTallyParamsQuorum randomized TallyParamsQuorum
@@ -55,6 +74,23 @@ func GenTallyParamsVeto(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 250, 334)), 3)
 }
 
+// GenTallyParamsExpeditedThreshold randomized TallyParamsExpeditedThreshold,
+// always greater than the randomized TallyParamsThreshold
+func GenTallyParamsExpeditedThreshold(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 551, 750)), 3)
+}
+
+// GenDepositParamsBurn randomized DepositParamsBurnVoteQuorum,
+// DepositParamsBurnPrevote and DepositParamsBurnVoteVeto
+func GenDepositParamsBurn(r *rand.Rand) bool {
+	return r.Int63n(2) == 0
+}
+
+// GenDepositParamsProposalCancelRatio randomized DepositParamsProposalCancelRatio
+func GenDepositParamsProposalCancelRatio(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 0, 1000)), 3)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -71,12 +107,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { depositPeriod = GenDepositParamsDepositPeriod(r) },
 	)
 
+	var expeditedMinDeposit sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsExpeditedMinDeposit, &expeditedMinDeposit, simState.Rand,
+		func(r *rand.Rand) { expeditedMinDeposit = GenDepositParamsExpeditedMinDeposit(r) },
+	)
+
 	var votingPeriod time.Duration
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, VotingParamsVotingPeriod, &votingPeriod, simState.Rand,
 		func(r *rand.Rand) { votingPeriod = GenVotingParamsVotingPeriod(r) },
 	)
 
+	var expeditedVotingPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, VotingParamsExpeditedPeriod, &expeditedVotingPeriod, simState.Rand,
+		func(r *rand.Rand) { expeditedVotingPeriod = GenVotingParamsExpeditedVotingPeriod(r, votingPeriod) },
+	)
+
 	var quorum sdk.Dec
 	simState.AppParams.GetOrGenerate(
 		simState.Cdc, TallyParamsQuorum, &quorum, simState.Rand,
@@ -95,11 +143,44 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { veto = GenTallyParamsVeto(r) },
 	)
 
+	var expeditedThreshold sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TallyParamsExpeditedThreshold, &expeditedThreshold, simState.Rand,
+		func(r *rand.Rand) { expeditedThreshold = GenTallyParamsExpeditedThreshold(r) },
+	)
+
+	var burnVoteQuorum bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnVoteQuorum, &burnVoteQuorum, simState.Rand,
+		func(r *rand.Rand) { burnVoteQuorum = GenDepositParamsBurn(r) },
+	)
+
+	var burnProposalDepositPrevote bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnPrevote, &burnProposalDepositPrevote, simState.Rand,
+		func(r *rand.Rand) { burnProposalDepositPrevote = GenDepositParamsBurn(r) },
+	)
+
+	var burnVoteVeto bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsBurnVoteVeto, &burnVoteVeto, simState.Rand,
+		func(r *rand.Rand) { burnVoteVeto = GenDepositParamsBurn(r) },
+	)
+
+	var proposalCancelRatio sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DepositParamsProposalCancelRatio, &proposalCancelRatio, simState.Rand,
+		func(r *rand.Rand) { proposalCancelRatio = GenDepositParamsProposalCancelRatio(r) },
+	)
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
-		v1.NewDepositParams(minDeposit, depositPeriod),
-		v1.NewVotingParams(votingPeriod),
-		v1.NewTallyParams(quorum, threshold, veto),
+		v1.NewDepositParams(
+			minDeposit, depositPeriod, expeditedMinDeposit,
+			burnVoteQuorum, burnProposalDepositPrevote, burnVoteVeto, proposalCancelRatio, "",
+		),
+		v1.NewVotingParams(votingPeriod, expeditedVotingPeriod),
+		v1.NewTallyParams(quorum, threshold, veto, expeditedThreshold),
 	)
 
 	bz, err := json.MarshalIndent(&govGenesis, "", " ")
@@ -25,13 +25,15 @@ var (
 	TypeMsgVote           = sdk.MsgTypeURL(&v1.MsgVote{})
 	TypeMsgVoteWeighted   = sdk.MsgTypeURL(&v1.MsgVoteWeighted{})
 	TypeMsgSubmitProposal = sdk.MsgTypeURL(&v1.MsgSubmitProposal{})
+	TypeMsgCancelProposal = sdk.MsgTypeURL(&v1.MsgCancelProposal{})
 )
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgDeposit      = "op_weight_msg_deposit"       //nolint:gosec
-	OpWeightMsgVote         = "op_weight_msg_vote"          //nolint:gosec
-	OpWeightMsgVoteWeighted = "op_weight_msg_weighted_vote" //nolint:gosec
+	OpWeightMsgDeposit        = "op_weight_msg_deposit"         //nolint:gosec
+	OpWeightMsgVote           = "op_weight_msg_vote"            //nolint:gosec
+	OpWeightMsgVoteWeighted   = "op_weight_msg_weighted_vote"   //nolint:gosec
+	OpWeightMsgCancelProposal = "op_weight_msg_cancel_proposal" //nolint:gosec
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -40,9 +42,10 @@ func WeightedOperations(
 	bk types.BankKeeper, k keeper.Keeper, wContents []simtypes.WeightedProposalContent,
 ) simulation.WeightedOperations {
 	var (
-		weightMsgDeposit      int
-		weightMsgVote         int
-		weightMsgVoteWeighted int
+		weightMsgDeposit        int
+		weightMsgVote           int
+		weightMsgVoteWeighted   int
+		weightMsgCancelProposal int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
@@ -63,6 +66,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelProposal, &weightMsgCancelProposal, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelProposal = simappparams.DefaultWeightMsgCancelProposal
+		},
+	)
+
 	// generate the weighted operations for the proposal contents
 	var wProposalOps simulation.WeightedOperations
 
@@ -94,6 +103,10 @@ func WeightedOperations(
 			weightMsgVoteWeighted,
 			SimulateMsgVoteWeighted(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelProposal,
+			SimulateMsgCancelProposal(ak, bk, k),
+		),
 	}
 
 	return append(wProposalOps, wGovOps...)
@@ -417,6 +430,50 @@ func randomDeposit(r *rand.Rand, ctx sdk.Context,
 	return sdk.Coins{sdk.NewCoin(denom, amount)}, false, nil
 }
 
+// SimulateMsgCancelProposal generates a MsgCancelProposal for a random
+// proposal in its voting period.
+func SimulateMsgCancelProposal(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		proposalID, ok := randomProposalID(r, k, ctx, v1.StatusVotingPeriod)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "unable to generate proposalID"), nil, nil
+		}
+
+		proposal, _ := k.GetProposal(ctx, proposalID)
+		proposer, err := sdk.AccAddressFromBech32(proposal.Proposer)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "proposal has no proposer"), nil, nil
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, proposer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelProposal, "unable to find proposer"), nil, nil
+		}
+
+		msg := v1.NewMsgCancelProposal(simAccount.Address, proposalID)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
 // Pick a random proposal ID between the initial proposal ID
 // (defined in gov GenesisState) and the latest proposal ID
 // that matches a given Status.
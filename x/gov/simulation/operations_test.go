@@ -82,6 +82,7 @@ func TestWeightedOperations(t *testing.T) {
 		{simappparams.DefaultWeightMsgDeposit, types.ModuleName, simulation.TypeMsgDeposit},
 		{simappparams.DefaultWeightMsgVote, types.ModuleName, simulation.TypeMsgVote},
 		{simappparams.DefaultWeightMsgVoteWeighted, types.ModuleName, simulation.TypeMsgVoteWeighted},
+		{simappparams.DefaultWeightMsgCancelProposal, types.ModuleName, simulation.TypeMsgCancelProposal},
 	}
 
 	for i, w := range weightesOps {
@@ -261,6 +262,50 @@ func TestSimulateMsgVoteWeighted(t *testing.T) {
 }
 
 // returns context and an app with updated mint keeper
+// TestSimulateMsgCancelProposal tests the normal scenario of a valid message of type TypeMsgCancelProposal.
+// Abnormal scenarios, where errors occur, are not tested here.
+func TestSimulateMsgCancelProposal(t *testing.T) {
+	app, ctx := createTestApp(t, false)
+	blockTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(blockTime)
+
+	// setup 3 accounts
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := getTestingAccounts(t, r, app, ctx, 3)
+
+	// setup a proposal
+	govAcc := app.GovKeeper.GetGovernanceAccount(ctx).GetAddress().String()
+	contentMsg, err := v1.NewLegacyContent(v1beta1.NewTextProposal("Test", "description"), govAcc)
+	require.NoError(t, err)
+
+	submitTime := ctx.BlockHeader().Time
+	depositPeriod := app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod
+
+	proposal, err := v1.NewProposal([]sdk.Msg{contentMsg}, 1, "", submitTime, submitTime.Add(*depositPeriod))
+	require.NoError(t, err)
+	proposal.Proposer = accounts[0].Address.String()
+
+	app.GovKeeper.ActivateVotingPeriod(ctx, proposal)
+
+	// begin a new block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1, AppHash: app.LastCommitID().Hash, Time: blockTime}})
+
+	// execute operation
+	op := simulation.SimulateMsgCancelProposal(app.AccountKeeper, app.BankKeeper, app.GovKeeper)
+	operationMsg, _, err := op(r, app.BaseApp, ctx, accounts, "")
+	require.NoError(t, err)
+
+	var msg v1.MsgCancelProposal
+	v1.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+
+	require.True(t, operationMsg.OK)
+	require.Equal(t, uint64(1), msg.ProposalId)
+	require.Equal(t, accounts[0].Address.String(), msg.Proposer)
+	require.Equal(t, "gov", msg.Route())
+	require.Equal(t, simulation.TypeMsgCancelProposal, msg.Type())
+}
+
 func createTestApp(t *testing.T, isCheckTx bool) (*simapp.SimApp, sdk.Context) {
 	app := simapp.Setup(t, isCheckTx)
 
@@ -8,11 +8,14 @@ const (
 	EventTypeInactiveProposal = "inactive_proposal"
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeSignalProposal   = "signal_proposal"
+	EventTypeCancelProposal   = "cancel_proposal"
 
 	AttributeKeyProposalResult     = "proposal_result"
 	AttributeKeyOption             = "option"
 	AttributeKeyProposalID         = "proposal_id"
 	AttributeKeyProposalMessages   = "proposal_messages" // Msg type_urls in the proposal
+	AttributeKeyProposalExpedited  = "proposal_expedited"
+	AttributeKeyProposer           = "proposer"
 	AttributeKeyVotingPeriodStart  = "voting_period_start"
 	AttributeValueCategory         = "governance"
 	AttributeValueProposalDropped  = "proposal_dropped"  // didn't meet min deposit
@@ -22,4 +25,6 @@ const (
 	AttributeKeyProposalType       = "proposal_type"
 	AttributeSignalTitle           = "signal_title"
 	AttributeSignalDescription     = "signal_description"
+
+	AttributeValueExpeditedProposalRejected = "expedited_proposal_rejected" // didn't pass as expedited, converted to regular
 )
@@ -19,6 +19,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgVote{}, "cosmos-sdk/v1/MsgVote")
 	legacy.RegisterAminoMsg(cdc, &MsgVoteWeighted{}, "cosmos-sdk/v1/MsgVoteWeighted")
 	legacy.RegisterAminoMsg(cdc, &MsgExecLegacyContent{}, "cosmos-sdk/v1/MsgExecLegacyContent")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelProposal{}, "cosmos-sdk/v1/MsgCancelProposal")
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -28,6 +29,7 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgVoteWeighted{},
 		&MsgDeposit{},
 		&MsgExecLegacyContent{},
+		&MsgCancelProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
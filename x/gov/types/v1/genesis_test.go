@@ -69,6 +69,101 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expErr: true,
 		},
+		{
+			name: "expedited voting period not shorter than voting period",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &v1.VotingParams{VotingPeriod: votingParams.VotingPeriod, ExpeditedVotingPeriod: votingParams.VotingPeriod},
+				TallyParams:        &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited min deposit lower than min deposit",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &v1.DepositParams{MinDeposit: depositParams.ExpeditedMinDeposit, MaxDepositPeriod: depositParams.MaxDepositPeriod, ExpeditedMinDeposit: depositParams.MinDeposit},
+				VotingParams:       &votingParams,
+				TallyParams:        &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "expedited threshold not greater than threshold",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams:        &v1.TallyParams{Quorum: tallyParams.Quorum, Threshold: tallyParams.Threshold, VetoThreshold: tallyParams.VetoThreshold, ExpeditedThreshold: tallyParams.Threshold},
+			},
+			expErr: true,
+		},
+		{
+			name: "duplicate message tally params",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams:      &depositParams,
+				VotingParams:       &votingParams,
+				TallyParams: &v1.TallyParams{
+					Quorum:             tallyParams.Quorum,
+					Threshold:          tallyParams.Threshold,
+					VetoThreshold:      tallyParams.VetoThreshold,
+					ExpeditedThreshold: tallyParams.ExpeditedThreshold,
+					MessageTallyParams: []v1.MessageTallyParams{
+						v1.NewMessageTallyParams("/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", v1.DefaultQuorum, v1.DefaultExpeditedThreshold, v1.DefaultVetoThreshold),
+						v1.NewMessageTallyParams("/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade", v1.DefaultQuorum, v1.DefaultThreshold, v1.DefaultVetoThreshold),
+					},
+				},
+			},
+			expErr: true,
+		},
+		{
+			name: "proposal cancel ratio greater than one",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams: &v1.DepositParams{
+					MinDeposit:          depositParams.MinDeposit,
+					MaxDepositPeriod:    depositParams.MaxDepositPeriod,
+					ExpeditedMinDeposit: depositParams.ExpeditedMinDeposit,
+					ProposalCancelRatio: "1.1",
+				},
+				VotingParams: &votingParams,
+				TallyParams:  &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "invalid proposal cancel destination",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams: &v1.DepositParams{
+					MinDeposit:          depositParams.MinDeposit,
+					MaxDepositPeriod:    depositParams.MaxDepositPeriod,
+					ExpeditedMinDeposit: depositParams.ExpeditedMinDeposit,
+					ProposalCancelRatio: depositParams.ProposalCancelRatio,
+					ProposalCancelDest:  "invalid",
+				},
+				VotingParams: &votingParams,
+				TallyParams:  &tallyParams,
+			},
+			expErr: true,
+		},
+		{
+			name: "proposal cancel destination address",
+			genesisState: &v1.GenesisState{
+				StartingProposalId: v1.DefaultStartingProposalID,
+				DepositParams: &v1.DepositParams{
+					MinDeposit:          depositParams.MinDeposit,
+					MaxDepositPeriod:    depositParams.MaxDepositPeriod,
+					ExpeditedMinDeposit: depositParams.ExpeditedMinDeposit,
+					ProposalCancelRatio: depositParams.ProposalCancelRatio,
+					ProposalCancelDest:  "cosmos1ghekyjucln7y67ntx7cf27m9dpuxxemn4c8g4r",
+				},
+				VotingParams: &votingParams,
+				TallyParams:  &tallyParams,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
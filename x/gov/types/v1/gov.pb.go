@@ -251,6 +251,11 @@ type Proposal struct {
 	VotingEndTime    *time.Time   `protobuf:"bytes,9,opt,name=voting_end_time,json=votingEndTime,proto3,stdtime" json:"voting_end_time,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,10,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// expedited defines if the proposal is expedited. An expedited proposal
+	// which fails is converted to a regular proposal.
+	Expedited bool `protobuf:"varint,11,opt,name=expedited,proto3" json:"expedited,omitempty"`
+	// proposer is the address of the proposal submitter.
+	Proposer string `protobuf:"bytes,12,opt,name=proposer,proto3" json:"proposer,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -356,6 +361,20 @@ func (m *Proposal) GetMetadata() string {
 	return ""
 }
 
+func (m *Proposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
+func (m *Proposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
 // TallyResult defines a standard tally for a governance proposal.
 type TallyResult struct {
 	YesCount        string `protobuf:"bytes,1,opt,name=yes_count,json=yesCount,proto3" json:"yes_count,omitempty"`
@@ -503,6 +522,22 @@ type DepositParams struct {
 	//  Maximum period for Atom holders to deposit on a proposal. Initial value: 2
 	//  months.
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
+	//  Minimum deposit for an expedited proposal to enter voting period.
+	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,3,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit,omitempty"`
+	//  Burn the deposits of a proposal which does not reach quorum.
+	BurnVoteQuorum bool `protobuf:"varint,4,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
+	//  Burn the deposits of a proposal which does not reach the minimum deposit
+	//  before the end of its deposit period.
+	BurnProposalDepositPrevote bool `protobuf:"varint,5,opt,name=burn_proposal_deposit_prevote,json=burnProposalDepositPrevote,proto3" json:"burn_proposal_deposit_prevote,omitempty"`
+	//  Burn the deposits of a proposal which is vetoed.
+	BurnVoteVeto bool `protobuf:"varint,6,opt,name=burn_vote_veto,json=burnVoteVeto,proto3" json:"burn_vote_veto,omitempty"`
+	//  Ratio of the deposits of a canceled proposal which is burnt or sent to
+	//  proposal_cancel_dest, the rest being refunded to the depositors.
+	ProposalCancelRatio string `protobuf:"bytes,7,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
+	//  Address receiving the charged deposits of canceled proposals. The deposits
+	//  are burnt if empty, and sent to the community pool if it is the
+	//  distribution module address.
+	ProposalCancelDest string `protobuf:"bytes,8,opt,name=proposal_cancel_dest,json=proposalCancelDest,proto3" json:"proposal_cancel_dest,omitempty"`
 }
 
 func (m *DepositParams) Reset()         { *m = DepositParams{} }
@@ -552,10 +587,55 @@ func (m *DepositParams) GetMaxDepositPeriod() *time.Duration {
 	return nil
 }
 
+func (m *DepositParams) GetExpeditedMinDeposit() []types.Coin {
+	if m != nil {
+		return m.ExpeditedMinDeposit
+	}
+	return nil
+}
+
+func (m *DepositParams) GetBurnVoteQuorum() bool {
+	if m != nil {
+		return m.BurnVoteQuorum
+	}
+	return false
+}
+
+func (m *DepositParams) GetBurnProposalDepositPrevote() bool {
+	if m != nil {
+		return m.BurnProposalDepositPrevote
+	}
+	return false
+}
+
+func (m *DepositParams) GetBurnVoteVeto() bool {
+	if m != nil {
+		return m.BurnVoteVeto
+	}
+	return false
+}
+
+func (m *DepositParams) GetProposalCancelRatio() string {
+	if m != nil {
+		return m.ProposalCancelRatio
+	}
+	return ""
+}
+
+func (m *DepositParams) GetProposalCancelDest() string {
+	if m != nil {
+		return m.ProposalCancelDest
+	}
+	return ""
+}
+
 // VotingParams defines the params for voting on governance proposals.
 type VotingParams struct {
 	//  Length of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,1,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
+	//  Length of the voting period of expedited proposals. It must be shorter
+	//  than the voting period.
+	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,2,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 }
 
 func (m *VotingParams) Reset()         { *m = VotingParams{} }
@@ -598,6 +678,13 @@ func (m *VotingParams) GetVotingPeriod() *time.Duration {
 	return nil
 }
 
+func (m *VotingParams) GetExpeditedVotingPeriod() *time.Duration {
+	if m != nil {
+		return m.ExpeditedVotingPeriod
+	}
+	return nil
+}
+
 // TallyParams defines the params for tallying votes on governance proposals.
 type TallyParams struct {
 	//  Minimum percentage of total stake needed to vote for a result to be
@@ -608,6 +695,12 @@ type TallyParams struct {
 	//  Minimum value of Veto votes to Total votes ratio for proposal to be
 	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	//  Minimum proportion of Yes votes for an expedited proposal to pass. It
+	//  must be greater than the threshold. Default value: 0.667.
+	ExpeditedThreshold string `protobuf:"bytes,4,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
+	//  Stricter tally params applied to the proposals containing a message of a
+	//  given type URL.
+	MessageTallyParams []MessageTallyParams `protobuf:"bytes,5,rep,name=message_tally_params,json=messageTallyParams,proto3" json:"message_tally_params,omitempty"`
 }
 
 func (m *TallyParams) Reset()         { *m = TallyParams{} }
@@ -664,6 +757,98 @@ func (m *TallyParams) GetVetoThreshold() string {
 	return ""
 }
 
+func (m *TallyParams) GetExpeditedThreshold() string {
+	if m != nil {
+		return m.ExpeditedThreshold
+	}
+	return ""
+}
+
+func (m *TallyParams) GetMessageTallyParams() []MessageTallyParams {
+	if m != nil {
+		return m.MessageTallyParams
+	}
+	return nil
+}
+
+// MessageTallyParams defines the tally params of the proposals containing a
+// message of a given type URL. When a proposal contains messages of several
+// configured types, or when the params are looser than the default tally
+// params, the strictest quorum and thresholds apply.
+type MessageTallyParams struct {
+	//  Type URL of the message, e.g. /cosmos.upgrade.v1beta1.MsgSoftwareUpgrade.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
+	Quorum string `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	//  Minimum proportion of Yes votes for proposal to pass.
+	Threshold string `protobuf:"bytes,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed.
+	VetoThreshold string `protobuf:"bytes,4,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+}
+
+func (m *MessageTallyParams) Reset()         { *m = MessageTallyParams{} }
+func (m *MessageTallyParams) String() string { return proto.CompactTextString(m) }
+func (*MessageTallyParams) ProtoMessage()    {}
+func (*MessageTallyParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{8}
+}
+func (m *MessageTallyParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageTallyParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageTallyParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageTallyParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageTallyParams.Merge(m, src)
+}
+func (m *MessageTallyParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageTallyParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageTallyParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageTallyParams proto.InternalMessageInfo
+
+func (m *MessageTallyParams) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MessageTallyParams) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *MessageTallyParams) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *MessageTallyParams) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
 	proto.RegisterEnum("cosmos.gov.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
@@ -675,81 +860,102 @@ func init() {
 	proto.RegisterType((*DepositParams)(nil), "cosmos.gov.v1.DepositParams")
 	proto.RegisterType((*VotingParams)(nil), "cosmos.gov.v1.VotingParams")
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
+	proto.RegisterType((*MessageTallyParams)(nil), "cosmos.gov.v1.MessageTallyParams")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1104 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x4f, 0x53, 0xdb, 0x46,
-	0x14, 0x47, 0xb6, 0x30, 0xe6, 0x19, 0x1b, 0x75, 0xa1, 0x45, 0x90, 0x60, 0x13, 0x4f, 0xdb, 0xa1,
-	0xa4, 0xd8, 0x25, 0x99, 0xb6, 0x33, 0xcd, 0xc9, 0xc6, 0x4a, 0x31, 0xc3, 0x60, 0x57, 0x52, 0xcc,
-	0xa4, 0x17, 0x8d, 0x8c, 0x14, 0x5b, 0x53, 0x4b, 0xeb, 0x6a, 0xd7, 0x0e, 0xfe, 0x08, 0xbd, 0xe5,
-	0xd8, 0x99, 0x7e, 0x8d, 0x4c, 0x3f, 0x43, 0x4e, 0x9d, 0x4c, 0x0e, 0xfd, 0x73, 0x71, 0x5b, 0xb8,
-	0xf1, 0x29, 0x32, 0x5a, 0xad, 0xb0, 0x2d, 0xc8, 0xc0, 0x49, 0xd2, 0x7b, 0xbf, 0xdf, 0xef, 0xbd,
-	0xdd, 0xf7, 0xd3, 0x4a, 0xb0, 0x76, 0x8a, 0x89, 0x8b, 0x49, 0xb9, 0x83, 0x87, 0xe5, 0xe1, 0x5e,
-	0x70, 0x29, 0xf5, 0x7d, 0x4c, 0x31, 0xca, 0x86, 0x89, 0x52, 0x10, 0x19, 0xee, 0x6d, 0xe4, 0x39,
-	0xae, 0x6d, 0x12, 0xbb, 0x3c, 0xdc, 0x6b, 0xdb, 0xd4, 0xdc, 0x2b, 0x9f, 0x62, 0xc7, 0x0b, 0xe1,
-	0x1b, 0xab, 0x1d, 0xdc, 0xc1, 0xec, 0xb6, 0x1c, 0xdc, 0xf1, 0x68, 0xa1, 0x83, 0x71, 0xa7, 0x67,
-	0x97, 0xd9, 0x53, 0x7b, 0xf0, 0xa2, 0x4c, 0x1d, 0xd7, 0x26, 0xd4, 0x74, 0xfb, 0x1c, 0xb0, 0x1e,
-	0x07, 0x98, 0xde, 0x88, 0xa7, 0xf2, 0xf1, 0x94, 0x35, 0xf0, 0x4d, 0xea, 0xe0, 0xa8, 0xe2, 0x7a,
-	0xd8, 0x91, 0x11, 0x16, 0xe5, 0xdd, 0xb2, 0x87, 0x22, 0x06, 0x74, 0x62, 0x3b, 0x9d, 0x2e, 0xb5,
-	0xad, 0x16, 0xa6, 0x76, 0xa3, 0x1f, 0xd0, 0xd0, 0x1e, 0xa4, 0x30, 0xbb, 0x93, 0x85, 0x2d, 0x61,
-	0x3b, 0xf7, 0x68, 0xbd, 0x34, 0xb3, 0xc4, 0xd2, 0x04, 0xaa, 0x72, 0x20, 0xfa, 0x1c, 0x52, 0x2f,
-	0x99, 0x90, 0x9c, 0xd8, 0x12, 0xb6, 0x17, 0xab, 0xb9, 0x77, 0xaf, 0x77, 0x81, 0xb3, 0x6a, 0xf6,
-	0xa9, 0xca, 0xb3, 0xc5, 0xdf, 0x04, 0x58, 0xa8, 0xd9, 0x7d, 0x4c, 0x1c, 0x8a, 0x0a, 0x90, 0xe9,
-	0xfb, 0xb8, 0x8f, 0x89, 0xd9, 0x33, 0x1c, 0x8b, 0xd5, 0x12, 0x55, 0x88, 0x42, 0x75, 0x0b, 0x7d,
-	0x03, 0x8b, 0x56, 0x88, 0xc5, 0x3e, 0xd7, 0x95, 0xdf, 0xbd, 0xde, 0x5d, 0xe5, 0xba, 0x15, 0xcb,
-	0xf2, 0x6d, 0x42, 0x34, 0xea, 0x3b, 0x5e, 0x47, 0x9d, 0x40, 0xd1, 0xb7, 0x90, 0x32, 0x5d, 0x3c,
-	0xf0, 0xa8, 0x9c, 0xdc, 0x4a, 0x6e, 0x67, 0x26, 0xfd, 0x07, 0x33, 0x29, 0xf1, 0x99, 0x94, 0xf6,
-	0xb1, 0xe3, 0x55, 0xc5, 0x37, 0xe3, 0xc2, 0x9c, 0xca, 0xe1, 0xc5, 0x3f, 0x45, 0x48, 0x37, 0x79,
-	0x7d, 0x94, 0x83, 0xc4, 0x55, 0x57, 0x09, 0xc7, 0x42, 0x5f, 0x41, 0xda, 0xb5, 0x09, 0x31, 0x3b,
-	0x36, 0x91, 0x13, 0x4c, 0x77, 0xb5, 0x14, 0xee, 0x7c, 0x29, 0xda, 0xf9, 0x52, 0xc5, 0x1b, 0xa9,
-	0x57, 0x28, 0xf4, 0x35, 0xa4, 0x08, 0x35, 0xe9, 0x80, 0xc8, 0x49, 0xb6, 0x8f, 0x9b, 0xb1, 0x7d,
-	0x8c, 0x4a, 0x69, 0x0c, 0xa4, 0x72, 0x30, 0x3a, 0x00, 0xf4, 0xc2, 0xf1, 0xcc, 0x9e, 0x41, 0xcd,
-	0x5e, 0x6f, 0x64, 0xf8, 0x36, 0x19, 0xf4, 0xa8, 0x2c, 0x6e, 0x09, 0xdb, 0x99, 0x47, 0x1b, 0x31,
-	0x09, 0x3d, 0x80, 0xa8, 0x0c, 0xa1, 0x4a, 0x8c, 0x35, 0x15, 0x41, 0x15, 0xc8, 0x90, 0x41, 0xdb,
-	0x75, 0xa8, 0x11, 0xd8, 0x49, 0x9e, 0xe7, 0x12, 0xf1, 0xae, 0xf5, 0xc8, 0x6b, 0x55, 0xf1, 0xd5,
-	0xbf, 0x05, 0x41, 0x85, 0x90, 0x14, 0x84, 0xd1, 0x21, 0x48, 0x7c, 0x63, 0x0d, 0xdb, 0xb3, 0x42,
-	0x9d, 0xd4, 0x1d, 0x75, 0x72, 0x9c, 0xa9, 0x78, 0x16, 0xd3, 0xaa, 0x41, 0x96, 0x62, 0x6a, 0xf6,
-	0x0c, 0x1e, 0x97, 0x17, 0xee, 0x36, 0x9e, 0x25, 0xc6, 0x8a, 0x6c, 0x73, 0x04, 0x1f, 0x0d, 0x31,
-	0x75, 0xbc, 0x8e, 0x41, 0xa8, 0xe9, 0xf3, 0xa5, 0xa5, 0xef, 0xd8, 0xd2, 0x72, 0x48, 0xd5, 0x02,
-	0x26, 0xeb, 0xe9, 0x00, 0x78, 0x68, 0xb2, 0xbc, 0xc5, 0x3b, 0x6a, 0x65, 0x43, 0x62, 0xb4, 0xba,
-	0x8d, 0xc0, 0x1f, 0xd4, 0xb4, 0x4c, 0x6a, 0xca, 0x10, 0x98, 0x55, 0xbd, 0x7a, 0x2e, 0xfe, 0x25,
-	0x40, 0x66, 0x7a, 0x30, 0x0f, 0x61, 0x71, 0x64, 0x13, 0xe3, 0x94, 0x99, 0x54, 0xb8, 0xf6, 0xc6,
-	0xd4, 0x3d, 0xaa, 0xa6, 0x47, 0x36, 0xd9, 0x0f, 0xf2, 0xe8, 0x31, 0x64, 0xcd, 0x36, 0xa1, 0xa6,
-	0xe3, 0x71, 0x42, 0xe2, 0x46, 0xc2, 0x12, 0x07, 0x85, 0xa4, 0x2f, 0x20, 0xed, 0x61, 0x8e, 0x4f,
-	0xde, 0x88, 0x5f, 0xf0, 0x70, 0x08, 0x7d, 0x02, 0xc8, 0xc3, 0xc6, 0x4b, 0x87, 0x76, 0x8d, 0xa1,
-	0x4d, 0x23, 0x92, 0x78, 0x23, 0x69, 0xd9, 0xc3, 0x27, 0x0e, 0xed, 0xb6, 0x6c, 0x1a, 0x92, 0x8b,
-	0xbf, 0x0b, 0x20, 0x06, 0xe7, 0xc1, 0xed, 0x6f, 0x73, 0x09, 0xe6, 0x87, 0x98, 0xda, 0xb7, 0xbf,
-	0xc9, 0x21, 0x0c, 0x3d, 0x81, 0x85, 0xf0, 0x70, 0x21, 0xb2, 0xc8, 0x7c, 0xf2, 0x20, 0xe6, 0xfd,
-	0xeb, 0x27, 0x97, 0x1a, 0x31, 0x66, 0x86, 0x31, 0x3f, 0x3b, 0x8c, 0x43, 0x31, 0x9d, 0x94, 0xc4,
-	0xe2, 0x3f, 0x02, 0x64, 0xb9, 0xa5, 0x9a, 0xa6, 0x6f, 0xba, 0x04, 0x3d, 0x87, 0x8c, 0xeb, 0x78,
-	0x57, 0xe6, 0x14, 0x6e, 0x33, 0xe7, 0x66, 0x60, 0xce, 0xcb, 0x71, 0xe1, 0xe3, 0x29, 0xd6, 0x97,
-	0xd8, 0x75, 0xa8, 0xed, 0xf6, 0xe9, 0x48, 0x05, 0xd7, 0xf1, 0x22, 0xcf, 0xba, 0x80, 0x5c, 0xf3,
-	0x2c, 0x02, 0x19, 0x7d, 0xdb, 0x77, 0xb0, 0xc5, 0x36, 0x22, 0xa8, 0x10, 0x37, 0x5a, 0x8d, 0x9f,
-	0xdf, 0xd5, 0x4f, 0x2f, 0xc7, 0x85, 0xfb, 0xd7, 0x89, 0x93, 0x22, 0xbf, 0x06, 0x3e, 0x94, 0x5c,
-	0xf3, 0x2c, 0x5a, 0x09, 0xcb, 0x17, 0x75, 0x58, 0x6a, 0x31, 0x6f, 0xf2, 0x95, 0xd5, 0x80, 0x7b,
-	0x35, 0xaa, 0x2c, 0xdc, 0x56, 0x59, 0x64, 0xca, 0x4b, 0x21, 0x8b, 0xab, 0xfe, 0x1f, 0x99, 0x98,
-	0xab, 0x7e, 0x07, 0xa9, 0x9f, 0x07, 0xd8, 0x1f, 0xb8, 0xdc, 0xc1, 0xc5, 0xcb, 0x71, 0x41, 0x0a,
-	0x23, 0x93, 0x0e, 0xe3, 0xdf, 0x81, 0x30, 0x8f, 0xf6, 0x61, 0x91, 0x76, 0x7d, 0x9b, 0x74, 0x71,
-	0xcf, 0xe2, 0x86, 0xf8, 0xec, 0x72, 0x5c, 0x58, 0xb9, 0x0a, 0x7e, 0x50, 0x61, 0xc2, 0x43, 0x3f,
-	0x40, 0x8e, 0x19, 0x76, 0xa2, 0x14, 0x3a, 0x7d, 0xe7, 0x72, 0x5c, 0x90, 0x67, 0x33, 0x1f, 0x94,
-	0xcb, 0x06, 0x38, 0x3d, 0x82, 0xed, 0xfc, 0x22, 0x00, 0x4c, 0x7d, 0x09, 0xef, 0xc1, 0x5a, 0xab,
-	0xa1, 0x2b, 0x46, 0xa3, 0xa9, 0xd7, 0x1b, 0xc7, 0xc6, 0xb3, 0x63, 0xad, 0xa9, 0xec, 0xd7, 0x9f,
-	0xd6, 0x95, 0x9a, 0x34, 0x87, 0x56, 0x60, 0x79, 0x3a, 0xf9, 0x5c, 0xd1, 0x24, 0x01, 0xad, 0xc1,
-	0xca, 0x74, 0xb0, 0x52, 0xd5, 0xf4, 0x4a, 0xfd, 0x58, 0x4a, 0x20, 0x04, 0xb9, 0xe9, 0xc4, 0x71,
-	0x43, 0x4a, 0xa2, 0xfb, 0x20, 0xcf, 0xc6, 0x8c, 0x93, 0xba, 0x7e, 0x60, 0xb4, 0x14, 0xbd, 0x21,
-	0x89, 0x3b, 0x7f, 0x08, 0x90, 0x9b, 0xfd, 0x44, 0xa0, 0x02, 0xdc, 0x6b, 0xaa, 0x8d, 0x66, 0x43,
-	0xab, 0x1c, 0x19, 0x9a, 0x5e, 0xd1, 0x9f, 0x69, 0xb1, 0x9e, 0x8a, 0x90, 0x8f, 0x03, 0x6a, 0x4a,
-	0xb3, 0xa1, 0xd5, 0x75, 0xa3, 0xa9, 0xa8, 0xf5, 0x46, 0x4d, 0x12, 0xd0, 0x03, 0xd8, 0x8c, 0x63,
-	0x5a, 0x0d, 0xbd, 0x7e, 0xfc, 0x7d, 0x04, 0x49, 0xa0, 0x0d, 0xf8, 0x24, 0x0e, 0x69, 0x56, 0x34,
-	0x4d, 0xa9, 0x85, 0x4d, 0xc7, 0x73, 0xaa, 0x72, 0xa8, 0xec, 0xeb, 0x4a, 0x4d, 0x12, 0x6f, 0x62,
-	0x3e, 0xad, 0xd4, 0x8f, 0x94, 0x9a, 0x34, 0x5f, 0x55, 0xde, 0x9c, 0xe7, 0x85, 0xb7, 0xe7, 0x79,
-	0xe1, 0xbf, 0xf3, 0xbc, 0xf0, 0xea, 0x22, 0x3f, 0xf7, 0xf6, 0x22, 0x3f, 0xf7, 0xf7, 0x45, 0x7e,
-	0xee, 0xc7, 0x87, 0x1d, 0x87, 0x76, 0x07, 0xed, 0xd2, 0x29, 0x76, 0xf9, 0x0f, 0x0a, 0xbf, 0xec,
-	0x12, 0xeb, 0xa7, 0xf2, 0x19, 0xfb, 0xe9, 0xa2, 0xa3, 0xbe, 0x4d, 0x82, 0x3f, 0xaa, 0x14, 0xb3,
-	0xeb, 0xe3, 0xf7, 0x01, 0x00, 0x00, 0xff, 0xff, 0x73, 0xa8, 0x09, 0x8c, 0x92, 0x09, 0x00, 0x00,
+	// 1410 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4d, 0x6f, 0xdb, 0xc6,
+	0x16, 0x35, 0x65, 0xd9, 0x96, 0xaf, 0x6d, 0x85, 0x6f, 0xec, 0x3c, 0x33, 0x4e, 0x2c, 0x39, 0x42,
+	0x5e, 0x9e, 0x5f, 0x3e, 0xa4, 0xe7, 0xa4, 0x69, 0x81, 0x66, 0x25, 0x59, 0x4c, 0x23, 0x23, 0xb1,
+	0x14, 0x8a, 0xb1, 0x91, 0x6e, 0x58, 0xda, 0x9c, 0xc8, 0x44, 0x45, 0x8e, 0xca, 0x19, 0x29, 0x16,
+	0xfa, 0x0b, 0xba, 0xcb, 0xb2, 0x40, 0xff, 0x41, 0x81, 0xee, 0x82, 0xae, 0x0b, 0x74, 0x93, 0x55,
+	0x11, 0x64, 0xd3, 0xae, 0xdc, 0x22, 0xd9, 0x79, 0xdd, 0x1f, 0x50, 0xcc, 0x70, 0x28, 0x4a, 0xb4,
+	0x0c, 0x79, 0x25, 0xe9, 0xde, 0x73, 0xce, 0xcc, 0x9d, 0x7b, 0x78, 0x35, 0x84, 0xd5, 0x43, 0x42,
+	0x3d, 0x42, 0x4b, 0x2d, 0xd2, 0x2b, 0xf5, 0xb6, 0xf8, 0x47, 0xb1, 0x13, 0x10, 0x46, 0xd0, 0x52,
+	0x98, 0x28, 0xf2, 0x48, 0x6f, 0x6b, 0x2d, 0x27, 0x71, 0x07, 0x36, 0xc5, 0xa5, 0xde, 0xd6, 0x01,
+	0x66, 0xf6, 0x56, 0xe9, 0x90, 0xb8, 0x7e, 0x08, 0x5f, 0x5b, 0x69, 0x91, 0x16, 0x11, 0x5f, 0x4b,
+	0xfc, 0x9b, 0x8c, 0xe6, 0x5b, 0x84, 0xb4, 0xda, 0xb8, 0x24, 0x7e, 0x1d, 0x74, 0x5f, 0x96, 0x98,
+	0xeb, 0x61, 0xca, 0x6c, 0xaf, 0x23, 0x01, 0x57, 0x92, 0x00, 0xdb, 0xef, 0xcb, 0x54, 0x2e, 0x99,
+	0x72, 0xba, 0x81, 0xcd, 0x5c, 0x12, 0xad, 0x78, 0x25, 0xdc, 0x91, 0x15, 0x2e, 0x2a, 0x77, 0x2b,
+	0x7e, 0x14, 0x08, 0xa0, 0x7d, 0xec, 0xb6, 0x8e, 0x18, 0x76, 0xf6, 0x08, 0xc3, 0xf5, 0x0e, 0xa7,
+	0xa1, 0x2d, 0x98, 0x25, 0xe2, 0x9b, 0xa6, 0x6c, 0x28, 0x9b, 0xd9, 0x7b, 0x57, 0x8a, 0x23, 0x25,
+	0x16, 0x63, 0xa8, 0x21, 0x81, 0xe8, 0x26, 0xcc, 0xbe, 0x12, 0x42, 0x5a, 0x6a, 0x43, 0xd9, 0x9c,
+	0xaf, 0x64, 0xdf, 0xbf, 0xb9, 0x0b, 0x92, 0x55, 0xc5, 0x87, 0x86, 0xcc, 0x16, 0x7e, 0x50, 0x60,
+	0xae, 0x8a, 0x3b, 0x84, 0xba, 0x0c, 0xe5, 0x61, 0xa1, 0x13, 0x90, 0x0e, 0xa1, 0x76, 0xdb, 0x72,
+	0x1d, 0xb1, 0x56, 0xda, 0x80, 0x28, 0x54, 0x73, 0xd0, 0xa7, 0x30, 0xef, 0x84, 0x58, 0x12, 0x48,
+	0x5d, 0xed, 0xfd, 0x9b, 0xbb, 0x2b, 0x52, 0xb7, 0xec, 0x38, 0x01, 0xa6, 0xb4, 0xc9, 0x02, 0xd7,
+	0x6f, 0x19, 0x31, 0x14, 0x7d, 0x06, 0xb3, 0xb6, 0x47, 0xba, 0x3e, 0xd3, 0xa6, 0x37, 0xa6, 0x37,
+	0x17, 0xe2, 0xfd, 0xf3, 0x9e, 0x14, 0x65, 0x4f, 0x8a, 0xdb, 0xc4, 0xf5, 0x2b, 0xe9, 0xb7, 0x27,
+	0xf9, 0x29, 0x43, 0xc2, 0x0b, 0x3f, 0xce, 0x40, 0xa6, 0x21, 0xd7, 0x47, 0x59, 0x48, 0x0d, 0x76,
+	0x95, 0x72, 0x1d, 0xf4, 0x7f, 0xc8, 0x78, 0x98, 0x52, 0xbb, 0x85, 0xa9, 0x96, 0x12, 0xba, 0x2b,
+	0xc5, 0xf0, 0xe4, 0x8b, 0xd1, 0xc9, 0x17, 0xcb, 0x7e, 0xdf, 0x18, 0xa0, 0xd0, 0x03, 0x98, 0xa5,
+	0xcc, 0x66, 0x5d, 0xaa, 0x4d, 0x8b, 0x73, 0x5c, 0x4f, 0x9c, 0x63, 0xb4, 0x54, 0x53, 0x80, 0x0c,
+	0x09, 0x46, 0x8f, 0x01, 0xbd, 0x74, 0x7d, 0xbb, 0x6d, 0x31, 0xbb, 0xdd, 0xee, 0x5b, 0x01, 0xa6,
+	0xdd, 0x36, 0xd3, 0xd2, 0x1b, 0xca, 0xe6, 0xc2, 0xbd, 0xb5, 0x84, 0x84, 0xc9, 0x21, 0x86, 0x40,
+	0x18, 0xaa, 0x60, 0x0d, 0x45, 0x50, 0x19, 0x16, 0x68, 0xf7, 0xc0, 0x73, 0x99, 0xc5, 0xed, 0xa4,
+	0xcd, 0x48, 0x89, 0xe4, 0xae, 0xcd, 0xc8, 0x6b, 0x95, 0xf4, 0xeb, 0x3f, 0xf3, 0x8a, 0x01, 0x21,
+	0x89, 0x87, 0xd1, 0x0e, 0xa8, 0xf2, 0x60, 0x2d, 0xec, 0x3b, 0xa1, 0xce, 0xec, 0x05, 0x75, 0xb2,
+	0x92, 0xa9, 0xfb, 0x8e, 0xd0, 0xaa, 0xc2, 0x12, 0x23, 0xcc, 0x6e, 0x5b, 0x32, 0xae, 0xcd, 0x5d,
+	0xac, 0x3d, 0x8b, 0x82, 0x15, 0xd9, 0xe6, 0x09, 0xfc, 0xab, 0x47, 0x98, 0xeb, 0xb7, 0x2c, 0xca,
+	0xec, 0x40, 0x96, 0x96, 0xb9, 0xe0, 0x96, 0x2e, 0x85, 0xd4, 0x26, 0x67, 0x8a, 0x3d, 0x3d, 0x06,
+	0x19, 0x8a, 0xcb, 0x9b, 0xbf, 0xa0, 0xd6, 0x52, 0x48, 0x8c, 0xaa, 0x5b, 0xe3, 0xfe, 0x60, 0xb6,
+	0x63, 0x33, 0x5b, 0x03, 0x6e, 0x56, 0x63, 0xf0, 0x1b, 0x5d, 0x83, 0x79, 0x7c, 0xdc, 0xc1, 0x8e,
+	0xcb, 0xb0, 0xa3, 0x2d, 0x6c, 0x28, 0x9b, 0x19, 0x23, 0x0e, 0xa0, 0x4f, 0x20, 0x13, 0xba, 0x1e,
+	0x07, 0xda, 0xe2, 0x04, 0x9b, 0x0f, 0x90, 0x85, 0xdf, 0x15, 0x58, 0x18, 0x6e, 0xf6, 0x6d, 0x98,
+	0xef, 0x63, 0x6a, 0x1d, 0x0a, 0xe3, 0x2b, 0x67, 0x9e, 0xc2, 0x9a, 0xcf, 0x8c, 0x4c, 0x1f, 0xd3,
+	0x6d, 0x9e, 0x47, 0xf7, 0x61, 0xc9, 0x3e, 0xa0, 0xcc, 0x76, 0x7d, 0x49, 0x48, 0x8d, 0x25, 0x2c,
+	0x4a, 0x50, 0x48, 0xfa, 0x1f, 0x64, 0x7c, 0x22, 0xf1, 0xd3, 0x63, 0xf1, 0x73, 0x3e, 0x09, 0xa1,
+	0x0f, 0x01, 0xf9, 0xc4, 0x7a, 0xe5, 0xb2, 0x23, 0xab, 0x87, 0x59, 0x44, 0x4a, 0x8f, 0x25, 0x5d,
+	0xf2, 0xc9, 0xbe, 0xcb, 0x8e, 0xf6, 0x30, 0x0b, 0xc9, 0x85, 0x9f, 0x15, 0x48, 0xf3, 0x19, 0x33,
+	0x79, 0x42, 0x14, 0x61, 0xa6, 0x47, 0x18, 0x9e, 0x3c, 0x1d, 0x42, 0x18, 0x7a, 0x08, 0x73, 0xe1,
+	0xc0, 0xa2, 0x5a, 0x5a, 0x78, 0xef, 0x7a, 0xe2, 0x79, 0x3a, 0x3b, 0x0d, 0x8d, 0x88, 0x31, 0xd2,
+	0xe0, 0x99, 0xd1, 0x06, 0xef, 0xa4, 0x33, 0xd3, 0x6a, 0xba, 0xf0, 0x77, 0x1a, 0x96, 0xa4, 0x4d,
+	0x1b, 0x76, 0x60, 0x7b, 0x14, 0xbd, 0x80, 0x05, 0xcf, 0xf5, 0x07, 0x86, 0x57, 0x26, 0x19, 0x7e,
+	0x9d, 0x1b, 0xfe, 0xf4, 0x24, 0x7f, 0x79, 0x88, 0x75, 0x87, 0x78, 0x2e, 0xc3, 0x5e, 0x87, 0xf5,
+	0x0d, 0xf0, 0x5c, 0x3f, 0x7a, 0x0e, 0x3c, 0x40, 0x9e, 0x7d, 0x1c, 0x81, 0xac, 0x0e, 0x0e, 0x5c,
+	0xe2, 0x88, 0x83, 0xe0, 0x2b, 0x24, 0xcd, 0x5b, 0x95, 0xff, 0x09, 0x95, 0x1b, 0xa7, 0x27, 0xf9,
+	0x6b, 0x67, 0x89, 0xf1, 0x22, 0xdf, 0x73, 0x6f, 0xab, 0x9e, 0x7d, 0x1c, 0x55, 0x22, 0xf2, 0xa8,
+	0x07, 0x97, 0x07, 0x8e, 0xb5, 0x86, 0x6b, 0x9a, 0x38, 0x63, 0xff, 0x2b, 0x6b, 0xca, 0x8f, 0xe5,
+	0x0f, 0x55, 0xb7, 0x3c, 0x00, 0x3c, 0x8d, 0xcb, 0xdc, 0x04, 0xf5, 0xa0, 0x1b, 0xf8, 0x16, 0x6f,
+	0xa0, 0xf5, 0x4d, 0x97, 0x04, 0x5d, 0x4f, 0xf8, 0x28, 0x63, 0x64, 0x79, 0x9c, 0x37, 0xea, 0x99,
+	0x88, 0xa2, 0x32, 0xac, 0x0b, 0xe4, 0xc0, 0x32, 0x83, 0x0a, 0x03, 0xcc, 0xd9, 0xa2, 0x69, 0x19,
+	0x63, 0x8d, 0x83, 0xa2, 0xd1, 0x1b, 0xd5, 0x18, 0x22, 0xd0, 0x0d, 0xc8, 0xc6, 0x8b, 0x71, 0xe3,
+	0x8a, 0x59, 0x97, 0x31, 0x16, 0xa3, 0xa5, 0xb8, 0x49, 0x51, 0x05, 0x2e, 0x0f, 0xd6, 0x38, 0xb4,
+	0xfd, 0x43, 0xdc, 0xb6, 0xc4, 0xe1, 0x6a, 0x73, 0x63, 0xff, 0xfb, 0x96, 0x23, 0xf0, 0xb6, 0xc0,
+	0x1a, 0x1c, 0x8a, 0x76, 0x60, 0x25, 0xa9, 0xe1, 0x60, 0xca, 0xb4, 0xcc, 0x04, 0x23, 0xa3, 0x51,
+	0xb1, 0x2a, 0xa6, 0xac, 0xf0, 0x93, 0x02, 0x8b, 0x7b, 0x62, 0x16, 0x49, 0xd7, 0x55, 0x41, 0xce,
+	0xa6, 0xc8, 0x15, 0xca, 0x24, 0x57, 0xa4, 0x45, 0xd7, 0x17, 0x43, 0x96, 0xec, 0xf8, 0x3e, 0xac,
+	0xc6, 0x1d, 0x1b, 0xd5, 0x4b, 0x5d, 0x4c, 0x2f, 0x76, 0xcc, 0xde, 0x90, 0x70, 0xe1, 0x97, 0x69,
+	0x39, 0xb9, 0xe4, 0x76, 0x3f, 0x87, 0x59, 0xd9, 0xd8, 0x70, 0x6c, 0x15, 0x4e, 0x4f, 0xf2, 0x6a,
+	0x18, 0x89, 0xdd, 0x91, 0xbc, 0x50, 0x84, 0x79, 0xb4, 0x0d, 0xf3, 0xec, 0x28, 0xc0, 0xf4, 0x88,
+	0xb4, 0x1d, 0x39, 0x05, 0xfe, 0x73, 0x7a, 0x92, 0x5f, 0x1e, 0x04, 0xcf, 0x55, 0x88, 0x79, 0xe8,
+	0x19, 0x64, 0xc5, 0x94, 0x8a, 0x95, 0xc2, 0xf1, 0x76, 0xeb, 0xf4, 0x24, 0xaf, 0x8d, 0x66, 0xce,
+	0x95, 0x5b, 0xe2, 0x38, 0x73, 0x20, 0xf9, 0x15, 0xc4, 0x6e, 0x1e, 0xd2, 0x0d, 0x27, 0x60, 0xe9,
+	0xf4, 0x24, 0xbf, 0x3e, 0x26, 0x7d, 0xae, 0x38, 0x1a, 0x80, 0xe3, 0x15, 0xbe, 0x85, 0x15, 0x79,
+	0xd3, 0x90, 0x17, 0x85, 0x8e, 0x38, 0x4d, 0x6d, 0x66, 0xec, 0x60, 0x7b, 0x1a, 0x42, 0x87, 0x8e,
+	0xbd, 0x72, 0x53, 0x3e, 0x97, 0xb9, 0x71, 0x32, 0x43, 0x8f, 0x25, 0xf2, 0xce, 0x70, 0x0b, 0xbf,
+	0x2a, 0x80, 0xce, 0x4a, 0xa2, 0x0d, 0x58, 0xf4, 0x68, 0xcb, 0x62, 0xfd, 0x0e, 0xb6, 0xba, 0x41,
+	0x3b, 0xec, 0xa7, 0x01, 0x1e, 0x6d, 0x99, 0xfd, 0x0e, 0x7e, 0x1e, 0xb4, 0xf9, 0x45, 0x51, 0xf6,
+	0xfa, 0x9c, 0x8b, 0xa2, 0xec, 0xeb, 0x1d, 0x98, 0x4f, 0x76, 0x23, 0x7b, 0x7e, 0x03, 0x1f, 0x9c,
+	0x69, 0x60, 0x7a, 0x2c, 0x65, 0xb4, 0x49, 0xb7, 0xbe, 0x53, 0x00, 0x86, 0xee, 0xbd, 0x57, 0x61,
+	0x75, 0xaf, 0x6e, 0xea, 0x56, 0xbd, 0x61, 0xd6, 0xea, 0xbb, 0xd6, 0xf3, 0xdd, 0x66, 0x43, 0xdf,
+	0xae, 0x3d, 0xaa, 0xe9, 0x55, 0x75, 0x0a, 0x2d, 0xc3, 0xa5, 0xe1, 0xe4, 0x0b, 0xbd, 0xa9, 0x2a,
+	0x68, 0x15, 0x96, 0x87, 0x83, 0xe5, 0x4a, 0xd3, 0x2c, 0xd7, 0x76, 0xd5, 0x14, 0x42, 0x90, 0x1d,
+	0x4e, 0xec, 0xd6, 0xd5, 0x69, 0x74, 0x0d, 0xb4, 0xd1, 0x98, 0xb5, 0x5f, 0x33, 0x1f, 0x5b, 0x7b,
+	0xba, 0x59, 0x57, 0xd3, 0xb7, 0x7e, 0x53, 0x20, 0x3b, 0x7a, 0x21, 0x44, 0x79, 0xb8, 0xda, 0x30,
+	0xea, 0x8d, 0x7a, 0xb3, 0xfc, 0xc4, 0x6a, 0x9a, 0x65, 0xf3, 0x79, 0x33, 0xb1, 0xa7, 0x02, 0xe4,
+	0x92, 0x80, 0xaa, 0xde, 0xa8, 0x37, 0x6b, 0xa6, 0xd5, 0xd0, 0x8d, 0x5a, 0xbd, 0xaa, 0x2a, 0xe8,
+	0x3a, 0xac, 0x27, 0x31, 0x7b, 0x75, 0xb3, 0xb6, 0xfb, 0x45, 0x04, 0x49, 0xa1, 0x35, 0xf8, 0x77,
+	0x12, 0xd2, 0x28, 0x37, 0x9b, 0x7a, 0x35, 0xdc, 0x74, 0x32, 0x67, 0xe8, 0x3b, 0xfa, 0xb6, 0xa9,
+	0x57, 0xd5, 0xf4, 0x38, 0xe6, 0xa3, 0x72, 0xed, 0x89, 0x5e, 0x55, 0x67, 0x2a, 0xfa, 0xdb, 0x0f,
+	0x39, 0xe5, 0xdd, 0x87, 0x9c, 0xf2, 0xd7, 0x87, 0x9c, 0xf2, 0xfa, 0x63, 0x6e, 0xea, 0xdd, 0xc7,
+	0xdc, 0xd4, 0x1f, 0x1f, 0x73, 0x53, 0x5f, 0xde, 0x6e, 0xb9, 0xec, 0xa8, 0x7b, 0x50, 0x3c, 0x24,
+	0x9e, 0x7c, 0x1d, 0x91, 0x1f, 0x77, 0xa9, 0xf3, 0x75, 0xe9, 0x58, 0xbc, 0x62, 0x71, 0x0b, 0x51,
+	0xfe, 0xfe, 0x34, 0x2b, 0x86, 0xcb, 0xfd, 0x7f, 0x06, 0x00, 0x3c, 0xbb, 0xdc, 0x0f, 0x80, 0x0d,
+	0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -856,6 +1062,23 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1083,6 +1306,64 @@ func (m *DepositParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProposalCancelDest) > 0 {
+		i -= len(m.ProposalCancelDest)
+		copy(dAtA[i:], m.ProposalCancelDest)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelDest)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ProposalCancelRatio) > 0 {
+		i -= len(m.ProposalCancelRatio)
+		copy(dAtA[i:], m.ProposalCancelRatio)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ProposalCancelRatio)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.BurnVoteVeto {
+		i--
+		if m.BurnVoteVeto {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BurnProposalDepositPrevote {
+		i--
+		if m.BurnProposalDepositPrevote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BurnVoteQuorum {
+		i--
+		if m.BurnVoteQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for iNdEx := len(m.ExpeditedMinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpeditedMinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.MaxDepositPeriod != nil {
 		n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.MaxDepositPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod):])
 		if err6 != nil {
@@ -1130,14 +1411,24 @@ func (m *VotingParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.VotingPeriod != nil {
-		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+	if m.ExpeditedVotingPeriod != nil {
+		n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.ExpeditedVotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod):])
 		if err7 != nil {
 			return 0, err7
 		}
 		i -= n7
 		i = encodeVarintGov(dAtA, i, uint64(n7))
 		i--
+		dAtA[i] = 0x12
+	}
+	if m.VotingPeriod != nil {
+		n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(*m.VotingPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintGov(dAtA, i, uint64(n8))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -1163,6 +1454,27 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MessageTallyParams) > 0 {
+		for iNdEx := len(m.MessageTallyParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MessageTallyParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ExpeditedThreshold) > 0 {
+		i -= len(m.ExpeditedThreshold)
+		copy(dAtA[i:], m.ExpeditedThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.ExpeditedThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.VetoThreshold) > 0 {
 		i -= len(m.VetoThreshold)
 		copy(dAtA[i:], m.VetoThreshold)
@@ -1187,6 +1499,57 @@ func (m *TallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MessageTallyParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageTallyParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageTallyParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Quorum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -1284,6 +1647,13 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1354,6 +1724,29 @@ func (m *DepositParams) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.MaxDepositPeriod)
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if len(m.ExpeditedMinDeposit) > 0 {
+		for _, e := range m.ExpeditedMinDeposit {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.BurnVoteQuorum {
+		n += 2
+	}
+	if m.BurnProposalDepositPrevote {
+		n += 2
+	}
+	if m.BurnVoteVeto {
+		n += 2
+	}
+	l = len(m.ProposalCancelRatio)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.ProposalCancelDest)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1367,6 +1760,10 @@ func (m *VotingParams) Size() (n int) {
 		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.VotingPeriod)
 		n += 1 + l + sovGov(uint64(l))
 	}
+	if m.ExpeditedVotingPeriod != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdDuration(*m.ExpeditedVotingPeriod)
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1388,6 +1785,41 @@ func (m *TallyParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGov(uint64(l))
 	}
+	l = len(m.ExpeditedThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.MessageTallyParams) > 0 {
+		for _, e := range m.MessageTallyParams {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MessageTallyParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -1980,17 +2412,69 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -2445,6 +2929,164 @@ func (m *DepositParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedMinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedMinDeposit = append(m.ExpeditedMinDeposit, types.Coin{})
+			if err := m.ExpeditedMinDeposit[len(m.ExpeditedMinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteQuorum = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnProposalDepositPrevote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnProposalDepositPrevote = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnVoteVeto", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnVoteVeto = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelRatio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalCancelDest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalCancelDest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2531,6 +3173,42 @@ func (m *VotingParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedVotingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpeditedVotingPeriod == nil {
+				m.ExpeditedVotingPeriod = new(time.Duration)
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(m.ExpeditedVotingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -2677,6 +3355,250 @@ func (m *TallyParams) Unmarshal(dAtA []byte) error {
 			}
 			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpeditedThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpeditedThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTallyParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageTallyParams = append(m.MessageTallyParams, MessageTallyParams{})
+			if err := m.MessageTallyParams[len(m.MessageTallyParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageTallyParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageTallyParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageTallyParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -12,8 +12,8 @@ import (
 )
 
 var (
-	_, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}
-	_, _          codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
+	_, _, _, _, _, _ sdk.Msg                            = &MsgSubmitProposal{}, &MsgDeposit{}, &MsgVote{}, &MsgVoteWeighted{}, &MsgExecLegacyContent{}, &MsgCancelProposal{}
+	_, _             codectypes.UnpackInterfacesMessage = &MsgSubmitProposal{}, &MsgExecLegacyContent{}
 )
 
 // NewMsgSubmitProposal creates a new MsgSubmitProposal.
@@ -139,6 +139,40 @@ func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{depositor}
 }
 
+// NewMsgCancelProposal creates a new MsgCancelProposal instance
+//
+//nolint:interfacer
+func NewMsgCancelProposal(proposer sdk.AccAddress, proposalID uint64) *MsgCancelProposal {
+	return &MsgCancelProposal{proposalID, proposer.String()}
+}
+
+// Route implements Msg
+func (msg MsgCancelProposal) Route() string { return types.RouterKey }
+
+// Type implements Msg
+func (msg MsgCancelProposal) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic implements Msg
+func (msg MsgCancelProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Proposer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid proposer address: %s", err)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements Msg
+func (msg MsgCancelProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners implements Msg
+func (msg MsgCancelProposal) GetSigners() []sdk.AccAddress {
+	proposer, _ := sdk.AccAddressFromBech32(msg.Proposer)
+	return []sdk.AccAddress{proposer}
+}
+
 // NewMsgVote creates a message to cast a vote on an active proposal
 //
 //nolint:interfacer
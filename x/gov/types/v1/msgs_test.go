@@ -57,6 +57,28 @@ func TestMsgDeposit(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for MsgCancelProposal
+func TestMsgCancelProposal(t *testing.T) {
+	tests := []struct {
+		proposalID   uint64
+		proposerAddr sdk.AccAddress
+		expectPass   bool
+	}{
+		{1, addrs[0], true},
+		{0, addrs[1], true},
+		{1, sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := v1.NewMsgCancelProposal(tc.proposerAddr, tc.proposalID)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgVote
 func TestMsgVote(t *testing.T) {
 	metadata := "metadata"
@@ -11,15 +11,26 @@ import (
 
 // Default period for deposits & voting
 const (
-	DefaultPeriod time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultPeriod          time.Duration = time.Hour * 24 * 2 // 2 days
+	DefaultExpeditedPeriod time.Duration = time.Hour * 24     // 1 day
 )
 
 // Default governance params
 var (
-	DefaultMinDepositTokens = sdk.NewInt(10000000)
-	DefaultQuorum           = sdk.NewDecWithPrec(334, 3)
-	DefaultThreshold        = sdk.NewDecWithPrec(5, 1)
-	DefaultVetoThreshold    = sdk.NewDecWithPrec(334, 3)
+	DefaultMinDepositTokens          = sdk.NewInt(10000000)
+	DefaultExpeditedMinDepositTokens = DefaultMinDepositTokens.MulRaw(5)
+	DefaultQuorum                    = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold                 = sdk.NewDecWithPrec(5, 1)
+	DefaultVetoThreshold             = sdk.NewDecWithPrec(334, 3)
+	DefaultExpeditedThreshold        = sdk.NewDecWithPrec(667, 3)
+	DefaultProposalCancelRatio       = sdk.NewDecWithPrec(5, 1)
+)
+
+// Default deposit burning options
+const (
+	DefaultBurnVoteQuorum             = false
+	DefaultBurnProposalDepositPrevote = false
+	DefaultBurnVoteVeto               = true
 )
 
 // Parameter store key
@@ -39,10 +50,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewDepositParams creates a new DepositParams object
-func NewDepositParams(minDeposit sdk.Coins, maxDepositPeriod time.Duration) DepositParams {
+func NewDepositParams(
+	minDeposit sdk.Coins, maxDepositPeriod time.Duration, expeditedMinDeposit sdk.Coins,
+	burnVoteQuorum, burnProposalDepositPrevote, burnVoteVeto bool,
+	proposalCancelRatio sdk.Dec, proposalCancelDest string,
+) DepositParams {
 	return DepositParams{
-		MinDeposit:       minDeposit,
-		MaxDepositPeriod: &maxDepositPeriod,
+		MinDeposit:                 minDeposit,
+		MaxDepositPeriod:           &maxDepositPeriod,
+		ExpeditedMinDeposit:        expeditedMinDeposit,
+		BurnVoteQuorum:             burnVoteQuorum,
+		BurnProposalDepositPrevote: burnProposalDepositPrevote,
+		BurnVoteVeto:               burnVoteVeto,
+		ProposalCancelRatio:        proposalCancelRatio.String(),
+		ProposalCancelDest:         proposalCancelDest,
 	}
 }
 
@@ -51,12 +72,31 @@ func DefaultDepositParams() DepositParams {
 	return NewDepositParams(
 		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultMinDepositTokens)),
 		DefaultPeriod,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, DefaultExpeditedMinDepositTokens)),
+		DefaultBurnVoteQuorum,
+		DefaultBurnProposalDepositPrevote,
+		DefaultBurnVoteVeto,
+		DefaultProposalCancelRatio,
+		"",
 	)
 }
 
 // Equal checks equality of DepositParams
 func (dp DepositParams) Equal(dp2 DepositParams) bool {
-	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod
+	return sdk.Coins(dp.MinDeposit).IsEqual(dp2.MinDeposit) && dp.MaxDepositPeriod == dp2.MaxDepositPeriod &&
+		sdk.Coins(dp.ExpeditedMinDeposit).IsEqual(dp2.ExpeditedMinDeposit) &&
+		dp.BurnVoteQuorum == dp2.BurnVoteQuorum && dp.BurnProposalDepositPrevote == dp2.BurnProposalDepositPrevote &&
+		dp.BurnVoteVeto == dp2.BurnVoteVeto && dp.ProposalCancelRatio == dp2.ProposalCancelRatio &&
+		dp.ProposalCancelDest == dp2.ProposalCancelDest
+}
+
+// ProposalMinDeposit returns the minimum deposit of a regular or expedited
+// proposal.
+func (dp DepositParams) ProposalMinDeposit(expedited bool) sdk.Coins {
+	if expedited {
+		return dp.ExpeditedMinDeposit
+	}
+	return dp.MinDeposit
 }
 
 func validateDepositParams(i interface{}) error {
@@ -71,27 +111,97 @@ func validateDepositParams(i interface{}) error {
 	if v.MaxDepositPeriod == nil || v.MaxDepositPeriod.Seconds() <= 0 {
 		return fmt.Errorf("maximum deposit period must be positive: %d", v.MaxDepositPeriod)
 	}
+	if !sdk.Coins(v.ExpeditedMinDeposit).IsValid() {
+		return fmt.Errorf("invalid expedited minimum deposit: %s", v.ExpeditedMinDeposit)
+	}
+	if !sdk.Coins(v.ExpeditedMinDeposit).IsAllGTE(v.MinDeposit) {
+		return fmt.Errorf("expedited minimum deposit %s must be greater than or equal to minimum deposit %s", v.ExpeditedMinDeposit, v.MinDeposit)
+	}
+
+	proposalCancelRatio, err := sdk.NewDecFromStr(v.ProposalCancelRatio)
+	if err != nil {
+		return fmt.Errorf("invalid proposal cancel ratio string: %w", err)
+	}
+	if proposalCancelRatio.IsNegative() {
+		return fmt.Errorf("proposal cancel ratio cannot be negative: %s", proposalCancelRatio)
+	}
+	if proposalCancelRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("proposal cancel ratio too large: %s", proposalCancelRatio)
+	}
+	if v.ProposalCancelDest != "" {
+		if _, err := sdk.AccAddressFromBech32(v.ProposalCancelDest); err != nil {
+			return fmt.Errorf("invalid proposal cancel destination address: %w", err)
+		}
+	}
 
 	return nil
 }
 
 // NewTallyParams creates a new TallyParams object
-func NewTallyParams(quorum, threshold, vetoThreshold sdk.Dec) TallyParams {
+func NewTallyParams(quorum, threshold, vetoThreshold, expeditedThreshold sdk.Dec) TallyParams {
 	return TallyParams{
-		Quorum:        quorum.String(),
-		Threshold:     threshold.String(),
-		VetoThreshold: vetoThreshold.String(),
+		Quorum:             quorum.String(),
+		Threshold:          threshold.String(),
+		VetoThreshold:      vetoThreshold.String(),
+		ExpeditedThreshold: expeditedThreshold.String(),
 	}
 }
 
 // DefaultTallyParams default parameters for tallying
 func DefaultTallyParams() TallyParams {
-	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold)
+	return NewTallyParams(DefaultQuorum, DefaultThreshold, DefaultVetoThreshold, DefaultExpeditedThreshold)
 }
 
 // Equal checks equality of TallyParams
 func (tp TallyParams) Equal(other TallyParams) bool {
-	return tp.Quorum == other.Quorum && tp.Threshold == other.Threshold && tp.VetoThreshold == other.VetoThreshold
+	if tp.Quorum != other.Quorum || tp.Threshold != other.Threshold || tp.VetoThreshold != other.VetoThreshold ||
+		tp.ExpeditedThreshold != other.ExpeditedThreshold || len(tp.MessageTallyParams) != len(other.MessageTallyParams) {
+		return false
+	}
+	for i, mtp := range tp.MessageTallyParams {
+		if mtp != other.MessageTallyParams[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// NewMessageTallyParams creates a new MessageTallyParams object
+func NewMessageTallyParams(msgTypeURL string, quorum, threshold, vetoThreshold sdk.Dec) MessageTallyParams {
+	return MessageTallyParams{
+		MsgTypeUrl:    msgTypeURL,
+		Quorum:        quorum.String(),
+		Threshold:     threshold.String(),
+		VetoThreshold: vetoThreshold.String(),
+	}
+}
+
+// ProposalTallyParams returns the quorum, threshold and veto threshold of a
+// proposal containing messages of the given type URLs: the strictest of the
+// default tally params, of the expedited threshold if the proposal is
+// expedited, and of the message tally params of the given type URLs.
+func (tp TallyParams) ProposalTallyParams(msgTypeURLs []string, expedited bool) (quorum, threshold, vetoThreshold sdk.Dec) {
+	quorum = sdk.MustNewDecFromStr(tp.Quorum)
+	threshold = sdk.MustNewDecFromStr(tp.Threshold)
+	vetoThreshold = sdk.MustNewDecFromStr(tp.VetoThreshold)
+	if expedited {
+		threshold = sdk.MaxDec(threshold, sdk.MustNewDecFromStr(tp.ExpeditedThreshold))
+	}
+
+	for _, mtp := range tp.MessageTallyParams {
+		for _, typeURL := range msgTypeURLs {
+			if mtp.MsgTypeUrl != typeURL {
+				continue
+			}
+
+			quorum = sdk.MaxDec(quorum, sdk.MustNewDecFromStr(mtp.Quorum))
+			threshold = sdk.MaxDec(threshold, sdk.MustNewDecFromStr(mtp.Threshold))
+			vetoThreshold = sdk.MinDec(vetoThreshold, sdk.MustNewDecFromStr(mtp.VetoThreshold))
+			break
+		}
+	}
+
+	return quorum, threshold, vetoThreshold
 }
 
 func validateTallyParams(i interface{}) error {
@@ -100,7 +210,42 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	quorum, err := sdk.NewDecFromStr(v.Quorum)
+	if err := validateTally(v.Quorum, v.Threshold, v.VetoThreshold); err != nil {
+		return err
+	}
+
+	threshold, _ := sdk.NewDecFromStr(v.Threshold)
+	expeditedThreshold, err := sdk.NewDecFromStr(v.ExpeditedThreshold)
+	if err != nil {
+		return fmt.Errorf("invalid expedited threshold string: %w", err)
+	}
+	if expeditedThreshold.LTE(threshold) {
+		return fmt.Errorf("expedited vote threshold %s must be greater than the vote threshold %s", expeditedThreshold, threshold)
+	}
+	if expeditedThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("expedited vote threshold too large: %s", expeditedThreshold)
+	}
+
+	seen := make(map[string]bool, len(v.MessageTallyParams))
+	for _, mtp := range v.MessageTallyParams {
+		if mtp.MsgTypeUrl == "" {
+			return errors.New("message tally params type URL cannot be empty")
+		}
+		if seen[mtp.MsgTypeUrl] {
+			return fmt.Errorf("duplicate message tally params for %s", mtp.MsgTypeUrl)
+		}
+		seen[mtp.MsgTypeUrl] = true
+
+		if err := validateTally(mtp.Quorum, mtp.Threshold, mtp.VetoThreshold); err != nil {
+			return fmt.Errorf("invalid message tally params for %s: %w", mtp.MsgTypeUrl, err)
+		}
+	}
+
+	return nil
+}
+
+func validateTally(quorumStr, thresholdStr, vetoThresholdStr string) error {
+	quorum, err := sdk.NewDecFromStr(quorumStr)
 	if err != nil {
 		return fmt.Errorf("invalid quorum string: %w", err)
 	}
@@ -108,10 +253,10 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("quorom cannot be negative: %s", quorum)
 	}
 	if quorum.GT(sdk.OneDec()) {
-		return fmt.Errorf("quorom too large: %s", v)
+		return fmt.Errorf("quorom too large: %s", quorum)
 	}
 
-	threshold, err := sdk.NewDecFromStr(v.Threshold)
+	threshold, err := sdk.NewDecFromStr(thresholdStr)
 	if err != nil {
 		return fmt.Errorf("invalid threshold string: %w", err)
 	}
@@ -119,10 +264,10 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("vote threshold must be positive: %s", threshold)
 	}
 	if threshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("vote threshold too large: %s", v)
+		return fmt.Errorf("vote threshold too large: %s", threshold)
 	}
 
-	vetoThreshold, err := sdk.NewDecFromStr(v.VetoThreshold)
+	vetoThreshold, err := sdk.NewDecFromStr(vetoThresholdStr)
 	if err != nil {
 		return fmt.Errorf("invalid vetoThreshold string: %w", err)
 	}
@@ -130,27 +275,37 @@ func validateTallyParams(i interface{}) error {
 		return fmt.Errorf("veto threshold must be positive: %s", vetoThreshold)
 	}
 	if vetoThreshold.GT(sdk.OneDec()) {
-		return fmt.Errorf("veto threshold too large: %s", v)
+		return fmt.Errorf("veto threshold too large: %s", vetoThreshold)
 	}
 
 	return nil
 }
 
 // NewVotingParams creates a new VotingParams object
-func NewVotingParams(votingPeriod time.Duration) VotingParams {
+func NewVotingParams(votingPeriod, expeditedVotingPeriod time.Duration) VotingParams {
 	return VotingParams{
-		VotingPeriod: &votingPeriod,
+		VotingPeriod:          &votingPeriod,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
 	}
 }
 
 // DefaultVotingParams default parameters for voting
 func DefaultVotingParams() VotingParams {
-	return NewVotingParams(DefaultPeriod)
+	return NewVotingParams(DefaultPeriod, DefaultExpeditedPeriod)
 }
 
 // Equal checks equality of TallyParams
 func (vp VotingParams) Equal(other VotingParams) bool {
-	return vp.VotingPeriod == other.VotingPeriod
+	return vp.VotingPeriod == other.VotingPeriod && vp.ExpeditedVotingPeriod == other.ExpeditedVotingPeriod
+}
+
+// ProposalVotingPeriod returns the voting period of a regular or expedited
+// proposal.
+func (vp VotingParams) ProposalVotingPeriod(expedited bool) time.Duration {
+	if expedited {
+		return *vp.ExpeditedVotingPeriod
+	}
+	return *vp.VotingPeriod
 }
 
 func validateVotingParams(i interface{}) error {
@@ -167,6 +322,18 @@ func validateVotingParams(i interface{}) error {
 		return fmt.Errorf("voting period must be positive: %s", v.VotingPeriod)
 	}
 
+	if v.ExpeditedVotingPeriod == nil {
+		return errors.New("expedited voting period must not be nil")
+	}
+
+	if v.ExpeditedVotingPeriod.Seconds() <= 0 {
+		return fmt.Errorf("expedited voting period must be positive: %s", v.ExpeditedVotingPeriod)
+	}
+
+	if *v.ExpeditedVotingPeriod >= *v.VotingPeriod {
+		return fmt.Errorf("expedited voting period %s must be strictly less than the voting period %s", v.ExpeditedVotingPeriod, v.VotingPeriod)
+	}
+
 	return nil
 }
 
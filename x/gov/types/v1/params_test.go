@@ -0,0 +1,72 @@
+package v1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+)
+
+func TestProposalTallyParams(t *testing.T) {
+	const (
+		upgradeTypeURL = "/cosmos.upgrade.v1beta1.MsgSoftwareUpgrade"
+		sendTypeURL    = "/cosmos.bank.v1beta1.MsgSend"
+	)
+
+	tallyParams := v1.DefaultTallyParams()
+	tallyParams.MessageTallyParams = []v1.MessageTallyParams{
+		v1.NewMessageTallyParams(upgradeTypeURL, sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(75, 2), sdk.NewDecWithPrec(2, 1)),
+	}
+
+	testCases := []struct {
+		name          string
+		msgTypeURLs   []string
+		expedited     bool
+		quorum        sdk.Dec
+		threshold     sdk.Dec
+		vetoThreshold sdk.Dec
+	}{
+		{
+			name:          "regular proposal",
+			msgTypeURLs:   []string{sendTypeURL},
+			quorum:        v1.DefaultQuorum,
+			threshold:     v1.DefaultThreshold,
+			vetoThreshold: v1.DefaultVetoThreshold,
+		},
+		{
+			name:          "expedited proposal",
+			msgTypeURLs:   []string{sendTypeURL},
+			expedited:     true,
+			quorum:        v1.DefaultQuorum,
+			threshold:     v1.DefaultExpeditedThreshold,
+			vetoThreshold: v1.DefaultVetoThreshold,
+		},
+		{
+			name:          "message tally params",
+			msgTypeURLs:   []string{sendTypeURL, upgradeTypeURL},
+			quorum:        sdk.NewDecWithPrec(4, 1),
+			threshold:     sdk.NewDecWithPrec(75, 2),
+			vetoThreshold: sdk.NewDecWithPrec(2, 1),
+		},
+		{
+			name:          "expedited proposal with message tally params",
+			msgTypeURLs:   []string{upgradeTypeURL},
+			expedited:     true,
+			quorum:        sdk.NewDecWithPrec(4, 1),
+			threshold:     sdk.NewDecWithPrec(75, 2),
+			vetoThreshold: sdk.NewDecWithPrec(2, 1),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			quorum, threshold, vetoThreshold := tallyParams.ProposalTallyParams(tc.msgTypeURLs, tc.expedited)
+			require.Equal(t, tc.quorum, quorum)
+			require.Equal(t, tc.threshold, threshold)
+			require.Equal(t, tc.vetoThreshold, vetoThreshold)
+		})
+	}
+}
@@ -13,18 +13,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,8 @@ type MsgSubmitProposal struct {
 	Proposer       string        `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// expedited defines if the proposal is expedited.
+	Expedited bool `protobuf:"varint,5,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -103,6 +109,13 @@ func (m *MsgSubmitProposal) GetMetadata() string {
 	return ""
 }
 
+func (m *MsgSubmitProposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
@@ -551,6 +564,120 @@ func (m *MsgDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
 
+// MsgCancelProposal defines a message to cancel a proposal.
+type MsgCancelProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	Proposer   string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
+func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposal) ProtoMessage()    {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{10}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
+func (m *MsgCancelProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+// MsgCancelProposalResponse defines the Msg/CancelProposal response type.
+type MsgCancelProposalResponse struct {
+	ProposalId     uint64    `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	CanceledTime   time.Time `protobuf:"bytes,2,opt,name=canceled_time,json=canceledTime,proto3,stdtime" json:"canceled_time"`
+	CanceledHeight uint64    `protobuf:"varint,3,opt,name=canceled_height,json=canceledHeight,proto3" json:"canceled_height,omitempty"`
+}
+
+func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResponse{} }
+func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalResponse) ProtoMessage()    {}
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{11}
+}
+func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalResponse proto.InternalMessageInfo
+
+func (m *MsgCancelProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelProposalResponse) GetCanceledTime() time.Time {
+	if m != nil {
+		return m.CanceledTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCancelProposalResponse) GetCanceledHeight() uint64 {
+	if m != nil {
+		return m.CanceledHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -562,58 +689,68 @@ func init() {
 	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "cosmos.gov.v1.MsgVoteWeightedResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos.gov.v1.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "cosmos.gov.v1.MsgDepositResponse")
+	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.gov.v1.MsgCancelProposal")
+	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.gov.v1.MsgCancelProposalResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x6a, 0xdb, 0x4a,
-	0x14, 0xc6, 0xad, 0xd8, 0x37, 0x4e, 0x4e, 0x6e, 0x1c, 0x32, 0x98, 0x44, 0x16, 0x41, 0x71, 0x7c,
-	0xe1, 0x62, 0x6e, 0x88, 0x14, 0xe7, 0x96, 0x16, 0x92, 0x52, 0x88, 0xd3, 0xd0, 0x16, 0x6a, 0x5a,
-	0x14, 0x48, 0xa1, 0x14, 0x82, 0x6c, 0x4d, 0x27, 0xa2, 0x91, 0x46, 0x68, 0xc6, 0x26, 0x5e, 0xb6,
-	0x4f, 0xd0, 0xf7, 0xe8, 0xa6, 0x8b, 0xec, 0xbb, 0x2b, 0xa1, 0xab, 0xd0, 0x55, 0x56, 0xa1, 0x24,
-	0x8b, 0x42, 0x57, 0x7d, 0x84, 0x22, 0xcd, 0x48, 0x4e, 0xac, 0xfc, 0xeb, 0xa6, 0x2b, 0x4b, 0xe7,
-	0x7c, 0xdf, 0x99, 0xf3, 0xd3, 0xcc, 0xf1, 0xc0, 0x4c, 0x87, 0x32, 0x8f, 0x32, 0x93, 0xd0, 0x9e,
-	0xd9, 0x6b, 0x98, 0x7c, 0xdf, 0x08, 0x42, 0xca, 0x29, 0x9a, 0x14, 0x71, 0x83, 0xd0, 0x9e, 0xd1,
-	0x6b, 0x68, 0xba, 0x94, 0xb5, 0x6d, 0x86, 0xcd, 0x5e, 0xa3, 0x8d, 0xb9, 0xdd, 0x30, 0x3b, 0xd4,
-	0xf5, 0x85, 0x5c, 0x9b, 0xbd, 0x58, 0x26, 0x72, 0x89, 0x44, 0x99, 0x50, 0x42, 0xe3, 0x47, 0x33,
-	0x7a, 0x92, 0xd1, 0x8a, 0x90, 0xef, 0x88, 0x84, 0x5c, 0x4a, 0xa6, 0x08, 0xa5, 0x64, 0x0f, 0x9b,
-	0xf1, 0x5b, 0xbb, 0xfb, 0xda, 0xb4, 0xfd, 0xfe, 0xd0, 0x22, 0x1e, 0x23, 0xd1, 0x22, 0x1e, 0x23,
-	0x22, 0x51, 0xfb, 0xa9, 0xc0, 0x74, 0x8b, 0x91, 0xad, 0x6e, 0xdb, 0x73, 0xf9, 0xf3, 0x90, 0x06,
-	0x94, 0xd9, 0x7b, 0x68, 0x19, 0xc6, 0x3c, 0xcc, 0x98, 0x4d, 0x30, 0x53, 0x95, 0x6a, 0xbe, 0x3e,
-	0xb1, 0x52, 0x36, 0x44, 0x71, 0x23, 0x29, 0x6e, 0xac, 0xfb, 0x7d, 0x2b, 0x55, 0xa1, 0xc7, 0x30,
-	0xe5, 0xfa, 0x2e, 0x77, 0xed, 0xbd, 0x1d, 0x07, 0x07, 0x94, 0xb9, 0x5c, 0x1d, 0x89, 0x8d, 0x15,
-	0x43, 0xf6, 0x18, 0xf1, 0x1b, 0x92, 0xdf, 0xd8, 0xa0, 0xae, 0xdf, 0x2c, 0x1c, 0x9e, 0xcc, 0xe7,
-	0xac, 0x92, 0xf4, 0x3d, 0x14, 0x36, 0x74, 0x07, 0xc6, 0x82, 0xb8, 0x0f, 0x1c, 0xaa, 0xf9, 0xaa,
-	0x52, 0x1f, 0x6f, 0xaa, 0x5f, 0x0f, 0x96, 0xca, 0xb2, 0xca, 0xba, 0xe3, 0x84, 0x98, 0xb1, 0x2d,
-	0x1e, 0xba, 0x3e, 0xb1, 0x52, 0x25, 0xd2, 0xa2, 0x8e, 0xb9, 0xed, 0xd8, 0xdc, 0x56, 0x0b, 0x91,
-	0xcb, 0x4a, 0xdf, 0x57, 0x27, 0xdf, 0x7d, 0xff, 0xf8, 0x5f, 0x2a, 0xad, 0xdd, 0x87, 0x4a, 0x86,
-	0xd8, 0xc2, 0x2c, 0xa0, 0x3e, 0xc3, 0x68, 0x1e, 0x26, 0x02, 0x19, 0xdb, 0x71, 0x1d, 0x55, 0xa9,
-	0x2a, 0xf5, 0x82, 0x05, 0x49, 0xe8, 0x89, 0x53, 0x7b, 0xab, 0x40, 0xb9, 0xc5, 0xc8, 0xe6, 0x3e,
-	0xee, 0x3c, 0xc5, 0xc4, 0xee, 0xf4, 0x37, 0xa8, 0xcf, 0xb1, 0xcf, 0xd1, 0x1a, 0x14, 0x3b, 0xe2,
-	0x31, 0x76, 0x5d, 0xf1, 0xc9, 0x9a, 0x13, 0x5f, 0x0e, 0x96, 0x8a, 0xd2, 0x63, 0x25, 0x0e, 0x34,
-	0x07, 0xe3, 0x76, 0x97, 0xef, 0xd2, 0xd0, 0xe5, 0x7d, 0x75, 0x24, 0xee, 0x7f, 0x10, 0x58, 0x2d,
-	0x45, 0x00, 0x83, 0xf7, 0x9a, 0x0e, 0x73, 0x97, 0xb5, 0x90, 0x40, 0xd4, 0x3e, 0x2b, 0x50, 0x6c,
-	0x31, 0xb2, 0x4d, 0x39, 0x46, 0xcb, 0x97, 0x00, 0x35, 0xa7, 0x7e, 0x9c, 0xcc, 0x9f, 0x0f, 0x9f,
-	0x27, 0x44, 0x06, 0xfc, 0xd5, 0xa3, 0x1c, 0x87, 0xa2, 0x8f, 0x6b, 0xbe, 0xbe, 0x90, 0xa1, 0x06,
-	0x8c, 0xd2, 0x80, 0xbb, 0xd4, 0x8f, 0xb7, 0xab, 0x34, 0xd8, 0x71, 0x31, 0x00, 0x46, 0xd4, 0xc6,
-	0xb3, 0x58, 0x60, 0x49, 0xe1, 0xb5, 0xbb, 0x05, 0x11, 0xac, 0x28, 0x5d, 0x9b, 0x86, 0x29, 0xc9,
-	0x91, 0xb2, 0x1d, 0x2b, 0x69, 0xec, 0x05, 0x76, 0xc9, 0x2e, 0xc7, 0xce, 0x1f, 0x60, 0x5c, 0x83,
-	0xa2, 0x68, 0x9d, 0xa9, 0xf9, 0xf8, 0x58, 0x2f, 0x0c, 0x41, 0x26, 0xbd, 0x9c, 0x83, 0x4d, 0x1c,
-	0xb7, 0xa6, 0xad, 0xc0, 0xec, 0x10, 0x59, 0x4a, 0xfd, 0x49, 0x01, 0x68, 0x31, 0x92, 0xcc, 0xc8,
-	0xef, 0x03, 0xdf, 0x85, 0x71, 0x39, 0x97, 0xf4, 0x66, 0xe8, 0x81, 0x14, 0xdd, 0x83, 0x51, 0xdb,
-	0xa3, 0x5d, 0x9f, 0x4b, 0xee, 0x1b, 0xc7, 0x59, 0xca, 0xe5, 0x99, 0x4d, 0x0b, 0xd5, 0xca, 0x80,
-	0x06, 0x00, 0x09, 0xd7, 0xca, 0x87, 0x3c, 0xe4, 0x5b, 0x8c, 0xa0, 0x57, 0x50, 0x1a, 0xfa, 0x0b,
-	0xaa, 0x0e, 0x7d, 0xe0, 0xcc, 0xc8, 0x6a, 0xf5, 0x9b, 0x14, 0xe9, 0x50, 0x63, 0x98, 0xce, 0xce,
-	0xeb, 0x3f, 0x59, 0x7b, 0x46, 0xa4, 0x2d, 0xde, 0x42, 0x94, 0x2e, 0xf3, 0x00, 0x0a, 0xf1, 0xc8,
-	0xcd, 0x64, 0x4d, 0x51, 0x5c, 0xd3, 0x2f, 0x8f, 0xa7, 0xfe, 0x6d, 0xf8, 0xfb, 0xc2, 0xb1, 0xbe,
-	0x42, 0x9f, 0xe4, 0xb5, 0x7f, 0xaf, 0xcf, 0xa7, 0x75, 0x1f, 0x41, 0x31, 0x39, 0x38, 0x95, 0xac,
-	0x45, 0xa6, 0xb4, 0x85, 0x2b, 0x53, 0x49, 0xa1, 0xe6, 0xe6, 0xe1, 0xa9, 0xae, 0x1c, 0x9d, 0xea,
-	0xca, 0xb7, 0x53, 0x5d, 0x79, 0x7f, 0xa6, 0xe7, 0x8e, 0xce, 0xf4, 0xdc, 0xf1, 0x99, 0x9e, 0x7b,
-	0xb9, 0x48, 0x5c, 0xbe, 0xdb, 0x6d, 0x1b, 0x1d, 0xea, 0xc9, 0x3b, 0x49, 0xfe, 0x2c, 0x31, 0xe7,
-	0x8d, 0xb9, 0x1f, 0x5f, 0x6e, 0xbc, 0x1f, 0x60, 0x16, 0xdd, 0x80, 0xa3, 0xf1, 0x1f, 0xe2, 0xff,
-	0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb7, 0xdb, 0x52, 0x42, 0x41, 0x07, 0x00, 0x00,
+	// 863 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x8b, 0xdb, 0x46,
+	0x14, 0x5e, 0xd9, 0xce, 0xda, 0xfb, 0x9c, 0xb5, 0x59, 0x61, 0x12, 0x59, 0x04, 0xd9, 0x71, 0xa1,
+	0x35, 0x0d, 0x2b, 0xc5, 0xdb, 0xd2, 0xc2, 0xa6, 0x14, 0xe2, 0x6d, 0x68, 0x02, 0x35, 0x2d, 0x4a,
+	0x49, 0xa1, 0x04, 0x8c, 0x2c, 0x4d, 0xc7, 0xa2, 0x96, 0x46, 0x78, 0xc6, 0xc6, 0x3e, 0xb6, 0xb7,
+	0xf6, 0x50, 0xf2, 0x53, 0x7a, 0xc8, 0xbd, 0xf4, 0x52, 0x42, 0x4f, 0xa1, 0xa7, 0x9c, 0xd2, 0xb2,
+	0x7b, 0x28, 0xf4, 0x57, 0x14, 0x8d, 0x46, 0x63, 0xaf, 0xe4, 0x8d, 0x77, 0x2f, 0x39, 0x49, 0x7a,
+	0xef, 0xfb, 0xde, 0xbc, 0x6f, 0xe6, 0xcd, 0x87, 0xe0, 0x86, 0x4b, 0x68, 0x40, 0xa8, 0x85, 0xc9,
+	0xdc, 0x9a, 0xf7, 0x2c, 0xb6, 0x30, 0xa3, 0x29, 0x61, 0x44, 0xdd, 0x4f, 0xe2, 0x26, 0x26, 0x73,
+	0x73, 0xde, 0xd3, 0x0d, 0x01, 0x1b, 0x39, 0x14, 0x59, 0xf3, 0xde, 0x08, 0x31, 0xa7, 0x67, 0xb9,
+	0xc4, 0x0f, 0x13, 0xb8, 0x7e, 0xf3, 0x7c, 0x99, 0x98, 0x95, 0x24, 0x1a, 0x98, 0x60, 0xc2, 0x5f,
+	0xad, 0xf8, 0x4d, 0x44, 0x9b, 0x09, 0x7c, 0x98, 0x24, 0xc4, 0x52, 0x22, 0x85, 0x09, 0xc1, 0x13,
+	0x64, 0xf1, 0xaf, 0xd1, 0xec, 0x3b, 0xcb, 0x09, 0x97, 0x22, 0xd5, 0xca, 0xa6, 0x98, 0x1f, 0x20,
+	0xca, 0x9c, 0x20, 0xca, 0x74, 0x11, 0x50, 0x1c, 0x77, 0x11, 0x50, 0x9c, 0x24, 0x3a, 0xbf, 0x14,
+	0xe0, 0x60, 0x40, 0xf1, 0xe3, 0xd9, 0x28, 0xf0, 0xd9, 0x57, 0x53, 0x12, 0x11, 0xea, 0x4c, 0xd4,
+	0xbb, 0x50, 0x09, 0x10, 0xa5, 0x0e, 0x46, 0x54, 0x53, 0xda, 0xc5, 0x6e, 0xf5, 0xa8, 0x61, 0x26,
+	0x4b, 0x98, 0xe9, 0x12, 0xe6, 0xfd, 0x70, 0x69, 0x4b, 0x94, 0xfa, 0x10, 0xea, 0x7e, 0xe8, 0x33,
+	0xdf, 0x99, 0x0c, 0x3d, 0x14, 0x11, 0xea, 0x33, 0xad, 0xc0, 0x89, 0x4d, 0x53, 0x88, 0x88, 0x37,
+	0xc8, 0x14, 0x1b, 0x64, 0x9e, 0x10, 0x3f, 0xec, 0x97, 0x5e, 0xbc, 0x6e, 0xed, 0xd8, 0x35, 0xc1,
+	0xfb, 0x2c, 0xa1, 0xa9, 0x1f, 0x42, 0x25, 0xe2, 0x7d, 0xa0, 0xa9, 0x56, 0x6c, 0x2b, 0xdd, 0xbd,
+	0xbe, 0xf6, 0xd7, 0xf3, 0xc3, 0x86, 0xa8, 0x72, 0xdf, 0xf3, 0xa6, 0x88, 0xd2, 0xc7, 0x6c, 0xea,
+	0x87, 0xd8, 0x96, 0x48, 0x55, 0x8f, 0x3b, 0x66, 0x8e, 0xe7, 0x30, 0x47, 0x2b, 0xc5, 0x2c, 0x5b,
+	0x7e, 0xab, 0xb7, 0x60, 0x0f, 0x2d, 0x22, 0xe4, 0xf9, 0x0c, 0x79, 0xda, 0xb5, 0xb6, 0xd2, 0xad,
+	0xd8, 0xab, 0xc0, 0xf1, 0xfe, 0x8f, 0xff, 0xfe, 0xfa, 0xbe, 0x2c, 0xd4, 0xf9, 0x04, 0x9a, 0xb9,
+	0xfd, 0xb0, 0x11, 0x8d, 0x48, 0x48, 0x91, 0xda, 0x82, 0x6a, 0x24, 0x62, 0x43, 0xdf, 0xd3, 0x94,
+	0xb6, 0xd2, 0x2d, 0xd9, 0x90, 0x86, 0x1e, 0x79, 0x9d, 0x1f, 0x14, 0x68, 0x0c, 0x28, 0x7e, 0xb0,
+	0x40, 0xee, 0x17, 0x08, 0x3b, 0xee, 0xf2, 0x84, 0x84, 0x0c, 0x85, 0x4c, 0xbd, 0x07, 0x65, 0x37,
+	0x79, 0xe5, 0xac, 0x0b, 0x36, 0xb4, 0x5f, 0xfd, 0xf3, 0xf9, 0x61, 0x59, 0x70, 0xec, 0x94, 0x11,
+	0x0b, 0x70, 0x66, 0x6c, 0x4c, 0xa6, 0x3e, 0x5b, 0x6a, 0x05, 0xae, 0x6e, 0x15, 0x38, 0xae, 0xc5,
+	0x02, 0x56, 0xdf, 0x1d, 0x03, 0x6e, 0x6d, 0x6a, 0x21, 0x15, 0xd1, 0xf9, 0x43, 0x81, 0xf2, 0x80,
+	0xe2, 0x27, 0x84, 0x21, 0xf5, 0xee, 0x06, 0x41, 0xfd, 0xfa, 0x7f, 0xaf, 0x5b, 0xeb, 0xe1, 0x75,
+	0x85, 0xaa, 0x09, 0xd7, 0xe6, 0x84, 0xa1, 0xa9, 0x56, 0xd8, 0x72, 0x36, 0x09, 0x4c, 0xed, 0xc1,
+	0x2e, 0x89, 0x98, 0x4f, 0x42, 0x7e, 0x98, 0xb5, 0xd5, 0x3c, 0x24, 0xf7, 0xc7, 0x8c, 0xdb, 0xf8,
+	0x92, 0x03, 0x6c, 0x01, 0x7c, 0xd3, 0x59, 0x1e, 0x43, 0x2c, 0x36, 0x29, 0xdd, 0x39, 0x80, 0xba,
+	0xd0, 0x21, 0xb5, 0xbd, 0x52, 0x64, 0xec, 0x1b, 0xe4, 0xe3, 0x31, 0x43, 0xde, 0x5b, 0xd0, 0x78,
+	0x0f, 0xca, 0x49, 0xeb, 0x54, 0x2b, 0xf2, 0xa1, 0xbf, 0x9d, 0x11, 0x99, 0xf6, 0xb2, 0x26, 0x36,
+	0x65, 0x5c, 0x5a, 0x6d, 0x13, 0x6e, 0x66, 0x94, 0x49, 0xd5, 0xbf, 0x29, 0x00, 0x03, 0x8a, 0xd3,
+	0x1b, 0x74, 0x75, 0xc1, 0x1f, 0xc1, 0x9e, 0xb8, 0xb5, 0x64, 0xbb, 0xe8, 0x15, 0x54, 0xfd, 0x18,
+	0x76, 0x9d, 0x80, 0xcc, 0x42, 0x26, 0x74, 0x6f, 0xbd, 0xec, 0x02, 0x2e, 0x66, 0x56, 0x16, 0xea,
+	0x34, 0x40, 0x5d, 0x09, 0x90, 0xba, 0x7e, 0x56, 0xb8, 0x39, 0x9d, 0x38, 0xa1, 0x8b, 0x26, 0x6b,
+	0xe6, 0x74, 0x55, 0x79, 0xeb, 0x96, 0x52, 0xb8, 0xac, 0xa5, 0x64, 0x8d, 0xe1, 0x77, 0x05, 0x9a,
+	0xb9, 0x66, 0xa4, 0x33, 0x5c, 0xbd, 0xa9, 0x47, 0xb0, 0xef, 0xf2, 0x5a, 0xc8, 0x1b, 0xc6, 0x76,
+	0xcd, 0x3b, 0xab, 0x1e, 0xe9, 0x39, 0x5f, 0xf8, 0x3a, 0xf5, 0xf2, 0x7e, 0x25, 0xde, 0xc3, 0x67,
+	0x7f, 0xb7, 0x14, 0xfb, 0x7a, 0x4a, 0x8d, 0x93, 0xea, 0x7b, 0x50, 0x97, 0xa5, 0xc6, 0x7c, 0x38,
+	0xf8, 0x65, 0x2b, 0xd9, 0xb5, 0x34, 0xfc, 0x90, 0x47, 0x8f, 0x7e, 0x2a, 0x41, 0x71, 0x40, 0xb1,
+	0xfa, 0x14, 0x6a, 0x19, 0xc7, 0x6f, 0x67, 0x26, 0x36, 0xe7, 0x81, 0x7a, 0x77, 0x1b, 0x42, 0xee,
+	0x05, 0x82, 0x83, 0xbc, 0x01, 0xbe, 0x93, 0xa7, 0xe7, 0x40, 0xfa, 0x9d, 0x4b, 0x80, 0xe4, 0x32,
+	0x9f, 0x42, 0x89, 0x7b, 0xd8, 0x8d, 0x3c, 0x29, 0x8e, 0xeb, 0xc6, 0xe6, 0xb8, 0xe4, 0x3f, 0x81,
+	0xeb, 0xe7, 0x7c, 0xe2, 0x02, 0x7c, 0x9a, 0xd7, 0xdf, 0x7d, 0x73, 0x5e, 0xd6, 0xfd, 0x1c, 0xca,
+	0xe9, 0x4d, 0x6c, 0xe6, 0x29, 0x22, 0xa5, 0xdf, 0xbe, 0x30, 0x25, 0x0b, 0x3d, 0x85, 0x5a, 0x66,
+	0xf4, 0x37, 0x9c, 0xd2, 0x79, 0x84, 0xde, 0xdd, 0x86, 0x48, 0xab, 0xf7, 0x1f, 0xbc, 0x38, 0x35,
+	0x94, 0x97, 0xa7, 0x86, 0xf2, 0xcf, 0xa9, 0xa1, 0x3c, 0x3b, 0x33, 0x76, 0x5e, 0x9e, 0x19, 0x3b,
+	0xaf, 0xce, 0x8c, 0x9d, 0x6f, 0xef, 0x60, 0x9f, 0x8d, 0x67, 0x23, 0xd3, 0x25, 0x81, 0xf8, 0x03,
+	0x11, 0x8f, 0x43, 0xea, 0x7d, 0x6f, 0x2d, 0xf8, 0xaf, 0x0c, 0x5b, 0x46, 0x88, 0xc6, 0xff, 0x3b,
+	0xbb, 0x7c, 0x4e, 0x3f, 0xf8, 0x7f, 0x00, 0x57, 0x85, 0x8d, 0x78, 0x2f, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -639,6 +776,8 @@ type MsgClient interface {
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// CancelProposal defines a method to cancel a proposal by its proposer.
+	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
 }
 
 type msgClient struct {
@@ -694,6 +833,15 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error) {
+	out := new(MsgCancelProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/CancelProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given a content.
@@ -707,6 +855,8 @@ type MsgServer interface {
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// CancelProposal defines a method to cancel a proposal by its proposer.
+	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -728,6 +878,9 @@ func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWei
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -823,6 +976,24 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/CancelProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposal(ctx, req.(*MsgCancelProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -847,6 +1018,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "CancelProposal",
+			Handler:    _Msg_CancelProposal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -872,6 +1047,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1231,6 +1416,82 @@ func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanceledHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CanceledHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CanceledTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1268,6 +1529,9 @@ func (m *MsgSubmitProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Expedited {
+		n += 2
+	}
 	return n
 }
 
@@ -1407,6 +1671,39 @@ func (m *MsgDepositResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CanceledTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.CanceledHeight != 0 {
+		n += 1 + sovTx(uint64(m.CanceledHeight))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1574,6 +1871,26 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2436,6 +2753,228 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CanceledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledHeight", wireType)
+			}
+			m.CanceledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanceledHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
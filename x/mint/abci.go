@@ -18,10 +18,19 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, ic types.InflationCalculatio
 	bondedRatio := k.BondedRatio(ctx)
 	minter.Inflation = ic(ctx, minter, params, bondedRatio)
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
+
+	// cap the provision so that the supply never exceeds the max supply
+	mintedCoin := minter.BlockProvision(params)
+	if params.MaxSupply.IsPositive() {
+		supply := k.GetSupply(ctx, params.MintDenom).Amount
+		mintedCoin.Amount = types.CapProvision(mintedCoin.Amount, supply, params.MaxSupply)
+		if supply.GTE(params.MaxSupply) {
+			minter.AnnualProvisions = sdk.ZeroDec()
+		}
+	}
 	k.SetMinter(ctx, minter)
 
 	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
 	mintedCoins := sdk.NewCoins(mintedCoin)
 
 	err := k.MintCoins(ctx, mintedCoins)
@@ -10,6 +10,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
+// Flags for the projected-provisions query.
+const (
+	FlagPeriods         = "periods"
+	FlagBlocksPerPeriod = "blocks-per-period"
+)
+
 // GetQueryCmd returns the cli query commands for the minting module.
 func GetQueryCmd() *cobra.Command {
 	mintingQueryCmd := &cobra.Command{
@@ -24,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQueryProjectedProvisions(),
 	)
 
 	return mintingQueryCmd
@@ -115,3 +122,47 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryProjectedProvisions implements a command to return the projected
+// provisions of the active inflation schedule.
+func GetCmdQueryProjectedProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-provisions",
+		Short: "Query the projected provisions of the active inflation schedule",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			periods, err := cmd.Flags().GetUint32(FlagPeriods)
+			if err != nil {
+				return err
+			}
+
+			blocksPerPeriod, err := cmd.Flags().GetUint64(FlagBlocksPerPeriod)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryProjectedProvisionsRequest{
+				Periods:         periods,
+				BlocksPerPeriod: blocksPerPeriod,
+			}
+			res, err := queryClient.ProjectedProvisions(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagPeriods, 1, "Number of periods to project")
+	cmd.Flags().Uint64(FlagBlocksPerPeriod, 0, "Number of blocks per period (defaults to blocks_per_year)")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
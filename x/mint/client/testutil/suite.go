@@ -67,17 +67,22 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate_change":"0.130000000000000000","inflation_max":"1.000000000000000000","inflation_min":"1.000000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","inflation_schedule":"INFLATION_SCHEDULE_BONDED_RATIO","fixed_inflation":"0.000000000000000000","halving_interval":"0","schedule_points":[],"max_supply":"0"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`blocks_per_year: "6311520"
+fixed_inflation: "0.000000000000000000"
 goal_bonded: "0.670000000000000000"
+halving_interval: "0"
 inflation_max: "1.000000000000000000"
 inflation_min: "1.000000000000000000"
 inflation_rate_change: "0.130000000000000000"
-mint_denom: stake`,
+inflation_schedule: INFLATION_SCHEDULE_BONDED_RATIO
+max_supply: "0"
+mint_denom: stake
+schedule_points: []`,
 		},
 	}
 
@@ -162,3 +167,53 @@ func (s *IntegrationTestSuite) TestGetCmdQueryAnnualProvisions() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestGetCmdQueryProjectedProvisions() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		expLen    int
+	}{
+		{
+			"default periods",
+			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			1,
+		},
+		{
+			"custom periods",
+			[]string{fmt.Sprintf("--%s=3", cli.FlagPeriods), fmt.Sprintf("--%s=100", cli.FlagBlocksPerPeriod), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			3,
+		},
+		{
+			"too many periods",
+			[]string{fmt.Sprintf("--%s=1000", cli.FlagPeriods), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			true,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryProjectedProvisions()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			var res minttypes.QueryProjectedProvisionsResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Len(res.Provisions, tc.expLen)
+		})
+	}
+}
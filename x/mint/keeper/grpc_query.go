@@ -3,12 +3,19 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 var _ types.QueryServer = Keeper{}
 
+// MaxProjectedPeriods is the maximum number of periods a ProjectedProvisions
+// query can project.
+const MaxProjectedPeriods = 100
+
 // Params returns params of the mint module.
 func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -32,3 +39,31 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// ProjectedProvisions returns the projected provisions of the next periods.
+func (k Keeper) ProjectedProvisions(c context.Context, req *types.QueryProjectedProvisionsRequest) (*types.QueryProjectedProvisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	periods := req.Periods
+	if periods == 0 {
+		periods = 1
+	}
+	if periods > MaxProjectedPeriods {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot project more than %d periods", MaxProjectedPeriods)
+	}
+	blocksPerPeriod := req.BlocksPerPeriod
+	if blocksPerPeriod == 0 {
+		blocksPerPeriod = params.BlocksPerYear
+	}
+
+	supply := k.GetSupply(ctx, params.MintDenom).Amount
+	provisions := types.ProjectProvisions(minter, params, ctx.BlockHeight(), supply, k.BondedRatio(ctx), periods, blocksPerPeriod)
+
+	return &types.QueryProjectedProvisionsResponse{Provisions: provisions}, nil
+}
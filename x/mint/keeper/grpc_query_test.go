@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -51,6 +52,26 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCProjectedProvisions() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.InflationSchedule = types.INFLATION_SCHEDULE_FIXED
+	params.FixedInflation = sdk.NewDecWithPrec(10, 2)
+	app.MintKeeper.SetParams(ctx, params)
+
+	res, err := queryClient.ProjectedProvisions(gocontext.Background(), &types.QueryProjectedProvisionsRequest{Periods: 2})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Provisions, 2)
+
+	supply := app.MintKeeper.GetSupply(ctx, params.MintDenom).Amount
+	suite.Require().Equal(ctx.BlockHeight()+int64(params.BlocksPerYear), res.Provisions[0].Height)
+	suite.Require().Equal(params.FixedInflation.MulInt(supply).TruncateInt(), res.Provisions[0].Provisions)
+
+	_, err = queryClient.ProjectedProvisions(gocontext.Background(), &types.QueryProjectedProvisionsRequest{Periods: keeper.MaxProjectedPeriods + 1})
+	suite.Require().Error(err)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -94,6 +94,12 @@ func (k Keeper) BondedRatio(ctx sdk.Context) sdk.Dec {
 	return k.stakingKeeper.BondedRatio(ctx)
 }
 
+// GetSupply implements an alias call to the underlying bank keeper's
+// GetSupply to be used in BeginBlocker.
+func (k Keeper) GetSupply(ctx sdk.Context, denom string) sdk.Coin {
+	return k.bankKeeper.GetSupply(ctx, denom)
+}
+
 // MintCoins implements an alias call to the underlying supply keeper's
 // MintCoins to be used in BeginBlocker.
 func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2 "github.com/cosmos/cosmos-sdk/x/mint/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the x/mint params from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	v2.MigrateParams(ctx, m.keeper.paramSpace)
+	return nil
+}
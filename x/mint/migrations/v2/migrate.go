@@ -0,0 +1,19 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateParams performs in-place params migrations from v1 to v2. It sets
+// the inflation schedule and max supply params introduced in v2 to their
+// default values, which keep the bonded ratio schedule and an uncapped supply.
+func MigrateParams(ctx sdk.Context, paramSpace paramtypes.Subspace) {
+	defaults := types.DefaultParams()
+	paramSpace.Set(ctx, types.KeyInflationSchedule, defaults.InflationSchedule)
+	paramSpace.Set(ctx, types.KeyFixedInflation, defaults.FixedInflation)
+	paramSpace.Set(ctx, types.KeyHalvingInterval, defaults.HalvingInterval)
+	paramSpace.Set(ctx, types.KeySchedulePoints, defaults.SchedulePoints)
+	paramSpace.Set(ctx, types.KeyMaxSupply, defaults.MaxSupply)
+}
@@ -0,0 +1,39 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v2mint "github.com/cosmos/cosmos-sdk/x/mint/migrations/v2"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	mintKey := sdk.NewKVStoreKey("mint")
+	tMintKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(mintKey, tMintKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, mintKey, tMintKey, "mint").
+		WithKeyTable(types.ParamKeyTable())
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyInflationSchedule))
+	require.False(t, paramstore.Has(ctx, types.KeyMaxSupply))
+
+	// Run migrations.
+	v2mint.MigrateParams(ctx, paramstore)
+
+	// Make sure the new params are set.
+	var schedule types.InflationSchedule
+	paramstore.Get(ctx, types.KeyInflationSchedule, &schedule)
+	require.Equal(t, types.INFLATION_SCHEDULE_BONDED_RATIO, schedule)
+
+	var maxSupply sdk.Int
+	paramstore.Get(ctx, types.KeyMaxSupply, &maxSupply)
+	require.True(t, maxSupply.IsZero())
+}
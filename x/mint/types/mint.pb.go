@@ -25,6 +25,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// InflationSchedule selects how the default inflation calculation function
+// computes the inflation rate of every block.
+type InflationSchedule int32
+
+const (
+	// INFLATION_SCHEDULE_BONDED_RATIO moves the inflation towards the bonded
+	// ratio goal within [inflation_min, inflation_max].
+	INFLATION_SCHEDULE_BONDED_RATIO InflationSchedule = 0
+	// INFLATION_SCHEDULE_FIXED uses fixed_inflation at every height.
+	INFLATION_SCHEDULE_FIXED InflationSchedule = 1
+	// INFLATION_SCHEDULE_HALVING starts at inflation_max and halves the
+	// inflation every halving_interval blocks, down to inflation_min.
+	INFLATION_SCHEDULE_HALVING InflationSchedule = 2
+	// INFLATION_SCHEDULE_PIECEWISE_LINEAR interpolates linearly between
+	// schedule_points by block height.
+	INFLATION_SCHEDULE_PIECEWISE_LINEAR InflationSchedule = 3
+)
+
+var InflationSchedule_name = map[int32]string{
+	0: "INFLATION_SCHEDULE_BONDED_RATIO",
+	1: "INFLATION_SCHEDULE_FIXED",
+	2: "INFLATION_SCHEDULE_HALVING",
+	3: "INFLATION_SCHEDULE_PIECEWISE_LINEAR",
+}
+
+var InflationSchedule_value = map[string]int32{
+	"INFLATION_SCHEDULE_BONDED_RATIO":     0,
+	"INFLATION_SCHEDULE_FIXED":            1,
+	"INFLATION_SCHEDULE_HALVING":          2,
+	"INFLATION_SCHEDULE_PIECEWISE_LINEAR": 3,
+}
+
+func (x InflationSchedule) String() string {
+	return proto.EnumName(InflationSchedule_name, int32(x))
+}
+
+func (InflationSchedule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -80,6 +120,17 @@ type Params struct {
 	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// inflation schedule used by the default inflation calculation function
+	InflationSchedule InflationSchedule `protobuf:"varint,7,opt,name=inflation_schedule,json=inflationSchedule,proto3,enum=cosmos.mint.v1beta1.InflationSchedule" json:"inflation_schedule,omitempty"`
+	// annual inflation rate of the fixed schedule
+	FixedInflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=fixed_inflation,json=fixedInflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fixed_inflation"`
+	// number of blocks after which the inflation of the halving schedule is halved
+	HalvingInterval uint64 `protobuf:"varint,9,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+	// points of the piecewise-linear schedule, sorted by height
+	SchedulePoints []InflationSchedulePoint `protobuf:"bytes,10,rep,name=schedule_points,json=schedulePoints,proto3" json:"schedule_points"`
+	// maximum supply of the mint denom, minting stops once it is reached. Zero
+	// means the supply is not capped.
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -128,40 +179,127 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
+func (m *Params) GetInflationSchedule() InflationSchedule {
+	if m != nil {
+		return m.InflationSchedule
+	}
+	return INFLATION_SCHEDULE_BONDED_RATIO
+}
+
+func (m *Params) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
+func (m *Params) GetSchedulePoints() []InflationSchedulePoint {
+	if m != nil {
+		return m.SchedulePoints
+	}
+	return nil
+}
+
+// InflationSchedulePoint is the annual inflation rate at a block height of
+// the piecewise-linear schedule.
+type InflationSchedulePoint struct {
+	Height    int64                                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+}
+
+func (m *InflationSchedulePoint) Reset()         { *m = InflationSchedulePoint{} }
+func (m *InflationSchedulePoint) String() string { return proto.CompactTextString(m) }
+func (*InflationSchedulePoint) ProtoMessage()    {}
+func (*InflationSchedulePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *InflationSchedulePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InflationSchedulePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InflationSchedulePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InflationSchedulePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InflationSchedulePoint.Merge(m, src)
+}
+func (m *InflationSchedulePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *InflationSchedulePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_InflationSchedulePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InflationSchedulePoint proto.InternalMessageInfo
+
+func (m *InflationSchedulePoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.InflationSchedule", InflationSchedule_name, InflationSchedule_value)
 	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
 	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*InflationSchedulePoint)(nil), "cosmos.mint.v1beta1.InflationSchedulePoint")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
 
 var fileDescriptor_2df116d183c1e223 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0xaa, 0xda, 0x40,
-	0x18, 0x85, 0x93, 0x6a, 0x03, 0x4e, 0x2b, 0x6d, 0xc7, 0x16, 0x52, 0xa1, 0x51, 0x5c, 0x88, 0x5d,
-	0x98, 0x20, 0xdd, 0x95, 0xae, 0xd4, 0xad, 0x20, 0xd9, 0x55, 0x28, 0x61, 0x92, 0x4c, 0xe3, 0x60,
-	0x32, 0x13, 0x66, 0x46, 0xd1, 0xb7, 0xe8, 0xb2, 0xcb, 0x3e, 0x44, 0x1f, 0xc2, 0x5d, 0xa5, 0xab,
-	0xd2, 0x85, 0x5c, 0x74, 0x7b, 0x1f, 0xe2, 0x92, 0x99, 0x10, 0xe1, 0x2e, 0xee, 0x2a, 0xab, 0x64,
-	0xce, 0x99, 0xff, 0x3b, 0x27, 0x81, 0x1f, 0x38, 0x11, 0x13, 0x19, 0x13, 0x5e, 0x46, 0xa8, 0xf4,
-	0x76, 0x93, 0x10, 0x4b, 0x34, 0x51, 0x07, 0x37, 0xe7, 0x4c, 0x32, 0xd8, 0xd1, 0xbe, 0xab, 0xa4,
-	0xd2, 0xef, 0xbe, 0x4d, 0x58, 0xc2, 0x94, 0xef, 0x15, 0x6f, 0xfa, 0x6a, 0xf7, 0xbd, 0xbe, 0x1a,
-	0x68, 0xa3, 0x9c, 0x53, 0x87, 0xc1, 0x1f, 0x13, 0x58, 0x0b, 0x42, 0x25, 0xe6, 0x70, 0x05, 0x5a,
-	0x84, 0x7e, 0x4f, 0x91, 0x24, 0x8c, 0xda, 0x66, 0xdf, 0x1c, 0xb5, 0xa6, 0x5f, 0x8e, 0xe7, 0x9e,
-	0xf1, 0xff, 0xdc, 0x1b, 0x26, 0x44, 0xae, 0xb7, 0xa1, 0x1b, 0xb1, 0xac, 0x1c, 0x2f, 0x1f, 0x63,
-	0x11, 0x6f, 0x3c, 0x79, 0xc8, 0xb1, 0x70, 0xe7, 0x38, 0xfa, 0xfb, 0x7b, 0x0c, 0x4a, 0xfa, 0x1c,
-	0x47, 0xfe, 0x0d, 0x07, 0x09, 0x78, 0x83, 0x28, 0xdd, 0xa2, 0xb4, 0xe8, 0xb0, 0x23, 0x82, 0x30,
-	0x2a, 0xec, 0x67, 0x35, 0x64, 0xbc, 0xd6, 0xd8, 0x65, 0x45, 0x1d, 0xdc, 0x37, 0x80, 0xb5, 0x44,
-	0x1c, 0x65, 0x02, 0x7e, 0x00, 0xa0, 0xf8, 0x3b, 0x41, 0x8c, 0x29, 0xcb, 0xf4, 0x27, 0xf9, 0xad,
-	0x42, 0x99, 0x17, 0x02, 0xcc, 0xc1, 0xbb, 0xaa, 0x61, 0xc0, 0x91, 0xc4, 0x41, 0xb4, 0x46, 0x34,
-	0xc1, 0xb5, 0x14, 0xeb, 0x54, 0x68, 0x1f, 0x49, 0x3c, 0x53, 0x60, 0x88, 0x40, 0xfb, 0x96, 0x98,
-	0xa1, 0xbd, 0xdd, 0xa8, 0x21, 0xe9, 0x65, 0x85, 0x5c, 0xa0, 0xfd, 0xa3, 0x08, 0x42, 0xed, 0x66,
-	0xbd, 0x11, 0x84, 0xc2, 0x6f, 0xe0, 0x45, 0xc2, 0x50, 0x1a, 0x84, 0x8c, 0xc6, 0x38, 0xb6, 0x9f,
-	0xd7, 0x10, 0x00, 0x0a, 0xe0, 0x54, 0xf1, 0xe0, 0x10, 0xbc, 0x0a, 0x53, 0x16, 0x6d, 0x44, 0x90,
-	0x63, 0x1e, 0x1c, 0x30, 0xe2, 0xb6, 0xd5, 0x37, 0x47, 0x4d, 0xbf, 0xad, 0xe5, 0x25, 0xe6, 0x5f,
-	0x31, 0xe2, 0x9f, 0x9b, 0x3f, 0x7f, 0xf5, 0x8c, 0xe9, 0xec, 0x78, 0x71, 0xcc, 0xd3, 0xc5, 0x31,
-	0xef, 0x2e, 0x8e, 0xf9, 0xe3, 0xea, 0x18, 0xa7, 0xab, 0x63, 0xfc, 0xbb, 0x3a, 0xc6, 0xea, 0xe3,
-	0x93, 0x4d, 0xf6, 0x7a, 0xb1, 0x54, 0xa1, 0xd0, 0x52, 0xcb, 0xf0, 0xe9, 0x21, 0x00, 0x00, 0xff,
-	0xff, 0x83, 0xde, 0x9f, 0x75, 0x74, 0x03, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xde, 0xa5, 0xb5, 0xd8, 0x41, 0x68, 0x19, 0x94, 0xac, 0x8d, 0x6e, 0x1b, 0x48, 0xb0, 0x68,
+	0x68, 0x03, 0xde, 0x8c, 0x17, 0xda, 0x5d, 0x64, 0x93, 0x52, 0x9a, 0xad, 0xf8, 0x81, 0x31, 0x93,
+	0xe9, 0x76, 0xd8, 0x4e, 0xd8, 0x9d, 0xd9, 0xec, 0x6e, 0x9b, 0xf2, 0x0f, 0x3c, 0x18, 0xe3, 0xd1,
+	0xa3, 0x89, 0xf1, 0x1f, 0xf8, 0x23, 0xb8, 0x49, 0x3c, 0x19, 0x0f, 0xc4, 0xc0, 0x1f, 0x31, 0xfb,
+	0x41, 0x8b, 0xd8, 0x18, 0x4d, 0xf6, 0xd4, 0xce, 0xf3, 0xbe, 0xf3, 0x7c, 0x4c, 0xf2, 0xbe, 0x0b,
+	0x64, 0x83, 0x7b, 0x36, 0xf7, 0xaa, 0x36, 0x65, 0x7e, 0x75, 0xb0, 0xde, 0x21, 0x3e, 0x5e, 0x0f,
+	0x0f, 0x15, 0xc7, 0xe5, 0x3e, 0x87, 0x0b, 0x51, 0xbd, 0x12, 0x42, 0x71, 0xbd, 0x70, 0xd3, 0xe4,
+	0x26, 0x0f, 0xeb, 0xd5, 0xe0, 0x5f, 0xd4, 0x5a, 0xb8, 0x1d, 0xb5, 0xa2, 0xa8, 0x10, 0xdf, 0x0b,
+	0x0f, 0x4b, 0x5f, 0x45, 0x90, 0xd9, 0xa1, 0xcc, 0x27, 0x2e, 0xdc, 0x07, 0x59, 0xca, 0x0e, 0x2c,
+	0xec, 0x53, 0xce, 0x24, 0xb1, 0x24, 0x96, 0xb3, 0xb5, 0xc7, 0xc7, 0xa7, 0x45, 0xe1, 0xc7, 0x69,
+	0x71, 0xc5, 0xa4, 0x7e, 0xaf, 0xdf, 0xa9, 0x18, 0xdc, 0x8e, 0xaf, 0xc7, 0x3f, 0x6b, 0x5e, 0xf7,
+	0xb0, 0xea, 0x1f, 0x39, 0xc4, 0xab, 0x28, 0xc4, 0xf8, 0xf6, 0x65, 0x0d, 0xc4, 0xec, 0x0a, 0x31,
+	0xf4, 0x31, 0x1d, 0xa4, 0x60, 0x1e, 0x33, 0xd6, 0xc7, 0x56, 0xe0, 0x61, 0x40, 0x3d, 0xca, 0x99,
+	0x27, 0x4d, 0x25, 0xa0, 0x91, 0x8f, 0x68, 0x5b, 0x23, 0xd6, 0xa5, 0x77, 0xd3, 0x20, 0xd3, 0xc2,
+	0x2e, 0xb6, 0x3d, 0x78, 0x17, 0x80, 0xe0, 0x75, 0x50, 0x97, 0x30, 0x6e, 0x47, 0x91, 0xf4, 0x6c,
+	0x80, 0x28, 0x01, 0x00, 0x1d, 0x70, 0x6b, 0xe4, 0x10, 0xb9, 0xd8, 0x27, 0xc8, 0xe8, 0x61, 0x66,
+	0x92, 0x44, 0x8c, 0x2d, 0x8c, 0xa8, 0x75, 0xec, 0x93, 0x7a, 0x48, 0x0c, 0x31, 0x98, 0x1d, 0x2b,
+	0xda, 0x78, 0x28, 0xa5, 0x12, 0x50, 0xba, 0x31, 0xa2, 0xdc, 0xc1, 0xc3, 0x2b, 0x12, 0x94, 0x49,
+	0xe9, 0x64, 0x25, 0x28, 0x83, 0xaf, 0xc1, 0x8c, 0xc9, 0xb1, 0x85, 0x3a, 0x9c, 0x75, 0x49, 0x57,
+	0xba, 0x96, 0x80, 0x00, 0x08, 0x08, 0x6b, 0x21, 0x1f, 0x5c, 0x01, 0xb9, 0x8e, 0xc5, 0x8d, 0x43,
+	0x0f, 0x39, 0xc4, 0x45, 0x47, 0x04, 0xbb, 0x52, 0xa6, 0x24, 0x96, 0xd3, 0xfa, 0x6c, 0x04, 0xb7,
+	0x88, 0xfb, 0x92, 0x60, 0x17, 0xee, 0x01, 0x38, 0x4e, 0xea, 0x19, 0x3d, 0xd2, 0xed, 0x5b, 0x44,
+	0x9a, 0x2e, 0x89, 0xe5, 0xb9, 0x8d, 0x95, 0xca, 0x84, 0xe9, 0xa8, 0x68, 0x17, 0xed, 0xed, 0xb8,
+	0x5b, 0x9f, 0xa7, 0x57, 0x21, 0x48, 0x40, 0xee, 0x80, 0x0e, 0x49, 0x17, 0x8d, 0x87, 0xe1, 0x7a,
+	0x02, 0x09, 0xe7, 0x42, 0xd2, 0x91, 0x03, 0xb8, 0x0a, 0xf2, 0x3d, 0x6c, 0x0d, 0x28, 0x33, 0x51,
+	0x38, 0x7e, 0x03, 0x6c, 0x49, 0xd9, 0x30, 0x66, 0x2e, 0xc6, 0xb5, 0x18, 0x86, 0xfb, 0x20, 0x77,
+	0x11, 0x0f, 0x39, 0x9c, 0x32, 0xdf, 0x93, 0x40, 0x29, 0x55, 0x9e, 0xd9, 0x78, 0xf0, 0x6f, 0x29,
+	0x5b, 0xc1, 0x9d, 0x5a, 0x3a, 0xb0, 0xaf, 0xcf, 0x79, 0x97, 0x41, 0x0f, 0xbe, 0x02, 0xc0, 0xc6,
+	0x43, 0xe4, 0xf5, 0x1d, 0xc7, 0x3a, 0x92, 0x66, 0xfe, 0x3b, 0xa8, 0xc6, 0xfc, 0x4b, 0x41, 0x35,
+	0xe6, 0xeb, 0x59, 0x1b, 0x0f, 0xdb, 0x21, 0xdd, 0xa3, 0xf4, 0x87, 0x8f, 0x45, 0x61, 0xe9, 0xad,
+	0x08, 0x16, 0x27, 0x7b, 0x82, 0x8b, 0x20, 0xd3, 0x23, 0xd4, 0xec, 0xf9, 0xe1, 0x70, 0xa6, 0xf4,
+	0xf8, 0xf4, 0xfb, 0x2a, 0x9a, 0x4a, 0x74, 0x15, 0xdd, 0xff, 0x2c, 0x82, 0xf9, 0x3f, 0xec, 0xc0,
+	0x65, 0x50, 0xd4, 0x9a, 0x5b, 0x8d, 0xcd, 0xa7, 0xda, 0x6e, 0x13, 0xb5, 0xeb, 0xdb, 0xaa, 0xb2,
+	0xd7, 0x50, 0x51, 0x6d, 0xb7, 0xa9, 0xa8, 0x0a, 0xd2, 0x03, 0x38, 0x2f, 0xc0, 0x3b, 0x40, 0x9a,
+	0xd0, 0xb4, 0xa5, 0xbd, 0x50, 0x95, 0xbc, 0x08, 0x65, 0x50, 0x98, 0x50, 0xdd, 0xde, 0x6c, 0x3c,
+	0xd3, 0x9a, 0x4f, 0xf2, 0x53, 0xf0, 0x1e, 0x58, 0x9e, 0x50, 0x6f, 0x69, 0x6a, 0x5d, 0x7d, 0xae,
+	0xb5, 0x55, 0xd4, 0xd0, 0x9a, 0xea, 0xa6, 0x9e, 0x4f, 0x15, 0xd2, 0x6f, 0x3e, 0xc9, 0x42, 0xad,
+	0x7e, 0x7c, 0x26, 0x8b, 0x27, 0x67, 0xb2, 0xf8, 0xf3, 0x4c, 0x16, 0xdf, 0x9f, 0xcb, 0xc2, 0xc9,
+	0xb9, 0x2c, 0x7c, 0x3f, 0x97, 0x85, 0xfd, 0xd5, 0xbf, 0x3e, 0xc1, 0x30, 0xfa, 0x62, 0x84, 0x2f,
+	0xd1, 0xc9, 0x84, 0x5b, 0xfe, 0xe1, 0xaf, 0x01, 0x00, 0xc9, 0xd9, 0x55, 0xfd, 0x4d, 0x06, 0x00,
+	0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -227,6 +365,50 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.SchedulePoints) > 0 {
+		for iNdEx := len(m.SchedulePoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SchedulePoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.HalvingInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.HalvingInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.FixedInflation.Size()
+		i -= size
+		if _, err := m.FixedInflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.InflationSchedule != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationSchedule))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.BlocksPerYear != 0 {
 		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
 		i--
@@ -282,6 +464,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InflationSchedulePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InflationSchedulePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InflationSchedulePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -327,6 +547,36 @@ func (m *Params) Size() (n int) {
 	if m.BlocksPerYear != 0 {
 		n += 1 + sovMint(uint64(m.BlocksPerYear))
 	}
+	if m.InflationSchedule != 0 {
+		n += 1 + sovMint(uint64(m.InflationSchedule))
+	}
+	l = m.FixedInflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.HalvingInterval != 0 {
+		n += 1 + sovMint(uint64(m.HalvingInterval))
+	}
+	if len(m.SchedulePoints) > 0 {
+		for _, e := range m.SchedulePoints {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *InflationSchedulePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
 	return n
 }
 
@@ -670,6 +920,249 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationSchedule", wireType)
+			}
+			m.InflationSchedule = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationSchedule |= InflationSchedule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedInflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedInflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingInterval", wireType)
+			}
+			m.HalvingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulePoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchedulePoints = append(m.SchedulePoints, InflationSchedulePoint{})
+			if err := m.SchedulePoints[len(m.SchedulePoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InflationSchedulePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InflationSchedulePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InflationSchedulePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMint(dAtA[iNdEx:])
@@ -79,3 +79,15 @@ func (m Minter) BlockProvision(params Params) sdk.Coin {
 	provisionAmt := m.AnnualProvisions.QuoInt(sdk.NewInt(int64(params.BlocksPerYear)))
 	return sdk.NewCoin(params.MintDenom, provisionAmt.TruncateInt())
 }
+
+// CapProvision returns the part of the provision which can be minted without
+// raising the supply above maxSupply. A zero maxSupply disables the cap.
+func CapProvision(provision, supply, maxSupply math.Int) math.Int {
+	if !maxSupply.IsPositive() {
+		return provision
+	}
+	if supply.GTE(maxSupply) {
+		return math.ZeroInt()
+	}
+	return math.MinInt(provision, maxSupply.Sub(supply))
+}
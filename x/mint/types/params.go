@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/math"
 	"sigs.k8s.io/yaml"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,11 @@ var (
 	KeyInflationMin        = []byte("InflationMin")
 	KeyGoalBonded          = []byte("GoalBonded")
 	KeyBlocksPerYear       = []byte("BlocksPerYear")
+	KeyInflationSchedule   = []byte("InflationSchedule")
+	KeyFixedInflation      = []byte("FixedInflation")
+	KeyHalvingInterval     = []byte("HalvingInterval")
+	KeySchedulePoints      = []byte("SchedulePoints")
+	KeyMaxSupply           = []byte("MaxSupply")
 )
 
 // ParamTable for minting module.
@@ -36,6 +42,10 @@ func NewParams(
 		InflationMin:        inflationMin,
 		GoalBonded:          goalBonded,
 		BlocksPerYear:       blocksPerYear,
+		InflationSchedule:   INFLATION_SCHEDULE_BONDED_RATIO,
+		FixedInflation:      sdk.ZeroDec(),
+		SchedulePoints:      []InflationSchedulePoint{},
+		MaxSupply:           sdk.ZeroInt(),
 	}
 }
 
@@ -48,6 +58,11 @@ func DefaultParams() Params {
 		InflationMin:        sdk.NewDecWithPrec(7, 2),
 		GoalBonded:          sdk.NewDecWithPrec(67, 2),
 		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		InflationSchedule:   INFLATION_SCHEDULE_BONDED_RATIO,
+		FixedInflation:      sdk.ZeroDec(),
+		HalvingInterval:     0,
+		SchedulePoints:      []InflationSchedulePoint{},
+		MaxSupply:           sdk.ZeroInt(),
 	}
 }
 
@@ -71,12 +86,33 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateInflationSchedule(p.InflationSchedule); err != nil {
+		return err
+	}
+	if err := validateFixedInflation(p.FixedInflation); err != nil {
+		return err
+	}
+	if err := validateHalvingInterval(p.HalvingInterval); err != nil {
+		return err
+	}
+	if err := validateSchedulePoints(p.SchedulePoints); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
 	if p.InflationMax.LT(p.InflationMin) {
 		return fmt.Errorf(
 			"max inflation (%s) must be greater than or equal to min inflation (%s)",
 			p.InflationMax, p.InflationMin,
 		)
 	}
+	if p.InflationSchedule == INFLATION_SCHEDULE_HALVING && p.HalvingInterval == 0 {
+		return errors.New("halving interval must be positive with the halving inflation schedule")
+	}
+	if p.InflationSchedule == INFLATION_SCHEDULE_PIECEWISE_LINEAR && len(p.SchedulePoints) == 0 {
+		return errors.New("schedule points cannot be empty with the piecewise-linear inflation schedule")
+	}
 
 	return nil
 }
@@ -96,6 +132,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
 		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyInflationSchedule, &p.InflationSchedule, validateInflationSchedule),
+		paramtypes.NewParamSetPair(KeyFixedInflation, &p.FixedInflation, validateFixedInflation),
+		paramtypes.NewParamSetPair(KeyHalvingInterval, &p.HalvingInterval, validateHalvingInterval),
+		paramtypes.NewParamSetPair(KeySchedulePoints, &p.SchedulePoints, validateSchedulePoints),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
 	}
 }
 
@@ -191,3 +232,77 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateInflationSchedule(i interface{}) error {
+	v, ok := i.(InflationSchedule)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := InflationSchedule_name[int32(v)]; !ok {
+		return fmt.Errorf("unknown inflation schedule: %d", v)
+	}
+
+	return nil
+}
+
+func validateFixedInflation(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("fixed inflation cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fixed inflation too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateHalvingInterval(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateSchedulePoints(i interface{}) error {
+	v, ok := i.([]InflationSchedulePoint)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, point := range v {
+		if point.Height < 0 {
+			return fmt.Errorf("schedule point height cannot be negative: %d", point.Height)
+		}
+		if j > 0 && point.Height <= v[j-1].Height {
+			return fmt.Errorf("schedule point heights must be strictly increasing: %d after %d", point.Height, v[j-1].Height)
+		}
+		if point.Inflation.IsNil() || point.Inflation.IsNegative() {
+			return fmt.Errorf("schedule point inflation cannot be negative: %s", point.Inflation)
+		}
+		if point.Inflation.GT(sdk.OneDec()) {
+			return fmt.Errorf("schedule point inflation too large: %s", point.Inflation)
+		}
+	}
+
+	return nil
+}
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max supply cannot be negative: %s", v)
+	}
+
+	return nil
+}
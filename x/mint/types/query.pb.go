@@ -268,6 +268,163 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
+// QueryProjectedProvisionsRequest is the request type for the
+// Query/ProjectedProvisions RPC method.
+type QueryProjectedProvisionsRequest struct {
+	// periods is the number of periods to project, defaults to 1.
+	Periods uint32 `protobuf:"varint,1,opt,name=periods,proto3" json:"periods,omitempty"`
+	// blocks_per_period is the length of a period in blocks, defaults to
+	// blocks_per_year.
+	BlocksPerPeriod uint64 `protobuf:"varint,2,opt,name=blocks_per_period,json=blocksPerPeriod,proto3" json:"blocks_per_period,omitempty"`
+}
+
+func (m *QueryProjectedProvisionsRequest) Reset()         { *m = QueryProjectedProvisionsRequest{} }
+func (m *QueryProjectedProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedProvisionsRequest) ProtoMessage()    {}
+func (*QueryProjectedProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedProvisionsRequest.Merge(m, src)
+}
+func (m *QueryProjectedProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedProvisionsRequest proto.InternalMessageInfo
+
+func (m *QueryProjectedProvisionsRequest) GetPeriods() uint32 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *QueryProjectedProvisionsRequest) GetBlocksPerPeriod() uint64 {
+	if m != nil {
+		return m.BlocksPerPeriod
+	}
+	return 0
+}
+
+// QueryProjectedProvisionsResponse is the response type for the
+// Query/ProjectedProvisions RPC method.
+type QueryProjectedProvisionsResponse struct {
+	// provisions holds one projection per period.
+	Provisions []ProjectedProvision `protobuf:"bytes,1,rep,name=provisions,proto3" json:"provisions"`
+}
+
+func (m *QueryProjectedProvisionsResponse) Reset()         { *m = QueryProjectedProvisionsResponse{} }
+func (m *QueryProjectedProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectedProvisionsResponse) ProtoMessage()    {}
+func (*QueryProjectedProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{7}
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectedProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectedProvisionsResponse.Merge(m, src)
+}
+func (m *QueryProjectedProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectedProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectedProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectedProvisionsResponse proto.InternalMessageInfo
+
+func (m *QueryProjectedProvisionsResponse) GetProvisions() []ProjectedProvision {
+	if m != nil {
+		return m.Provisions
+	}
+	return nil
+}
+
+// ProjectedProvision is the projection of the provisions minted during a
+// period.
+type ProjectedProvision struct {
+	// height is the last block height of the period.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// inflation is the annual inflation rate at the start of the period.
+	Inflation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation"`
+	// provisions is the amount minted during the period.
+	Provisions github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=provisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"provisions"`
+	// supply is the supply of the mint denom at the end of the period.
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+}
+
+func (m *ProjectedProvision) Reset()         { *m = ProjectedProvision{} }
+func (m *ProjectedProvision) String() string { return proto.CompactTextString(m) }
+func (*ProjectedProvision) ProtoMessage()    {}
+func (*ProjectedProvision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{8}
+}
+func (m *ProjectedProvision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedProvision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedProvision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectedProvision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedProvision.Merge(m, src)
+}
+func (m *ProjectedProvision) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedProvision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedProvision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedProvision proto.InternalMessageInfo
+
+func (m *ProjectedProvision) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
@@ -275,40 +432,54 @@ func init() {
 	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
 	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QueryProjectedProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryProjectedProvisionsRequest")
+	proto.RegisterType((*QueryProjectedProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryProjectedProvisionsResponse")
+	proto.RegisterType((*ProjectedProvision)(nil), "cosmos.mint.v1beta1.ProjectedProvision")
 }
 
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
 
 var fileDescriptor_d0a1e393be338aea = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x83, 0x2a, 0x90, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb, 0x83, 0x58,
-	0x10, 0xa5, 0x52, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89,
-	0x79, 0x79, 0xf9, 0x25, 0x89, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x50, 0x59, 0x39, 0x6c, 0x36, 0x81,
-	0x4d, 0x05, 0xcb, 0x2b, 0x89, 0x70, 0x09, 0x05, 0x82, 0xec, 0x0d, 0x48, 0x2c, 0x4a, 0xcc, 0x2d,
-	0x0e, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe0, 0x12, 0x46, 0x11, 0x2d, 0x2e, 0xc8,
-	0xcf, 0x2b, 0x4e, 0x15, 0xb2, 0xe4, 0x62, 0x2b, 0x00, 0x8b, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x1b, 0x49, 0xeb, 0x61, 0x71, 0xa6, 0x1e, 0x44, 0x93, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41,
-	0x50, 0x0d, 0x4a, 0xe2, 0x5c, 0xa2, 0x60, 0x13, 0x3d, 0xf3, 0xd2, 0x72, 0xc0, 0x0e, 0x84, 0x59,
-	0x95, 0xc6, 0x25, 0x86, 0x2e, 0x01, 0xb5, 0xcd, 0x87, 0x8b, 0x33, 0x13, 0x26, 0x08, 0xb6, 0x90,
-	0xc7, 0x49, 0x0f, 0x64, 0xe6, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x0f, 0x42, 0x28, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82,
-	0xd4, 0x62, 0x3d, 0x97, 0xd4, 0xe4, 0x20, 0x84, 0x01, 0x4a, 0x72, 0x5c, 0x32, 0x60, 0x7b, 0x1c,
-	0xf3, 0xf2, 0x4a, 0x13, 0x73, 0x02, 0x8a, 0xf2, 0xcb, 0x32, 0x8b, 0x41, 0xe1, 0x04, 0x73, 0x47,
-	0x0d, 0x97, 0x2c, 0x0e, 0x79, 0xa8, 0x73, 0xa2, 0xb9, 0x04, 0x13, 0xc1, 0x72, 0xf1, 0x05, 0x70,
-	0x49, 0x32, 0x9d, 0x25, 0x90, 0x88, 0x66, 0x89, 0xd1, 0x51, 0x66, 0x2e, 0x56, 0xb0, 0xf5, 0x42,
-	0x0d, 0x8c, 0x5c, 0x6c, 0x90, 0x10, 0x14, 0x52, 0xc7, 0x1a, 0xbc, 0x98, 0xd1, 0x25, 0xa5, 0x41,
-	0x58, 0x21, 0xc4, 0x13, 0x4a, 0xca, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x92, 0x15, 0x92, 0xd6, 0xc7,
-	0x96, 0x2e, 0x20, 0x71, 0x25, 0xd4, 0xc3, 0xc8, 0xc5, 0x09, 0x8f, 0x0e, 0x21, 0x2d, 0xdc, 0x86,
-	0xa3, 0x47, 0xa6, 0x94, 0x36, 0x51, 0x6a, 0xa1, 0x6e, 0x51, 0x03, 0xbb, 0x45, 0x41, 0x48, 0x0e,
-	0xab, 0x5b, 0xe0, 0x31, 0x27, 0xb4, 0x92, 0x91, 0x4b, 0x00, 0x3d, 0x56, 0x84, 0x0c, 0x71, 0xdb,
-	0x84, 0x23, 0x86, 0xa5, 0x8c, 0x48, 0xd1, 0x02, 0x75, 0xa3, 0x1e, 0xd8, 0x8d, 0x1a, 0x42, 0x6a,
-	0x58, 0xdd, 0x88, 0x91, 0x1e, 0x9c, 0x9c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
-	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
-	0x21, 0x4a, 0x13, 0x6f, 0xda, 0xa8, 0x80, 0x18, 0x0c, 0x4e, 0x22, 0x49, 0x6c, 0xe0, 0xac, 0x69,
-	0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xed, 0x9c, 0x7b, 0x26, 0x04, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0x12, 0x41,
+	0x18, 0x66, 0x01, 0x31, 0x7d, 0xab, 0xb1, 0x1d, 0x6a, 0x25, 0xdb, 0x76, 0x21, 0x6b, 0x82, 0xb4,
+	0x8d, 0xbb, 0x01, 0xf5, 0xe0, 0x51, 0x34, 0x26, 0x4d, 0xd4, 0xe0, 0x1e, 0xf5, 0x40, 0x16, 0x98,
+	0x2e, 0x6b, 0x61, 0x67, 0xd9, 0x19, 0x1a, 0x49, 0x3c, 0x18, 0xcf, 0x1e, 0x8c, 0xfe, 0x0a, 0x7f,
+	0x80, 0xff, 0xa1, 0xc7, 0x26, 0x5e, 0x8c, 0x87, 0xc6, 0x80, 0x7f, 0xc3, 0xc4, 0x30, 0x33, 0xb4,
+	0xc0, 0xee, 0x56, 0x69, 0x4f, 0xb0, 0xef, 0xc7, 0xf3, 0x3c, 0xef, 0xbc, 0xfb, 0xcc, 0x42, 0xbe,
+	0x49, 0x68, 0x97, 0x50, 0xb3, 0xeb, 0x7a, 0xcc, 0x3c, 0x2c, 0x37, 0x30, 0xb3, 0xcb, 0x66, 0xaf,
+	0x8f, 0x83, 0x81, 0xe1, 0x07, 0x84, 0x11, 0x94, 0x15, 0x05, 0xc6, 0xb8, 0xc0, 0x90, 0x05, 0xea,
+	0x9a, 0x43, 0x1c, 0xc2, 0xf3, 0xe6, 0xf8, 0x9f, 0x28, 0x55, 0x37, 0x1d, 0x42, 0x9c, 0x0e, 0x36,
+	0x6d, 0xdf, 0x35, 0x6d, 0xcf, 0x23, 0xcc, 0x66, 0x2e, 0xf1, 0xa8, 0xcc, 0x6a, 0x51, 0x4c, 0x1c,
+	0x95, 0xe7, 0xf5, 0x35, 0x40, 0x2f, 0xc7, 0xbc, 0x35, 0x3b, 0xb0, 0xbb, 0xd4, 0xc2, 0xbd, 0x3e,
+	0xa6, 0x4c, 0xaf, 0x41, 0x76, 0x26, 0x4a, 0x7d, 0xe2, 0x51, 0x8c, 0x1e, 0x42, 0xc6, 0xe7, 0x91,
+	0x9c, 0x52, 0x50, 0x4a, 0xcb, 0x95, 0x0d, 0x23, 0x42, 0xa6, 0x21, 0x9a, 0xaa, 0xe9, 0xa3, 0x93,
+	0x7c, 0xc2, 0x92, 0x0d, 0xfa, 0x2d, 0xb8, 0xc9, 0x11, 0xf7, 0xbc, 0xfd, 0x0e, 0x17, 0x38, 0xa1,
+	0xda, 0x87, 0xf5, 0xf9, 0x84, 0x64, 0x7b, 0x06, 0x4b, 0xee, 0x24, 0xc8, 0x09, 0xaf, 0x55, 0x8d,
+	0x31, 0xe6, 0xcf, 0x93, 0x7c, 0xd1, 0x71, 0x59, 0xbb, 0xdf, 0x30, 0x9a, 0xa4, 0x6b, 0xca, 0x01,
+	0xc5, 0xcf, 0x5d, 0xda, 0x3a, 0x30, 0xd9, 0xc0, 0xc7, 0xd4, 0x78, 0x82, 0x9b, 0xd6, 0x19, 0x80,
+	0xae, 0xc1, 0x26, 0xe7, 0x79, 0xe4, 0x79, 0x7d, 0xbb, 0x53, 0x0b, 0xc8, 0xa1, 0x4b, 0xc7, 0xe7,
+	0x34, 0xd1, 0xf1, 0x0e, 0xb6, 0x62, 0xf2, 0x52, 0xce, 0x6b, 0x58, 0xb5, 0x79, 0xae, 0xee, 0x9f,
+	0x26, 0x2f, 0x28, 0x6b, 0xc5, 0x9e, 0x23, 0xd1, 0x1d, 0xc8, 0x8b, 0x03, 0x0f, 0xc8, 0x1b, 0xdc,
+	0x64, 0xb8, 0x15, 0x12, 0x88, 0x72, 0x70, 0xd5, 0xc7, 0x81, 0x4b, 0x5a, 0x82, 0xf5, 0xba, 0x35,
+	0x79, 0x44, 0x3b, 0xb0, 0xda, 0xe8, 0x90, 0xe6, 0x01, 0xad, 0xfb, 0x38, 0xa8, 0x8b, 0x68, 0x2e,
+	0x59, 0x50, 0x4a, 0x69, 0xeb, 0x86, 0x48, 0xd4, 0x70, 0x50, 0xe3, 0x61, 0xbd, 0x07, 0x85, 0x78,
+	0x22, 0x39, 0xe9, 0x73, 0x80, 0x99, 0x11, 0x53, 0xa5, 0xe5, 0xca, 0x9d, 0xe8, 0x55, 0x87, 0x50,
+	0xe4, 0xda, 0xa7, 0x00, 0xf4, 0xcf, 0x49, 0x40, 0xe1, 0x42, 0xb4, 0x0e, 0x99, 0x36, 0x76, 0x9d,
+	0x36, 0xe3, 0xe3, 0xa4, 0x2c, 0xf9, 0x34, 0xbb, 0xf6, 0xe4, 0x25, 0xd7, 0x8e, 0x5e, 0xcc, 0xcc,
+	0x92, 0x5a, 0x18, 0x6e, 0xcf, 0x63, 0xd3, 0xc3, 0xa0, 0xa7, 0x90, 0xa1, 0x7d, 0xdf, 0xef, 0x0c,
+	0x72, 0xe9, 0x0b, 0x61, 0xc9, 0xee, 0xca, 0x9f, 0x34, 0x5c, 0xe1, 0x8b, 0x40, 0xef, 0x15, 0xc8,
+	0x08, 0xcb, 0xa0, 0xe8, 0x43, 0x0e, 0xfb, 0x53, 0x2d, 0xfd, 0xbb, 0x50, 0xec, 0x52, 0xbf, 0xfd,
+	0xe1, 0xfb, 0xef, 0x2f, 0xc9, 0x2d, 0xb4, 0x61, 0x46, 0x5d, 0x04, 0xc2, 0x9c, 0xe8, 0xa3, 0x02,
+	0x4b, 0xa7, 0xfe, 0x43, 0x3b, 0xf1, 0xe0, 0xf3, 0xee, 0x55, 0x77, 0xff, 0xab, 0x56, 0x6a, 0x29,
+	0x72, 0x2d, 0x05, 0xa4, 0x45, 0x6a, 0x39, 0xdb, 0xd9, 0x57, 0x05, 0x56, 0xe6, 0x6d, 0x88, 0xca,
+	0xf1, 0x4c, 0x31, 0x96, 0x56, 0x2b, 0x8b, 0xb4, 0x48, 0x8d, 0x06, 0xd7, 0x58, 0x42, 0xc5, 0x48,
+	0x8d, 0xa1, 0x0b, 0x00, 0x7d, 0x53, 0x20, 0x1b, 0xe1, 0x25, 0x74, 0xff, 0x9c, 0x0d, 0xc5, 0x7a,
+	0x5c, 0x7d, 0xb0, 0x60, 0x97, 0x14, 0x5d, 0xe6, 0xa2, 0x77, 0xd1, 0x76, 0xf4, 0x92, 0x27, 0x9d,
+	0x53, 0xba, 0xab, 0x8f, 0x8f, 0x86, 0x9a, 0x72, 0x3c, 0xd4, 0x94, 0x5f, 0x43, 0x4d, 0xf9, 0x34,
+	0xd2, 0x12, 0xc7, 0x23, 0x2d, 0xf1, 0x63, 0xa4, 0x25, 0x5e, 0x6d, 0x9f, 0xfb, 0x26, 0xbf, 0x15,
+	0xd8, 0xfc, 0x85, 0x6e, 0x64, 0xf8, 0x37, 0xe4, 0xde, 0xdf, 0x01, 0x00, 0xfb, 0x9c, 0x34, 0x40,
+	0xcf, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -329,6 +500,9 @@ type QueryClient interface {
 	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedProvisions projects the provisions of the next periods from the
+	// current minter, params and bonded ratio.
+	ProjectedProvisions(ctx context.Context, in *QueryProjectedProvisionsRequest, opts ...grpc.CallOption) (*QueryProjectedProvisionsResponse, error)
 }
 
 type queryClient struct {
@@ -366,6 +540,15 @@ func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvi
 	return out, nil
 }
 
+func (c *queryClient) ProjectedProvisions(ctx context.Context, in *QueryProjectedProvisionsRequest, opts ...grpc.CallOption) (*QueryProjectedProvisionsResponse, error) {
+	out := new(QueryProjectedProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/ProjectedProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params returns the total set of minting parameters.
@@ -374,6 +557,9 @@ type QueryServer interface {
 	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
 	// AnnualProvisions current minting annual provisions value.
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// ProjectedProvisions projects the provisions of the next periods from the
+	// current minter, params and bonded ratio.
+	ProjectedProvisions(context.Context, *QueryProjectedProvisionsRequest) (*QueryProjectedProvisionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -389,6 +575,9 @@ func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflat
 func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
 }
+func (*UnimplementedQueryServer) ProjectedProvisions(ctx context.Context, req *QueryProjectedProvisionsRequest) (*QueryProjectedProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectedProvisions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -448,6 +637,24 @@ func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectedProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectedProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectedProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/ProjectedProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectedProvisions(ctx, req.(*QueryProjectedProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.mint.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -464,6 +671,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AnnualProvisions",
 			Handler:    _Query_AnnualProvisions_Handler,
 		},
+		{
+			MethodName: "ProjectedProvisions",
+			Handler:    _Query_ProjectedProvisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/mint/v1beta1/query.proto",
@@ -637,6 +848,134 @@ func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProjectedProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksPerPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksPerPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Periods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectedProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectedProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectedProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Provisions) > 0 {
+		for iNdEx := len(m.Provisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Provisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Provisions.Size()
+		i -= size
+		if _, err := m.Provisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -708,6 +1047,54 @@ func (m *QueryAnnualProvisionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProjectedProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		n += 1 + sovQuery(uint64(m.Periods))
+	}
+	if m.BlocksPerPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksPerPeriod))
+	}
+	return n
+}
+
+func (m *QueryProjectedProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Provisions) > 0 {
+		for _, e := range m.Provisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectedProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Provisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1113,6 +1500,346 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProjectedProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerPeriod", wireType)
+			}
+			m.BlocksPerPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectedProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectedProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provisions = append(m.Provisions, ProjectedProvision{})
+			if err := m.Provisions[len(m.Provisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ScheduledInflation returns the inflation rate of the built-in schedule
+// selected by params.InflationSchedule for the block at the given height.
+func ScheduledInflation(minter Minter, params Params, height int64, bondedRatio sdk.Dec) sdk.Dec {
+	switch params.InflationSchedule {
+	case INFLATION_SCHEDULE_BONDED_RATIO:
+		return minter.NextInflationRate(params, bondedRatio)
+	case INFLATION_SCHEDULE_FIXED:
+		return params.FixedInflation
+	case INFLATION_SCHEDULE_HALVING:
+		return HalvingInflation(params, height)
+	case INFLATION_SCHEDULE_PIECEWISE_LINEAR:
+		return PiecewiseLinearInflation(params.SchedulePoints, height)
+	default:
+		panic(fmt.Sprintf("unknown inflation schedule: %s", params.InflationSchedule))
+	}
+}
+
+// HalvingInflation returns InflationMax halved once for every HalvingInterval
+// blocks elapsed at the given height, floored at InflationMin.
+func HalvingInflation(params Params, height int64) sdk.Dec {
+	if params.HalvingInterval == 0 || height <= 0 {
+		return params.InflationMax
+	}
+
+	halvings := uint64(height) / params.HalvingInterval
+	inflation := params.InflationMax
+	for i := uint64(0); i < halvings && inflation.GT(params.InflationMin); i++ {
+		inflation = inflation.QuoInt64(2)
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation
+}
+
+// PiecewiseLinearInflation interpolates linearly the inflation between the
+// two schedule points surrounding the given height. Heights before the first
+// point use the inflation of the first point and heights after the last point
+// the inflation of the last point.
+func PiecewiseLinearInflation(points []InflationSchedulePoint, height int64) sdk.Dec {
+	if len(points) == 0 {
+		return sdk.ZeroDec()
+	}
+	if height <= points[0].Height {
+		return points[0].Inflation
+	}
+
+	for i := 1; i < len(points); i++ {
+		start, end := points[i-1], points[i]
+		if height > end.Height {
+			continue
+		}
+
+		// start + (end - start) * (height - start.Height) / (end.Height - start.Height)
+		progress := sdk.NewDec(height - start.Height).QuoInt64(end.Height - start.Height)
+		return start.Inflation.Add(end.Inflation.Sub(start.Inflation).Mul(progress))
+	}
+
+	return points[len(points)-1].Inflation
+}
+
+// ProjectProvisions projects the provisions minted during the given number of
+// periods of blocksPerPeriod blocks following height, starting from the
+// current minter and supply of the mint denom. The bonded ratio is assumed to
+// stay constant and the inflation rate is evaluated once at the start of each
+// period, so the projection is an approximation of the per block minting.
+func ProjectProvisions(
+	minter Minter, params Params, height int64, supply math.Int, bondedRatio sdk.Dec, periods uint32, blocksPerPeriod uint64,
+) []ProjectedProvision {
+	projections := make([]ProjectedProvision, 0, periods)
+	inflation := minter.Inflation
+	for i := uint32(0); i < periods; i++ {
+		switch {
+		case params.InflationSchedule != INFLATION_SCHEDULE_BONDED_RATIO:
+			inflation = ScheduledInflation(minter, params, height+1, bondedRatio)
+		case i > 0:
+			inflation = advanceBondedRatioInflation(inflation, params, bondedRatio, blocksPerPeriod)
+		}
+
+		provisions := inflation.MulInt(supply).
+			MulInt64(int64(blocksPerPeriod)).
+			QuoInt64(int64(params.BlocksPerYear)).
+			TruncateInt()
+		provisions = CapProvision(provisions, supply, params.MaxSupply)
+		supply = supply.Add(provisions)
+		height += int64(blocksPerPeriod)
+
+		projections = append(projections, ProjectedProvision{
+			Height:     height,
+			Inflation:  inflation,
+			Provisions: provisions,
+			Supply:     supply,
+		})
+	}
+
+	return projections
+}
+
+// advanceBondedRatioInflation applies blocks successive NextInflationRate
+// changes at a constant bonded ratio. As the change is the same for every
+// block, it is applied at once before clamping.
+func advanceBondedRatioInflation(inflation sdk.Dec, params Params, bondedRatio sdk.Dec, blocks uint64) sdk.Dec {
+	change := sdk.OneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange).
+		MulInt64(int64(blocks)).
+		QuoInt64(int64(params.BlocksPerYear))
+
+	inflation = inflation.Add(change)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+	return inflation
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestHalvingInflation(t *testing.T) {
+	params := DefaultParams()
+	params.InflationSchedule = INFLATION_SCHEDULE_HALVING
+	params.HalvingInterval = 100
+
+	tests := []struct {
+		height int64
+		exp    sdk.Dec
+	}{
+		{0, params.InflationMax},
+		{99, params.InflationMax},
+		{100, params.InflationMax.QuoInt64(2)},
+		{250, params.InflationMin},
+		{100000, params.InflationMin},
+	}
+	for i, tc := range tests {
+		require.True(t, HalvingInflation(params, tc.height).Equal(tc.exp), "test index: %d", i)
+	}
+}
+
+func TestPiecewiseLinearInflation(t *testing.T) {
+	points := []InflationSchedulePoint{
+		{Height: 100, Inflation: sdk.NewDecWithPrec(20, 2)},
+		{Height: 200, Inflation: sdk.NewDecWithPrec(10, 2)},
+		{Height: 400, Inflation: sdk.NewDecWithPrec(10, 2)},
+	}
+
+	tests := []struct {
+		height int64
+		exp    sdk.Dec
+	}{
+		{1, sdk.NewDecWithPrec(20, 2)},
+		{100, sdk.NewDecWithPrec(20, 2)},
+		{150, sdk.NewDecWithPrec(15, 2)},
+		{200, sdk.NewDecWithPrec(10, 2)},
+		{300, sdk.NewDecWithPrec(10, 2)},
+		{1000, sdk.NewDecWithPrec(10, 2)},
+	}
+	for i, tc := range tests {
+		require.True(t, PiecewiseLinearInflation(points, tc.height).Equal(tc.exp), "test index: %d", i)
+	}
+
+	require.True(t, PiecewiseLinearInflation(nil, 10).IsZero())
+}
+
+func TestScheduledInflation(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	bondedRatio := sdk.NewDecWithPrec(5, 1)
+
+	require.Equal(t, minter.NextInflationRate(params, bondedRatio), ScheduledInflation(minter, params, 1, bondedRatio))
+
+	params.InflationSchedule = INFLATION_SCHEDULE_FIXED
+	params.FixedInflation = sdk.NewDecWithPrec(3, 2)
+	require.Equal(t, params.FixedInflation, ScheduledInflation(minter, params, 1, bondedRatio))
+}
+
+func TestCapProvision(t *testing.T) {
+	tests := []struct {
+		provision, supply, maxSupply, exp int64
+	}{
+		{100, 1000, 0, 100},
+		{100, 1000, 2000, 100},
+		{100, 1950, 2000, 50},
+		{100, 2000, 2000, 0},
+		{100, 2500, 2000, 0},
+	}
+	for i, tc := range tests {
+		res := CapProvision(sdk.NewInt(tc.provision), sdk.NewInt(tc.supply), sdk.NewInt(tc.maxSupply))
+		require.Equal(t, sdk.NewInt(tc.exp), res, "test index: %d", i)
+	}
+}
+
+func TestProjectProvisions(t *testing.T) {
+	minter := DefaultInitialMinter()
+	params := DefaultParams()
+	params.InflationSchedule = INFLATION_SCHEDULE_FIXED
+	params.FixedInflation = sdk.NewDecWithPrec(10, 2)
+	params.MaxSupply = sdk.NewInt(1200)
+
+	projections := ProjectProvisions(minter, params, 0, sdk.NewInt(1000), sdk.ZeroDec(), 3, params.BlocksPerYear)
+	require.Len(t, projections, 3)
+
+	require.Equal(t, int64(params.BlocksPerYear), projections[0].Height)
+	require.Equal(t, sdk.NewInt(100), projections[0].Provisions)
+	require.Equal(t, sdk.NewInt(1100), projections[0].Supply)
+	require.Equal(t, sdk.NewInt(100), projections[1].Provisions)
+	require.Equal(t, sdk.NewInt(1200), projections[1].Supply)
+	require.True(t, projections[2].Provisions.IsZero())
+	require.Equal(t, sdk.NewInt(1200), projections[2].Supply)
+}
+
+func TestValidateScheduleParams(t *testing.T) {
+	params := DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.InflationSchedule = INFLATION_SCHEDULE_HALVING
+	require.Error(t, params.Validate())
+	params.HalvingInterval = 10
+	require.NoError(t, params.Validate())
+
+	params.InflationSchedule = INFLATION_SCHEDULE_PIECEWISE_LINEAR
+	require.Error(t, params.Validate())
+	params.SchedulePoints = []InflationSchedulePoint{
+		{Height: 10, Inflation: sdk.NewDecWithPrec(1, 1)},
+		{Height: 10, Inflation: sdk.NewDecWithPrec(1, 1)},
+	}
+	require.Error(t, params.Validate())
+	params.SchedulePoints[1].Height = 20
+	require.NoError(t, params.Validate())
+
+	params.MaxSupply = sdk.NewInt(-1)
+	require.Error(t, params.Validate())
+}
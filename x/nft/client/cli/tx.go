@@ -9,10 +9,23 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
+// Flag names and values
+const (
+	FlagName            = "name"
+	FlagSymbol          = "symbol"
+	FlagDescription     = "description"
+	FlagURI             = "uri"
+	FlagURIHash         = "uri-hash"
+	FlagRoyaltyReceiver = "royalty-receiver"
+	FlagRoyaltyRate     = "royalty-rate"
+	FlagMintCap         = "mint-cap"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	nftTxCmd := &cobra.Command{
@@ -26,6 +39,10 @@ func GetTxCmd() *cobra.Command {
 
 	nftTxCmd.AddCommand(
 		NewCmdSend(),
+		NewCmdCreateClass(),
+		NewCmdMint(),
+		NewCmdBurn(),
+		NewCmdUpdate(),
 	)
 
 	return nftTxCmd
@@ -58,3 +75,162 @@ func NewCmdSend() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCmdCreateClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class [class-id] --from [issuer]",
+		Args:  cobra.ExactArgs(1),
+		Short: "create a new nft class issued by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s create-class <class-id> --name <name> --symbol <symbol> --royalty-receiver <address> --royalty-rate 0.05 --mint-cap 1000 --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, _ := cmd.Flags().GetString(FlagName)
+			symbol, _ := cmd.Flags().GetString(FlagSymbol)
+			description, _ := cmd.Flags().GetString(FlagDescription)
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+			royaltyReceiver, _ := cmd.Flags().GetString(FlagRoyaltyReceiver)
+			royaltyRateStr, _ := cmd.Flags().GetString(FlagRoyaltyRate)
+			mintCap, err := cmd.Flags().GetUint64(FlagMintCap)
+			if err != nil {
+				return err
+			}
+
+			var royaltyRate *sdk.Dec
+			if royaltyRateStr != "" {
+				rate, err := sdk.NewDecFromStr(royaltyRateStr)
+				if err != nil {
+					return err
+				}
+				royaltyRate = &rate
+			}
+
+			msg := nft.MsgCreateClass{
+				Issuer:          clientCtx.GetFromAddress().String(),
+				Id:              args[0],
+				Name:            name,
+				Symbol:          symbol,
+				Description:     description,
+				Uri:             uri,
+				UriHash:         uriHash,
+				RoyaltyReceiver: royaltyReceiver,
+				RoyaltyRate:     royaltyRate,
+				MintCap:         mintCap,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "The human-readable name of the class")
+	cmd.Flags().String(FlagSymbol, "", "The abbreviated name of the class")
+	cmd.Flags().String(FlagDescription, "", "A brief description of the class")
+	cmd.Flags().String(FlagURI, "", "The uri of the off chain class metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	cmd.Flags().String(FlagRoyaltyReceiver, "", "The address receiving royalties on secondary sales")
+	cmd.Flags().String(FlagRoyaltyRate, "", "The royalty rate owed to the royalty receiver, between 0 and 1")
+	cmd.Flags().Uint64(FlagMintCap, 0, "The maximum number of nfts of the class that may ever be minted, 0 for unlimited")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [class-id] [nft-id] [receiver] --from [issuer]",
+		Args:  cobra.ExactArgs(3),
+		Short: "mint a new nft of a class issued by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s mint <class-id> <nft-id> <receiver> --uri <uri> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+
+			msg := nft.MsgMintNFT{
+				Issuer:   clientCtx.GetFromAddress().String(),
+				ClassId:  args[0],
+				Id:       args[1],
+				Uri:      uri,
+				UriHash:  uriHash,
+				Receiver: args[2],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The uri of the off chain nft metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdBurn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [class-id] [nft-id] --from [owner]",
+		Args:  cobra.ExactArgs(2),
+		Short: "burn a nft owned by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s burn <class-id> <nft-id> --from <owner> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := nft.MsgBurnNFT{
+				Owner:   clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdUpdate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [class-id] [nft-id] --from [issuer]",
+		Args:  cobra.ExactArgs(2),
+		Short: "update the metadata of a nft of a class issued by the sender",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s update <class-id> <nft-id> --uri <uri> --uri-hash <uri-hash> --from <issuer> --chain-id <chain-id>`, version.AppName, nft.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			uri, _ := cmd.Flags().GetString(FlagURI)
+			uriHash, _ := cmd.Flags().GetString(FlagURIHash)
+
+			msg := nft.MsgUpdateNFT{
+				Issuer:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Id:      args[1],
+				Uri:     uri,
+				UriHash: uriHash,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagURI, "", "The new uri of the off chain nft metadata")
+	cmd.Flags().String(FlagURIHash, "", "The hash of the document pointed by uri")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
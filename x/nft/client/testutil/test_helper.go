@@ -16,6 +16,16 @@ func ExecSend(val *network.Validator, args []string) (testutil.BufferWriter, err
 	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
 }
 
+func ExecCreateClass(val *network.Validator, args []string) (testutil.BufferWriter, error) {
+	cmd := cli.NewCmdCreateClass()
+	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+}
+
+func ExecMint(val *network.Validator, args []string) (testutil.BufferWriter, error) {
+	cmd := cli.NewCmdMint()
+	return clitestutil.ExecTestCLICmd(val.ClientCtx, cmd, args)
+}
+
 func ExecQueryClass(val *network.Validator, classID string) (testutil.BufferWriter, error) {
 	cmd := cli.GetCmdQueryClass()
 	var args []string
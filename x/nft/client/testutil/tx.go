@@ -11,6 +11,7 @@ import (
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/cosmos/cosmos-sdk/x/nft/client/cli"
 )
 
 const (
@@ -142,6 +143,58 @@ func (s *IntegrationTestSuite) TestCLITxSend() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCLITxCreateClass() {
+	val := s.network.Validators[0]
+	out, err := ExecCreateClass(val, []string{
+		"puppy",
+		fmt.Sprintf("--%s=%s", cli.FlagName, "Crypto Puppy"),
+		fmt.Sprintf("--%s=%s", cli.FlagRoyaltyReceiver, val.Address.String()),
+		fmt.Sprintf("--%s=%s", cli.FlagRoyaltyRate, "0.05"),
+		fmt.Sprintf("--%s=%d", cli.FlagMintCap, 100),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	})
+	s.Require().NoError(err)
+
+	tx, err := s.cfg.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	s.Require().Len(tx.GetMsgs(), 1)
+
+	msg, ok := tx.GetMsgs()[0].(*nft.MsgCreateClass)
+	s.Require().True(ok)
+	s.Require().Equal(val.Address.String(), msg.Issuer)
+	s.Require().Equal("puppy", msg.Id)
+	s.Require().Equal("Crypto Puppy", msg.Name)
+	s.Require().Equal(val.Address.String(), msg.RoyaltyReceiver)
+	s.Require().Equal(sdk.NewDecWithPrec(5, 2), *msg.RoyaltyRate)
+	s.Require().Equal(uint64(100), msg.MintCap)
+}
+
+func (s *IntegrationTestSuite) TestCLITxMint() {
+	val := s.network.Validators[0]
+	out, err := ExecMint(val, []string{
+		testClassID,
+		"kitty2",
+		s.owner.String(),
+		fmt.Sprintf("--%s=%s", cli.FlagURI, testURI),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagGenerateOnly),
+	})
+	s.Require().NoError(err)
+
+	tx, err := s.cfg.TxConfig.TxJSONDecoder()(out.Bytes())
+	s.Require().NoError(err)
+	s.Require().Len(tx.GetMsgs(), 1)
+
+	msg, ok := tx.GetMsgs()[0].(*nft.MsgMintNFT)
+	s.Require().True(ok)
+	s.Require().Equal(val.Address.String(), msg.Issuer)
+	s.Require().Equal(testClassID, msg.ClassId)
+	s.Require().Equal("kitty2", msg.Id)
+	s.Require().Equal(testURI, msg.Uri)
+	s.Require().Equal(s.owner.String(), msg.Receiver)
+}
+
 func (s *IntegrationTestSuite) initAccount() {
 	val := s.network.Validators[0]
 	ctx := val.ClientCtx
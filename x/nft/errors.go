@@ -13,4 +13,6 @@ var (
 	ErrNFTNotExists   = sdkerrors.Register(ModuleName, 6, "nft does not exist")
 	ErrInvalidID      = sdkerrors.Register(ModuleName, 7, "invalid id")
 	ErrInvalidClassID = sdkerrors.Register(ModuleName, 8, "invalid class id")
+	ErrMintCapReached = sdkerrors.Register(ModuleName, 9, "nft class mint cap reached")
+	ErrInvalidRoyalty = sdkerrors.Register(ModuleName, 10, "invalid royalty")
 )
@@ -213,31 +213,142 @@ func (m *EventBurn) GetOwner() string {
 	return ""
 }
 
+// EventCreateClass is emitted on Msg/CreateClass
+type EventCreateClass struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Issuer  string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+}
+
+func (m *EventCreateClass) Reset()         { *m = EventCreateClass{} }
+func (m *EventCreateClass) String() string { return proto.CompactTextString(m) }
+func (*EventCreateClass) ProtoMessage()    {}
+func (*EventCreateClass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49f05440d2b8ed9d, []int{3}
+}
+func (m *EventCreateClass) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateClass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateClass.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateClass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateClass.Merge(m, src)
+}
+func (m *EventCreateClass) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateClass) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateClass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateClass proto.InternalMessageInfo
+
+func (m *EventCreateClass) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *EventCreateClass) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+// EventUpdate is emitted on Msg/UpdateNFT
+type EventUpdate struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *EventUpdate) Reset()         { *m = EventUpdate{} }
+func (m *EventUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventUpdate) ProtoMessage()    {}
+func (*EventUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49f05440d2b8ed9d, []int{4}
+}
+func (m *EventUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdate.Merge(m, src)
+}
+func (m *EventUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdate proto.InternalMessageInfo
+
+func (m *EventUpdate) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *EventUpdate) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventSend)(nil), "cosmos.nft.v1beta1.EventSend")
 	proto.RegisterType((*EventMint)(nil), "cosmos.nft.v1beta1.EventMint")
 	proto.RegisterType((*EventBurn)(nil), "cosmos.nft.v1beta1.EventBurn")
+	proto.RegisterType((*EventCreateClass)(nil), "cosmos.nft.v1beta1.EventCreateClass")
+	proto.RegisterType((*EventUpdate)(nil), "cosmos.nft.v1beta1.EventUpdate")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/event.proto", fileDescriptor_49f05440d2b8ed9d) }
 
 var fileDescriptor_49f05440d2b8ed9d = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x2d, 0x4b, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xc8, 0xeb, 0xe5,
-	0xa5, 0x95, 0xe8, 0x41, 0xe5, 0x95, 0xb2, 0xb8, 0x38, 0x5d, 0x41, 0x4a, 0x82, 0x53, 0xf3, 0x52,
-	0x84, 0x24, 0xb9, 0x38, 0x92, 0x73, 0x12, 0x8b, 0x8b, 0xe3, 0x33, 0x53, 0x24, 0x18, 0x15, 0x18,
-	0x35, 0x38, 0x83, 0xd8, 0xc1, 0x7c, 0xcf, 0x14, 0x21, 0x3e, 0x2e, 0xa6, 0xcc, 0x14, 0x09, 0x26,
-	0xb0, 0x20, 0x53, 0x66, 0x8a, 0x90, 0x18, 0x17, 0x5b, 0x71, 0x6a, 0x5e, 0x4a, 0x6a, 0x91, 0x04,
-	0x33, 0x58, 0x0c, 0xca, 0x13, 0x92, 0xe2, 0xe2, 0x28, 0x4a, 0x4d, 0x4e, 0xcd, 0x2c, 0x4b, 0x2d,
-	0x92, 0x60, 0x01, 0xcb, 0xc0, 0xf9, 0x4a, 0x3e, 0x50, 0xbb, 0x7c, 0x33, 0xf3, 0x4a, 0x48, 0xb1,
-	0x4b, 0x84, 0x8b, 0x35, 0xbf, 0x3c, 0x0f, 0x6e, 0x15, 0x84, 0x03, 0x37, 0xcd, 0xa9, 0xb4, 0x28,
-	0x8f, 0x62, 0xd3, 0x9c, 0x6c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x29, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0xc0, 0x10, 0x4a,
-	0xb7, 0x38, 0x25, 0x5b, 0xbf, 0x02, 0x14, 0xda, 0x49, 0x6c, 0xe0, 0x00, 0x36, 0x06, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0x29, 0x44, 0x2b, 0xe7, 0x82, 0x01, 0x00, 0x00,
+	// 276 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x31, 0x4b, 0xc4, 0x30,
+	0x14, 0xc7, 0xaf, 0x55, 0xeb, 0xdd, 0x13, 0x44, 0x82, 0x1c, 0xd5, 0x21, 0x48, 0x27, 0x17, 0x1b,
+	0x0e, 0x17, 0x07, 0xa7, 0x3b, 0x6e, 0x10, 0x74, 0x51, 0x5c, 0x5c, 0xa4, 0x6d, 0xde, 0x69, 0xd4,
+	0x4b, 0x8e, 0x24, 0xad, 0x7e, 0x0c, 0x3f, 0x96, 0xe3, 0x8d, 0x8e, 0xd2, 0x7e, 0x11, 0x69, 0x1a,
+	0x3a, 0x0a, 0x87, 0x53, 0xf8, 0xbd, 0x97, 0xfc, 0xfe, 0x81, 0x3f, 0xd0, 0x42, 0x99, 0xa5, 0x32,
+	0x4c, 0x2e, 0x2c, 0xab, 0x26, 0x39, 0xda, 0x6c, 0xc2, 0xb0, 0x42, 0x69, 0xd3, 0x95, 0x56, 0x56,
+	0x11, 0xd2, 0xed, 0x53, 0xb9, 0xb0, 0xa9, 0xdf, 0x27, 0x2f, 0x30, 0x9a, 0xb7, 0x57, 0xee, 0x50,
+	0x72, 0x72, 0x04, 0xc3, 0xe2, 0x2d, 0x33, 0xe6, 0x51, 0xf0, 0x38, 0x38, 0x09, 0x4e, 0x47, 0xb7,
+	0xbb, 0x8e, 0xaf, 0x38, 0xd9, 0x87, 0x50, 0xf0, 0x38, 0x74, 0xc3, 0x50, 0x70, 0x32, 0x86, 0xc8,
+	0xa0, 0xe4, 0xa8, 0xe3, 0x2d, 0x37, 0xf3, 0x44, 0x8e, 0x61, 0xa8, 0xb1, 0x40, 0x51, 0xa1, 0x8e,
+	0xb7, 0xdd, 0xa6, 0xe7, 0xe4, 0xda, 0x67, 0xdd, 0x08, 0x69, 0x37, 0xc9, 0x3a, 0x84, 0x1d, 0xf5,
+	0x2e, 0xfb, 0xa8, 0x0e, 0x7a, 0xdb, 0xb4, 0xd4, 0xf2, 0xff, 0xb6, 0x39, 0x1c, 0x38, 0xdb, 0x4c,
+	0x63, 0x66, 0x71, 0xd6, 0xbe, 0xfd, 0x4b, 0x3a, 0x86, 0x48, 0x18, 0x53, 0xa2, 0xf6, 0x62, 0x4f,
+	0xc9, 0x05, 0xec, 0x39, 0xcd, 0xfd, 0x8a, 0x67, 0x16, 0x37, 0xf8, 0xd6, 0xf4, 0xf2, 0xab, 0xa6,
+	0xc1, 0xba, 0xa6, 0xc1, 0x4f, 0x4d, 0x83, 0xcf, 0x86, 0x0e, 0xd6, 0x0d, 0x1d, 0x7c, 0x37, 0x74,
+	0xf0, 0x90, 0x3c, 0x09, 0xfb, 0x5c, 0xe6, 0x69, 0xa1, 0x96, 0xcc, 0x37, 0xdc, 0x1d, 0x67, 0x86,
+	0xbf, 0xb2, 0x8f, 0xb6, 0xee, 0x3c, 0x72, 0x0d, 0x9f, 0xff, 0x0e, 0x00, 0x23, 0x53, 0x4b, 0x67,
+	0x03, 0x02, 0x00, 0x00,
 }
 
 func (m *EventSend) Marshal() (dAtA []byte, err error) {
@@ -379,6 +490,80 @@ func (m *EventBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCreateClass) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateClass) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateClass) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvent(v)
 	base := offset
@@ -457,6 +642,40 @@ func (m *EventBurn) Size() (n int) {
 	return n
 }
 
+func (m *EventCreateClass) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
 func sovEvent(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -933,6 +1152,234 @@ func (m *EventBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCreateClass) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateClass: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateClass: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvent(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
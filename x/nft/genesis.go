@@ -2,15 +2,27 @@ package nft
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // ValidateGenesis check the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState) error {
+	mintCaps := make(map[string]uint64, len(data.Classes))
 	for _, class := range data.Classes {
 		if err := ValidateClassID(class.Id); err != nil {
 			return err
 		}
+		if class.Issuer != "" {
+			if _, err := sdk.AccAddressFromBech32(class.Issuer); err != nil {
+				return err
+			}
+		}
+		if err := ValidateRoyalty(class.RoyaltyReceiver, class.RoyaltyRate); err != nil {
+			return err
+		}
+		mintCaps[class.Id] = class.MintCap
 	}
+	supplies := make(map[string]uint64)
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
 			if err := ValidateNFTID(nft.Id); err != nil {
@@ -19,6 +31,21 @@ func ValidateGenesis(data GenesisState) error {
 			if _, err := sdk.AccAddressFromBech32(entry.Owner); err != nil {
 				return err
 			}
+			supplies[nft.ClassId]++
+		}
+	}
+	for _, minted := range data.Minted {
+		if err := ValidateClassID(minted.ClassId); err != nil {
+			return err
+		}
+		if minted.Count < supplies[minted.ClassId] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("class %s minted %d nfts but has a supply of %d", minted.ClassId, minted.Count, supplies[minted.ClassId])
+		}
+		supplies[minted.ClassId] = minted.Count
+	}
+	for classID, count := range supplies {
+		if limit := mintCaps[classID]; limit > 0 && count > limit {
+			return sdkerrors.Wrapf(ErrMintCapReached, "class %s is capped at %d nfts", classID, limit)
 		}
 	}
 	return nil
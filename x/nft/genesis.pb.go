@@ -27,6 +27,8 @@ type GenesisState struct {
 	// class defines the class of the nft type.
 	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	Entries []*Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// minted defines the number of nfts ever minted per class, including the burned ones.
+	Minted []*Minted `protobuf:"bytes,3,rep,name=minted,proto3" json:"minted,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +78,66 @@ func (m *GenesisState) GetEntries() []*Entry {
 	return nil
 }
 
+func (m *GenesisState) GetMinted() []*Minted {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+// Minted defines the number of nfts ever minted of a class
+type Minted struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Count   uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *Minted) Reset()         { *m = Minted{} }
+func (m *Minted) String() string { return proto.CompactTextString(m) }
+func (*Minted) ProtoMessage()    {}
+func (*Minted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0095f7548e354a72, []int{1}
+}
+func (m *Minted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Minted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Minted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Minted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Minted.Merge(m, src)
+}
+func (m *Minted) XXX_Size() int {
+	return m.Size()
+}
+func (m *Minted) XXX_DiscardUnknown() {
+	xxx_messageInfo_Minted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Minted proto.InternalMessageInfo
+
+func (m *Minted) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *Minted) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
 // Entry Defines all nft owned by a person
 type Entry struct {
 	// owner is the owner address of the following nft
@@ -88,7 +150,7 @@ func (m *Entry) Reset()         { *m = Entry{} }
 func (m *Entry) String() string { return proto.CompactTextString(m) }
 func (*Entry) ProtoMessage()    {}
 func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0095f7548e354a72, []int{1}
+	return fileDescriptor_0095f7548e354a72, []int{2}
 }
 func (m *Entry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -133,28 +195,33 @@ func (m *Entry) GetNfts() []*NFT {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.nft.v1beta1.GenesisState")
+	proto.RegisterType((*Minted)(nil), "cosmos.nft.v1beta1.Minted")
 	proto.RegisterType((*Entry)(nil), "cosmos.nft.v1beta1.Entry")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/genesis.proto", fileDescriptor_0095f7548e354a72) }
 
 var fileDescriptor_0095f7548e354a72 = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
+	// 298 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
 	0xcd, 0x2f, 0xd6, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
 	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
 	0xd0, 0xcb, 0x4b, 0x2b, 0xd1, 0x83, 0xaa, 0x90, 0x92, 0xc1, 0xa2, 0x0b, 0x24, 0x0f, 0xd6, 0xa1,
-	0x54, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x22, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x98, 0x8b, 0x3d,
-	0x39, 0x27, 0xb1, 0xb8, 0x38, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x52, 0x0f,
-	0xd3, 0x4c, 0x3d, 0x67, 0x90, 0x92, 0x20, 0x98, 0x4a, 0x90, 0xa6, 0xd4, 0xbc, 0x92, 0xa2, 0xcc,
-	0xd4, 0x62, 0x09, 0x26, 0xdc, 0x9a, 0x5c, 0xf3, 0x4a, 0x8a, 0x2a, 0x83, 0x60, 0x2a, 0x95, 0xbc,
-	0xb8, 0x58, 0xc1, 0x22, 0x42, 0x22, 0x5c, 0xac, 0xf9, 0xe5, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x36, 0x17, 0x4b, 0x5e, 0x5a, 0x09, 0xcc, 0x40, 0x71,
-	0x6c, 0x06, 0xfa, 0xb9, 0x85, 0x04, 0x81, 0x15, 0x39, 0xd9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x52, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae,
-	0x3e, 0x34, 0x20, 0x20, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x05, 0x28, 0x24, 0x92, 0xd8, 0xc0,
-	0x41, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xee, 0x44, 0x0c, 0xb7, 0x60, 0x01, 0x00, 0x00,
+	0xb4, 0x86, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x46, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x31, 0x17,
+	0x7b, 0x72, 0x4e, 0x62, 0x71, 0x71, 0x6a, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0xa4,
+	0x1e, 0xa6, 0xa1, 0x7a, 0xce, 0x20, 0x25, 0x41, 0x30, 0x95, 0x20, 0x4d, 0xa9, 0x79, 0x25, 0x45,
+	0x99, 0xa9, 0xc5, 0x12, 0x4c, 0xb8, 0x35, 0xb9, 0xe6, 0x95, 0x14, 0x55, 0x06, 0xc1, 0x54, 0x0a,
+	0x19, 0x71, 0xb1, 0xe5, 0x66, 0xe6, 0x95, 0xa4, 0xa6, 0x48, 0x30, 0x83, 0xf5, 0x48, 0x61, 0xd3,
+	0xe3, 0x0b, 0x56, 0x11, 0x04, 0x55, 0xa9, 0x64, 0xc9, 0xc5, 0x06, 0x11, 0x11, 0x92, 0xe4, 0xe2,
+	0x00, 0xdb, 0x1e, 0x9f, 0x99, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x09, 0x75, 0x8d, 0x67, 0x8a,
+	0x90, 0x08, 0x17, 0x6b, 0x72, 0x7e, 0x69, 0x5e, 0x89, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x4b, 0x10,
+	0x84, 0xa3, 0xe4, 0xc5, 0xc5, 0x0a, 0x76, 0x00, 0x48, 0x3a, 0xbf, 0x3c, 0x2f, 0xb5, 0x08, 0xaa,
+	0x0d, 0xc2, 0x11, 0xd2, 0xe6, 0x62, 0xc9, 0x4b, 0x2b, 0x81, 0xb9, 0x5f, 0x1c, 0x9b, 0x5b, 0xfc,
+	0xdc, 0x42, 0x82, 0xc0, 0x8a, 0x9c, 0x6c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1,
+	0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e,
+	0x21, 0x4a, 0x29, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x1a, 0xf0,
+	0x10, 0x4a, 0xb7, 0x38, 0x25, 0x5b, 0xbf, 0x02, 0x14, 0xf2, 0x49, 0x6c, 0xe0, 0xa0, 0x37, 0x06,
+	0x0c, 0x00, 0x81, 0x7c, 0x5a, 0xa0, 0xd0, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -177,6 +244,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Entries) > 0 {
 		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +289,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Minted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Entry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -281,6 +397,28 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Minted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovGenesis(uint64(m.Count))
+	}
 	return n
 }
 
@@ -406,6 +544,141 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, &Minted{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Minted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,11 +23,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	// the burned nfts count toward the mint cap as well
+	for _, minted := range data.Minted {
+		if minted.Count > k.GetMinted(ctx, minted.ClassId) {
+			k.SetMinted(ctx, minted.ClassId, minted.Count)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 	classes := k.GetClasses(ctx)
+	minted := make([]*nft.Minted, 0)
+	for _, class := range classes {
+		if count := k.GetMinted(ctx, class.Id); count > 0 {
+			minted = append(minted, &nft.Minted{ClassId: class.Id, Count: count})
+		}
+	}
 	nftMap := make(map[string][]*nft.NFT)
 	for _, class := range classes {
 		nfts := k.GetNFTsOfClass(ctx, class.Id)
@@ -57,5 +69,6 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 	return &nft.GenesisState{
 		Classes: classes,
 		Entries: entries,
+		Minted:  minted,
 	}
 }
@@ -324,6 +324,10 @@ func (s *TestSuite) TestExportGenesis() {
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		Minted: []*nft.Minted{{
+			ClassId: testClassID,
+			Count:   1,
+		}},
 	}
 	genesis := s.app.NFTKeeper.ExportGenesis(s.ctx)
 	s.Require().Equal(expGenesis, genesis)
@@ -349,6 +353,10 @@ func (s *TestSuite) TestInitGenesis() {
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		Minted: []*nft.Minted{{
+			ClassId: testClassID,
+			Count:   2,
+		}},
 	}
 	s.app.NFTKeeper.InitGenesis(s.ctx, expGenesis)
 
@@ -360,4 +368,7 @@ func (s *TestSuite) TestInitGenesis() {
 	actNFT, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
 	s.Require().True(has)
 	s.Require().EqualValues(expNFT, actNFT)
+
+	// the burned nfts still count as minted
+	s.Require().EqualValues(2, s.app.NFTKeeper.GetMinted(s.ctx, testClassID))
 }
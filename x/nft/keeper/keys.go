@@ -15,6 +15,7 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassMinted          = []byte{0x06}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -48,6 +49,14 @@ func classTotalSupply(classID string) []byte {
 	return key
 }
 
+// classMinted returns the byte representation of the ClassMinted
+func classMinted(classID string) []byte {
+	key := make([]byte, len(ClassMinted)+len(classID))
+	copy(key, ClassMinted)
+	copy(key[len(ClassMinted):], classID)
+	return key
+}
+
 // nftOfClassByOwnerStoreKey returns the byte representation of the nft owner
 // Items are stored with the following key: values
 // 0x03<owner><Delimiter(1 Byte)><classID><Delimiter(1 Byte)>
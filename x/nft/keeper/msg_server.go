@@ -40,3 +40,112 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implement CreateClass method of the types.MsgServer.
+func (k Keeper) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	class := nft.Class{
+		Id:              msg.Id,
+		Name:            msg.Name,
+		Symbol:          msg.Symbol,
+		Description:     msg.Description,
+		Uri:             msg.Uri,
+		UriHash:         msg.UriHash,
+		Issuer:          msg.Issuer,
+		RoyaltyReceiver: msg.RoyaltyReceiver,
+		RoyaltyRate:     msg.RoyaltyRate,
+		MintCap:         msg.MintCap,
+	}
+	if err := k.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: msg.Id,
+		Issuer:  msg.Issuer,
+	})
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// MintNFT implement MintNFT method of the types.MsgServer.
+func (k Keeper) MintNFT(goCtx context.Context, msg *nft.MsgMintNFT) (*nft.MsgMintNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertIssuer(ctx, msg.ClassId, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+	}
+	if err := k.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+	return &nft.MsgMintNFTResponse{}, nil
+}
+
+// BurnNFT implement BurnNFT method of the types.MsgServer.
+func (k Keeper) BurnNFT(goCtx context.Context, msg *nft.MsgBurnNFT) (*nft.MsgBurnNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !owner.Equals(sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	}
+
+	if err := k.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+	return &nft.MsgBurnNFTResponse{}, nil
+}
+
+// UpdateNFT implement UpdateNFT method of the types.MsgServer.
+func (k Keeper) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.assertIssuer(ctx, msg.ClassId, msg.Issuer); err != nil {
+		return nil, err
+	}
+
+	token, has := k.GetNFT(ctx, msg.ClassId, msg.Id)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrNFTNotExists, msg.Id)
+	}
+	token.Uri = msg.Uri
+	token.UriHash = msg.UriHash
+	if err := k.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+	})
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
+
+// assertIssuer checks that the given address is the issuer of the class
+func (k Keeper) assertIssuer(ctx sdk.Context, classID, issuer string) error {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+	if class.Issuer == "" || class.Issuer != issuer {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the issuer of class %s", issuer, classID)
+	}
+	return nil
+}
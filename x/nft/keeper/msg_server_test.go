@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (s *TestSuite) TestCreateClass() {
+	issuer := s.addrs[0].String()
+	royaltyRate := sdk.NewDecWithPrec(5, 2)
+
+	testCases := []struct {
+		msg    string
+		req    *nft.MsgCreateClass
+		expErr error
+	}{
+		{
+			"valid class",
+			&nft.MsgCreateClass{
+				Issuer:          issuer,
+				Id:              testClassID,
+				Name:            testClassName,
+				Symbol:          testClassSymbol,
+				RoyaltyReceiver: s.addrs[1].String(),
+				RoyaltyRate:     &royaltyRate,
+				MintCap:         1,
+			},
+			nil,
+		},
+		{
+			"class already exists",
+			&nft.MsgCreateClass{
+				Issuer: s.addrs[1].String(),
+				Id:     testClassID,
+			},
+			nft.ErrClassExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.msg, func() {
+			_, err := s.app.NFTKeeper.CreateClass(sdk.WrapSDKContext(s.ctx), tc.req)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			class, has := s.app.NFTKeeper.GetClass(s.ctx, tc.req.Id)
+			s.Require().True(has)
+			s.Require().Equal(tc.req.Issuer, class.Issuer)
+			s.Require().Equal(tc.req.RoyaltyReceiver, class.RoyaltyReceiver)
+			s.Require().True(tc.req.RoyaltyRate.Equal(*class.RoyaltyRate))
+			s.Require().Equal(tc.req.MintCap, class.MintCap)
+		})
+	}
+}
+
+func (s *TestSuite) TestMintNFTMsg() {
+	issuer, other := s.addrs[0], s.addrs[1]
+	_, err := s.app.NFTKeeper.CreateClass(sdk.WrapSDKContext(s.ctx), &nft.MsgCreateClass{
+		Issuer:  issuer.String(),
+		Id:      testClassID,
+		MintCap: 1,
+	})
+	s.Require().NoError(err)
+
+	// only the issuer may mint
+	_, err = s.app.NFTKeeper.MintNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgMintNFT{
+		Issuer:   other.String(),
+		ClassId:  testClassID,
+		Id:       testID,
+		Receiver: other.String(),
+	})
+	s.Require().Error(err)
+
+	_, err = s.app.NFTKeeper.MintNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgMintNFT{
+		Issuer:   issuer.String(),
+		ClassId:  testClassID,
+		Id:       testID,
+		Uri:      testURI,
+		Receiver: other.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(other, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// the mint cap of the class is reached
+	_, err = s.app.NFTKeeper.MintNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgMintNFT{
+		Issuer:   issuer.String(),
+		ClassId:  testClassID,
+		Id:       "kitty2",
+		Receiver: other.String(),
+	})
+	s.Require().ErrorIs(err, nft.ErrMintCapReached)
+
+	// burning does not free room for another mint
+	s.Require().NoError(s.app.NFTKeeper.Burn(s.ctx, testClassID, testID))
+	s.Require().Equal(uint64(1), s.app.NFTKeeper.GetMinted(s.ctx, testClassID))
+	_, err = s.app.NFTKeeper.MintNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgMintNFT{
+		Issuer:   issuer.String(),
+		ClassId:  testClassID,
+		Id:       "kitty2",
+		Receiver: other.String(),
+	})
+	s.Require().ErrorIs(err, nft.ErrMintCapReached)
+
+	// classes without an issuer can not be minted through messages
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: "puppy"}))
+	_, err = s.app.NFTKeeper.MintNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgMintNFT{
+		Issuer:   issuer.String(),
+		ClassId:  "puppy",
+		Id:       "puppy1",
+		Receiver: other.String(),
+	})
+	s.Require().Error(err)
+}
+
+func (s *TestSuite) TestBurnNFTMsg() {
+	issuer, owner := s.addrs[0], s.addrs[1]
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Issuer: issuer.String()}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, owner))
+
+	// the issuer is not the owner of the nft
+	_, err := s.app.NFTKeeper.BurnNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgBurnNFT{
+		Owner:   issuer.String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().Error(err)
+
+	_, err = s.app.NFTKeeper.BurnNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgBurnNFT{
+		Owner:   owner.String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.app.NFTKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().EqualValues(0, s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestUpdateNFTMsg() {
+	issuer, owner := s.addrs[0], s.addrs[1]
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Issuer: issuer.String()}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID, Uri: testURI}, owner))
+
+	// the owner is not the issuer of the class
+	_, err := s.app.NFTKeeper.UpdateNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgUpdateNFT{
+		Issuer:  owner.String(),
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     "new uri",
+	})
+	s.Require().Error(err)
+
+	_, err = s.app.NFTKeeper.UpdateNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgUpdateNFT{
+		Issuer:  issuer.String(),
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     "new uri",
+		UriHash: testURIHash,
+	})
+	s.Require().NoError(err)
+
+	token, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal("new uri", token.Uri)
+	s.Require().Equal(testURIHash, token.UriHash)
+	s.Require().Equal(owner, s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+}
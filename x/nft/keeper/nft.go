@@ -8,8 +8,11 @@ import (
 )
 
 // Mint defines a method for minting a new nft
+// Note: the mint cap of the class is enforced, but the caller is responsible for
+// checking that the minter is allowed to issue nfts of the class
 func (k Keeper) Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error {
-	if !k.HasClass(ctx, token.ClassId) {
+	class, has := k.GetClass(ctx, token.ClassId)
+	if !has {
 		return sdkerrors.Wrap(nft.ErrClassNotExists, token.ClassId)
 	}
 
@@ -17,9 +20,14 @@ func (k Keeper) Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) er
 		return sdkerrors.Wrap(nft.ErrNFTExists, token.Id)
 	}
 
+	if class.MintCap > 0 && k.GetMinted(ctx, token.ClassId) >= class.MintCap {
+		return sdkerrors.Wrapf(nft.ErrMintCapReached, "class %s is capped at %d nfts", token.ClassId, class.MintCap)
+	}
+
 	k.setNFT(ctx, token)
 	k.setOwner(ctx, token.ClassId, token.Id, receiver)
 	k.incrTotalSupply(ctx, token.ClassId)
+	k.SetMinted(ctx, token.ClassId, k.GetMinted(ctx, token.ClassId)+1)
 
 	ctx.EventManager().EmitTypedEvent(&nft.EventMint{
 		ClassId: token.ClassId,
@@ -148,6 +156,20 @@ func (k Keeper) GetTotalSupply(ctx sdk.Context, classID string) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// GetMinted returns the number of nfts ever minted under the specified classID,
+// including the burned ones
+func (k Keeper) GetMinted(ctx sdk.Context, classID string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(classMinted(classID))
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetMinted sets the number of nfts ever minted under the specified classID
+func (k Keeper) SetMinted(ctx sdk.Context, classID string, minted uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(classMinted(classID), sdk.Uint64ToBigEndian(minted))
+}
+
 // HasNFT determines whether the specified classID and nftID exist
 func (k Keeper) HasNFT(ctx sdk.Context, classID, id string) bool {
 	store := k.getNFTStore(ctx, classID)
@@ -7,10 +7,20 @@ import (
 
 const (
 	// TypeMsgSend nft message types
-	TypeMsgSend = "send"
+	TypeMsgSend        = "send"
+	TypeMsgCreateClass = "create_class"
+	TypeMsgMintNFT     = "mint_nft"
+	TypeMsgBurnNFT     = "burn_nft"
+	TypeMsgUpdateNFT   = "update_nft"
 )
 
-var _ sdk.Msg = &MsgSend{}
+var (
+	_ sdk.Msg = &MsgSend{}
+	_ sdk.Msg = &MsgCreateClass{}
+	_ sdk.Msg = &MsgMintNFT{}
+	_ sdk.Msg = &MsgBurnNFT{}
+	_ sdk.Msg = &MsgUpdateNFT{}
+)
 
 // ValidateBasic implements the Msg.ValidateBasic method.
 func (m MsgSend) ValidateBasic() error {
@@ -39,3 +49,92 @@ func (m MsgSend) GetSigners() []sdk.AccAddress {
 	signer, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{signer}
 }
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgCreateClass) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", m.Issuer)
+	}
+
+	if err := ValidateClassID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "Invalid class id (%s)", m.Id)
+	}
+
+	return ValidateRoyalty(m.RoyaltyReceiver, m.RoyaltyRate)
+}
+
+// GetSigners implements Msg
+func (m MsgCreateClass) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgMintNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", m.Issuer)
+	}
+
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Receiver); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", m.Receiver)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgMintNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgBurnNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", m.Owner)
+	}
+
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgBurnNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{signer}
+}
+
+// ValidateBasic implements the Msg.ValidateBasic method.
+func (m MsgUpdateNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid issuer address (%s)", m.Issuer)
+	}
+
+	if err := ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidClassID, "Invalid class id (%s)", m.ClassId)
+	}
+
+	if err := ValidateNFTID(m.Id); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidID, "Invalid nft id (%s)", m.Id)
+	}
+	return nil
+}
+
+// GetSigners implements Msg
+func (m MsgUpdateNFT) GetSigners() []sdk.AccAddress {
+	signer, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{signer}
+}
@@ -5,7 +5,10 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -39,6 +42,16 @@ type Class struct {
 	UriHash string `protobuf:"bytes,6,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	// data is the app specific metadata of the NFT class. Optional
 	Data *types.Any `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	// issuer is the address allowed to mint and update NFTs of this class. Classes
+	// without an issuer can only be minted through the keeper by other modules. Optional
+	Issuer string `protobuf:"bytes,8,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// royalty_receiver is the address that should receive royalties on secondary sales. Optional
+	RoyaltyReceiver string `protobuf:"bytes,9,opt,name=royalty_receiver,json=royaltyReceiver,proto3" json:"royalty_receiver,omitempty"`
+	// royalty_rate is the fraction of a sale price owed to royalty_receiver, in the range [0, 1]. Optional
+	RoyaltyRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=royalty_rate,json=royaltyRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"royalty_rate,omitempty"`
+	// mint_cap is the maximum number of NFTs of this class that may ever be minted, burned
+	// NFTs still count toward it. Zero means unlimited. Optional
+	MintCap uint64 `protobuf:"varint,11,opt,name=mint_cap,json=mintCap,proto3" json:"mint_cap,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -123,6 +136,27 @@ func (m *Class) GetData() *types.Any {
 	return nil
 }
 
+func (m *Class) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *Class) GetRoyaltyReceiver() string {
+	if m != nil {
+		return m.RoyaltyReceiver
+	}
+	return ""
+}
+
+func (m *Class) GetMintCap() uint64 {
+	if m != nil {
+		return m.MintCap
+	}
+	return 0
+}
+
 // NFT defines the NFT.
 type NFT struct {
 	// class_id associated with the NFT, similar to the contract address of ERC721
@@ -213,27 +247,36 @@ func init() {
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 317 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x97, 0xb6, 0xdb, 0x34, 0x03, 0x91, 0x20, 0x92, 0x89, 0x84, 0xb1, 0xd3, 0x2e, 0x26,
-	0x4c, 0xaf, 0x5e, 0x54, 0x10, 0xbd, 0x78, 0x18, 0x9e, 0xbc, 0x8c, 0xb4, 0xe9, 0xd6, 0xe0, 0xda,
-	0x8c, 0x26, 0x15, 0xfb, 0x04, 0x5e, 0x7d, 0x20, 0x1f, 0xc0, 0xe3, 0x8e, 0x1e, 0xa5, 0x7d, 0x11,
-	0x49, 0x5a, 0x87, 0x87, 0x81, 0xa7, 0xfc, 0xf3, 0x7d, 0x1f, 0x7f, 0x7e, 0x1f, 0x7f, 0x78, 0x1a,
-	0x29, 0x9d, 0x2a, 0xcd, 0xb2, 0x85, 0x61, 0x2f, 0xd3, 0x30, 0x36, 0x7c, 0x6a, 0x67, 0xba, 0xce,
-	0x95, 0x51, 0x08, 0x35, 0x2e, 0xb5, 0x4a, 0xeb, 0x9e, 0x0c, 0x97, 0x4a, 0x2d, 0x57, 0x31, 0x73,
-	0x89, 0xb0, 0x58, 0x30, 0x9e, 0x95, 0x4d, 0x7c, 0xfc, 0x01, 0x60, 0xf7, 0x66, 0xc5, 0xb5, 0x46,
-	0x07, 0xd0, 0x93, 0x02, 0x83, 0x11, 0x98, 0xec, 0xcf, 0x3c, 0x29, 0x10, 0x82, 0x41, 0xc6, 0xd3,
-	0x18, 0x7b, 0x4e, 0x71, 0x33, 0x3a, 0x86, 0x3d, 0x5d, 0xa6, 0xa1, 0x5a, 0x61, 0xdf, 0xa9, 0xed,
-	0x0f, 0x8d, 0xe0, 0x40, 0xc4, 0x3a, 0xca, 0xe5, 0xda, 0x48, 0x95, 0xe1, 0xc0, 0x99, 0x7f, 0x25,
-	0x74, 0x08, 0xfd, 0x22, 0x97, 0xb8, 0xeb, 0x1c, 0x3b, 0xa2, 0x21, 0xdc, 0x2b, 0x72, 0x39, 0x4f,
-	0xb8, 0x4e, 0x70, 0xcf, 0xc9, 0xfd, 0x22, 0x97, 0x77, 0x5c, 0x27, 0x68, 0x02, 0x03, 0xc1, 0x0d,
-	0xc7, 0xfd, 0x11, 0x98, 0x0c, 0xce, 0x8f, 0x68, 0x83, 0x4f, 0x7f, 0xf1, 0xe9, 0x55, 0x56, 0xce,
-	0x5c, 0x62, 0xfc, 0x06, 0xa0, 0xff, 0x70, 0xfb, 0x68, 0x97, 0x45, 0xb6, 0xc5, 0x7c, 0x5b, 0xa1,
-	0xef, 0xfe, 0xf7, 0xa2, 0xed, 0xe5, 0x6d, 0x7b, 0xb5, 0x24, 0xfe, 0x6e, 0x92, 0x60, 0x37, 0x09,
-	0xfc, 0x8f, 0xe4, 0xfa, 0xf2, 0xb3, 0x22, 0x60, 0x53, 0x11, 0xf0, 0x5d, 0x11, 0xf0, 0x5e, 0x93,
-	0xce, 0xa6, 0x26, 0x9d, 0xaf, 0x9a, 0x74, 0x9e, 0xc6, 0x4b, 0x69, 0x92, 0x22, 0xa4, 0x91, 0x4a,
-	0x59, 0x7b, 0xba, 0xe6, 0x39, 0xd3, 0xe2, 0x99, 0xbd, 0xda, 0xdb, 0x85, 0x3d, 0xb7, 0xf1, 0xe2,
-	0x27, 0x00, 0x00, 0xff, 0xff, 0x94, 0x4b, 0x2c, 0xc3, 0xdc, 0x01, 0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x63, 0x37, 0x69, 0x5f, 0x10, 0x54, 0xa7, 0x08, 0x5d, 0x2a, 0x64, 0xa2, 0x0c, 0x28,
+	0x4b, 0x6c, 0x0a, 0x0b, 0x03, 0x4b, 0x9b, 0x0a, 0xc1, 0xc2, 0x60, 0x98, 0x60, 0xb0, 0xce, 0xbe,
+	0xab, 0x73, 0x22, 0xf6, 0x59, 0x77, 0xe7, 0x0a, 0xff, 0x02, 0x56, 0xfe, 0x07, 0x6b, 0x7f, 0x04,
+	0x63, 0xd5, 0x09, 0x31, 0x20, 0x94, 0xfc, 0x11, 0x74, 0xe7, 0x6b, 0xc4, 0x50, 0xb5, 0x93, 0xdf,
+	0xfb, 0xbe, 0xef, 0x7d, 0xa7, 0xf7, 0x3d, 0xc3, 0x93, 0x5c, 0xa8, 0x52, 0xa8, 0xb8, 0x3a, 0xd7,
+	0xf1, 0xc5, 0x71, 0xc6, 0x34, 0x39, 0x36, 0x75, 0x54, 0x4b, 0xa1, 0x05, 0x42, 0x1d, 0x1b, 0x19,
+	0xc4, 0xb1, 0x47, 0x93, 0x42, 0x88, 0x62, 0xcd, 0x62, 0xab, 0xc8, 0x9a, 0xf3, 0x98, 0x54, 0x6d,
+	0x27, 0x3f, 0x1a, 0x17, 0xa2, 0x10, 0xb6, 0x8c, 0x4d, 0xe5, 0xd0, 0x49, 0x67, 0x92, 0x76, 0x84,
+	0x73, 0xb4, 0xcd, 0xec, 0x87, 0x0f, 0x7b, 0xcb, 0x35, 0x51, 0x0a, 0x3d, 0x84, 0x3e, 0xa7, 0xd8,
+	0x9b, 0x7a, 0xf3, 0x83, 0xa4, 0xcf, 0x29, 0x42, 0x10, 0x54, 0xa4, 0x64, 0xb8, 0x6f, 0x11, 0x5b,
+	0xa3, 0xc7, 0x30, 0x50, 0x6d, 0x99, 0x89, 0x35, 0xf6, 0x2d, 0xea, 0x3a, 0x34, 0x85, 0x11, 0x65,
+	0x2a, 0x97, 0xbc, 0xd6, 0x5c, 0x54, 0x38, 0xb0, 0xe4, 0xff, 0x10, 0x3a, 0x04, 0xbf, 0x91, 0x1c,
+	0xef, 0x59, 0xc6, 0x94, 0x68, 0x02, 0xfb, 0x8d, 0xe4, 0xe9, 0x8a, 0xa8, 0x15, 0x1e, 0x58, 0x78,
+	0xd8, 0x48, 0xfe, 0x96, 0xa8, 0x15, 0x9a, 0x43, 0x40, 0x89, 0x26, 0x78, 0x38, 0xf5, 0xe6, 0xa3,
+	0x17, 0xe3, 0xa8, 0xdb, 0x37, 0xba, 0xd9, 0x37, 0x3a, 0xa9, 0xda, 0xc4, 0x2a, 0xd0, 0x73, 0x18,
+	0x70, 0xa5, 0x1a, 0x26, 0xf1, 0xbe, 0xb1, 0x38, 0xc5, 0xd7, 0x97, 0x8b, 0xb1, 0x5b, 0xf0, 0x84,
+	0x52, 0xc9, 0x94, 0xfa, 0xa0, 0x25, 0xaf, 0x8a, 0xc4, 0xe9, 0xd0, 0x12, 0x0e, 0xa5, 0x68, 0xc9,
+	0x5a, 0xb7, 0xa9, 0x64, 0x39, 0xe3, 0x17, 0x4c, 0xe2, 0x83, 0x7b, 0x66, 0x1f, 0xb9, 0x89, 0xc4,
+	0x0d, 0xa0, 0xcf, 0xf0, 0x60, 0x67, 0x42, 0x34, 0xc3, 0x60, 0x0d, 0x5e, 0xfd, 0xfe, 0xf3, 0xf4,
+	0x59, 0xc1, 0xf5, 0xaa, 0xc9, 0xa2, 0x5c, 0x94, 0x2e, 0x68, 0xf7, 0x59, 0x28, 0xfa, 0x25, 0xd6,
+	0x6d, 0xcd, 0x54, 0x74, 0xc6, 0xf2, 0xeb, 0xcb, 0x05, 0xb8, 0xa7, 0xce, 0x58, 0x9e, 0x8c, 0x6e,
+	0x1e, 0x20, 0x9a, 0x99, 0x60, 0x4a, 0x5e, 0xe9, 0x34, 0x27, 0x35, 0x1e, 0x4d, 0xbd, 0x79, 0x90,
+	0x0c, 0x4d, 0xbf, 0x24, 0xf5, 0xec, 0x9b, 0x07, 0xfe, 0xfb, 0x37, 0x1f, 0x8d, 0x24, 0x37, 0x47,
+	0x4b, 0x77, 0x17, 0x1b, 0xda, 0xfe, 0x1d, 0x75, 0x67, 0xec, 0xef, 0xce, 0xe8, 0x82, 0xf7, 0x6f,
+	0x0f, 0x3e, 0xb8, 0x3d, 0x78, 0xb8, 0x2f, 0xf8, 0xd3, 0xd7, 0x3f, 0x37, 0xa1, 0x77, 0xb5, 0x09,
+	0xbd, 0xbf, 0x9b, 0xd0, 0xfb, 0xbe, 0x0d, 0x7b, 0x57, 0xdb, 0xb0, 0xf7, 0x6b, 0x1b, 0xf6, 0x3e,
+	0xcd, 0xee, 0x4c, 0xe0, 0xab, 0xf9, 0xb7, 0xb3, 0x81, 0x75, 0x7c, 0xf9, 0x6f, 0x00, 0xda, 0x2b,
+	0xf1, 0x2f, 0xfc, 0x02, 0x00, 0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -256,6 +299,37 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintCap != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MintCap))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RoyaltyRate != nil {
+		{
+			size := m.RoyaltyRate.Size()
+			i -= size
+			if _, err := m.RoyaltyRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RoyaltyReceiver) > 0 {
+		i -= len(m.RoyaltyReceiver)
+		copy(dAtA[i:], m.RoyaltyReceiver)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.RoyaltyReceiver)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Data != nil {
 		{
 			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
@@ -421,6 +495,21 @@ func (m *Class) Size() (n int) {
 		l = m.Data.Size()
 		n += 1 + l + sovNft(uint64(l))
 	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.RoyaltyReceiver)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.RoyaltyRate != nil {
+		l = m.RoyaltyRate.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.MintCap != 0 {
+		n += 1 + sovNft(uint64(m.MintCap))
+	}
 	return n
 }
 
@@ -716,6 +805,125 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoyaltyReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.RoyaltyRate = &v
+			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintCap", wireType)
+			}
+			m.MintCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintCap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -54,6 +54,7 @@ func TestDecodeStore(t *testing.T) {
 			{Key: []byte(keeper.NFTOfClassByOwnerKey), Value: nftOfClassByOwnerValue},
 			{Key: []byte(keeper.OwnerKey), Value: ownerAddr1},
 			{Key: []byte(keeper.ClassTotalSupply), Value: totalSupplyBz},
+			{Key: []byte(keeper.ClassMinted), Value: totalSupplyBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -68,6 +69,7 @@ func TestDecodeStore(t *testing.T) {
 		{"NFTOfClassByOwnerKey", false, fmt.Sprintf("%v\n%v", nftOfClassByOwnerValue, nftOfClassByOwnerValue)},
 		{"OwnerKey", false, fmt.Sprintf("%v\n%v", ownerAddr1, ownerAddr1)},
 		{"ClassTotalSupply", false, fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
+		{"ClassMinted", false, fmt.Sprintf("%v\n%v", totalSupply, totalSupply)},
 		{"other", true, ""},
 	}
 
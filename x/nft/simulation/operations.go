@@ -19,15 +19,29 @@ import (
 
 const (
 	// OpWeightMsgSend Simulation operation weights constants
-	OpWeightMsgSend = "op_weight_msg_send" //nolint:gosec
+	OpWeightMsgSend        = "op_weight_msg_send"         //nolint:gosec
+	OpWeightMsgCreateClass = "op_weight_msg_create_class" //nolint:gosec
+	OpWeightMsgMintNFT     = "op_weight_msg_mint_nft"     //nolint:gosec
+	OpWeightMsgBurnNFT     = "op_weight_msg_burn_nft"     //nolint:gosec
+	OpWeightMsgUpdateNFT   = "op_weight_msg_update_nft"   //nolint:gosec
 )
 
 const (
 	// WeightSend nft operations weights
-	WeightSend = 100
+	WeightSend        = 100
+	WeightCreateClass = 20
+	WeightMintNFT     = 80
+	WeightBurnNFT     = 20
+	WeightUpdateNFT   = 20
 )
 
-var TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
+var (
+	TypeMsgSend        = sdk.MsgTypeURL(&nft.MsgSend{})
+	TypeMsgCreateClass = sdk.MsgTypeURL(&nft.MsgCreateClass{})
+	TypeMsgMintNFT     = sdk.MsgTypeURL(&nft.MsgMintNFT{})
+	TypeMsgBurnNFT     = sdk.MsgTypeURL(&nft.MsgBurnNFT{})
+	TypeMsgUpdateNFT   = sdk.MsgTypeURL(&nft.MsgUpdateNFT{})
+)
 
 // WeightedOperations returns all the operations from the module with their respective weights
 func WeightedOperations(
@@ -38,7 +52,13 @@ func WeightedOperations(
 	bk nft.BankKeeper,
 	k keeper.Keeper,
 ) simulation.WeightedOperations {
-	var weightMsgSend int
+	var (
+		weightMsgSend        int
+		weightMsgCreateClass int
+		weightMsgMintNFT     int
+		weightMsgBurnNFT     int
+		weightMsgUpdateNFT   int
+	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
 		func(_ *rand.Rand) {
@@ -46,10 +66,51 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClass, &weightMsgCreateClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClass = WeightCreateClass
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMintNFT, &weightMsgMintNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintNFT = WeightMintNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurnNFT, &weightMsgBurnNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurnNFT = WeightBurnNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateNFT, &weightMsgUpdateNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateNFT = WeightUpdateNFT
+		},
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
-			SimulateMsgSend(codec.NewProtoCodec(registry), ak, bk, k),
+			SimulateMsgSend(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateClass,
+			SimulateMsgCreateClass(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintNFT,
+			SimulateMsgMintNFT(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateNFT,
+			SimulateMsgUpdateNFT(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurnNFT,
+			SimulateMsgBurnNFT(protoCdc, ak, bk, k),
 		),
 	}
 }
@@ -120,6 +181,207 @@ func SimulateMsgSend(
 	}
 }
 
+// SimulateMsgCreateClass generates a MsgCreateClass with random values.
+func SimulateMsgCreateClass(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, _ := simtypes.RandomAcc(r, accs)
+		royaltyReceiver, _ := simtypes.RandomAcc(r, accs)
+
+		classID := simtypes.RandStringOfLength(r, 10)
+		if k.HasClass(ctx, classID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgCreateClass, "class already exists"), nil, nil
+		}
+
+		royaltyRate := simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(1, 1))
+		msg := &nft.MsgCreateClass{
+			Issuer:          issuer.Address.String(),
+			Id:              classID,
+			Name:            simtypes.RandStringOfLength(r, 10),
+			Symbol:          simtypes.RandStringOfLength(r, 5),
+			Description:     simtypes.RandStringOfLength(r, 20),
+			Uri:             simtypes.RandStringOfLength(r, 10),
+			RoyaltyReceiver: royaltyReceiver.Address.String(),
+			RoyaltyRate:     &royaltyRate,
+			MintCap:         uint64(simtypes.RandIntBetween(r, 0, 100)),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       TypeMsgCreateClass,
+			Context:       ctx,
+			SimAccount:    issuer,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    nft.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgMintNFT generates a MsgMintNFT with random values.
+func SimulateMsgMintNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, issuer, found := randIssuedClass(ctx, r, k, accs, false)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMintNFT, "no class issued by a simulation account"), nil, nil
+		}
+		if class.MintCap > 0 && k.GetMinted(ctx, class.Id) >= class.MintCap {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMintNFT, "mint cap reached"), nil, nil
+		}
+
+		nftID := simtypes.RandStringOfLength(r, 10)
+		if k.HasNFT(ctx, class.Id, nftID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMintNFT, "nft already exists"), nil, nil
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+		msg := &nft.MsgMintNFT{
+			Issuer:   issuer.Address.String(),
+			ClassId:  class.Id,
+			Id:       nftID,
+			Uri:      simtypes.RandStringOfLength(r, 10),
+			Receiver: receiver.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       TypeMsgMintNFT,
+			Context:       ctx,
+			SimAccount:    issuer,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    nft.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgBurnNFT generates a MsgBurnNFT with random values.
+func SimulateMsgBurnNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		n, err := randNFT(ctx, r, k, owner.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgBurnNFT, err.Error()), nil, nil
+		}
+
+		msg := &nft.MsgBurnNFT{
+			Owner:   owner.Address.String(),
+			ClassId: n.ClassId,
+			Id:      n.Id,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       TypeMsgBurnNFT,
+			Context:       ctx,
+			SimAccount:    owner,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    nft.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgUpdateNFT generates a MsgUpdateNFT with random values.
+func SimulateMsgUpdateNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, issuer, found := randIssuedClass(ctx, r, k, accs, true)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateNFT, "no class with nfts issued by a simulation account"), nil, nil
+		}
+
+		nfts := k.GetNFTsOfClass(ctx, class.Id)
+		n := nfts[r.Intn(len(nfts))]
+
+		msg := &nft.MsgUpdateNFT{
+			Issuer:  issuer.Address.String(),
+			ClassId: n.ClassId,
+			Id:      n.Id,
+			Uri:     simtypes.RandStringOfLength(r, 10),
+			UriHash: simtypes.RandStringOfLength(r, 10),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       TypeMsgUpdateNFT,
+			Context:       ctx,
+			SimAccount:    issuer,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    nft.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randIssuedClass returns a random class whose issuer is one of the simulation accounts,
+// optionally restricted to classes that have at least one nft.
+func randIssuedClass(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, accs []simtypes.Account, withNFTs bool) (nft.Class, simtypes.Account, bool) {
+	var candidates []nft.Class
+	for _, c := range k.GetClasses(ctx) {
+		if c.Issuer != "" && (!withNFTs || k.GetTotalSupply(ctx, c.Id) > 0) {
+			candidates = append(candidates, *c)
+		}
+	}
+	r.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	for _, c := range candidates {
+		issuer, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(c.Issuer))
+		if found {
+			return c, issuer, true
+		}
+	}
+	return nft.Class{}, simtypes.Account{}, false
+}
+
 func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
 	c, err := randClass(ctx, r, k)
 	if err != nil {
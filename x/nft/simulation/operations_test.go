@@ -53,6 +53,10 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		opMsgName  string
 	}{
 		{simulation.WeightSend, simulation.TypeMsgSend, simulation.TypeMsgSend},
+		{simulation.WeightCreateClass, simulation.TypeMsgCreateClass, simulation.TypeMsgCreateClass},
+		{simulation.WeightMintNFT, simulation.TypeMsgMintNFT, simulation.TypeMsgMintNFT},
+		{simulation.WeightUpdateNFT, simulation.TypeMsgUpdateNFT, simulation.TypeMsgUpdateNFT},
+		{simulation.WeightBurnNFT, simulation.TypeMsgBurnNFT, simulation.TypeMsgBurnNFT},
 	}
 
 	for i, w := range weightedOps {
@@ -109,6 +113,67 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+func (suite *SimTestSuite) TestSimulateMsgCreateClass() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	registry := suite.app.InterfaceRegistry()
+	op := simulation.SimulateMsgCreateClass(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgCreateClass
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+
+	class, has := suite.app.NFTKeeper.GetClass(suite.ctx, msg.Id)
+	suite.Require().True(has)
+	suite.Require().Equal(msg.Issuer, class.Issuer)
+}
+
+func (suite *SimTestSuite) TestSimulateMsgMintNFT() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+
+	suite.Require().NoError(suite.app.NFTKeeper.SaveClass(suite.ctx, nft.Class{
+		Id:     "kitty",
+		Issuer: accounts[0].Address.String(),
+	}))
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	registry := suite.app.InterfaceRegistry()
+	op := simulation.SimulateMsgMintNFT(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgMintNFT
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Len(futureOperations, 0)
+	suite.Require().Equal("kitty", msg.ClassId)
+	suite.Require().Equal(accounts[0].Address.String(), msg.Issuer)
+}
+
 func TestSimTestSuite(t *testing.T) {
 	suite.Run(t, new(SimTestSuite))
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -33,3 +34,21 @@ func ValidateNFTID(id string) error {
 	}
 	return nil
 }
+
+// ValidateRoyalty returns whether the royalty receiver and rate of a class are consistent.
+// Both fields are optional, but a rate must come with a receiver and lie in [0, 1].
+func ValidateRoyalty(receiver string, rate *sdk.Dec) error {
+	if rate == nil || rate.IsNil() {
+		if receiver != "" {
+			return sdkerrors.Wrap(ErrInvalidRoyalty, "royalty receiver set without a royalty rate")
+		}
+		return nil
+	}
+	if rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "royalty rate must be between 0 and 1: %s", rate)
+	}
+	if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "invalid royalty receiver address (%s)", receiver)
+	}
+	return nil
+}
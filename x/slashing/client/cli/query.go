@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQuerySigningInfo(),
 		GetCmdQueryParams(),
 		GetCmdQuerySigningInfos(),
+		GetCmdQueryInfractionHistory(),
 	)
 
 	return slashingQueryCmd
@@ -108,6 +109,51 @@ $ <appd> query slashing signing-infos
 	return cmd
 }
 
+// GetCmdQueryInfractionHistory implements the command to query the downtime
+// infraction history of a validator.
+func GetCmdQueryInfractionHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "infraction-history [validator-conspub]",
+		Short: "Query a validator's downtime infraction history",
+		Long: strings.TrimSpace(`Use a validators' consensus public key to find the downtime infraction history of that validator:
+
+$ <appd> query slashing infraction-history '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"OauFcTKbN5Lx3fJL689cikXBqe+hcp6Y+x0rYUdR9Jk="}'
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			consAddr := sdk.ConsAddress(pk.Address())
+			params := &types.QueryInfractionHistoryRequest{ConsAddress: consAddr.String(), Pagination: pageReq}
+			res, err := queryClient.InfractionHistory(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "infraction history")
+
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -115,16 +115,20 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000"}`,
+			`{"signed_blocks_window":"100","min_signed_per_window":"0.500000000000000000","downtime_jail_duration":"600s","slash_fraction_double_sign":"0.050000000000000000","slash_fraction_downtime":"0.010000000000000000","downtime_slash_multiplier":"1.000000000000000000","downtime_jail_multiplier":"1.000000000000000000","downtime_infraction_decay_period":"2592000s","soft_jail_downtime_infractions":"0"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			`downtime_jail_duration: 600s
+			`downtime_infraction_decay_period: 2592000s
+downtime_jail_duration: 600s
+downtime_jail_multiplier: "1.000000000000000000"
+downtime_slash_multiplier: "1.000000000000000000"
 min_signed_per_window: "0.500000000000000000"
 signed_blocks_window: "100"
 slash_fraction_double_sign: "0.050000000000000000"
-slash_fraction_downtime: "0.010000000000000000"`,
+slash_fraction_downtime: "0.010000000000000000"
+soft_jail_downtime_infractions: "0"`,
 		},
 	}
 
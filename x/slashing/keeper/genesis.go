@@ -38,6 +38,16 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, stakingKeeper types.StakingKee
 		}
 	}
 
+	for _, history := range data.DowntimeInfractions {
+		address, err := sdk.ConsAddressFromBech32(history.Address)
+		if err != nil {
+			panic(err)
+		}
+		for _, infraction := range history.Infractions {
+			keeper.SetDowntimeInfraction(ctx, address, infraction)
+		}
+	}
+
 	keeper.SetParams(ctx, data.Params)
 }
 
@@ -48,6 +58,7 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 	params := keeper.GetParams(ctx)
 	signingInfos := make([]types.SigningInfo, 0)
 	missedBlocks := make([]types.ValidatorMissedBlocks, 0)
+	downtimeInfractions := make([]types.ValidatorDowntimeInfractions, 0)
 	keeper.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info types.ValidatorSigningInfo) (stop bool) {
 		bechAddr := address.String()
 		signingInfos = append(signingInfos, types.SigningInfo{
@@ -62,8 +73,15 @@ func (keeper Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 			MissedBlocks: localMissedBlocks,
 		})
 
+		if infractions := keeper.GetDowntimeInfractions(ctx, address); len(infractions) > 0 {
+			downtimeInfractions = append(downtimeInfractions, types.ValidatorDowntimeInfractions{
+				Address:     bechAddr,
+				Infractions: infractions,
+			})
+		}
+
 		return false
 	})
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	return types.NewGenesisState(params, signingInfos, missedBlocks, downtimeInfractions)
 }
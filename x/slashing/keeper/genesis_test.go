@@ -28,11 +28,15 @@ func TestExportAndInitGenesis(t *testing.T) {
 
 	app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[0]), info1)
 	app.SlashingKeeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrDels[1]), info2)
+	infraction := types.NewDowntimeInfraction(int64(2), time.Now().UTC(), sdk.NewDecWithPrec(1, 2), time.Hour, false)
+	app.SlashingKeeper.SetDowntimeInfraction(ctx, sdk.ConsAddress(addrDels[0]), infraction)
 	genesisState := app.SlashingKeeper.ExportGenesis(ctx)
 
 	require.Equal(t, genesisState.Params, testslashing.TestParams())
 	require.Len(t, genesisState.SigningInfos, 2)
 	require.Equal(t, genesisState.SigningInfos[0].ValidatorSigningInfo, info1)
+	require.Len(t, genesisState.DowntimeInfractions, 1)
+	require.Equal(t, []types.DowntimeInfraction{infraction}, genesisState.DowntimeInfractions[0].Infractions)
 
 	// Tombstone validators after genesis shouldn't effect genesis state
 	app.SlashingKeeper.Tombstone(ctx, sdk.ConsAddress(addrDels[0]))
@@ -56,4 +60,5 @@ func TestExportAndInitGenesis(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, info1, newInfo1)
 	require.Equal(t, info2, newInfo2)
+	require.Equal(t, []types.DowntimeInfraction{infraction}, app.SlashingKeeper.GetDowntimeInfractions(ctx, sdk.ConsAddress(addrDels[0])))
 }
@@ -72,3 +72,37 @@ func (k Keeper) SigningInfos(c context.Context, req *types.QuerySigningInfosRequ
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+func (k Keeper) InfractionHistory(c context.Context, req *types.QueryInfractionHistoryRequest) (*types.QueryInfractionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	var infractions []types.DowntimeInfraction
+
+	infractionStore := prefix.NewStore(store, types.DowntimeInfractionPrefixKey(consAddr))
+	pageRes, err := query.Paginate(infractionStore, req.Pagination, func(key []byte, value []byte) error {
+		var infraction types.DowntimeInfraction
+		err := k.cdc.Unmarshal(value, &infraction)
+		if err != nil {
+			return err
+		}
+		infractions = append(infractions, infraction)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryInfractionHistoryResponse{Infractions: infractions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// SetDowntimeInfraction sets a downtime infraction in the infraction history of a validator
+func (k Keeper) SetDowntimeInfraction(ctx sdk.Context, address sdk.ConsAddress, infraction types.DowntimeInfraction) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&infraction)
+	store.Set(types.DowntimeInfractionKey(address, infraction.Height), bz)
+}
+
+// IterateDowntimeInfractions iterates over the infraction history of a validator, by height
+func (k Keeper) IterateDowntimeInfractions(ctx sdk.Context,
+	address sdk.ConsAddress, handler func(infraction types.DowntimeInfraction) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DowntimeInfractionPrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var infraction types.DowntimeInfraction
+		k.cdc.MustUnmarshal(iter.Value(), &infraction)
+		if handler(infraction) {
+			break
+		}
+	}
+}
+
+// GetDowntimeInfractions returns the infraction history of a validator, by height
+func (k Keeper) GetDowntimeInfractions(ctx sdk.Context, address sdk.ConsAddress) []types.DowntimeInfraction {
+	infractions := []types.DowntimeInfraction{}
+	k.IterateDowntimeInfractions(ctx, address, func(infraction types.DowntimeInfraction) (stop bool) {
+		infractions = append(infractions, infraction)
+		return false
+	})
+
+	return infractions
+}
+
+// countDowntimeInfractions returns the number of downtime infractions of a validator
+func (k Keeper) countDowntimeInfractions(ctx sdk.Context, address sdk.ConsAddress) int64 {
+	count := int64(0)
+	k.IterateDowntimeInfractions(ctx, address, func(infraction types.DowntimeInfraction) (stop bool) {
+		count++
+		return false
+	})
+
+	return count
+}
+
+// pruneDowntimeInfractions deletes the downtime infractions of a validator up to the given time,
+// which no longer escalate the penalties of the next ones
+func (k Keeper) pruneDowntimeInfractions(ctx sdk.Context, address sdk.ConsAddress, until time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DowntimeInfractionPrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var infraction types.DowntimeInfraction
+		k.cdc.MustUnmarshal(iter.Value(), &infraction)
+		// the infractions are ordered by height, so by time
+		if infraction.Time.After(until) {
+			break
+		}
+		store.Delete(iter.Key())
+	}
+}
+
+// clearDowntimeInfractions deletes the infraction history of a validator
+func (k Keeper) clearDowntimeInfractions(ctx sdk.Context, address sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DowntimeInfractionPrefixKey(address))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		store.Delete(iter.Key())
+	}
+}
@@ -13,9 +13,10 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 	logger := k.Logger(ctx)
 	height := ctx.BlockHeight()
 
-	// fetch the validator public key
-	consAddr := sdk.ConsAddress(addr)
-	if _, err := k.GetPubkey(ctx, addr); err != nil {
+	// fetch the validator public key, resolving the old consensus address of a
+	// validator which rotated its consensus public key to its current one
+	consAddr := k.sk.ValidatorIdentifier(ctx, sdk.ConsAddress(addr))
+	if _, err := k.GetPubkey(ctx, consAddr.Bytes()); err != nil {
 		panic(fmt.Sprintf("Validator consensus-address %s not found", consAddr))
 	}
 
@@ -84,20 +85,44 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 			// That's fine since this is just used to filter unbonding delegations & redelegations.
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
+			// Escalate the penalties with the previous downtime infractions of the validator which
+			// have not decayed yet, pruning the decayed ones, the first ones only soft jailing it
+			// if configured to.
+			params := k.GetParams(ctx)
+			k.pruneDowntimeInfractions(ctx, consAddr, ctx.BlockHeader().Time.Add(-params.DowntimeInfractionDecayPeriod))
+			previousInfractions := k.countDowntimeInfractions(ctx, consAddr)
+			slashFraction := params.EscalatedDowntimeSlashFraction(previousInfractions)
+			softJailed := previousInfractions < params.SoftJailDowntimeInfractions
+
+			coinsBurned := k.sk.Slash(ctx, consAddr, distributionHeight, power, slashFraction)
+			jailedAttribute := sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String())
+			if softJailed {
+				jailedAttribute = sdk.NewAttribute(types.AttributeKeySoftJailed, consAddr.String())
+			}
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeSlash,
 					sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
 					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
 					sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueMissingSignature),
-					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					jailedAttribute,
 					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
 				),
 			)
-			k.sk.Jail(ctx, consAddr)
 
-			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
+			jailDuration := params.EscalatedDowntimeJailDuration(previousInfractions)
+			infraction := types.NewDowntimeInfraction(height, ctx.BlockHeader().Time, slashFraction, jailDuration, softJailed)
+			if softJailed {
+				// A soft jailed validator stays bonded with a capped voting power for the jail
+				// duration, and gets a new signed blocks window to recover its liveness before
+				// being slashed again.
+				k.sk.CapPower(ctx, consAddr, params.SoftJailPowerCap(power), ctx.BlockHeader().Time.Add(jailDuration))
+				signInfo.StartHeight = height
+			} else {
+				k.sk.Jail(ctx, consAddr)
+				signInfo.JailedUntil = ctx.BlockHeader().Time.Add(jailDuration)
+			}
+			k.SetDowntimeInfraction(ctx, consAddr, infraction)
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
@@ -110,7 +135,9 @@ func (k Keeper) HandleValidatorSignature(ctx sdk.Context, addr cryptotypes.Addre
 				"validator", consAddr.String(),
 				"min_height", minHeight,
 				"threshold", minSignedPerWindow,
-				"slashed", k.SlashFractionDowntime(ctx).String(),
+				"slashed", slashFraction.String(),
+				"previous_infractions", previousInfractions,
+				"soft_jailed", softJailed,
 				"jailed_until", signInfo.JailedUntil,
 			)
 		} else {
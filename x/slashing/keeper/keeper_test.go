@@ -10,7 +10,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/testslashing"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -124,6 +126,150 @@ func TestHandleNewValidator(t *testing.T) {
 	require.True(t, expTokens.Equal(app.BankKeeper.GetBalance(ctx, bondPool.GetAddress(), app.StakingKeeper.BondDenom(ctx)).Amount))
 }
 
+// Test a validator rotating its consensus public key keeps its signing info and
+// missed blocks, including for the signatures of its old consensus public key
+func TestHandleRotatedValidator(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(2)
+	addr, oldPk, newPk := valAddrs[0], pks[0], pks[1]
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	ctx = ctx.WithBlockHeight(1)
+
+	tstaking.CreateValidatorWithValPower(addr, oldPk, 100, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), 100, false)
+	infraction := types.NewDowntimeInfraction(1, ctx.BlockTime(), sdk.NewDecWithPrec(1, 2), time.Hour, false)
+	app.SlashingKeeper.SetDowntimeInfraction(ctx, sdk.ConsAddress(oldPk.Address()), infraction)
+
+	// rotate the consensus public key
+	ctx = ctx.WithBlockHeight(2)
+	msg, err := stakingtypes.NewMsgRotateConsPubKey(addr, newPk)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the signing info, the missed blocks and the downtime infractions moved to
+	// the new consensus address
+	_, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(oldPk.Address()))
+	require.False(t, found)
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(newPk.Address()))
+	require.True(t, found)
+	require.Equal(t, sdk.ConsAddress(newPk.Address()).String(), info.Address)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+	require.True(t, app.SlashingKeeper.GetValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(newPk.Address()), 0))
+	require.Empty(t, app.SlashingKeeper.GetValidatorMissedBlocks(ctx, sdk.ConsAddress(oldPk.Address())))
+	require.Equal(t, []types.DowntimeInfraction{infraction}, app.SlashingKeeper.GetDowntimeInfractions(ctx, sdk.ConsAddress(newPk.Address())))
+	require.Empty(t, app.SlashingKeeper.GetDowntimeInfractions(ctx, sdk.ConsAddress(oldPk.Address())))
+
+	// the old consensus public key still signs the blocks before the rotation is applied
+	app.SlashingKeeper.HandleValidatorSignature(ctx, oldPk.Address(), 100, false)
+	ctx = ctx.WithBlockHeight(3)
+	app.SlashingKeeper.HandleValidatorSignature(ctx, newPk.Address(), 100, true)
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(newPk.Address()))
+	require.True(t, found)
+	require.Equal(t, int64(3), info.IndexOffset)
+	require.Equal(t, int64(2), info.MissedBlocksCounter)
+}
+
+// Test the downtime penalties escalating with the downtime infraction history
+// of a validator, the first infraction soft jailing it, until they decay
+func TestHandleGraduatedDowntime(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Unix(1e9, 0).UTC()})
+
+	params := app.SlashingKeeper.GetParams(ctx)
+	params.DowntimeSlashMultiplier = sdk.NewDec(2)
+	params.DowntimeJailMultiplier = sdk.NewDec(3)
+	params.SoftJailDowntimeInfractions = 1
+	app.SlashingKeeper.SetParams(ctx, params)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 200))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+	pks := simapp.CreateTestPubKeys(1)
+	addr, val := valAddrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	power := int64(100)
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+
+	amt := tstaking.CreateValidatorWithValPower(addr, val, power, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	// misses blocks until the validator is slashed for downtime
+	height := int64(0)
+	missBlocks := func() types.DowntimeInfraction {
+		infractions := len(app.SlashingKeeper.GetDowntimeInfractions(ctx, consAddr))
+		for ; len(app.SlashingKeeper.GetDowntimeInfractions(ctx, consAddr)) == infractions; height++ {
+			require.Less(t, height, int64(1000))
+			ctx = ctx.WithBlockHeight(height)
+			app.SlashingKeeper.HandleValidatorSignature(ctx, val.Address(), power, false)
+		}
+		staking.EndBlocker(ctx, app.StakingKeeper)
+
+		history := app.SlashingKeeper.GetDowntimeInfractions(ctx, consAddr)
+		return history[len(history)-1]
+	}
+
+	// the first infraction soft jails the validator, which is slashed and stays bonded with a
+	// capped voting power for the jail duration
+	infraction := missBlocks()
+	require.True(t, infraction.SoftJailed)
+	require.True(t, params.SlashFractionDowntime.Equal(infraction.SlashFraction))
+	require.Equal(t, params.DowntimeJailDuration, infraction.JailDuration)
+
+	validator, _ := app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.Equal(t, stakingtypes.Bonded, validator.GetStatus())
+	require.False(t, validator.IsJailed())
+	amt = amt.Sub(app.StakingKeeper.TokensFromConsensusPower(ctx, 1))
+	require.Equal(t, amt, validator.GetTokens())
+	require.Equal(t, int64(50), app.StakingKeeper.GetLastValidatorPower(ctx, addr))
+
+	// the voting power is restored once the jail duration ends
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithBlockTime(ctx.BlockTime().Add(infraction.JailDuration))
+	staking.EndBlocker(cacheCtx, app.StakingKeeper)
+	require.Equal(t, int64(99), app.StakingKeeper.GetLastValidatorPower(cacheCtx, addr))
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, infraction.Height, info.StartHeight)
+	require.Zero(t, info.MissedBlocksCounter)
+
+	// the second infraction jails the validator with escalated penalties
+	infraction = missBlocks()
+	require.False(t, infraction.SoftJailed)
+	require.True(t, sdk.NewDecWithPrec(2, 2).Equal(infraction.SlashFraction))
+	require.Equal(t, 3*params.DowntimeJailDuration, infraction.JailDuration)
+
+	validator, _ = app.StakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	require.Equal(t, stakingtypes.Unbonding, validator.GetStatus())
+	amt = amt.Sub(app.StakingKeeper.TokensFromConsensusPower(ctx, 2))
+	require.Equal(t, amt, validator.GetTokens())
+
+	info, found = app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(infraction.JailDuration), info.JailedUntil)
+
+	res, err := app.SlashingKeeper.InfractionHistory(sdk.WrapSDKContext(ctx), &types.QueryInfractionHistoryRequest{ConsAddress: consAddr.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Infractions, 2)
+
+	// once the previous infractions decayed, they are pruned and the validator is soft jailed again
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(params.DowntimeInfractionDecayPeriod))
+	require.NoError(t, app.SlashingKeeper.Unjail(ctx, addr))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	infraction = missBlocks()
+	require.True(t, infraction.SoftJailed)
+	require.True(t, params.SlashFractionDowntime.Equal(infraction.SlashFraction))
+	require.Len(t, app.SlashingKeeper.GetDowntimeInfractions(ctx, consAddr), 1)
+}
+
 // Test a jailed validator being "down" twice
 // Ensure that they're only slashed once
 func TestHandleAlreadyJailed(t *testing.T) {
@@ -42,6 +42,36 @@ func (k Keeper) SlashFractionDowntime(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// DowntimeSlashMultiplier - multiplier of the downtime slash fraction per previous downtime infraction
+func (k Keeper) DowntimeSlashMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimeSlashMultiplier, &res)
+	return
+}
+
+// DowntimeJailMultiplier - multiplier of the downtime jail duration per previous downtime infraction
+func (k Keeper) DowntimeJailMultiplier(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeyDowntimeJailMultiplier, &res)
+	return
+}
+
+// DowntimeInfractionDecayPeriod - period after which a downtime infraction no longer escalates penalties
+func (k Keeper) DowntimeInfractionDecayPeriod(ctx sdk.Context) (res time.Duration) {
+	k.paramspace.Get(ctx, types.KeyDowntimeInfractionDecayPeriod, &res)
+	return
+}
+
+// SoftJailDowntimeInfractions - number of downtime infractions within the decay period which soft jail
+func (k Keeper) SoftJailDowntimeInfractions(ctx sdk.Context) (res int64) {
+	k.paramspace.Get(ctx, types.KeySoftJailDowntimeInfractions, &res)
+	return
+}
+
+// SoftJailPowerFraction - fraction of its voting power a soft jailed validator keeps
+func (k Keeper) SoftJailPowerFraction(ctx sdk.Context) (res sdk.Dec) {
+	k.paramspace.Get(ctx, types.KeySoftJailPowerFraction, &res)
+	return
+}
+
 // GetParams returns the total set of slashing parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramspace.GetParamSet(ctx, &params)
@@ -0,0 +1,27 @@
+package v047
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// MigrateStore performs in-place store migrations for the graduated downtime
+// penalties. The migration includes:
+//
+// - Setting the DowntimeSlashMultiplier, DowntimeJailMultiplier,
+// DowntimeInfractionDecayPeriod, SoftJailDowntimeInfractions and
+// SoftJailPowerFraction params in the paramstore, to defaults which keep the
+// downtime penalties unchanged
+func MigrateStore(ctx sdk.Context, paramstore types.ParamSubspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyDowntimeSlashMultiplier, types.DefaultDowntimeSlashMultiplier)
+	paramstore.Set(ctx, types.KeyDowntimeJailMultiplier, types.DefaultDowntimeJailMultiplier)
+	paramstore.Set(ctx, types.KeyDowntimeInfractionDecayPeriod, types.DefaultDowntimeInfractionDecayPeriod)
+	paramstore.Set(ctx, types.KeySoftJailDowntimeInfractions, types.DefaultSoftJailDowntimeInfractions)
+	paramstore.Set(ctx, types.KeySoftJailPowerFraction, types.DefaultSoftJailPowerFraction)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package v047_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	slashingKey := sdk.NewKVStoreKey("slashing")
+	tSlashingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(slashingKey, tSlashingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, slashingKey, tSlashingKey, "slashing").
+		WithKeyTable(types.ParamKeyTable())
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyDowntimeSlashMultiplier))
+	require.False(t, paramstore.Has(ctx, types.KeySoftJailDowntimeInfractions))
+
+	// Run migrations.
+	err := v047slashing.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	var downtimeSlashMultiplier, downtimeJailMultiplier, softJailPowerFraction sdk.Dec
+	var downtimeInfractionDecayPeriod time.Duration
+	var softJailDowntimeInfractions int64
+	paramstore.Get(ctx, types.KeyDowntimeSlashMultiplier, &downtimeSlashMultiplier)
+	paramstore.Get(ctx, types.KeyDowntimeJailMultiplier, &downtimeJailMultiplier)
+	paramstore.Get(ctx, types.KeyDowntimeInfractionDecayPeriod, &downtimeInfractionDecayPeriod)
+	paramstore.Get(ctx, types.KeySoftJailDowntimeInfractions, &softJailDowntimeInfractions)
+	paramstore.Get(ctx, types.KeySoftJailPowerFraction, &softJailPowerFraction)
+	require.True(t, types.DefaultDowntimeSlashMultiplier.Equal(downtimeSlashMultiplier))
+	require.True(t, types.DefaultDowntimeJailMultiplier.Equal(downtimeJailMultiplier))
+	require.Equal(t, types.DefaultDowntimeInfractionDecayPeriod, downtimeInfractionDecayPeriod)
+	require.Equal(t, types.DefaultSoftJailDowntimeInfractions, softJailDowntimeInfractions)
+	require.True(t, types.DefaultSoftJailPowerFraction.Equal(softJailPowerFraction))
+}
@@ -39,6 +39,12 @@ func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
 			}
 			return fmt.Sprintf("PubKeyA: %s\nPubKeyB: %s", pubKeyA, pubKeyB)
 
+		case bytes.Equal(kvA.Key[:1], types.DowntimeInfractionKeyPrefix):
+			var infractionA, infractionB types.DowntimeInfraction
+			cdc.MustUnmarshal(kvA.Value, &infractionA)
+			cdc.MustUnmarshal(kvB.Value, &infractionB)
+			return fmt.Sprintf("%v\n%v", infractionA, infractionB)
+
 		default:
 			panic(fmt.Sprintf("invalid slashing key prefix %X", kvA.Key[:1]))
 		}
@@ -21,6 +21,12 @@ const (
 	DowntimeJailDuration    = "downtime_jail_duration"
 	SlashFractionDoubleSign = "slash_fraction_double_sign"
 	SlashFractionDowntime   = "slash_fraction_downtime"
+
+	DowntimeSlashMultiplier       = "downtime_slash_multiplier"
+	DowntimeJailMultiplier        = "downtime_jail_multiplier"
+	DowntimeInfractionDecayPeriod = "downtime_infraction_decay_period"
+	SoftJailDowntimeInfractions   = "soft_jail_downtime_infractions"
+	SoftJailPowerFraction         = "soft_jail_power_fraction"
 )
 
 // GenSignedBlocksWindow randomized SignedBlocksWindow
@@ -48,6 +54,31 @@ func GenSlashFractionDowntime(r *rand.Rand) sdk.Dec {
 	return sdk.NewDec(1).Quo(sdk.NewDec(int64(r.Intn(200) + 1)))
 }
 
+// GenDowntimeSlashMultiplier randomized DowntimeSlashMultiplier
+func GenDowntimeSlashMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(20)), 1))
+}
+
+// GenDowntimeJailMultiplier randomized DowntimeJailMultiplier
+func GenDowntimeJailMultiplier(r *rand.Rand) sdk.Dec {
+	return sdk.OneDec().Add(sdk.NewDecWithPrec(int64(r.Intn(20)), 1))
+}
+
+// GenDowntimeInfractionDecayPeriod randomized DowntimeInfractionDecayPeriod
+func GenDowntimeInfractionDecayPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60*60, 60*60*24*30)) * time.Second
+}
+
+// GenSoftJailDowntimeInfractions randomized SoftJailDowntimeInfractions
+func GenSoftJailDowntimeInfractions(r *rand.Rand) int64 {
+	return int64(r.Intn(3))
+}
+
+// GenSoftJailPowerFraction randomized SoftJailPowerFraction
+func GenSoftJailPowerFraction(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 11)), 1)
+}
+
 // RandomizedGenState generates a random GenesisState for slashing
 func RandomizedGenState(simState *module.SimulationState) {
 	var signedBlocksWindow int64
@@ -80,12 +111,44 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { slashFractionDowntime = GenSlashFractionDowntime(r) },
 	)
 
+	var downtimeSlashMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeSlashMultiplier, &downtimeSlashMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeSlashMultiplier = GenDowntimeSlashMultiplier(r) },
+	)
+
+	var downtimeJailMultiplier sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeJailMultiplier, &downtimeJailMultiplier, simState.Rand,
+		func(r *rand.Rand) { downtimeJailMultiplier = GenDowntimeJailMultiplier(r) },
+	)
+
+	var downtimeInfractionDecayPeriod time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DowntimeInfractionDecayPeriod, &downtimeInfractionDecayPeriod, simState.Rand,
+		func(r *rand.Rand) { downtimeInfractionDecayPeriod = GenDowntimeInfractionDecayPeriod(r) },
+	)
+
+	var softJailDowntimeInfractions int64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SoftJailDowntimeInfractions, &softJailDowntimeInfractions, simState.Rand,
+		func(r *rand.Rand) { softJailDowntimeInfractions = GenSoftJailDowntimeInfractions(r) },
+	)
+
+	var softJailPowerFraction sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, SoftJailPowerFraction, &softJailPowerFraction, simState.Rand,
+		func(r *rand.Rand) { softJailPowerFraction = GenSoftJailPowerFraction(r) },
+	)
+
 	params := types.NewParams(
 		signedBlocksWindow, minSignedPerWindow, downtimeJailDuration,
 		slashFractionDoubleSign, slashFractionDowntime,
+		downtimeSlashMultiplier, downtimeJailMultiplier, downtimeInfractionDecayPeriod,
+		softJailDowntimeInfractions, softJailPowerFraction,
 	)
 
-	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{})
+	slashingGenesis := types.NewGenesisState(params, []types.SigningInfo{}, []types.ValidatorMissedBlocks{}, []types.ValidatorDowntimeInfractions{})
 
 	bz, err := json.MarshalIndent(&slashingGenesis, "", " ")
 	if err != nil {
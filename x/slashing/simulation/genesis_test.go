@@ -51,6 +51,11 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec3, slashingGenesis.Params.SlashFractionDowntime)
 	require.Equal(t, int64(720), slashingGenesis.Params.SignedBlocksWindow)
 	require.Equal(t, time.Duration(34800000000000), slashingGenesis.Params.DowntimeJailDuration)
+	require.Equal(t, sdk.NewDecWithPrec(12, 1), slashingGenesis.Params.DowntimeSlashMultiplier)
+	require.Equal(t, sdk.NewDecWithPrec(19, 1), slashingGenesis.Params.DowntimeJailMultiplier)
+	require.Equal(t, time.Duration(2279128000000000), slashingGenesis.Params.DowntimeInfractionDecayPeriod)
+	require.Equal(t, int64(2), slashingGenesis.Params.SoftJailDowntimeInfractions)
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), slashingGenesis.Params.SoftJailPowerFraction)
 	require.Len(t, slashingGenesis.MissedBlocks, 0)
 	require.Len(t, slashingGenesis.SigningInfos, 0)
 }
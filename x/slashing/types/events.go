@@ -10,6 +10,7 @@ const (
 	AttributeKeyPower        = "power"
 	AttributeKeyReason       = "reason"
 	AttributeKeyJailed       = "jailed"
+	AttributeKeySoftJailed   = "soft_jailed"
 	AttributeKeyMissedBlocks = "missed_blocks"
 	AttributeKeyBurnedCoins  = "burned_coins"
 
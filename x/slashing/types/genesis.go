@@ -10,11 +10,13 @@ import (
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
 	params Params, signingInfos []SigningInfo, missedBlocks []ValidatorMissedBlocks,
+	downtimeInfractions []ValidatorDowntimeInfractions,
 ) *GenesisState {
 	return &GenesisState{
-		Params:       params,
-		SigningInfos: signingInfos,
-		MissedBlocks: missedBlocks,
+		Params:              params,
+		SigningInfos:        signingInfos,
+		MissedBlocks:        missedBlocks,
+		DowntimeInfractions: downtimeInfractions,
 	}
 }
 
@@ -26,12 +28,26 @@ func NewMissedBlock(index int64, missed bool) MissedBlock {
 	}
 }
 
+// NewDowntimeInfraction creates a new DowntimeInfraction instance
+func NewDowntimeInfraction(
+	height int64, time time.Time, slashFraction sdk.Dec, jailDuration time.Duration, softJailed bool,
+) DowntimeInfraction {
+	return DowntimeInfraction{
+		Height:        height,
+		Time:          time,
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+		SoftJailed:    softJailed,
+	}
+}
+
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		SigningInfos: []SigningInfo{},
-		MissedBlocks: []ValidatorMissedBlocks{},
+		Params:              DefaultParams(),
+		SigningInfos:        []SigningInfo{},
+		MissedBlocks:        []ValidatorMissedBlocks{},
+		DowntimeInfractions: []ValidatorDowntimeInfractions{},
 	}
 }
 
@@ -62,5 +78,31 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	if err := validateDowntimeSlashMultiplier(data.Params.DowntimeSlashMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeJailMultiplier(data.Params.DowntimeJailMultiplier); err != nil {
+		return err
+	}
+
+	if err := validateDowntimeInfractionDecayPeriod(data.Params.DowntimeInfractionDecayPeriod); err != nil {
+		return err
+	}
+
+	if err := validateSoftJailDowntimeInfractions(data.Params.SoftJailDowntimeInfractions); err != nil {
+		return err
+	}
+
+	if err := validateSoftJailPowerFraction(data.Params.SoftJailPowerFraction); err != nil {
+		return err
+	}
+
+	for _, infractions := range data.DowntimeInfractions {
+		if _, err := sdk.ConsAddressFromBech32(infractions.Address); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
@@ -34,6 +34,9 @@ type GenesisState struct {
 	// missed_blocks represents a map between validator addresses and their
 	// missed blocks.
 	MissedBlocks []ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// downtime_infractions represents a map between validator addresses and
+	// their downtime infraction history.
+	DowntimeInfractions []ValidatorDowntimeInfractions `protobuf:"bytes,4,rep,name=downtime_infractions,json=downtimeInfractions,proto3" json:"downtime_infractions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,6 +93,13 @@ func (m *GenesisState) GetMissedBlocks() []ValidatorMissedBlocks {
 	return nil
 }
 
+func (m *GenesisState) GetDowntimeInfractions() []ValidatorDowntimeInfractions {
+	if m != nil {
+		return m.DowntimeInfractions
+	}
+	return nil
+}
+
 // SigningInfo stores validator signing info of corresponding address.
 type SigningInfo struct {
 	// address is the validator address.
@@ -256,11 +266,68 @@ func (m *MissedBlock) GetMissed() bool {
 	return false
 }
 
+// ValidatorDowntimeInfractions contains the downtime infraction history of
+// corresponding address.
+type ValidatorDowntimeInfractions struct {
+	// address is the validator address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// infractions is the downtime infraction history of the validator.
+	Infractions []DowntimeInfraction `protobuf:"bytes,2,rep,name=infractions,proto3" json:"infractions"`
+}
+
+func (m *ValidatorDowntimeInfractions) Reset()         { *m = ValidatorDowntimeInfractions{} }
+func (m *ValidatorDowntimeInfractions) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDowntimeInfractions) ProtoMessage()    {}
+func (*ValidatorDowntimeInfractions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1923b9188b635394, []int{4}
+}
+func (m *ValidatorDowntimeInfractions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorDowntimeInfractions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorDowntimeInfractions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorDowntimeInfractions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDowntimeInfractions.Merge(m, src)
+}
+func (m *ValidatorDowntimeInfractions) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorDowntimeInfractions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDowntimeInfractions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDowntimeInfractions proto.InternalMessageInfo
+
+func (m *ValidatorDowntimeInfractions) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorDowntimeInfractions) GetInfractions() []DowntimeInfraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.slashing.v1beta1.GenesisState")
 	proto.RegisterType((*SigningInfo)(nil), "cosmos.slashing.v1beta1.SigningInfo")
 	proto.RegisterType((*ValidatorMissedBlocks)(nil), "cosmos.slashing.v1beta1.ValidatorMissedBlocks")
 	proto.RegisterType((*MissedBlock)(nil), "cosmos.slashing.v1beta1.MissedBlock")
+	proto.RegisterType((*ValidatorDowntimeInfractions)(nil), "cosmos.slashing.v1beta1.ValidatorDowntimeInfractions")
 }
 
 func init() {
@@ -268,34 +335,37 @@ func init() {
 }
 
 var fileDescriptor_1923b9188b635394 = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x86, 0xe3, 0x2e, 0x2c, 0xe0, 0xb4, 0x17, 0x2b, 0x94, 0xd0, 0x43, 0x5a, 0xad, 0x00, 0xf5,
-	0x92, 0x44, 0x5d, 0x8e, 0x88, 0x03, 0xb9, 0x54, 0x1c, 0x50, 0x51, 0x56, 0x42, 0x82, 0x4b, 0xe4,
-	0x6c, 0x5c, 0xd7, 0xea, 0xc6, 0x5e, 0x65, 0xcc, 0xaa, 0xbc, 0x05, 0x0f, 0xc0, 0x23, 0x70, 0xe4,
-	0x21, 0x7a, 0xac, 0x38, 0x71, 0x42, 0x68, 0xf7, 0x29, 0xb8, 0x21, 0x6c, 0x87, 0x8d, 0x60, 0xa3,
-	0x95, 0x38, 0x25, 0x63, 0x7f, 0xf3, 0xcf, 0xcc, 0xef, 0xc1, 0x8f, 0xa7, 0x0a, 0x6a, 0x05, 0x29,
-	0xcc, 0x28, 0x5c, 0x08, 0xc9, 0xd3, 0xc5, 0x49, 0xc9, 0x34, 0x3d, 0x49, 0x39, 0x93, 0x0c, 0x04,
-	0x24, 0xf3, 0x46, 0x69, 0x45, 0x1e, 0x58, 0x2c, 0x69, 0xb1, 0xc4, 0x61, 0x07, 0x01, 0x57, 0x5c,
-	0x19, 0x26, 0xfd, 0xfd, 0x67, 0xf1, 0x83, 0x27, 0x7d, 0xaa, 0x7f, 0xf2, 0x2d, 0xf7, 0xd0, 0x72,
-	0x85, 0x15, 0x70, 0x35, 0x4c, 0x30, 0xfa, 0x89, 0xf0, 0xee, 0xa9, 0xed, 0x61, 0xa2, 0xa9, 0x66,
-	0xe4, 0x39, 0x1e, 0xce, 0x69, 0x43, 0x6b, 0x08, 0xd1, 0x11, 0x3a, 0xf6, 0xc7, 0x87, 0x49, 0x4f,
-	0x4f, 0xc9, 0x6b, 0x83, 0x65, 0xb7, 0xae, 0xbf, 0x1f, 0x7a, 0xb9, 0x4b, 0x22, 0x67, 0x78, 0x0f,
-	0x04, 0x97, 0x42, 0xf2, 0x42, 0xc8, 0x73, 0x05, 0xe1, 0xce, 0xd1, 0xe0, 0xd8, 0x1f, 0x3f, 0xea,
-	0x55, 0x99, 0x58, 0xfa, 0xa5, 0x3c, 0x57, 0x4e, 0x6a, 0x17, 0xd6, 0x47, 0x40, 0xde, 0xe2, 0xbd,
-	0x5a, 0x00, 0xb0, 0xaa, 0x28, 0x67, 0x6a, 0x7a, 0x09, 0xe1, 0xc0, 0x08, 0x26, 0xbd, 0x82, 0x6f,
-	0xe8, 0x4c, 0x54, 0x54, 0xab, 0xe6, 0x95, 0x49, 0xcb, 0x4c, 0x56, 0x2b, 0x5d, 0x77, 0xce, 0x46,
-	0x9f, 0x11, 0xf6, 0x3b, 0xe5, 0xc9, 0x18, 0xdf, 0xa1, 0x55, 0xd5, 0x30, 0xb0, 0xb3, 0xdf, 0xcb,
-	0xc2, 0xaf, 0x5f, 0xe2, 0xc0, 0xd5, 0x79, 0x61, 0x6f, 0x26, 0xba, 0x11, 0x92, 0xe7, 0x2d, 0x48,
-	0x04, 0xde, 0x5f, 0xb4, 0x05, 0x8b, 0xee, 0xe4, 0xe1, 0x8e, 0xb1, 0x2f, 0xde, 0xde, 0xe7, 0xbf,
-	0x0e, 0x04, 0x8b, 0x0d, 0x77, 0xa3, 0x4f, 0x08, 0xdf, 0xdf, 0x38, 0xdc, 0x7f, 0x35, 0x7e, 0xf6,
-	0xb7, 0xaf, 0xdb, 0x1e, 0xaa, 0x53, 0x71, 0xa3, 0x9b, 0xcf, 0xb0, 0xdf, 0x41, 0x48, 0x80, 0x6f,
-	0x0b, 0x59, 0xb1, 0x2b, 0xd3, 0xd1, 0x20, 0xb7, 0x01, 0xd9, 0xc7, 0x43, 0x9b, 0x64, 0xec, 0xb9,
-	0x9b, 0xbb, 0x28, 0x3b, 0xbd, 0x5e, 0x46, 0xe8, 0x66, 0x19, 0xa1, 0x1f, 0xcb, 0x08, 0x7d, 0x5c,
-	0x45, 0xde, 0xcd, 0x2a, 0xf2, 0xbe, 0xad, 0x22, 0xef, 0x5d, 0xcc, 0x85, 0xbe, 0x78, 0x5f, 0x26,
-	0x53, 0x55, 0xbb, 0xcd, 0x75, 0x9f, 0x18, 0xaa, 0xcb, 0xf4, 0x6a, 0xbd, 0xfb, 0xfa, 0xc3, 0x9c,
-	0x41, 0x39, 0x34, 0x6b, 0xfd, 0xf4, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xac, 0xc1, 0x45, 0xfe,
-	0x71, 0x03, 0x00, 0x00,
+	// 480 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x6f, 0xda, 0x51, 0xc0, 0xd9, 0x2e, 0x26, 0x8c, 0x30, 0xa1, 0x6c, 0x8a, 0x00, 0x4d, 0x42,
+	0x4d, 0xb4, 0x22, 0x4e, 0x88, 0x03, 0x15, 0xd2, 0xb4, 0x03, 0x1a, 0x6a, 0x25, 0x24, 0xb8, 0x54,
+	0x6e, 0xed, 0x79, 0xd6, 0x1a, 0xbb, 0xca, 0x67, 0xca, 0x78, 0x0b, 0x1e, 0x80, 0x3b, 0x17, 0x8e,
+	0x3c, 0xc4, 0x8e, 0x13, 0x27, 0x4e, 0x08, 0xb5, 0x27, 0xde, 0x02, 0xcd, 0x76, 0x68, 0x44, 0x9b,
+	0x55, 0xea, 0xa9, 0xb5, 0xfd, 0xfb, 0xf7, 0x7d, 0x9f, 0x1d, 0xf4, 0x68, 0xa8, 0x20, 0x53, 0x90,
+	0xc2, 0x88, 0xc0, 0xa9, 0x90, 0x3c, 0x9d, 0x1c, 0x0c, 0x98, 0x26, 0x07, 0x29, 0x67, 0x92, 0x81,
+	0x80, 0x64, 0x9c, 0x2b, 0xad, 0xf0, 0x3d, 0x0b, 0x4b, 0x0a, 0x58, 0xe2, 0x60, 0x3b, 0x01, 0x57,
+	0x5c, 0x19, 0x4c, 0x7a, 0xf5, 0xcf, 0xc2, 0x77, 0x1e, 0x57, 0xa9, 0xfe, 0xe3, 0x5b, 0xdc, 0x7d,
+	0x8b, 0xeb, 0x5b, 0x01, 0xe7, 0x61, 0x16, 0xf1, 0x9f, 0x3a, 0xda, 0x3c, 0xb4, 0x19, 0x7a, 0x9a,
+	0x68, 0x86, 0x5f, 0xa0, 0xe6, 0x98, 0xe4, 0x24, 0x83, 0xd0, 0xdb, 0xf3, 0xf6, 0xfd, 0xf6, 0x6e,
+	0x52, 0x91, 0x29, 0x79, 0x63, 0x60, 0x9d, 0x8d, 0x8b, 0x5f, 0xbb, 0xb5, 0xae, 0x23, 0xe1, 0x63,
+	0xb4, 0x05, 0x82, 0x4b, 0x21, 0x79, 0x5f, 0xc8, 0x13, 0x05, 0x61, 0x7d, 0xaf, 0xb1, 0xef, 0xb7,
+	0x1f, 0x56, 0xaa, 0xf4, 0x2c, 0xfa, 0x48, 0x9e, 0x28, 0x27, 0xb5, 0x09, 0xf3, 0x2d, 0xc0, 0xef,
+	0xd0, 0x56, 0x26, 0x00, 0x18, 0xed, 0x0f, 0x46, 0x6a, 0x78, 0x06, 0x61, 0xc3, 0x08, 0x26, 0x95,
+	0x82, 0x6f, 0xc9, 0x48, 0x50, 0xa2, 0x55, 0xfe, 0xda, 0xd0, 0x3a, 0x86, 0x55, 0x48, 0x67, 0xa5,
+	0x3d, 0x2c, 0x51, 0x40, 0xd5, 0x47, 0xa9, 0x45, 0xc6, 0xae, 0xc2, 0xe6, 0x64, 0xa8, 0x85, 0x92,
+	0x10, 0x6e, 0x18, 0x87, 0x67, 0xab, 0x1d, 0x5e, 0x39, 0xf6, 0xd1, 0x9c, 0xec, 0x8c, 0xee, 0xd0,
+	0xc5, 0xa3, 0xf8, 0x9b, 0x87, 0xfc, 0x52, 0xb9, 0xb8, 0x8d, 0x6e, 0x12, 0x4a, 0x73, 0x06, 0xb6,
+	0xd7, 0xb7, 0x3b, 0xe1, 0x8f, 0xef, 0xad, 0xc0, 0xb9, 0xbe, 0xb4, 0x27, 0x3d, 0x9d, 0x0b, 0xc9,
+	0xbb, 0x05, 0x10, 0x0b, 0xb4, 0x3d, 0x29, 0xec, 0xfb, 0xe5, 0x4e, 0x87, 0x75, 0x33, 0xae, 0xd6,
+	0xea, 0xd4, 0x8b, 0x1d, 0x0f, 0x26, 0x4b, 0xce, 0xe2, 0x2f, 0x1e, 0xba, 0xbb, 0xb4, 0x99, 0x6b,
+	0x05, 0x3f, 0xfe, 0x7f, 0x8e, 0xab, 0x2e, 0x46, 0xc9, 0x71, 0xd9, 0xf4, 0xe2, 0xe7, 0xc8, 0x2f,
+	0x41, 0x70, 0x80, 0x6e, 0x08, 0x49, 0xd9, 0xb9, 0x49, 0xd4, 0xe8, 0xda, 0x05, 0xde, 0x46, 0x4d,
+	0x4b, 0x32, 0xed, 0xb9, 0xd5, 0x75, 0xab, 0xf8, 0xab, 0x87, 0x1e, 0x5c, 0x37, 0xc6, 0xb5, 0x4a,
+	0xec, 0x21, 0xbf, 0x7c, 0x8d, 0x6c, 0x81, 0x4f, 0x2a, 0x0b, 0x5c, 0xb4, 0x75, 0x75, 0x96, 0x55,
+	0x3a, 0x87, 0x17, 0xd3, 0xc8, 0xbb, 0x9c, 0x46, 0xde, 0xef, 0x69, 0xe4, 0x7d, 0x9e, 0x45, 0xb5,
+	0xcb, 0x59, 0x54, 0xfb, 0x39, 0x8b, 0x6a, 0xef, 0x5b, 0x5c, 0xe8, 0xd3, 0x0f, 0x83, 0x64, 0xa8,
+	0x32, 0xf7, 0xa6, 0xdd, 0x4f, 0x0b, 0xe8, 0x59, 0x7a, 0x3e, 0xff, 0x2a, 0xe8, 0x4f, 0x63, 0x06,
+	0x83, 0xa6, 0x79, 0xf0, 0x4f, 0xff, 0x0e, 0x00, 0xbe, 0x14, 0xe3, 0x87, 0x8b, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -318,6 +388,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DowntimeInfractions) > 0 {
+		for iNdEx := len(m.DowntimeInfractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DowntimeInfractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.MissedBlocks) > 0 {
 		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -481,6 +565,50 @@ func (m *MissedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ValidatorDowntimeInfractions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorDowntimeInfractions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorDowntimeInfractions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -512,6 +640,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DowntimeInfractions) > 0 {
+		for _, e := range m.DowntimeInfractions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -564,6 +698,25 @@ func (m *MissedBlock) Size() (n int) {
 	return n
 }
 
+func (m *ValidatorDowntimeInfractions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -700,6 +853,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeInfractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DowntimeInfractions = append(m.DowntimeInfractions, ValidatorDowntimeInfractions{})
+			if err := m.DowntimeInfractions[len(m.DowntimeInfractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1041,6 +1228,122 @@ func (m *MissedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ValidatorDowntimeInfractions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorDowntimeInfractions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorDowntimeInfractions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, DowntimeInfraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
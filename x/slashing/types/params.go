@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,14 +11,19 @@ import (
 
 // Default parameter namespace
 const (
-	DefaultSignedBlocksWindow   = int64(100)
-	DefaultDowntimeJailDuration = 60 * 10 * time.Second
+	DefaultSignedBlocksWindow            = int64(100)
+	DefaultDowntimeJailDuration          = 60 * 10 * time.Second
+	DefaultDowntimeInfractionDecayPeriod = 60 * 60 * 24 * 30 * time.Second
+	DefaultSoftJailDowntimeInfractions   = int64(0)
 )
 
 var (
 	DefaultMinSignedPerWindow      = sdk.NewDecWithPrec(5, 1)
 	DefaultSlashFractionDoubleSign = sdk.NewDec(1).Quo(sdk.NewDec(20))
 	DefaultSlashFractionDowntime   = sdk.NewDec(1).Quo(sdk.NewDec(100))
+	DefaultDowntimeSlashMultiplier = sdk.OneDec()
+	DefaultDowntimeJailMultiplier  = sdk.OneDec()
+	DefaultSoftJailPowerFraction   = sdk.NewDecWithPrec(5, 1)
 )
 
 // Parameter store keys
@@ -27,6 +33,12 @@ var (
 	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
 	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
 	KeySlashFractionDowntime   = []byte("SlashFractionDowntime")
+
+	KeyDowntimeSlashMultiplier       = []byte("DowntimeSlashMultiplier")
+	KeyDowntimeJailMultiplier        = []byte("DowntimeJailMultiplier")
+	KeyDowntimeInfractionDecayPeriod = []byte("DowntimeInfractionDecayPeriod")
+	KeySoftJailDowntimeInfractions   = []byte("SoftJailDowntimeInfractions")
+	KeySoftJailPowerFraction         = []byte("SoftJailPowerFraction")
 )
 
 // ParamKeyTable for slashing module
@@ -38,13 +50,20 @@ func ParamKeyTable() paramtypes.KeyTable {
 func NewParams(
 	signedBlocksWindow int64, minSignedPerWindow sdk.Dec, downtimeJailDuration time.Duration,
 	slashFractionDoubleSign, slashFractionDowntime sdk.Dec,
+	downtimeSlashMultiplier, downtimeJailMultiplier sdk.Dec, downtimeInfractionDecayPeriod time.Duration,
+	softJailDowntimeInfractions int64, softJailPowerFraction sdk.Dec,
 ) Params {
 	return Params{
-		SignedBlocksWindow:      signedBlocksWindow,
-		MinSignedPerWindow:      minSignedPerWindow,
-		DowntimeJailDuration:    downtimeJailDuration,
-		SlashFractionDoubleSign: slashFractionDoubleSign,
-		SlashFractionDowntime:   slashFractionDowntime,
+		SignedBlocksWindow:            signedBlocksWindow,
+		MinSignedPerWindow:            minSignedPerWindow,
+		DowntimeJailDuration:          downtimeJailDuration,
+		SlashFractionDoubleSign:       slashFractionDoubleSign,
+		SlashFractionDowntime:         slashFractionDowntime,
+		DowntimeSlashMultiplier:       downtimeSlashMultiplier,
+		DowntimeJailMultiplier:        downtimeJailMultiplier,
+		DowntimeInfractionDecayPeriod: downtimeInfractionDecayPeriod,
+		SoftJailDowntimeInfractions:   softJailDowntimeInfractions,
+		SoftJailPowerFraction:         softJailPowerFraction,
 	}
 }
 
@@ -56,6 +75,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDowntimeJailDuration, &p.DowntimeJailDuration, validateDowntimeJailDuration),
 		paramtypes.NewParamSetPair(KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign, validateSlashFractionDoubleSign),
 		paramtypes.NewParamSetPair(KeySlashFractionDowntime, &p.SlashFractionDowntime, validateSlashFractionDowntime),
+		paramtypes.NewParamSetPair(KeyDowntimeSlashMultiplier, &p.DowntimeSlashMultiplier, validateDowntimeSlashMultiplier),
+		paramtypes.NewParamSetPair(KeyDowntimeJailMultiplier, &p.DowntimeJailMultiplier, validateDowntimeJailMultiplier),
+		paramtypes.NewParamSetPair(KeyDowntimeInfractionDecayPeriod, &p.DowntimeInfractionDecayPeriod, validateDowntimeInfractionDecayPeriod),
+		paramtypes.NewParamSetPair(KeySoftJailDowntimeInfractions, &p.SoftJailDowntimeInfractions, validateSoftJailDowntimeInfractions),
+		paramtypes.NewParamSetPair(KeySoftJailPowerFraction, &p.SoftJailPowerFraction, validateSoftJailPowerFraction),
 	}
 }
 
@@ -64,9 +88,47 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration,
 		DefaultSlashFractionDoubleSign, DefaultSlashFractionDowntime,
+		DefaultDowntimeSlashMultiplier, DefaultDowntimeJailMultiplier, DefaultDowntimeInfractionDecayPeriod,
+		DefaultSoftJailDowntimeInfractions, DefaultSoftJailPowerFraction,
 	)
 }
 
+// EscalatedDowntimeSlashFraction returns the slash fraction of a downtime infraction of a
+// validator with the given number of previous downtime infractions within the downtime infraction
+// decay period, capped at one.
+func (p Params) EscalatedDowntimeSlashFraction(previousInfractions int64) sdk.Dec {
+	fraction := p.SlashFractionDowntime
+	for i := int64(0); i < previousInfractions && fraction.LT(sdk.OneDec()); i++ {
+		fraction = fraction.Mul(p.DowntimeSlashMultiplier)
+	}
+
+	return sdk.MinDec(fraction, sdk.OneDec())
+}
+
+// EscalatedDowntimeJailDuration returns the jail duration of a downtime infraction of a validator
+// with the given number of previous downtime infractions within the downtime infraction decay
+// period, capped at the maximum duration.
+func (p Params) EscalatedDowntimeJailDuration(previousInfractions int64) time.Duration {
+	maxDuration := sdk.NewDec(math.MaxInt64)
+	duration := sdk.NewDec(int64(p.DowntimeJailDuration))
+	for i := int64(0); i < previousInfractions && duration.LT(maxDuration); i++ {
+		duration = duration.Mul(p.DowntimeJailMultiplier)
+	}
+
+	return time.Duration(sdk.MinDec(duration, maxDuration).TruncateInt64())
+}
+
+// SoftJailPowerCap returns the voting power a soft jailed validator of the given voting power is
+// capped at, at least one so that it stays in the validator set.
+func (p Params) SoftJailPowerCap(power int64) int64 {
+	powerCap := p.SoftJailPowerFraction.MulInt64(power).TruncateInt64()
+	if powerCap < 1 {
+		return 1
+	}
+
+	return powerCap
+}
+
 func validateSignedBlocksWindow(i interface{}) error {
 	v, ok := i.(int64)
 	if !ok {
@@ -140,3 +202,71 @@ func validateSlashFractionDowntime(i interface{}) error {
 
 	return nil
 }
+
+func validateDowntimeSlashMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime slash multiplier must be greater than or equal to one: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeJailMultiplier(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.LT(sdk.OneDec()) {
+		return fmt.Errorf("downtime jail multiplier must be greater than or equal to one: %s", v)
+	}
+
+	return nil
+}
+
+func validateDowntimeInfractionDecayPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("downtime infraction decay period must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateSoftJailDowntimeInfractions(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("soft jail downtime infractions cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateSoftJailPowerFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("soft jail power fraction must be positive: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("soft jail power fraction too large: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEscalatedDowntimePenalties(t *testing.T) {
+	params := DefaultParams()
+	params.SlashFractionDowntime = sdk.NewDecWithPrec(1, 1)
+	params.DowntimeSlashMultiplier = sdk.NewDec(3)
+	params.DowntimeJailDuration = time.Hour
+	params.DowntimeJailMultiplier = sdk.NewDec(2)
+
+	tests := []struct {
+		previousInfractions int64
+		slashFraction       sdk.Dec
+		jailDuration        time.Duration
+	}{
+		{0, sdk.NewDecWithPrec(1, 1), time.Hour},
+		{1, sdk.NewDecWithPrec(3, 1), 2 * time.Hour},
+		{2, sdk.NewDecWithPrec(9, 1), 4 * time.Hour},
+		{3, sdk.OneDec(), 8 * time.Hour},
+		{1000, sdk.OneDec(), time.Duration(math.MaxInt64)},
+	}
+	for _, tc := range tests {
+		require.True(t, tc.slashFraction.Equal(params.EscalatedDowntimeSlashFraction(tc.previousInfractions)), tc.previousInfractions)
+		require.Equal(t, tc.jailDuration, params.EscalatedDowntimeJailDuration(tc.previousInfractions), tc.previousInfractions)
+	}
+
+	// the default multipliers keep the penalties unchanged
+	params = DefaultParams()
+	require.True(t, params.SlashFractionDowntime.Equal(params.EscalatedDowntimeSlashFraction(5)))
+	require.Equal(t, params.DowntimeJailDuration, params.EscalatedDowntimeJailDuration(5))
+}
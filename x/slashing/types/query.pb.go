@@ -308,6 +308,116 @@ func (m *QuerySigningInfosResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryInfractionHistoryRequest is the request type for the
+// Query/InfractionHistory RPC method
+type QueryInfractionHistoryRequest struct {
+	// cons_address is the address to query the infraction history of
+	ConsAddress string             `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInfractionHistoryRequest) Reset()         { *m = QueryInfractionHistoryRequest{} }
+func (m *QueryInfractionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInfractionHistoryRequest) ProtoMessage()    {}
+func (*QueryInfractionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{6}
+}
+func (m *QueryInfractionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInfractionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInfractionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInfractionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInfractionHistoryRequest.Merge(m, src)
+}
+func (m *QueryInfractionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInfractionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInfractionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInfractionHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryInfractionHistoryRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryInfractionHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInfractionHistoryResponse is the response type for the
+// Query/InfractionHistory RPC method
+type QueryInfractionHistoryResponse struct {
+	// infractions is the downtime infraction history of the validator, by height
+	Infractions []DowntimeInfraction `protobuf:"bytes,1,rep,name=infractions,proto3" json:"infractions"`
+	Pagination  *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInfractionHistoryResponse) Reset()         { *m = QueryInfractionHistoryResponse{} }
+func (m *QueryInfractionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInfractionHistoryResponse) ProtoMessage()    {}
+func (*QueryInfractionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_791b11d41a861ed0, []int{7}
+}
+func (m *QueryInfractionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInfractionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInfractionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInfractionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInfractionHistoryResponse.Merge(m, src)
+}
+func (m *QueryInfractionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInfractionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInfractionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInfractionHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryInfractionHistoryResponse) GetInfractions() []DowntimeInfraction {
+	if m != nil {
+		return m.Infractions
+	}
+	return nil
+}
+
+func (m *QueryInfractionHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.slashing.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.slashing.v1beta1.QueryParamsResponse")
@@ -315,6 +425,8 @@ func init() {
 	proto.RegisterType((*QuerySigningInfoResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfoResponse")
 	proto.RegisterType((*QuerySigningInfosRequest)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosRequest")
 	proto.RegisterType((*QuerySigningInfosResponse)(nil), "cosmos.slashing.v1beta1.QuerySigningInfosResponse")
+	proto.RegisterType((*QueryInfractionHistoryRequest)(nil), "cosmos.slashing.v1beta1.QueryInfractionHistoryRequest")
+	proto.RegisterType((*QueryInfractionHistoryResponse)(nil), "cosmos.slashing.v1beta1.QueryInfractionHistoryResponse")
 }
 
 func init() {
@@ -322,42 +434,48 @@ func init() {
 }
 
 var fileDescriptor_791b11d41a861ed0 = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0xb3, 0x6d, 0x7f, 0x81, 0xdf, 0xa4, 0x88, 0x8c, 0x81, 0xa6, 0x41, 0x36, 0xba, 0x42,
-	0x5a, 0xd4, 0xec, 0x9a, 0x88, 0x78, 0x90, 0x1e, 0xcc, 0xc1, 0xe0, 0x4d, 0x53, 0xe9, 0x41, 0x90,
-	0x30, 0x9b, 0x4c, 0xa7, 0x83, 0x9b, 0x99, 0xed, 0xce, 0x24, 0x18, 0xc4, 0x8b, 0x67, 0x0f, 0x82,
-	0x7f, 0x83, 0x47, 0x0f, 0x82, 0x7f, 0x44, 0x8f, 0x45, 0x2f, 0x9e, 0x44, 0x12, 0xff, 0x02, 0xff,
-	0x02, 0xc9, 0xcc, 0x4b, 0xb2, 0x25, 0xae, 0xa6, 0x9e, 0x32, 0x79, 0xf3, 0xbe, 0xdf, 0xf7, 0x79,
-	0x33, 0x6f, 0x16, 0x5d, 0xeb, 0x4a, 0xd5, 0x97, 0x2a, 0x50, 0x11, 0x51, 0x47, 0x5c, 0xb0, 0x60,
-	0x58, 0x0f, 0xa9, 0x26, 0xf5, 0xe0, 0x78, 0x40, 0x93, 0x91, 0x1f, 0x27, 0x52, 0x4b, 0xbc, 0x65,
-	0x93, 0xfc, 0x59, 0x92, 0x0f, 0x49, 0xe5, 0xeb, 0xa0, 0x0e, 0x89, 0xa2, 0x56, 0x31, 0xd7, 0xc7,
-	0x84, 0x71, 0x41, 0x34, 0x97, 0xc2, 0x9a, 0x94, 0x8b, 0x4c, 0x32, 0x69, 0x96, 0xc1, 0x74, 0x05,
-	0xd1, 0xcb, 0x4c, 0x4a, 0x16, 0xd1, 0x80, 0xc4, 0x3c, 0x20, 0x42, 0x48, 0x6d, 0x24, 0x0a, 0x76,
-	0xab, 0x59, 0x74, 0x73, 0x12, 0x9b, 0xb7, 0x6d, 0xf3, 0x3a, 0xd6, 0x1e, 0x68, 0xcd, 0x1f, 0xaf,
-	0x88, 0xf0, 0xe3, 0x29, 0xd8, 0x23, 0x92, 0x90, 0xbe, 0x6a, 0xd3, 0xe3, 0x01, 0x55, 0xda, 0x7b,
-	0x82, 0x2e, 0x9d, 0x89, 0xaa, 0x58, 0x0a, 0x45, 0xf1, 0x1e, 0xca, 0xc7, 0x26, 0x52, 0x72, 0xae,
-	0x38, 0xbb, 0x85, 0x46, 0xc5, 0xcf, 0xe8, 0xdc, 0xb7, 0xc2, 0xe6, 0xc6, 0xc9, 0xb7, 0x4a, 0xae,
-	0x0d, 0x22, 0xef, 0x00, 0x6d, 0x19, 0xd7, 0x7d, 0xce, 0x04, 0x17, 0xec, 0xa1, 0x38, 0x94, 0x50,
-	0x10, 0xdf, 0x43, 0x9b, 0x5d, 0x29, 0x54, 0x87, 0xf4, 0x7a, 0x09, 0x55, 0xd6, 0xff, 0xff, 0x66,
-	0xe9, 0xf3, 0xa7, 0x5a, 0x11, 0x4a, 0xdc, 0xb7, 0x3b, 0xfb, 0x3a, 0xe1, 0x82, 0xb5, 0x0b, 0xd3,
-	0x6c, 0x08, 0x79, 0x23, 0x54, 0x5a, 0xf6, 0x05, 0xe4, 0x67, 0xe8, 0xe2, 0x90, 0x44, 0x1d, 0x65,
-	0xb7, 0x3a, 0x5c, 0x1c, 0x4a, 0x80, 0xaf, 0x65, 0xc2, 0x1f, 0x90, 0x88, 0xf7, 0x88, 0x96, 0x49,
-	0xca, 0x10, 0x5a, 0xb9, 0x30, 0x24, 0x51, 0x2a, 0xea, 0x85, 0xcb, 0xa5, 0x67, 0x87, 0x88, 0x1f,
-	0x20, 0xb4, 0xb8, 0x65, 0x28, 0x5a, 0x9d, 0x15, 0x9d, 0x8e, 0x84, 0x6f, 0x87, 0x68, 0x71, 0x66,
-	0x8c, 0x82, 0xb6, 0x9d, 0x52, 0x7a, 0x1f, 0x1c, 0xb4, 0xfd, 0x9b, 0x22, 0xd0, 0x60, 0x0b, 0x6d,
-	0x40, 0x53, 0xeb, 0xff, 0xda, 0x94, 0x31, 0xc0, 0xad, 0x33, 0xb8, 0x6b, 0x06, 0x77, 0xe7, 0xaf,
-	0xb8, 0x96, 0x22, 0xcd, 0xdb, 0xf8, 0xb9, 0x8e, 0xfe, 0x33, 0xbc, 0xf8, 0x8d, 0x83, 0xf2, 0x76,
-	0x12, 0xf0, 0x8d, 0x4c, 0xb0, 0xe5, 0xf1, 0x2b, 0xdf, 0x5c, 0x2d, 0xd9, 0xd6, 0xf6, 0x76, 0x5e,
-	0x7f, 0xf9, 0xf1, 0x6e, 0xed, 0x2a, 0xae, 0x04, 0x59, 0xcf, 0xc1, 0xce, 0x1f, 0xfe, 0xe8, 0xa0,
-	0x42, 0xaa, 0x7b, 0x7c, 0xeb, 0xcf, 0x65, 0x96, 0xc7, 0xb4, 0x5c, 0x3f, 0x87, 0x02, 0xe8, 0xf6,
-	0x0c, 0xdd, 0x5d, 0x7c, 0x27, 0x93, 0x2e, 0x3d, 0x9b, 0x2a, 0x78, 0x99, 0x7e, 0x07, 0xaf, 0xf0,
-	0x7b, 0x07, 0x6d, 0xa6, 0xef, 0x1d, 0xaf, 0x8e, 0x30, 0x3f, 0xce, 0xc6, 0x79, 0x24, 0x80, 0xed,
-	0x1b, 0xec, 0x5d, 0x5c, 0x5d, 0x0d, 0xbb, 0xd9, 0x3a, 0x19, 0xbb, 0xce, 0xe9, 0xd8, 0x75, 0xbe,
-	0x8f, 0x5d, 0xe7, 0xed, 0xc4, 0xcd, 0x9d, 0x4e, 0xdc, 0xdc, 0xd7, 0x89, 0x9b, 0x7b, 0x5a, 0x63,
-	0x5c, 0x1f, 0x0d, 0x42, 0xbf, 0x2b, 0xfb, 0x33, 0x2f, 0xfb, 0x53, 0x53, 0xbd, 0xe7, 0xc1, 0x8b,
-	0x85, 0xb1, 0x1e, 0xc5, 0x54, 0x85, 0x79, 0xf3, 0x5d, 0xba, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff,
-	0xb9, 0x03, 0x61, 0xef, 0x7a, 0x05, 0x00, 0x00,
+	// 645 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0xc7, 0x99, 0xda, 0x92, 0x38, 0x34, 0x46, 0x47, 0x92, 0x52, 0xa2, 0x8b, 0xae, 0x09, 0x6d,
+	0x54, 0x76, 0x05, 0xa3, 0x4d, 0xd4, 0x1e, 0x24, 0xa6, 0xd8, 0x9b, 0x82, 0xe9, 0xc1, 0xc4, 0x90,
+	0x01, 0x96, 0x65, 0x22, 0xcc, 0x6c, 0x77, 0x06, 0x94, 0x18, 0x2f, 0x9e, 0x3d, 0x98, 0xf8, 0x15,
+	0xf4, 0xe8, 0xc1, 0xc4, 0xab, 0x9e, 0x39, 0x36, 0x7a, 0xf1, 0x64, 0x0c, 0xf8, 0x41, 0x0c, 0x33,
+	0x03, 0x2c, 0xd2, 0xad, 0xd0, 0xf4, 0xd4, 0xed, 0x9b, 0xf7, 0xff, 0xbf, 0xdf, 0x7b, 0xfb, 0x66,
+	0x81, 0x57, 0xaa, 0x8c, 0xb7, 0x18, 0xb7, 0x79, 0x13, 0xf3, 0x06, 0xa1, 0xae, 0xdd, 0xc9, 0x56,
+	0x1c, 0x81, 0xb3, 0xf6, 0x7e, 0xdb, 0xf1, 0xbb, 0x96, 0xe7, 0x33, 0xc1, 0xd0, 0x9a, 0x4a, 0xb2,
+	0x46, 0x49, 0x96, 0x4e, 0x4a, 0x5e, 0xd5, 0xea, 0x0a, 0xe6, 0x8e, 0x52, 0x8c, 0xf5, 0x1e, 0x76,
+	0x09, 0xc5, 0x82, 0x30, 0xaa, 0x4c, 0x92, 0x71, 0x97, 0xb9, 0x4c, 0x3e, 0xda, 0xc3, 0x27, 0x1d,
+	0xbd, 0xe0, 0x32, 0xe6, 0x36, 0x1d, 0x1b, 0x7b, 0xc4, 0xc6, 0x94, 0x32, 0x21, 0x25, 0x5c, 0x9f,
+	0xa6, 0xc3, 0xe8, 0xc6, 0x24, 0x2a, 0x6f, 0x5d, 0xe5, 0x95, 0x95, 0xbd, 0xa6, 0x95, 0xff, 0x98,
+	0x71, 0x88, 0x1e, 0x0f, 0xc1, 0x1e, 0x61, 0x1f, 0xb7, 0x78, 0xd1, 0xd9, 0x6f, 0x3b, 0x5c, 0x98,
+	0x4f, 0xe0, 0xf9, 0xa9, 0x28, 0xf7, 0x18, 0xe5, 0x0e, 0xda, 0x86, 0x51, 0x4f, 0x46, 0x12, 0xe0,
+	0x12, 0xd8, 0x8c, 0xe5, 0x52, 0x56, 0x48, 0xe7, 0x96, 0x12, 0xe6, 0x97, 0x7b, 0xbf, 0x52, 0x91,
+	0xa2, 0x16, 0x99, 0x7b, 0x70, 0x4d, 0xba, 0x96, 0x88, 0x4b, 0x09, 0x75, 0x77, 0x69, 0x9d, 0xe9,
+	0x82, 0xe8, 0x2e, 0x5c, 0xad, 0x32, 0xca, 0xcb, 0xb8, 0x56, 0xf3, 0x1d, 0xae, 0xfc, 0x4f, 0xe7,
+	0x13, 0xdf, 0xbf, 0x64, 0xe2, 0xba, 0xc4, 0x7d, 0x75, 0x52, 0x12, 0x3e, 0xa1, 0x6e, 0x31, 0x36,
+	0xcc, 0xd6, 0x21, 0xb3, 0x0b, 0x13, 0xb3, 0xbe, 0x1a, 0xf9, 0x19, 0x3c, 0xdb, 0xc1, 0xcd, 0x32,
+	0x57, 0x47, 0x65, 0x42, 0xeb, 0x4c, 0xc3, 0x67, 0x42, 0xe1, 0xf7, 0x70, 0x93, 0xd4, 0xb0, 0x60,
+	0x7e, 0xc0, 0x50, 0xb7, 0x72, 0xa6, 0x83, 0x9b, 0x81, 0xa8, 0x59, 0x99, 0x2d, 0x3d, 0x1a, 0x22,
+	0xda, 0x81, 0x70, 0xf2, 0x96, 0x75, 0xd1, 0xf4, 0xa8, 0xe8, 0x70, 0x25, 0x2c, 0xb5, 0x44, 0x93,
+	0x99, 0xb9, 0x8e, 0xd6, 0x16, 0x03, 0x4a, 0xf3, 0x13, 0x80, 0xeb, 0x87, 0x14, 0xd1, 0x0d, 0x16,
+	0xe0, 0xb2, 0x6e, 0xea, 0xd4, 0x71, 0x9b, 0x92, 0x06, 0xa8, 0x30, 0x85, 0xbb, 0x24, 0x71, 0x37,
+	0xfe, 0x8b, 0xab, 0x28, 0xa6, 0x78, 0x3f, 0x00, 0x78, 0x51, 0xf2, 0xee, 0xd2, 0xba, 0x8f, 0xab,
+	0xc3, 0xd8, 0x43, 0xc2, 0x05, 0xf3, 0xbb, 0x27, 0xf1, 0xb6, 0xd1, 0xce, 0x21, 0x9c, 0xc7, 0x19,
+	0xeb, 0x37, 0x00, 0x8d, 0x30, 0x4c, 0x3d, 0xdb, 0x12, 0x8c, 0x91, 0xf1, 0x21, 0xd7, 0x23, 0xbe,
+	0x16, 0x3a, 0xe2, 0x07, 0xec, 0x05, 0x15, 0xa4, 0xe5, 0x4c, 0x0c, 0xf5, 0x80, 0x83, 0x2e, 0x27,
+	0x36, 0xe7, 0xdc, 0xd7, 0x15, 0xb8, 0x22, 0x1b, 0x40, 0x6f, 0x01, 0x8c, 0xaa, 0x1b, 0x87, 0xc2,
+	0xe9, 0x66, 0xaf, 0x79, 0xf2, 0xfa, 0x7c, 0xc9, 0xaa, 0xb6, 0xb9, 0xf1, 0xe6, 0xc7, 0x9f, 0xf7,
+	0x4b, 0x97, 0x51, 0xca, 0x0e, 0xfb, 0xec, 0xa8, 0x7b, 0x8e, 0x3e, 0x03, 0x18, 0x0b, 0x6c, 0x19,
+	0xba, 0x71, 0x74, 0x99, 0xd9, 0xcf, 0x41, 0x32, 0xbb, 0x80, 0x42, 0xd3, 0x6d, 0x4b, 0xba, 0x2d,
+	0x74, 0x2b, 0x94, 0x2e, 0xf8, 0x0d, 0xe0, 0xf6, 0xab, 0xe0, 0x06, 0xbe, 0x46, 0x1f, 0x01, 0x5c,
+	0x0d, 0xd8, 0x72, 0x34, 0x3f, 0xc2, 0x78, 0x9c, 0xb9, 0x45, 0x24, 0x1a, 0xdb, 0x92, 0xd8, 0x9b,
+	0x28, 0x3d, 0x1f, 0x36, 0xea, 0x01, 0x78, 0x6e, 0x66, 0x61, 0xd1, 0xed, 0xa3, 0x2b, 0x87, 0x5d,
+	0xc4, 0xe4, 0xd6, 0xc2, 0x3a, 0x8d, 0x9d, 0x97, 0xd8, 0xf7, 0xd0, 0x9d, 0x50, 0xec, 0xc9, 0xca,
+	0x97, 0x1b, 0x4a, 0xfc, 0xcf, 0xc8, 0xf3, 0x85, 0x5e, 0xdf, 0x00, 0x07, 0x7d, 0x03, 0xfc, 0xee,
+	0x1b, 0xe0, 0xdd, 0xc0, 0x88, 0x1c, 0x0c, 0x8c, 0xc8, 0xcf, 0x81, 0x11, 0x79, 0x9a, 0x71, 0x89,
+	0x68, 0xb4, 0x2b, 0x56, 0x95, 0xb5, 0x46, 0xfe, 0xea, 0x4f, 0x86, 0xd7, 0x9e, 0xdb, 0x2f, 0x27,
+	0xc5, 0x44, 0xd7, 0x73, 0x78, 0x25, 0x2a, 0x7f, 0xca, 0x6e, 0xfe, 0x1d, 0x00, 0x2a, 0xc5, 0xbc,
+	0x55, 0xad, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -378,6 +496,8 @@ type QueryClient interface {
 	SigningInfo(ctx context.Context, in *QuerySigningInfoRequest, opts ...grpc.CallOption) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(ctx context.Context, in *QuerySigningInfosRequest, opts ...grpc.CallOption) (*QuerySigningInfosResponse, error)
+	// InfractionHistory queries the downtime infraction history of given cons address
+	InfractionHistory(ctx context.Context, in *QueryInfractionHistoryRequest, opts ...grpc.CallOption) (*QueryInfractionHistoryResponse, error)
 }
 
 type queryClient struct {
@@ -415,6 +535,15 @@ func (c *queryClient) SigningInfos(ctx context.Context, in *QuerySigningInfosReq
 	return out, nil
 }
 
+func (c *queryClient) InfractionHistory(ctx context.Context, in *QueryInfractionHistoryRequest, opts ...grpc.CallOption) (*QueryInfractionHistoryResponse, error) {
+	out := new(QueryInfractionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.slashing.v1beta1.Query/InfractionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of slashing module
@@ -423,6 +552,8 @@ type QueryServer interface {
 	SigningInfo(context.Context, *QuerySigningInfoRequest) (*QuerySigningInfoResponse, error)
 	// SigningInfos queries signing info of all validators
 	SigningInfos(context.Context, *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error)
+	// InfractionHistory queries the downtime infraction history of given cons address
+	InfractionHistory(context.Context, *QueryInfractionHistoryRequest) (*QueryInfractionHistoryResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -438,6 +569,9 @@ func (*UnimplementedQueryServer) SigningInfo(ctx context.Context, req *QuerySign
 func (*UnimplementedQueryServer) SigningInfos(ctx context.Context, req *QuerySigningInfosRequest) (*QuerySigningInfosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SigningInfos not implemented")
 }
+func (*UnimplementedQueryServer) InfractionHistory(ctx context.Context, req *QueryInfractionHistoryRequest) (*QueryInfractionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InfractionHistory not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -497,6 +631,24 @@ func _Query_SigningInfos_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InfractionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInfractionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InfractionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.slashing.v1beta1.Query/InfractionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InfractionHistory(ctx, req.(*QueryInfractionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.slashing.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -513,6 +665,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SigningInfos",
 			Handler:    _Query_SigningInfos_Handler,
 		},
+		{
+			MethodName: "InfractionHistory",
+			Handler:    _Query_InfractionHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/slashing/v1beta1/query.proto",
@@ -721,6 +877,97 @@ func (m *QuerySigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryInfractionHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInfractionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInfractionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInfractionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInfractionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInfractionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Infractions) > 0 {
+		for iNdEx := len(m.Infractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -808,6 +1055,42 @@ func (m *QuerySigningInfosResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryInfractionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInfractionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infractions) > 0 {
+		for _, e := range m.Infractions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1318,6 +1601,244 @@ func (m *QuerySigningInfosResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryInfractionHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInfractionHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInfractionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInfractionHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInfractionHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInfractionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infractions = append(m.Infractions, DowntimeInfraction{})
+			if err := m.Infractions[len(m.Infractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
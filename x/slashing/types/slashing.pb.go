@@ -131,6 +131,21 @@ type Params struct {
 	DowntimeJailDuration    time.Duration                          `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
 	SlashFractionDoubleSign github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=slash_fraction_double_sign,json=slashFractionDoubleSign,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_double_sign"`
 	SlashFractionDowntime   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_downtime"`
+	// downtime_slash_multiplier multiplies the downtime slash fraction of a validator for each of
+	// its previous downtime infractions within the downtime infraction decay period.
+	DowntimeSlashMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=downtime_slash_multiplier,json=downtimeSlashMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_slash_multiplier"`
+	// downtime_jail_multiplier multiplies the downtime jail duration of a validator for each of its
+	// previous downtime infractions within the downtime infraction decay period.
+	DowntimeJailMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=downtime_jail_multiplier,json=downtimeJailMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"downtime_jail_multiplier"`
+	// downtime_infraction_decay_period is the period after which a downtime infraction no longer
+	// escalates the penalties of the next ones.
+	DowntimeInfractionDecayPeriod time.Duration `protobuf:"bytes,8,opt,name=downtime_infraction_decay_period,json=downtimeInfractionDecayPeriod,proto3,stdduration" json:"downtime_infraction_decay_period"`
+	// soft_jail_downtime_infractions is the number of downtime infractions of a validator within the
+	// downtime infraction decay period which soft jail it: the validator is slashed and its voting
+	// power is capped for the jail duration, but it is not jailed and so does not begin unbonding.
+	SoftJailDowntimeInfractions int64 `protobuf:"varint,9,opt,name=soft_jail_downtime_infractions,json=softJailDowntimeInfractions,proto3" json:"soft_jail_downtime_infractions,omitempty"`
+	// soft_jail_power_fraction is the fraction of its voting power a soft jailed validator keeps.
+	SoftJailPowerFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=soft_jail_power_fraction,json=softJailPowerFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"soft_jail_power_fraction"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -180,9 +195,100 @@ func (m *Params) GetDowntimeJailDuration() time.Duration {
 	return 0
 }
 
+func (m *Params) GetDowntimeInfractionDecayPeriod() time.Duration {
+	if m != nil {
+		return m.DowntimeInfractionDecayPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetSoftJailDowntimeInfractions() int64 {
+	if m != nil {
+		return m.SoftJailDowntimeInfractions
+	}
+	return 0
+}
+
+// DowntimeInfraction defines a downtime infraction of a validator and the
+// penalties it incurred.
+type DowntimeInfraction struct {
+	// height is the height of the infraction.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the infraction.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// slash_fraction is the fraction of the validator stake slashed.
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// jail_duration is the duration the validator was jailed or soft jailed for.
+	JailDuration time.Duration `protobuf:"bytes,4,opt,name=jail_duration,json=jailDuration,proto3,stdduration" json:"jail_duration"`
+	// soft_jailed defines whether the validator was soft jailed rather than jailed.
+	SoftJailed bool `protobuf:"varint,5,opt,name=soft_jailed,json=softJailed,proto3" json:"soft_jailed,omitempty"`
+}
+
+func (m *DowntimeInfraction) Reset()         { *m = DowntimeInfraction{} }
+func (m *DowntimeInfraction) String() string { return proto.CompactTextString(m) }
+func (*DowntimeInfraction) ProtoMessage()    {}
+func (*DowntimeInfraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1078e5d96a74cc52, []int{2}
+}
+func (m *DowntimeInfraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeInfraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeInfraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeInfraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeInfraction.Merge(m, src)
+}
+func (m *DowntimeInfraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeInfraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeInfraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeInfraction proto.InternalMessageInfo
+
+func (m *DowntimeInfraction) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DowntimeInfraction) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *DowntimeInfraction) GetJailDuration() time.Duration {
+	if m != nil {
+		return m.JailDuration
+	}
+	return 0
+}
+
+func (m *DowntimeInfraction) GetSoftJailed() bool {
+	if m != nil {
+		return m.SoftJailed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ValidatorSigningInfo)(nil), "cosmos.slashing.v1beta1.ValidatorSigningInfo")
 	proto.RegisterType((*Params)(nil), "cosmos.slashing.v1beta1.Params")
+	proto.RegisterType((*DowntimeInfraction)(nil), "cosmos.slashing.v1beta1.DowntimeInfraction")
 }
 
 func init() {
@@ -190,43 +296,55 @@ func init() {
 }
 
 var fileDescriptor_1078e5d96a74cc52 = []byte{
-	// 576 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x1c, 0x8d, 0x1b, 0x08, 0xe1, 0x92, 0xe9, 0x48, 0x89, 0x9b, 0xc1, 0x0e, 0x1d, 0xaa, 0x2c, 0xb1,
-	0x69, 0xd8, 0xd8, 0x08, 0x11, 0x7f, 0x07, 0x2a, 0x87, 0x3f, 0x82, 0xc5, 0x3a, 0xfb, 0xce, 0xce,
-	0x11, 0xfb, 0x2e, 0xf2, 0x9d, 0x49, 0xf9, 0x16, 0x1d, 0x3b, 0x76, 0xe4, 0x03, 0x20, 0xf1, 0x15,
-	0xba, 0x20, 0x55, 0x4c, 0x88, 0xa1, 0xa0, 0x64, 0xe1, 0x63, 0x20, 0xdf, 0xd9, 0x2d, 0x6a, 0xa5,
-	0x4a, 0x9d, 0x12, 0xbf, 0xf7, 0x7e, 0xef, 0xf9, 0xf7, 0xee, 0x0c, 0x76, 0x42, 0x2e, 0x52, 0x2e,
-	0x5c, 0x91, 0x20, 0x31, 0xa3, 0x2c, 0x76, 0x3f, 0xed, 0x06, 0x44, 0xa2, 0xdd, 0x33, 0xc0, 0x59,
-	0x64, 0x5c, 0x72, 0xd8, 0xd5, 0x3a, 0xe7, 0x0c, 0x2e, 0x75, 0xbd, 0x4e, 0xcc, 0x63, 0xae, 0x34,
-	0x6e, 0xf1, 0x4f, 0xcb, 0x7b, 0x56, 0xcc, 0x79, 0x9c, 0x10, 0x57, 0x3d, 0x05, 0x79, 0xe4, 0xe2,
-	0x3c, 0x43, 0x92, 0x72, 0x56, 0xf2, 0xf6, 0x45, 0x5e, 0xd2, 0x94, 0x08, 0x89, 0xd2, 0x45, 0x29,
-	0xd8, 0xd2, 0x79, 0xbe, 0x76, 0x2e, 0xc3, 0xd5, 0xc3, 0xf6, 0xb7, 0x0d, 0xd0, 0x79, 0x8b, 0x12,
-	0x8a, 0x91, 0xe4, 0xd9, 0x94, 0xc6, 0x8c, 0xb2, 0xf8, 0x39, 0x8b, 0x38, 0x1c, 0x81, 0x5b, 0x08,
-	0xe3, 0x8c, 0x08, 0x61, 0x1a, 0x7d, 0x63, 0x70, 0x7b, 0x6c, 0xfe, 0xf8, 0x3a, 0xec, 0x94, 0xb3,
-	0x8f, 0x34, 0x33, 0x95, 0x19, 0x65, 0xb1, 0x57, 0x09, 0xe1, 0x3d, 0xd0, 0x16, 0x12, 0x65, 0xd2,
-	0x9f, 0x11, 0x1a, 0xcf, 0xa4, 0xb9, 0xd1, 0x37, 0x06, 0x75, 0xaf, 0xa5, 0xb0, 0x67, 0x0a, 0x2a,
-	0x24, 0x94, 0x61, 0xb2, 0xef, 0xf3, 0x28, 0x12, 0x44, 0x9a, 0x75, 0x2d, 0x51, 0xd8, 0x2b, 0x05,
-	0xc1, 0xa7, 0xa0, 0xfd, 0x11, 0xd1, 0x84, 0x60, 0x3f, 0x67, 0x92, 0x26, 0xe6, 0x8d, 0xbe, 0x31,
-	0x68, 0x8d, 0x7a, 0x8e, 0xde, 0xd2, 0xa9, 0xb6, 0x74, 0x5e, 0x57, 0x5b, 0x8e, 0x9b, 0xc7, 0xa7,
-	0x76, 0xed, 0xe0, 0xb7, 0x6d, 0x78, 0x2d, 0x3d, 0xf9, 0xa6, 0x18, 0x84, 0x16, 0x00, 0x92, 0xa7,
-	0x81, 0x90, 0x9c, 0x11, 0x6c, 0xde, 0xec, 0x1b, 0x83, 0xa6, 0xf7, 0x1f, 0x02, 0x47, 0x60, 0x33,
-	0xa5, 0x42, 0x10, 0xec, 0x07, 0x09, 0x0f, 0xe7, 0xc2, 0x0f, 0x79, 0xce, 0x24, 0xc9, 0xcc, 0x86,
-	0x7a, 0xa9, 0x3b, 0x9a, 0x1c, 0x2b, 0xee, 0xb1, 0xa6, 0x1e, 0x36, 0x0f, 0x8f, 0xec, 0xda, 0xdf,
-	0x23, 0xdb, 0xd8, 0xfe, 0x5e, 0x07, 0x8d, 0x3d, 0x94, 0xa1, 0x54, 0xc0, 0xfb, 0xa0, 0x23, 0x68,
-	0xcc, 0xce, 0x8d, 0x96, 0x94, 0x61, 0xbe, 0x54, 0xc5, 0xd5, 0x3d, 0xa8, 0x39, 0xed, 0xf3, 0x4e,
-	0x31, 0x10, 0x15, 0xd1, 0xcc, 0x2f, 0xa7, 0x16, 0x24, 0xab, 0x46, 0x8a, 0xca, 0xda, 0x63, 0xa7,
-	0x58, 0xe8, 0xd7, 0xa9, 0xbd, 0x13, 0x53, 0x39, 0xcb, 0x03, 0x27, 0xe4, 0x69, 0x79, 0x6c, 0xe5,
-	0xcf, 0x50, 0xe0, 0xb9, 0x2b, 0x3f, 0x2f, 0x88, 0x70, 0x26, 0x24, 0xf4, 0x60, 0x4a, 0xd9, 0x54,
-	0x79, 0xed, 0x91, 0xac, 0x8c, 0x78, 0x0f, 0xee, 0x62, 0xbe, 0x64, 0xc5, 0x5d, 0xf0, 0x8b, 0x56,
-	0xfc, 0xea, 0xd6, 0xa8, 0xce, 0x5b, 0xa3, 0xad, 0x4b, 0x85, 0x4e, 0x4a, 0x81, 0xee, 0xf3, 0xb0,
-	0xe8, 0xb3, 0x53, 0x59, 0xbc, 0x40, 0x34, 0xa9, 0x78, 0x38, 0x07, 0x3d, 0x75, 0x75, 0xfd, 0x28,
-	0x43, 0x61, 0x81, 0xf8, 0x98, 0xe7, 0x41, 0x42, 0xd4, 0x3e, 0xea, 0xbc, 0xae, 0xbf, 0x42, 0x57,
-	0x39, 0x3e, 0x29, 0x0d, 0x27, 0xca, 0xaf, 0x58, 0x09, 0x46, 0xa0, 0x7b, 0x29, 0x4c, 0xbf, 0x93,
-	0x3a, 0xd2, 0xeb, 0x27, 0x6d, 0x5e, 0x48, 0xd2, 0x66, 0xe3, 0x97, 0x5f, 0x56, 0x96, 0x71, 0xbc,
-	0xb2, 0x8c, 0x93, 0x95, 0x65, 0xfc, 0x59, 0x59, 0xc6, 0xc1, 0xda, 0xaa, 0x9d, 0xac, 0xad, 0xda,
-	0xcf, 0xb5, 0x55, 0xfb, 0x30, 0xbc, 0xd2, 0x7c, 0xff, 0xfc, 0x93, 0x57, 0x39, 0x41, 0x43, 0x95,
-	0xfa, 0xe0, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xbe, 0xf2, 0xba, 0x12, 0x04, 0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x3d, 0x4f, 0x1b, 0x49,
+	0x18, 0xf6, 0xda, 0xc6, 0x98, 0x31, 0x5c, 0x31, 0x67, 0x60, 0xf1, 0xe9, 0xd6, 0x3e, 0x0a, 0xe4,
+	0x86, 0xf5, 0xe1, 0x6b, 0x4e, 0xd7, 0x9d, 0xb1, 0xee, 0xe0, 0x4e, 0xa7, 0xb3, 0xec, 0x90, 0x28,
+	0x69, 0x56, 0x6b, 0xef, 0x78, 0x3d, 0xb0, 0x3b, 0x63, 0xed, 0x8c, 0x63, 0xf8, 0x17, 0x94, 0x94,
+	0x94, 0xfc, 0x80, 0x48, 0x29, 0xd3, 0x52, 0xa2, 0x54, 0x51, 0x0a, 0x12, 0x99, 0x26, 0x3f, 0x23,
+	0x9a, 0x8f, 0x5d, 0xdb, 0x58, 0x8a, 0x88, 0x2b, 0xd8, 0xf7, 0xe3, 0x79, 0xe6, 0x7d, 0xde, 0x67,
+	0xc6, 0x60, 0xaf, 0x47, 0x59, 0x48, 0x59, 0x8d, 0x05, 0x2e, 0x1b, 0x60, 0xe2, 0xd7, 0x5e, 0x1f,
+	0x74, 0x11, 0x77, 0x0f, 0x92, 0x80, 0x3d, 0x8c, 0x28, 0xa7, 0x70, 0x5b, 0xd5, 0xd9, 0x49, 0x58,
+	0xd7, 0x95, 0x8a, 0x3e, 0xf5, 0xa9, 0xac, 0xa9, 0x89, 0xff, 0x54, 0x79, 0xc9, 0xf2, 0x29, 0xf5,
+	0x03, 0x54, 0x93, 0x5f, 0xdd, 0x51, 0xbf, 0xe6, 0x8d, 0x22, 0x97, 0x63, 0x4a, 0x74, 0xbe, 0xfc,
+	0x38, 0xcf, 0x71, 0x88, 0x18, 0x77, 0xc3, 0xa1, 0x2e, 0xd8, 0x51, 0x7c, 0x8e, 0x42, 0xd6, 0xe4,
+	0xf2, 0x63, 0xf7, 0x6d, 0x1a, 0x14, 0x9f, 0xbb, 0x01, 0xf6, 0x5c, 0x4e, 0xa3, 0x0e, 0xf6, 0x09,
+	0x26, 0xfe, 0x31, 0xe9, 0x53, 0x58, 0x07, 0xab, 0xae, 0xe7, 0x45, 0x88, 0x31, 0xd3, 0xa8, 0x18,
+	0xd5, 0xb5, 0x86, 0xf9, 0xfe, 0xcd, 0x7e, 0x51, 0xf7, 0xfe, 0xa9, 0x32, 0x1d, 0x1e, 0x61, 0xe2,
+	0xb7, 0xe3, 0x42, 0xf8, 0x0b, 0x58, 0x67, 0xdc, 0x8d, 0xb8, 0x33, 0x40, 0xd8, 0x1f, 0x70, 0x33,
+	0x5d, 0x31, 0xaa, 0x99, 0x76, 0x41, 0xc6, 0x8e, 0x64, 0x48, 0x94, 0x60, 0xe2, 0xa1, 0x73, 0x87,
+	0xf6, 0xfb, 0x0c, 0x71, 0x33, 0xa3, 0x4a, 0x64, 0xec, 0x7f, 0x19, 0x82, 0x7f, 0x83, 0xf5, 0x53,
+	0x17, 0x07, 0xc8, 0x73, 0x46, 0x84, 0xe3, 0xc0, 0xcc, 0x56, 0x8c, 0x6a, 0xa1, 0x5e, 0xb2, 0xd5,
+	0x94, 0x76, 0x3c, 0xa5, 0xfd, 0x2c, 0x9e, 0xb2, 0x91, 0xbf, 0xbd, 0x2f, 0xa7, 0x2e, 0x3f, 0x95,
+	0x8d, 0x76, 0x41, 0x75, 0x9e, 0x88, 0x46, 0x68, 0x01, 0xc0, 0x69, 0xd8, 0x65, 0x9c, 0x12, 0xe4,
+	0x99, 0x2b, 0x15, 0xa3, 0x9a, 0x6f, 0xcf, 0x44, 0x60, 0x1d, 0x6c, 0x86, 0x98, 0x31, 0xe4, 0x39,
+	0xdd, 0x80, 0xf6, 0xce, 0x98, 0xd3, 0xa3, 0x23, 0xc2, 0x51, 0x64, 0xe6, 0xe4, 0xa1, 0x7e, 0x54,
+	0xc9, 0x86, 0xcc, 0x1d, 0xaa, 0xd4, 0x1f, 0xf9, 0xab, 0xeb, 0x72, 0xea, 0xcb, 0x75, 0xd9, 0xd8,
+	0x7d, 0xb7, 0x0a, 0x72, 0x2d, 0x37, 0x72, 0x43, 0x06, 0x7f, 0x05, 0x45, 0x86, 0x7d, 0x32, 0x05,
+	0x1a, 0x63, 0xe2, 0xd1, 0xb1, 0x14, 0x2e, 0xd3, 0x86, 0x2a, 0xa7, 0x70, 0x5e, 0xc8, 0x0c, 0x74,
+	0x05, 0x35, 0x71, 0x74, 0xd7, 0x10, 0x45, 0x71, 0x8b, 0x90, 0x6c, 0xbd, 0x61, 0x8b, 0x81, 0x3e,
+	0xde, 0x97, 0xf7, 0x7c, 0xcc, 0x07, 0xa3, 0xae, 0xdd, 0xa3, 0xa1, 0x5e, 0x9b, 0xfe, 0xb3, 0xcf,
+	0xbc, 0xb3, 0x1a, 0xbf, 0x18, 0x22, 0x66, 0x37, 0x51, 0xaf, 0x0d, 0x43, 0x4c, 0x3a, 0x12, 0xab,
+	0x85, 0x22, 0x4d, 0xf1, 0x12, 0x6c, 0x79, 0x74, 0x4c, 0x84, 0x17, 0x1c, 0xa1, 0x8a, 0x13, 0xbb,
+	0x46, 0x6a, 0x5e, 0xa8, 0xef, 0x2c, 0x08, 0xda, 0xd4, 0x05, 0x4a, 0xcf, 0x2b, 0xa1, 0x67, 0x31,
+	0x86, 0xf8, 0xc7, 0xc5, 0x41, 0x9c, 0x87, 0x67, 0xa0, 0x24, 0xad, 0xeb, 0xf4, 0x23, 0xb7, 0x27,
+	0x22, 0x8e, 0x47, 0x47, 0xdd, 0x00, 0xc9, 0x79, 0xcc, 0xec, 0x52, 0x23, 0x6c, 0x4b, 0xc4, 0xbf,
+	0x34, 0x60, 0x53, 0xe2, 0x89, 0x91, 0x60, 0x1f, 0x6c, 0x2f, 0x90, 0xa9, 0x33, 0x99, 0x2b, 0x4b,
+	0x31, 0x6d, 0x3e, 0x62, 0x52, 0x60, 0xf0, 0x14, 0xec, 0x24, 0x7a, 0x29, 0xc2, 0x70, 0x14, 0x70,
+	0x3c, 0x0c, 0xb0, 0x76, 0xc4, 0x12, 0x33, 0xc5, 0x80, 0x1d, 0x81, 0xf7, 0x5f, 0x02, 0x07, 0x07,
+	0xc0, 0x9c, 0xdf, 0xcd, 0x0c, 0xd5, 0xea, 0x52, 0x54, 0x5b, 0xb3, 0x8b, 0x9a, 0x61, 0x0a, 0x40,
+	0x25, 0x61, 0xc2, 0x64, 0x2a, 0x21, 0xea, 0xb9, 0x17, 0xc2, 0x77, 0x98, 0x7a, 0x66, 0xfe, 0xe9,
+	0x7e, 0xf8, 0x39, 0x06, 0x3b, 0x4e, 0xb0, 0x9a, 0x02, 0xaa, 0x25, 0x91, 0xe0, 0x21, 0xb0, 0x18,
+	0xed, 0x73, 0xed, 0xb7, 0x45, 0x5e, 0x66, 0xae, 0xc9, 0x2b, 0xf1, 0x93, 0xa8, 0x92, 0x96, 0x5a,
+	0x80, 0x63, 0xd0, 0x07, 0xe6, 0x14, 0x64, 0x48, 0xc7, 0x28, 0x4a, 0x56, 0x6f, 0x82, 0x25, 0x37,
+	0xae, 0xe9, 0x5a, 0x02, 0x2d, 0xde, 0xfc, 0xee, 0x4d, 0x1a, 0xc0, 0xc5, 0x03, 0xc0, 0x2d, 0x90,
+	0xd3, 0xef, 0x97, 0xba, 0xbf, 0xfa, 0x0b, 0xfe, 0x0e, 0xb2, 0xd2, 0x75, 0xe9, 0xef, 0x78, 0x8f,
+	0x64, 0x07, 0x3c, 0x01, 0x3f, 0xcc, 0x5b, 0xd8, 0xcc, 0x2c, 0x35, 0xc7, 0xc6, 0x9c, 0x73, 0xe1,
+	0x11, 0xd8, 0x98, 0xbf, 0xd8, 0xd9, 0xa7, 0x2f, 0x52, 0x3e, 0xb1, 0xc9, 0x85, 0x2e, 0x83, 0x42,
+	0x22, 0xf9, 0xf4, 0xa9, 0x8c, 0x55, 0x43, 0x5e, 0xe3, 0xdf, 0x9b, 0x89, 0x65, 0xdc, 0x4e, 0x2c,
+	0xe3, 0x6e, 0x62, 0x19, 0x9f, 0x27, 0x96, 0x71, 0xf9, 0x60, 0xa5, 0xee, 0x1e, 0xac, 0xd4, 0x87,
+	0x07, 0x2b, 0xf5, 0x6a, 0xff, 0x9b, 0xe7, 0x3f, 0x9f, 0xfe, 0x1e, 0xca, 0x51, 0xba, 0x39, 0x79,
+	0xb0, 0xdf, 0xbe, 0x0e, 0x00, 0x23, 0x76, 0x4a, 0x1f, 0x2f, 0x07, 0x00, 0x00,
 }
 
 func (this *ValidatorSigningInfo) Equal(that interface{}) bool {
@@ -302,6 +420,57 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
 		return false
 	}
+	if !this.DowntimeSlashMultiplier.Equal(that1.DowntimeSlashMultiplier) {
+		return false
+	}
+	if !this.DowntimeJailMultiplier.Equal(that1.DowntimeJailMultiplier) {
+		return false
+	}
+	if this.DowntimeInfractionDecayPeriod != that1.DowntimeInfractionDecayPeriod {
+		return false
+	}
+	if this.SoftJailDowntimeInfractions != that1.SoftJailDowntimeInfractions {
+		return false
+	}
+	if !this.SoftJailPowerFraction.Equal(that1.SoftJailPowerFraction) {
+		return false
+	}
+	return true
+}
+func (this *DowntimeInfraction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DowntimeInfraction)
+	if !ok {
+		that2, ok := that.(DowntimeInfraction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.JailDuration != that1.JailDuration {
+		return false
+	}
+	if this.SoftJailed != that1.SoftJailed {
+		return false
+	}
 	return true
 }
 func (m *ValidatorSigningInfo) Marshal() (dAtA []byte, err error) {
@@ -387,6 +556,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SoftJailPowerFraction.Size()
+		i -= size
+		if _, err := m.SoftJailPowerFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.SoftJailDowntimeInfractions != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.SoftJailDowntimeInfractions))
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeInfractionDecayPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeInfractionDecayPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.DowntimeJailMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeJailMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.DowntimeSlashMultiplier.Size()
+		i -= size
+		if _, err := m.DowntimeSlashMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.SlashFractionDowntime.Size()
 		i -= size
@@ -407,12 +619,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSlashing(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSlashing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -433,6 +645,70 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DowntimeInfraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeInfraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DowntimeInfraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SoftJailed {
+		i--
+		if m.SoftJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintSlashing(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintSlashing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSlashing(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintSlashing(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSlashing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSlashing(v)
 	base := offset
@@ -488,6 +764,38 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovSlashing(uint64(l))
 	l = m.SlashFractionDowntime.Size()
 	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeSlashMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.DowntimeJailMultiplier.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.DowntimeInfractionDecayPeriod)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.SoftJailDowntimeInfractions != 0 {
+		n += 1 + sovSlashing(uint64(m.SoftJailDowntimeInfractions))
+	}
+	l = m.SoftJailPowerFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	return n
+}
+
+func (m *DowntimeInfraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSlashing(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovSlashing(uint64(l))
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovSlashing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovSlashing(uint64(l))
+	if m.SoftJailed {
+		n += 2
+	}
 	return n
 }
 
@@ -869,6 +1177,345 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeSlashMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeSlashMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailMultiplier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DowntimeJailMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeInfractionDecayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.DowntimeInfractionDecayPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftJailDowntimeInfractions", wireType)
+			}
+			m.SoftJailDowntimeInfractions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SoftJailDowntimeInfractions |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftJailPowerFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoftJailPowerFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeInfraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeInfraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeInfraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SoftJailed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSlashing(dAtA[iNdEx:])
@@ -80,7 +80,8 @@ func FlagSetPublicKey() *flag.FlagSet {
 	return fs
 }
 
-func flagSetDescriptionEdit() *flag.FlagSet {
+// FlagSetDescriptionEdit Returns the FlagSet used for editing a validator description.
+func FlagSetDescriptionEdit() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
 	fs.String(FlagEditMoniker, types.DoNotModifyDesc, "The validator's name")
@@ -92,7 +93,8 @@ func flagSetDescriptionEdit() *flag.FlagSet {
 	return fs
 }
 
-func flagSetCommissionUpdate() *flag.FlagSet {
+// FlagSetCommissionUpdate Returns the FlagSet used for commission update.
+func FlagSetCommissionUpdate() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
 	fs.String(FlagCommissionRate, "", "The new commission rate percentage")
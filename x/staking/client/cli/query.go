@@ -39,6 +39,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryHistoricalInfo(),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryTokenizeShareRecordByID(),
+		GetCmdQueryTokenizeShareRecordByDenom(),
+		GetCmdQueryTokenizeShareRecordsOwned(),
+		GetCmdQueryAllTokenizeShareRecords(),
+		GetCmdQueryTotalLiquidStaked(),
 	)
 
 	return stakingQueryCmd
@@ -744,3 +749,200 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryTokenizeShareRecordByID implements the tokenize share record query command.
+func GetCmdQueryTokenizeShareRecordByID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-id [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query a tokenize share record by its id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query a tokenize share record by its id.
+
+Example:
+$ %s query staking tokenize-share-record-by-id 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid record id %s: %w", args[0], err)
+			}
+
+			res, err := queryClient.TokenizeShareRecordById(cmd.Context(), &types.QueryTokenizeShareRecordByIdRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordByDenom implements the tokenize share record by denom query command.
+func GetCmdQueryTokenizeShareRecordByDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-record-by-denom [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokenize share record of a share token denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share record of a share token denom.
+
+Example:
+$ %s query staking tokenize-share-record-by-denom cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj/1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenizeShareRecordByDenom(cmd.Context(), &types.QueryTokenizeShareRecordByDenomRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Record)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTokenizeShareRecordsOwned implements the tokenize share records of an owner query command.
+func GetCmdQueryTokenizeShareRecordsOwned() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "tokenize-share-records-owned [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the tokenize share records of an owner",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the tokenize share records of an owner.
+
+Example:
+$ %s query staking tokenize-share-records-owned %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenizeShareRecordsOwned(cmd.Context(), &types.QueryTokenizeShareRecordsOwnedRequest{Owner: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryAllTokenizeShareRecords implements the tokenize share records query command.
+func GetCmdQueryAllTokenizeShareRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-tokenize-share-records",
+		Args:  cobra.NoArgs,
+		Short: "Query all the tokenize share records",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all the tokenize share records.
+
+Example:
+$ %s query staking all-tokenize-share-records
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllTokenizeShareRecords(cmd.Context(), &types.QueryAllTokenizeShareRecordsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tokenize share records")
+
+	return cmd
+}
+
+// GetCmdQueryTotalLiquidStaked implements the total liquid staked tokens query command.
+func GetCmdQueryTotalLiquidStaked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-liquid-staked",
+		Args:  cobra.NoArgs,
+		Short: "Query the total liquid staked tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of tokens delegated by the tokenize share records.
+
+Example:
+$ %s query staking total-liquid-staked
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TotalLiquidStaked(cmd.Context(), &types.QueryTotalLiquidStakedRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
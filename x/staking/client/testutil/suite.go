@@ -894,16 +894,23 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 			"with text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`bond_denom: stake
+global_liquid_staking_cap: "1.000000000000000000"
 historical_entries: 10000
+key_rotation_fee:
+  amount: "1000000"
+  denom: stake
 max_entries: 7
 max_validators: 100
 min_commission_rate: "0.000000000000000000"
-unbonding_time: 1814400s`,
+min_self_delegation: "1"
+unbonding_time: 1814400s
+validator_bond_factor: "-1.000000000000000000"
+validator_liquid_staking_cap: "1.000000000000000000"`,
 		},
 		{
 			"with json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000"}`,
+			`{"unbonding_time":"1814400s","max_validators":100,"max_entries":7,"historical_entries":10000,"bond_denom":"stake","min_commission_rate":"0.000000000000000000","validator_bond_factor":"-1.000000000000000000","global_liquid_staking_cap":"1.000000000000000000","validator_liquid_staking_cap":"1.000000000000000000","min_self_delegation":"1","key_rotation_fee":{"denom":"stake","amount":"1000000"}}`,
 		},
 	}
 	for _, tc := range testCases {
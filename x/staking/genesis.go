@@ -28,7 +28,7 @@ func WriteValidators(ctx sdk.Context, keeper keeper.Keeper) (vals []tmtypes.Gene
 		vals = append(vals, tmtypes.GenesisValidator{
 			Address: sdk.ConsAddress(tmPk.Address()).Bytes(),
 			PubKey:  tmPk,
-			Power:   validator.GetConsensusPower(keeper.PowerReduction(ctx)),
+			Power:   keeper.GetLastValidatorPower(ctx, validator.GetOperator()),
 			Name:    validator.GetMoniker(),
 		})
 
@@ -45,9 +45,53 @@ func ValidateGenesis(data *types.GenesisState) error {
 		return err
 	}
 
+	if err := validateGenesisStateTokenizeShareRecords(data.TokenizeShareRecords, data.LastTokenizeShareRecordId); err != nil {
+		return err
+	}
+
+	if err := validateGenesisStateConsPubKeyRotationHistory(data.ConsPubKeyRotationHistory); err != nil {
+		return err
+	}
+
 	return data.Params.Validate()
 }
 
+func validateGenesisStateTokenizeShareRecords(records []types.TokenizeShareRecord, lastID uint64) error {
+	ids := make(map[uint64]bool, len(records))
+
+	for _, record := range records {
+		if ids[record.Id] {
+			return fmt.Errorf("duplicate tokenize share record in genesis state: id %d", record.Id)
+		}
+		if record.Id > lastID {
+			return fmt.Errorf("tokenize share record id %d is greater than the last tokenize share record id %d", record.Id, lastID)
+		}
+		if _, err := sdk.AccAddressFromBech32(record.Owner); err != nil {
+			return fmt.Errorf("invalid owner of tokenize share record %d: %w", record.Id, err)
+		}
+
+		ids[record.Id] = true
+	}
+
+	return nil
+}
+
+func validateGenesisStateConsPubKeyRotationHistory(histories []types.ConsPubKeyRotationHistory) error {
+	for _, history := range histories {
+		if _, err := sdk.ValAddressFromBech32(history.OperatorAddress); err != nil {
+			return fmt.Errorf("invalid validator of consensus public key rotation at height %d: %w", history.Height, err)
+		}
+		if _, err := history.OldConsPubKey(); err != nil {
+			return fmt.Errorf("invalid old consensus public key of %s at height %d: %w", history.OperatorAddress, history.Height, err)
+		}
+		if _, err := history.NewConsPubKey(); err != nil {
+			return fmt.Errorf("invalid new consensus public key of %s at height %d: %w", history.OperatorAddress, history.Height, err)
+		}
+	}
+
+	return nil
+}
+
 func validateGenesisStateValidators(validators []types.Validator) error {
 	addrMap := make(map[string]bool, len(validators))
 
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"time"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetConsPubKeyRotationHistory returns the consensus public key rotation of a validator at a height.
+func (k Keeper) GetConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.ValAddress, height int64) (history types.ConsPubKeyRotationHistory, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetConsPubKeyRotationHistoryKey(valAddr, height))
+	if bz == nil {
+		return history, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &history)
+	return history, true
+}
+
+// SetConsPubKeyRotationHistory sets a consensus public key rotation.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx sdk.Context, history types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&history)
+	store.Set(types.GetConsPubKeyRotationHistoryKey(history.GetOperator(), history.Height), bz)
+}
+
+// GetValidatorConsPubKeyRotationHistory returns the consensus public key rotations of a validator.
+func (k Keeper) GetValidatorConsPubKeyRotationHistory(ctx sdk.Context, valAddr sdk.ValAddress) (histories []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetValidatorConsPubKeyRotationHistoryPrefix(valAddr))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// GetAllConsPubKeyRotationHistory returns the consensus public key rotations of all the validators.
+func (k Keeper) GetAllConsPubKeyRotationHistory(ctx sdk.Context) (histories []types.ConsPubKeyRotationHistory) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ConsPubKeyRotationHistoryKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ConsPubKeyRotationHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		histories = append(histories, history)
+	}
+
+	return histories
+}
+
+// ValidatorIdentifier returns the current consensus address of the validator of a consensus
+// address. It differs from the consensus address only when it is the old consensus address of a
+// validator that rotated its consensus public key within the unbonding period.
+func (k Keeper) ValidatorIdentifier(ctx sdk.Context, consAddr sdk.ConsAddress) sdk.ConsAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOldToNewConsAddrKey(consAddr))
+	if bz == nil {
+		return consAddr
+	}
+
+	return sdk.ConsAddress(bz)
+}
+
+// setOldToNewConsAddr makes a rotated consensus address resolve to the new consensus address of
+// its validator, and queues its removal at the completion time of the rotation.
+func (k Keeper) setOldToNewConsAddr(ctx sdk.Context, oldConsAddr, newConsAddr sdk.ConsAddress, completionTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOldToNewConsAddrKey(oldConsAddr), newConsAddr)
+	store.Set(types.GetConsPubKeyRotationQueueKey(completionTime, oldConsAddr), oldConsAddr)
+}
+
+// PurgeMatureConsPubKeyRotations stops the old consensus addresses of all the consensus public
+// key rotations completed by currTime from resolving to their validators.
+func (k Keeper) PurgeMatureConsPubKeyRotations(ctx sdk.Context, currTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+
+	// gets an iterator for all the rotations from time 0 until the current Blockheader time
+	iterator := store.Iterator(types.ConsPubKeyRotationQueueKey,
+		sdk.PrefixEndBytes(types.GetConsPubKeyRotationTimeKey(currTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		store.Delete(types.GetOldToNewConsAddrKey(iterator.Value()))
+		store.Delete(iterator.Key())
+	}
+}
+
+// dequeueBlockConsPubKeyRotations returns the old consensus public keys of the validators which
+// rotated their consensus public key at the current height, by operator address, and deletes
+// them from the index of the rotations by height.
+func (k Keeper) dequeueBlockConsPubKeyRotations(ctx sdk.Context) (map[string]cryptotypes.PubKey, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetBlockConsPubKeyRotationPrefix(ctx.BlockHeight()))
+	defer iterator.Close()
+
+	oldPubKeys := make(map[string]cryptotypes.PubKey)
+	for ; iterator.Valid(); iterator.Next() {
+		valAddr := types.ParseBlockConsPubKeyRotationKey(iterator.Key())
+		history, found := k.GetConsPubKeyRotationHistory(ctx, valAddr, ctx.BlockHeight())
+		if !found {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "consensus public key rotation of %s at height %d", valAddr, ctx.BlockHeight())
+		}
+
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+
+		oldPubKeys[valAddr.String()] = oldPubKey
+		store.Delete(iterator.Key())
+	}
+
+	return oldPubKeys, nil
+}
+
+// RotateConsPubKey rotates the consensus public key of a validator and burns the key rotation fee
+// from its operator account. The old consensus public key is replaced by the new one in the
+// Tendermint validator set at the end of the block, and keeps resolving to the validator until
+// the end of the unbonding period, so that its signatures and infractions are still accounted
+// for.
+func (k Keeper) RotateConsPubKey(ctx sdk.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	valAddr := validator.GetOperator()
+
+	rotations := 0
+	for _, history := range k.GetValidatorConsPubKeyRotationHistory(ctx, valAddr) {
+		if history.CompletionTime.After(ctx.BlockTime()) {
+			rotations++
+		}
+	}
+	if rotations >= types.MaxConsPubKeyRotations {
+		return sdkerrors.Wrapf(types.ErrExceedingMaxConsPubKeyRotations, "%d rotations in progress", rotations)
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	fee := k.KeyRotationFee(ctx)
+	if fee.IsPositive() {
+		coins := sdk.NewCoins(fee)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), types.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	history, err := types.NewConsPubKeyRotationHistory(
+		valAddr, oldPubKey, newPubKey, ctx.BlockHeight(), fee, ctx.BlockTime().Add(k.UnbondingTime(ctx)),
+	)
+	if err != nil {
+		return err
+	}
+
+	oldConsAddr := sdk.GetConsAddress(oldPubKey)
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorByConsAddrKey(oldConsAddr))
+
+	validator.ConsensusPubkey = history.NewConsPubkey
+	k.SetValidator(ctx, validator)
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	k.SetConsPubKeyRotationHistory(ctx, history)
+	store.Set(types.GetBlockConsPubKeyRotationKey(ctx.BlockHeight(), valAddr), []byte{})
+	k.setOldToNewConsAddr(ctx, oldConsAddr, newConsAddr, history.CompletionTime)
+
+	return k.AfterConsensusPubKeyUpdate(ctx, oldPubKey, newPubKey, fee)
+}
@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func setupConsPubKeyRotation(t *testing.T) (*simapp.SimApp, sdk.Context, types.MsgServer, sdk.ValAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddr := sdk.ValAddress(addrs[0])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 10, true)
+	staking.EndBlocker(ctx, app.StakingKeeper)
+	tstaking.CheckValidator(valAddr, types.Bonded, false)
+
+	return app, ctx.WithBlockHeight(2), keeper.NewMsgServerImpl(app.StakingKeeper), valAddr
+}
+
+func TestRotateConsPubKey(t *testing.T) {
+	app, ctx, msgServer, valAddr := setupConsPubKeyRotation(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	fee := app.StakingKeeper.KeyRotationFee(ctx)
+	oldConsAddr, newConsAddr := sdk.GetConsAddress(PKs[0]), sdk.GetConsAddress(PKs[1])
+
+	balance := app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddr), bondDenom)
+	supply := app.BankKeeper.GetSupply(ctx, bondDenom)
+
+	msg, err := types.NewMsgRotateConsPubKey(valAddr, PKs[1])
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the fee is burnt from the operator account
+	require.True(t, balance.Sub(fee).IsEqual(app.BankKeeper.GetBalance(ctx, sdk.AccAddress(valAddr), bondDenom)))
+	require.True(t, supply.Sub(fee).IsEqual(app.BankKeeper.GetSupply(ctx, bondDenom)))
+
+	// both consensus addresses resolve to the validator
+	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, newConsAddr)
+	require.True(t, found)
+	require.Equal(t, valAddr, validator.GetOperator())
+	validator, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.True(t, found)
+	require.Equal(t, valAddr, validator.GetOperator())
+	require.Equal(t, newConsAddr, app.StakingKeeper.ValidatorIdentifier(ctx, oldConsAddr))
+	require.Equal(t, newConsAddr, app.StakingKeeper.ValidatorIdentifier(ctx, newConsAddr))
+
+	// the old consensus public key is replaced by the new one in the validator set
+	oldTmPk, err := cryptocodec.ToTmProtoPublicKey(PKs[0])
+	require.NoError(t, err)
+	newTmPk, err := cryptocodec.ToTmProtoPublicKey(PKs[1])
+	require.NoError(t, err)
+
+	updates, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	require.Equal(t, oldTmPk, updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+	require.Equal(t, newTmPk, updates[1].PubKey)
+	require.Equal(t, int64(10), updates[1].Power)
+
+	// the rotation is only applied once
+	ctx = ctx.WithBlockHeight(3)
+	updates, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// the old consensus public key cannot be used again during the unbonding period
+	msg, err = types.NewMsgRotateConsPubKey(valAddr, PKs[0])
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrValidatorPubKeyExists)
+
+	// the rotations are limited during the unbonding period
+	msg, err = types.NewMsgRotateConsPubKey(valAddr, PKs[2])
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrExceedingMaxConsPubKeyRotations)
+
+	// after the unbonding period the old consensus address no longer resolves
+	// to the validator, which can rotate its consensus public key again
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(ctx.BlockTime().Add(app.StakingKeeper.UnbondingTime(ctx)))
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	require.Equal(t, oldConsAddr, app.StakingKeeper.ValidatorIdentifier(ctx, oldConsAddr))
+	_, found = app.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.False(t, found)
+
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	histories := app.StakingKeeper.GetValidatorConsPubKeyRotationHistory(ctx, valAddr)
+	require.Len(t, histories, 2)
+	require.Equal(t, int64(2), histories[0].Height)
+	require.Equal(t, int64(4), histories[1].Height)
+	require.True(t, fee.IsEqual(histories[0].Fee))
+}
+
+func TestRotateConsPubKeyOfRemovedValidator(t *testing.T) {
+	app, ctx, msgServer, valAddr := setupConsPubKeyRotation(t)
+
+	msg, err := types.NewMsgRotateConsPubKey(valAddr, PKs[1])
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// the validator leaves the validator set in the block of the rotation
+	app.StakingKeeper.Jail(ctx, sdk.GetConsAddress(PKs[0]))
+
+	oldTmPk, err := cryptocodec.ToTmProtoPublicKey(PKs[0])
+	require.NoError(t, err)
+
+	updates, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Equal(t, oldTmPk, updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+}
+
+func TestConsPubKeyRotationGenesis(t *testing.T) {
+	app, ctx, msgServer, valAddr := setupConsPubKeyRotation(t)
+
+	msg, err := types.NewMsgRotateConsPubKey(valAddr, PKs[1])
+	require.NoError(t, err)
+	_, err = msgServer.RotateConsPubKey(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	genesis := app.StakingKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.ConsPubKeyRotationHistory, 1)
+	require.NoError(t, staking.ValidateGenesis(genesis))
+
+	// the old consensus address of the rotation in progress resolves to the
+	// validator after the import
+	app2 := simapp.Setup(t, false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{Time: ctx.BlockTime()})
+	genesis2 := app2.StakingKeeper.ExportGenesis(ctx2)
+	genesis2.ConsPubKeyRotationHistory = genesis.ConsPubKeyRotationHistory
+	app2.StakingKeeper.InitGenesis(ctx2, genesis2)
+
+	require.Equal(t, sdk.GetConsAddress(PKs[1]), app2.StakingKeeper.ValidatorIdentifier(ctx2, sdk.GetConsAddress(PKs[0])))
+}
@@ -682,7 +682,13 @@ func (k Keeper) Delegate(
 		}
 	}
 
-	_, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+	validator, newShares = k.AddValidatorTokensAndShares(ctx, validator, bondAmt)
+
+	// the shares added to a validator bond count toward the validator bond shares
+	if delegation.ValidatorBond {
+		validator.ValidatorBondShares = validator.ValidatorBondShares.Add(newShares)
+		k.SetValidator(ctx, validator)
+	}
 
 	// Update delegation
 	delegation.Shares = delegation.Shares.Add(newShares)
@@ -740,6 +746,12 @@ func (k Keeper) Unbond(
 		validator = k.mustGetValidator(ctx, validator.GetOperator())
 	}
 
+	// the shares removed from a validator bond no longer count toward the validator bond shares
+	if delegation.ValidatorBond {
+		validator.ValidatorBondShares = validator.ValidatorBondShares.Sub(shares)
+		k.SetValidator(ctx, validator)
+	}
+
 	if delegation.Shares.IsZero() {
 		err = k.RemoveDelegation(ctx, delegation)
 	} else {
@@ -1011,3 +1023,224 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferUnbonding moves up to wantAmt tokens of the unbonding delegation
+// entries of fromAddr with the given validator to toAddr. The entries keep
+// their creation height and completion time. It returns the amount of tokens
+// transferred, which is lower than wantAmt if fromAddr does not have enough
+// unbonding tokens or if toAddr reaches the maximum number of entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt math.Int,
+) math.Int {
+	transferred := sdk.ZeroInt()
+
+	ubdFrom, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	modified := false
+	for i := 0; i < len(ubdFrom.Entries) && wantAmt.IsPositive(); i++ {
+		entry := ubdFrom.Entries[i]
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		ubdTo := k.SetUnbondingDelegationEntry(ctx, toAddr, valAddr, entry.CreationHeight, entry.CompletionTime, toXfer)
+		k.InsertUBDQueue(ctx, ubdTo, entry.CompletionTime)
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+		modified = true
+
+		remaining := entry.Balance.Sub(toXfer)
+		if remaining.IsZero() {
+			ubdFrom.RemoveEntry(int64(i))
+			i--
+			continue
+		}
+
+		entry.Balance = remaining
+		ubdFrom.Entries[i] = entry
+	}
+
+	if modified {
+		if len(ubdFrom.Entries) == 0 {
+			k.RemoveUnbondingDelegation(ctx, ubdFrom)
+		} else {
+			k.SetUnbondingDelegation(ctx, ubdFrom)
+		}
+	}
+
+	return transferred
+}
+
+// TransferDelegation moves up to wantShares shares of the delegation of
+// fromAddr to the given validator to toAddr, along with the redelegation
+// entries to the validator that the remaining shares of fromAddr no longer
+// cover. The tokens stay bonded to the validator. It returns the amount of
+// shares transferred, which is zero if toAddr could exceed the maximum number
+// of redelegation entries. It returns an error if moving the shares out of a
+// validator bond would leave the validator bond shares below the validator
+// bond cap, as unbonding them would.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (sdk.Dec, error) {
+	transferred := sdk.ZeroDec()
+
+	if !wantShares.IsPositive() || fromAddr.Equals(toAddr) {
+		return transferred, nil
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred, nil
+	}
+
+	// check the redelegation entry limits of toAddr while nothing has been
+	// modified yet, assuming that all the redelegation entries to the
+	// validator are transferred
+	var redelegations []types.Redelegation
+	k.IterateDelegatorRedelegations(ctx, fromAddr, func(red types.Redelegation) (stop bool) {
+		if red.ValidatorDstAddress == valAddr.String() {
+			redelegations = append(redelegations, red)
+		}
+		return false
+	})
+
+	for _, red := range redelegations {
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		toRed, found := k.GetRedelegation(ctx, toAddr, valSrcAddr, valAddr)
+		if found && len(toRed.Entries)+len(red.Entries) >= int(k.MaxEntries(ctx)) {
+			return transferred, nil
+		}
+	}
+
+	transferred = sdk.MinDec(delFrom.Shares, wantShares)
+	remaining := delFrom.Shares.Sub(transferred)
+
+	// the shares moved out of or into a validator bond change the validator
+	// bond shares, which must still cover the liquid shares of the validator
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	bondShares := sdk.ZeroDec()
+	if delFrom.ValidatorBond {
+		bondShares = bondShares.Sub(transferred)
+	}
+	if found && delTo.ValidatorBond {
+		bondShares = bondShares.Add(transferred)
+	}
+	if bondShares.IsNegative() {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found {
+			return sdk.ZeroDec(), types.ErrNoValidatorFound
+		}
+		if err := k.CheckExceedsValidatorBondCap(ctx, validator, sdk.ZeroDec(), bondShares.Neg()); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	// update or create the delegation of toAddr
+	var err error
+	if found {
+		err = k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		err = k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+	if err := k.AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// update or remove the delegation of fromAddr
+	if err := k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	if remaining.IsZero() {
+		if err := k.RemoveDelegation(ctx, delFrom); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	} else {
+		delFrom.Shares = remaining
+		k.SetDelegation(ctx, delFrom)
+		if err := k.AfterDelegationModified(ctx, fromAddr, valAddr); err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	if !bondShares.IsZero() {
+		validator := k.mustGetValidator(ctx, valAddr)
+		validator.ValidatorBondShares = validator.ValidatorBondShares.Add(bondShares)
+		k.SetValidator(ctx, validator)
+	}
+
+	// The redelegation entries to the validator make fromAddr liable for
+	// slashing of the source validators. Keep the entries covered by the
+	// remaining shares and transfer the rest, splitting an entry if needed.
+	for _, red := range redelegations {
+		valSrcAddr, err := sdk.ValAddressFromBech32(red.ValidatorSrcAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		modified := false
+		for i := 0; i < len(red.Entries); i++ {
+			entry := red.Entries[i]
+
+			sharesToKeep := sdk.MinDec(entry.SharesDst, remaining)
+			sharesToSend := entry.SharesDst.Sub(sharesToKeep)
+			remaining = remaining.Sub(sharesToKeep)
+
+			if sharesToSend.IsZero() {
+				continue
+			}
+
+			balanceToSend := entry.InitialBalance
+			if sharesToKeep.IsPositive() {
+				balanceToSend = sharesToSend.Quo(entry.SharesDst).MulInt(entry.InitialBalance).TruncateInt()
+			}
+
+			toRed := k.SetRedelegationEntry(
+				ctx, toAddr, valSrcAddr, valAddr,
+				entry.CreationHeight, entry.CompletionTime, balanceToSend, sdk.ZeroDec(), sharesToSend,
+			)
+			k.InsertRedelegationQueue(ctx, toRed, entry.CompletionTime)
+			modified = true
+
+			if sharesToKeep.IsZero() {
+				// the queue entry of fromAddr is ignored once the entry is removed
+				red.RemoveEntry(int64(i))
+				i--
+				continue
+			}
+
+			entry.InitialBalance = entry.InitialBalance.Sub(balanceToSend)
+			entry.SharesDst = sharesToKeep
+			red.Entries[i] = entry
+		}
+
+		if modified {
+			if len(red.Entries) == 0 {
+				k.RemoveRedelegation(ctx, red)
+			} else {
+				k.SetRedelegation(ctx, red)
+			}
+		}
+	}
+
+	return transferred, nil
+}
@@ -1000,3 +1000,106 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(0))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	completionTime := time.Unix(1000, 0).UTC()
+	ubd := types.NewUnbondingDelegation(addrDels[0], valAddrs[2], 1, completionTime, sdk.NewInt(5))
+	ubd.AddEntry(2, completionTime.Add(time.Hour), sdk.NewInt(10))
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	// transfer the first entry and part of the second one
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddrs[2], sdk.NewInt(8))
+	require.Equal(t, sdk.NewInt(8), transferred)
+
+	resFrom, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], valAddrs[2])
+	require.True(t, found)
+	require.Len(t, resFrom.Entries, 1)
+	require.Equal(t, int64(2), resFrom.Entries[0].CreationHeight)
+	require.Equal(t, sdk.NewInt(7), resFrom.Entries[0].Balance)
+
+	resTo, found := app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[1], valAddrs[2])
+	require.True(t, found)
+	require.Len(t, resTo.Entries, 2)
+	require.Equal(t, sdk.NewInt(5), resTo.Entries[0].Balance)
+	require.Equal(t, completionTime, resTo.Entries[0].CompletionTime)
+	require.Equal(t, sdk.NewInt(3), resTo.Entries[1].Balance)
+	require.Equal(t, completionTime.Add(time.Hour), resTo.Entries[1].CompletionTime)
+
+	// transferring more than the balance removes the unbonding delegation
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddrs[2], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(7), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, addrDels[0], valAddrs[2])
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(15), app.StakingKeeper.GetDelegatorUnbonding(ctx, addrDels[1]))
+
+	// nothing left to transfer
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, addrDels[0], addrDels[1], valAddrs[2], sdk.NewInt(1))
+	require.True(t, transferred.IsZero())
+}
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(10000))
+	addrVals := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	// create a validator
+	validator := teststaking.NewValidator(t, addrVals[0], PKs[0])
+	validator, issuedShares := validator.AddTokensFromDel(sdk.NewInt(10))
+	validator = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[0], addrVals[0], issuedShares))
+
+	// the delegation is partly covered by a redelegation entry
+	completionTime := time.Unix(1000, 0).UTC()
+	red := types.NewRedelegation(addrDels[0], addrVals[1], addrVals[0], 1, completionTime, sdk.NewInt(4), sdk.NewDec(4))
+	app.StakingKeeper.SetRedelegation(ctx, red)
+
+	// transfer 7 shares: 3 shares remain, which still cover 3 of the
+	// redelegated shares
+	transferred, err := app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewDec(7))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(7), transferred)
+
+	delFrom, found := app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(3), delFrom.Shares)
+	delTo, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(7), delTo.Shares)
+
+	redFrom, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[1], addrVals[0])
+	require.True(t, found)
+	require.Len(t, redFrom.Entries, 1)
+	require.Equal(t, sdk.NewDec(3), redFrom.Entries[0].SharesDst)
+	require.Equal(t, sdk.NewInt(3), redFrom.Entries[0].InitialBalance)
+
+	redTo, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[1], addrVals[1], addrVals[0])
+	require.True(t, found)
+	require.Len(t, redTo.Entries, 1)
+	require.Equal(t, sdk.NewDec(1), redTo.Entries[0].SharesDst)
+	require.Equal(t, sdk.NewInt(1), redTo.Entries[0].InitialBalance)
+	require.Equal(t, completionTime, redTo.Entries[0].CompletionTime)
+
+	// transferring the remaining shares removes the delegation and the
+	// redelegation of the sender
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, addrDels[0], addrDels[1], addrVals[0], sdk.NewDec(100))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3), transferred)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[1], addrVals[0])
+	require.False(t, found)
+
+	delTo, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], addrVals[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(10), delTo.Shares)
+	redTo, found = app.StakingKeeper.GetRedelegation(ctx, addrDels[1], addrVals[1], addrVals[0])
+	require.True(t, found)
+	require.Len(t, redTo.Entries, 2)
+}
@@ -29,6 +29,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 	k.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	for _, validator := range data.Validators {
+		// the validators exported before the liquid staking have no validator bond and liquid shares
+		if validator.ValidatorBondShares.IsNil() {
+			validator.ValidatorBondShares = sdk.ZeroDec()
+		}
+		if validator.LiquidShares.IsNil() {
+			validator.LiquidShares = sdk.ZeroDec()
+		}
+
 		k.SetValidator(ctx, validator)
 
 		// Manually set indices for the first time
@@ -96,6 +104,35 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) (res []ab
 		}
 	}
 
+	for _, record := range data.TokenizeShareRecords {
+		if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+			panic(err)
+		}
+	}
+	k.SetLastTokenizeShareRecordID(ctx, data.LastTokenizeShareRecordId)
+	if !data.TotalLiquidStakedTokens.IsNil() {
+		k.SetTotalLiquidStakedTokens(ctx, data.TotalLiquidStakedTokens)
+	}
+
+	for _, history := range data.ConsPubKeyRotationHistory {
+		k.SetConsPubKeyRotationHistory(ctx, history)
+
+		// the old consensus public keys of the rotations in progress keep
+		// resolving to their validators
+		if history.CompletionTime.After(ctx.BlockTime()) {
+			oldPubKey, err := history.OldConsPubKey()
+			if err != nil {
+				panic(err)
+			}
+			newPubKey, err := history.NewConsPubKey()
+			if err != nil {
+				panic(err)
+			}
+
+			k.setOldToNewConsAddr(ctx, sdk.GetConsAddress(oldPubKey), sdk.GetConsAddress(newPubKey), history.CompletionTime)
+		}
+	}
+
 	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
 	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
 
@@ -197,5 +234,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		UnbondingDelegations: unbondingDelegations,
 		Redelegations:        redelegations,
 		Exported:             true,
+
+		LastTokenizeShareRecordId: k.GetLastTokenizeShareRecordID(ctx),
+		TokenizeShareRecords:      k.GetAllTokenizeShareRecords(ctx),
+		TotalLiquidStakedTokens:   k.GetTotalLiquidStakedTokens(ctx),
+
+		ConsPubKeyRotationHistory: k.GetAllConsPubKeyRotationHistory(ctx),
 	}
 }
@@ -532,3 +532,88 @@ func queryAllRedelegations(store sdk.KVStore, k Querier, req *types.QueryRedeleg
 
 	return redels, res, err
 }
+
+// TokenizeShareRecordById queries a tokenize share record by its identifier
+func (k Querier) TokenizeShareRecordById(c context.Context, req *types.QueryTokenizeShareRecordByIdRequest) (*types.QueryTokenizeShareRecordByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := k.GetTokenizeShareRecord(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record %d not found", req.Id)
+	}
+
+	return &types.QueryTokenizeShareRecordByIdResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordByDenom queries the tokenize share record of a share token denom
+func (k Querier) TokenizeShareRecordByDenom(c context.Context, req *types.QueryTokenizeShareRecordByDenomRequest) (*types.QueryTokenizeShareRecordByDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "tokenize share record of denom %s not found", req.Denom)
+	}
+
+	return &types.QueryTokenizeShareRecordByDenomResponse{Record: record}, nil
+}
+
+// TokenizeShareRecordsOwned queries the tokenize share records of an owner
+func (k Querier) TokenizeShareRecordsOwned(c context.Context, req *types.QueryTokenizeShareRecordsOwnedRequest) (*types.QueryTokenizeShareRecordsOwnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTokenizeShareRecordsOwnedResponse{
+		Records: k.GetTokenizeShareRecordsByOwner(ctx, owner),
+	}, nil
+}
+
+// AllTokenizeShareRecords queries all the tokenize share records
+func (k Querier) AllTokenizeShareRecords(c context.Context, req *types.QueryAllTokenizeShareRecordsRequest) (*types.QueryAllTokenizeShareRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var records []types.TokenizeShareRecord
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenizeShareRecordPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var record types.TokenizeShareRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTokenizeShareRecordsResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TotalLiquidStaked queries the amount of tokens delegated by the tokenize share records
+func (k Querier) TotalLiquidStaked(c context.Context, _ *types.QueryTotalLiquidStakedRequest) (*types.QueryTotalLiquidStakedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTotalLiquidStakedResponse{Tokens: k.GetTotalLiquidStakedTokens(ctx)}, nil
+}
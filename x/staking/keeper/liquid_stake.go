@@ -0,0 +1,328 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetTotalLiquidStakedTokens returns the amount of tokens delegated by the tokenize share records.
+func (k Keeper) GetTotalLiquidStakedTokens(ctx sdk.Context) math.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalLiquidStakedTokensKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// SetTotalLiquidStakedTokens sets the amount of tokens delegated by the tokenize share records.
+func (k Keeper) SetTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: tokens})
+	store.Set(types.TotalLiquidStakedTokensKey, bz)
+}
+
+// IncreaseTotalLiquidStakedTokens adds tokens to the total liquid staked tokens.
+func (k Keeper) IncreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	k.SetTotalLiquidStakedTokens(ctx, k.GetTotalLiquidStakedTokens(ctx).Add(tokens))
+}
+
+// DecreaseTotalLiquidStakedTokens removes tokens from the total liquid staked tokens. As the
+// tokens of the slashes and of the redemptions are truncated, the total is floored at zero.
+func (k Keeper) DecreaseTotalLiquidStakedTokens(ctx sdk.Context, tokens math.Int) {
+	total := k.GetTotalLiquidStakedTokens(ctx).Sub(tokens)
+	k.SetTotalLiquidStakedTokens(ctx, sdk.MaxInt(total, sdk.ZeroInt()))
+}
+
+// CheckExceedsGlobalLiquidStakingCap returns an error if tokenizing the tokens would make the
+// ratio of the liquid staked tokens to the bonded tokens exceed the global liquid staking cap.
+func (k Keeper) CheckExceedsGlobalLiquidStakingCap(ctx sdk.Context, tokens math.Int) error {
+	liquidStakingCap := k.GlobalLiquidStakingCap(ctx)
+	if liquidStakingCap.Equal(sdk.OneDec()) {
+		return nil
+	}
+
+	totalBonded := k.TotalBondedTokens(ctx)
+	totalLiquid := k.GetTotalLiquidStakedTokens(ctx).Add(tokens)
+	if !totalBonded.IsPositive() || sdk.NewDecFromInt(totalLiquid).QuoInt(totalBonded).GT(liquidStakingCap) {
+		return types.ErrGlobalLiquidStakingCapExceeded
+	}
+	return nil
+}
+
+// CheckExceedsValidatorLiquidStakingCap returns an error if tokenizing the shares would make the
+// ratio of the liquid shares of the validator to its delegator shares exceed the validator
+// liquid staking cap.
+func (k Keeper) CheckExceedsValidatorLiquidStakingCap(ctx sdk.Context, validator types.Validator, shares sdk.Dec) error {
+	liquidStakingCap := k.ValidatorLiquidStakingCap(ctx)
+	if liquidStakingCap.Equal(sdk.OneDec()) {
+		return nil
+	}
+
+	liquidShares := validator.LiquidShares.Add(shares)
+	if !validator.DelegatorShares.IsPositive() || liquidShares.Quo(validator.DelegatorShares).GT(liquidStakingCap) {
+		return types.ErrValidatorLiquidStakingCapExceeded
+	}
+	return nil
+}
+
+// CheckExceedsValidatorBondCap returns an error if the liquid shares of the validator would
+// exceed its validator bond shares multiplied by the validator bond factor, once the liquid
+// shares increased by liquidShares and the validator bond shares decreased by bondShares.
+func (k Keeper) CheckExceedsValidatorBondCap(ctx sdk.Context, validator types.Validator, liquidShares, bondShares sdk.Dec) error {
+	factor := k.ValidatorBondFactor(ctx)
+	if factor.Equal(types.DefaultValidatorBondFactor) {
+		return nil
+	}
+
+	maxLiquidShares := validator.ValidatorBondShares.Sub(bondShares).Mul(factor)
+	if validator.LiquidShares.Add(liquidShares).GT(maxLiquidShares) {
+		return types.ErrInsufficientValidatorBondShares
+	}
+	return nil
+}
+
+// TokenizeShares converts the amount of a delegation into share tokens sent to the delegator. The
+// amount is delegated to the validator by the module account of a new tokenize share record,
+// whose rewards are withdrawn by the record owner.
+func (k Keeper) TokenizeShares(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoDelegatorForAddress
+	}
+	if delegation.ValidatorBond {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrValidatorBondNotAllowedForTokenizeShare
+	}
+
+	bondDenom := k.BondDenom(ctx)
+	if amount.Denom != bondDenom {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrOnlyBondDenomAllowedForTokenize, "got %s, expected %s", amount.Denom, bondDenom,
+		)
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount.Amount)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// the tokens of a redelegation would escape the slashes of the source validator
+	if k.HasReceivingRedelegation(ctx, delAddr, valAddr) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	// the vesting delegations can not be tokenized, as the share tokens would be spendable
+	if acc, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestingexported.VestingAccount); ok {
+		if acc.GetDelegatedFree().AmountOf(bondDenom).LT(amount.Amount) {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrExceedingFreeVestingDelegations
+		}
+	}
+
+	if err := k.CheckExceedsValidatorBondCap(ctx, validator, shares, sdk.ZeroDec()); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.CheckExceedsGlobalLiquidStakingCap(ctx, validator.TokensFromShares(shares).TruncateInt()); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.CheckExceedsValidatorLiquidStakingCap(ctx, validator, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if !returnAmount.IsPositive() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "amount too small to be tokenized")
+	}
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	returnCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, returnAmount))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, returnCoins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	recordID := k.GetLastTokenizeShareRecordID(ctx) + 1
+	k.SetLastTokenizeShareRecordID(ctx, recordID)
+	record := types.NewTokenizeShareRecord(recordID, owner, valAddr)
+	if err := k.AddTokenizeShareRecord(ctx, record); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), shares.TruncateInt())
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	recordAddr := record.GetModuleAddress()
+	if k.authKeeper.GetAccount(ctx, recordAddr) == nil {
+		acc := authtypes.NewModuleAccount(authtypes.NewBaseAccountWithAddress(recordAddr), record.ModuleAccount)
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccount(ctx, acc))
+	}
+	if err := k.bankKeeper.SendCoins(ctx, delAddr, recordAddr, returnCoins); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// the validator may have been updated by the unbonding
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoValidatorFound
+	}
+	newShares, err := k.Delegate(ctx, recordAddr, returnAmount, types.Unbonded, validator, true)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	validator = k.mustGetValidator(ctx, valAddr)
+	validator.LiquidShares = validator.LiquidShares.Add(newShares)
+	k.SetValidator(ctx, validator)
+	k.IncreaseTotalLiquidStakedTokens(ctx, returnAmount)
+
+	return record, shareToken, nil
+}
+
+// RedeemTokensForShares burns share tokens of a tokenize share record and delegates the tokens of
+// their shares back from the delegator. The record is removed with its last shares, its remaining
+// rewards being sent to its owner.
+func (k Keeper) RedeemTokensForShares(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	balance := k.bankKeeper.GetBalance(ctx, delAddr, amount.Denom)
+	if balance.Amount.LT(amount.Amount) {
+		return sdk.Coin{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", balance, amount)
+	}
+
+	record, found := k.GetTokenizeShareRecordByDenom(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrTokenizeShareRecordNotExists
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(record.Validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidatorFound
+	}
+
+	recordAddr := record.GetModuleAddress()
+	delegation, found := k.GetDelegation(ctx, recordAddr, valAddr)
+	if !found {
+		return sdk.Coin{}, types.ErrNoDelegatorForAddress
+	}
+
+	// the share tokens are the truncated shares of the record, so that redeeming all of them
+	// redeems all the shares
+	shares := sdk.NewDecFromInt(amount.Amount)
+	if amount.Amount.Equal(delegation.Shares.TruncateInt()) || shares.GT(delegation.Shares) {
+		shares = delegation.Shares
+	}
+
+	k.DecreaseTotalLiquidStakedTokens(ctx, validator.TokensFromShares(shares).TruncateInt())
+	validator.LiquidShares = sdk.MaxDec(validator.LiquidShares.Sub(shares), sdk.ZeroDec())
+	k.SetValidator(ctx, validator)
+
+	returnAmount, err := k.Unbond(ctx, recordAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if validator.IsBonded() {
+		k.bondedTokensToNotBonded(ctx, returnAmount)
+	}
+
+	if _, found := k.GetDelegation(ctx, recordAddr, valAddr); !found {
+		if err := k.BeforeTokenizeShareRecordRemoved(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.DeleteTokenizeShareRecord(ctx, record.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnCoin := sdk.NewCoin(k.BondDenom(ctx), returnAmount)
+	if !returnAmount.IsPositive() {
+		return returnCoin, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delAddr, sdk.NewCoins(returnCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the validator is removed with its last shares if it is unbonded, the tokens then being
+	// left to the delegator
+	validator, found = k.GetValidator(ctx, valAddr)
+	if !found {
+		return returnCoin, nil
+	}
+	if _, err := k.Delegate(ctx, delAddr, returnAmount, types.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return returnCoin, nil
+}
+
+// ValidatorBond flags a delegation as a validator bond, its shares counting toward the validator
+// bond shares of the validator which bound its liquid shares.
+func (k Keeper) ValidatorBond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return types.ErrNoDelegatorForAddress
+	}
+	if delegation.ValidatorBond {
+		return nil
+	}
+
+	delegation.ValidatorBond = true
+	k.SetDelegation(ctx, delegation)
+
+	validator.ValidatorBondShares = validator.ValidatorBondShares.Add(delegation.Shares)
+	k.SetValidator(ctx, validator)
+
+	return nil
+}
+
+// CheckValidatorBondUnbond returns an error if unbonding the shares of a delegation would make the
+// liquid shares of the validator exceed its validator bond cap.
+func (k Keeper) CheckValidatorBondUnbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec) error {
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found || !delegation.ValidatorBond {
+		return nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+	return k.CheckExceedsValidatorBondCap(ctx, validator, sdk.ZeroDec(), shares)
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// setupLiquidStaking creates a bonded validator with an exchange rate of one share per token,
+// and accounts to delegate to it.
+func setupLiquidStaking(t *testing.T) (*simapp.SimApp, sdk.Context, types.MsgServer, []sdk.AccAddress, sdk.ValAddress) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 4, app.StakingKeeper.TokensFromConsensusPower(ctx, 100))
+	valAddr := sdk.ValAddress(addrs[3])
+
+	tstaking := teststaking.NewHelper(t, ctx, app.StakingKeeper)
+	tstaking.CreateValidatorWithValPower(valAddr, PKs[0], 10, true)
+	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	tstaking.CheckValidator(valAddr, types.Bonded, false)
+
+	return app, ctx, keeper.NewMsgServerImpl(app.StakingKeeper), addrs[:3], valAddr
+}
+
+func TestTokenizeSharesAndRedeemTokens(t *testing.T) {
+	app, ctx, msgServer, delAddrs, valAddr := setupLiquidStaking(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delegator, owner, newOwner := delAddrs[0], delAddrs[1], delAddrs[2]
+
+	delegated := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delegator, valAddr, sdk.NewCoin(bondDenom, delegated)))
+	require.NoError(t, err)
+
+	// only the bond denom can be tokenized
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegator, valAddr, sdk.NewInt64Coin("foo", 10), owner))
+	require.ErrorIs(t, err, types.ErrOnlyBondDenomAllowedForTokenize)
+
+	tokenized := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	res, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delegator, valAddr, sdk.NewCoin(bondDenom, tokenized), owner))
+	require.NoError(t, err)
+
+	record, found := app.StakingKeeper.GetTokenizeShareRecord(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, owner.String(), record.Owner)
+	require.Equal(t, valAddr.String(), record.Validator)
+	require.True(t, sdk.NewCoin(record.GetShareTokenDenom(), tokenized).IsEqual(res.Amount))
+	require.True(t, res.Amount.IsEqual(app.BankKeeper.GetBalance(ctx, delegator, record.GetShareTokenDenom())))
+
+	byDenom, found := app.StakingKeeper.GetTokenizeShareRecordByDenom(ctx, record.GetShareTokenDenom())
+	require.True(t, found)
+	require.Equal(t, record, byDenom)
+	require.Equal(t, []types.TokenizeShareRecord{record}, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+
+	// the tokenized shares are delegated by the record
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	require.True(t, found)
+	require.True(t, sdk.NewDecFromInt(delegated.Sub(tokenized)).Equal(delegation.Shares))
+	recordDelegation, found := app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.True(t, found)
+	require.True(t, sdk.NewDecFromInt(tokenized).Equal(recordDelegation.Shares))
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, sdk.NewDecFromInt(tokenized).Equal(validator.LiquidShares))
+	require.True(t, tokenized.Equal(app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)))
+
+	// only the owner transfers the record
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(record.Id, delegator, newOwner))
+	require.ErrorIs(t, err, types.ErrNotTokenizeShareRecordOwner)
+	_, err = msgServer.TransferTokenizeShareRecord(sdk.WrapSDKContext(ctx), types.NewMsgTransferTokenizeShareRecord(record.Id, owner, newOwner))
+	require.NoError(t, err)
+	require.Empty(t, app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner))
+	records := app.StakingKeeper.GetTokenizeShareRecordsByOwner(ctx, newOwner)
+	require.Len(t, records, 1)
+	require.Equal(t, newOwner.String(), records[0].Owner)
+
+	// redeeming a part of the share tokens
+	redeemed := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	redeemRes, err := msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegator, sdk.NewCoin(record.GetShareTokenDenom(), redeemed)))
+	require.NoError(t, err)
+	require.True(t, sdk.NewCoin(bondDenom, redeemed).IsEqual(redeemRes.Amount))
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	require.True(t, found)
+	require.True(t, sdk.NewDecFromInt(delegated.Sub(tokenized).Add(redeemed)).Equal(delegation.Shares))
+	require.True(t, tokenized.Sub(redeemed).Equal(app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)))
+
+	// redeeming more share tokens than owned fails
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegator, sdk.NewCoin(record.GetShareTokenDenom(), tokenized)))
+	require.Error(t, err)
+
+	// redeeming the remaining share tokens removes the record
+	_, err = msgServer.RedeemTokensForShares(sdk.WrapSDKContext(ctx), types.NewMsgRedeemTokensForShares(delegator, sdk.NewCoin(record.GetShareTokenDenom(), tokenized.Sub(redeemed))))
+	require.NoError(t, err)
+
+	_, found = app.StakingKeeper.GetTokenizeShareRecord(ctx, record.Id)
+	require.False(t, found)
+	_, found = app.StakingKeeper.GetDelegation(ctx, record.GetModuleAddress(), valAddr)
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetBalance(ctx, delegator, record.GetShareTokenDenom()).IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, record.GetShareTokenDenom()).IsZero())
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, delegator, valAddr)
+	require.True(t, found)
+	require.True(t, sdk.NewDecFromInt(delegated).Equal(delegation.Shares))
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.LiquidShares.IsZero())
+	require.True(t, app.StakingKeeper.GetTotalLiquidStakedTokens(ctx).IsZero())
+}
+
+func TestLiquidStakingCaps(t *testing.T) {
+	app, ctx, msgServer, delAddrs, valAddr := setupLiquidStaking(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	delegator, bonder := delAddrs[0], delAddrs[1]
+
+	delegated := app.StakingKeeper.TokensFromConsensusPower(ctx, 10)
+	for _, addr := range []sdk.AccAddress{delegator, bonder} {
+		_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(addr, valAddr, sdk.NewCoin(bondDenom, delegated)))
+		require.NoError(t, err)
+	}
+
+	// the tokenized stake is bounded by the global cap
+	params := app.StakingKeeper.GetParams(ctx)
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(1, 3)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	tokenizeMsg := types.NewMsgTokenizeShares(delegator, valAddr, sdk.NewCoin(bondDenom, delegated), delegator)
+	_, err := msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), tokenizeMsg)
+	require.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+
+	// the tokenized shares of a validator are bounded by the validator cap
+	params.GlobalLiquidStakingCap = sdk.OneDec()
+	params.ValidatorLiquidStakingCap = sdk.NewDecWithPrec(1, 3)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), tokenizeMsg)
+	require.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// the tokenized shares of a validator are bounded by its validator bond shares
+	params.ValidatorLiquidStakingCap = sdk.OneDec()
+	params.ValidatorBondFactor = sdk.NewDec(2)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), tokenizeMsg)
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	_, err = msgServer.ValidatorBond(sdk.WrapSDKContext(ctx), types.NewMsgValidatorBond(bonder, valAddr))
+	require.NoError(t, err)
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, sdk.NewDecFromInt(delegated).Equal(validator.ValidatorBondShares))
+
+	// a validator bond can not be tokenized
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(bonder, valAddr, sdk.NewCoin(bondDenom, delegated), bonder))
+	require.ErrorIs(t, err, types.ErrValidatorBondNotAllowedForTokenizeShare)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), tokenizeMsg)
+	require.NoError(t, err)
+
+	// the validator bond can not be unbonded below the tokenized shares
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(bonder, valAddr, sdk.NewCoin(bondDenom, delegated)))
+	require.ErrorIs(t, err, types.ErrInsufficientValidatorBondShares)
+
+	halfDelegated := delegated.QuoRaw(2)
+	_, err = msgServer.Undelegate(sdk.WrapSDKContext(ctx), types.NewMsgUndelegate(bonder, valAddr, sdk.NewCoin(bondDenom, halfDelegated)))
+	require.NoError(t, err)
+	validator, found = app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, sdk.NewDecFromInt(delegated.Sub(halfDelegated)).Equal(validator.ValidatorBondShares))
+}
+
+func TestSlashLiquidShares(t *testing.T) {
+	app, ctx, msgServer, delAddrs, valAddr := setupLiquidStaking(t)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	delegated := validator.Tokens
+	_, err := msgServer.Delegate(sdk.WrapSDKContext(ctx), types.NewMsgDelegate(delAddrs[0], valAddr, sdk.NewCoin(bondDenom, delegated)))
+	require.NoError(t, err)
+
+	_, err = msgServer.TokenizeShares(sdk.WrapSDKContext(ctx), types.NewMsgTokenizeShares(delAddrs[0], valAddr, sdk.NewCoin(bondDenom, delegated), delAddrs[0]))
+	require.NoError(t, err)
+	require.True(t, delegated.Equal(app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)))
+
+	// the liquid shares are half of the shares of the validator, and lose half of the slash
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	power := app.StakingKeeper.TokensToConsensusPower(ctx, delegated.MulRaw(2))
+	burned := app.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), power, sdk.NewDecWithPrec(1, 1))
+	require.True(t, burned.IsPositive())
+
+	require.True(t, delegated.Sub(burned.QuoRaw(2)).Equal(app.StakingKeeper.GetTotalLiquidStakedTokens(ctx)))
+}
@@ -4,6 +4,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v043 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v046"
+	v047 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v047"
+	v048 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v048"
+	v049 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v049"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -27,3 +30,18 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramstore)
 }
+
+// Migrate3to4 migrates x/staking state from consensus version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v047.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramstore)
+}
+
+// Migrate4to5 migrates x/staking state from consensus version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v048.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramstore)
+}
+
+// Migrate5to6 migrates x/staking state from consensus version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v049.MigrateStore(ctx, m.keeper.paramstore)
+}
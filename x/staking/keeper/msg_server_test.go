@@ -144,3 +144,60 @@ func TestCancelUnbondingDelegation(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatorFloors(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now().UTC()})
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	params := app.StakingKeeper.GetParams(ctx)
+	params.MinCommissionRate = sdk.NewDecWithPrec(5, 2)
+	params.MinSelfDelegation = sdk.NewInt(100)
+	app.StakingKeeper.SetParams(ctx, params)
+
+	addrs := simapp.AddTestAddrsIncremental(app, ctx, 1, sdk.NewInt(10000))
+	valAddr := sdk.ValAddress(addrs[0])
+	selfDelegation := sdk.NewCoin(bondDenom, sdk.NewInt(1000))
+	commission := types.NewCommissionRates(sdk.NewDecWithPrec(5, 2), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+
+	// create validator below the minimum commission rate
+	lowCommission := types.NewCommissionRates(sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	msg, err := types.NewMsgCreateValidator(valAddr, PKs[1], selfDelegation, types.Description{Moniker: "val"}, lowCommission, sdk.NewInt(100))
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrCommissionLTMinRate)
+
+	// create validator below the minimum self delegation
+	msg, err = types.NewMsgCreateValidator(valAddr, PKs[1], selfDelegation, types.Description{Moniker: "val"}, commission, sdk.NewInt(99))
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.ErrorIs(t, err, types.ErrMinSelfDelegationLTMinimum)
+
+	// create validator at the floors
+	msg, err = types.NewMsgCreateValidator(valAddr, PKs[1], selfDelegation, types.Description{Moniker: "val"}, commission, sdk.NewInt(100))
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	// edit the commission below the minimum commission rate
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(48 * time.Hour))
+	lowRate := sdk.NewDecWithPrec(4, 2)
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), types.NewMsgEditValidator(valAddr, types.Description{}, &lowRate, nil))
+	require.ErrorIs(t, err, types.ErrCommissionLTMinRate)
+
+	// raise the floor and edit the min self delegation below it
+	params.MinSelfDelegation = sdk.NewInt(500)
+	app.StakingKeeper.SetParams(ctx, params)
+	minSelfDelegation := sdk.NewInt(200)
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), types.NewMsgEditValidator(valAddr, types.Description{}, nil, &minSelfDelegation))
+	require.ErrorIs(t, err, types.ErrMinSelfDelegationLTMinimum)
+
+	minSelfDelegation = sdk.NewInt(500)
+	_, err = msgServer.EditValidator(sdk.WrapSDKContext(ctx), types.NewMsgEditValidator(valAddr, types.Description{}, nil, &minSelfDelegation))
+	require.NoError(t, err)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	require.True(t, validator.MinSelfDelegation.Equal(minSelfDelegation))
+}
@@ -54,6 +54,38 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// ValidatorBondFactor - Maximum ratio of the tokenized shares of a validator to its validator bond
+// shares, -1 disabling the check
+func (k Keeper) ValidatorBondFactor(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorBondFactor, &res)
+	return
+}
+
+// GlobalLiquidStakingCap - Maximum ratio of the tokenized stake to the total bonded stake
+func (k Keeper) GlobalLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGlobalLiquidStakingCap, &res)
+	return
+}
+
+// ValidatorLiquidStakingCap - Maximum ratio of the tokenized shares of a validator to its
+// delegator shares
+func (k Keeper) ValidatorLiquidStakingCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyValidatorLiquidStakingCap, &res)
+	return
+}
+
+// MinSelfDelegation - Chain-wide minimum self delegation a validator must declare
+func (k Keeper) MinSelfDelegation(ctx sdk.Context) (res math.Int) {
+	k.paramstore.Get(ctx, types.KeyMinSelfDelegation, &res)
+	return
+}
+
+// KeyRotationFee - Fee burnt for the rotation of the consensus public key of a validator
+func (k Keeper) KeyRotationFee(ctx sdk.Context) (res sdk.Coin) {
+	k.paramstore.Get(ctx, types.KeyKeyRotationFee, &res)
+	return
+}
+
 // Get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -63,6 +95,11 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.HistoricalEntries(ctx),
 		k.BondDenom(ctx),
 		k.MinCommissionRate(ctx),
+		k.ValidatorBondFactor(ctx),
+		k.GlobalLiquidStakingCap(ctx),
+		k.ValidatorLiquidStakingCap(ctx),
+		k.MinSelfDelegation(ctx),
+		k.KeyRotationFee(ctx),
 	)
 }
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -125,6 +126,13 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 		k.BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)
 	}
 
+	// The tokenized shares lose their part of the burned tokens, which is deducted from the total
+	// liquid staked tokens.
+	if validator.DelegatorShares.IsPositive() && validator.LiquidShares.IsPositive() {
+		liquidTokensToBurn := validator.LiquidShares.Quo(validator.DelegatorShares).MulInt(tokensToBurn).TruncateInt()
+		k.DecreaseTotalLiquidStakedTokens(ctx, liquidTokensToBurn)
+	}
+
 	// Deduct from validator's bonded tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator = k.RemoveValidatorTokens(ctx, validator, tokensToBurn)
@@ -159,6 +167,17 @@ func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	logger.Info("validator jailed", "validator", consAddr)
 }
 
+// CapPower caps the consensus power of a validator until the end time, without
+// changing its tokens. The validator stays bonded.
+func (k Keeper) CapPower(ctx sdk.Context, consAddr sdk.ConsAddress, power int64, endTime time.Time) {
+	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
+	validator.PowerCap = power
+	validator.PowerCapEndTime = endTime
+	k.SetValidator(ctx, validator)
+	logger := k.Logger(ctx)
+	logger.Info("validator power capped", "validator", consAddr, "power", power, "end_time", endTime)
+}
+
 // unjail a validator
 func (k Keeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
 	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetLastTokenizeShareRecordID returns the identifier of the last tokenize share record created.
+func (k Keeper) GetLastTokenizeShareRecordID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTokenizeShareRecordIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTokenizeShareRecordID sets the identifier of the last tokenize share record created.
+func (k Keeper) SetLastTokenizeShareRecordID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTokenizeShareRecordIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetTokenizeShareRecord returns a tokenize share record by its identifier.
+func (k Keeper) GetTokenizeShareRecord(ctx sdk.Context, id uint64) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordByIndexKey(id))
+	if bz == nil {
+		return record, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of a share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx sdk.Context, denom string) (record types.TokenizeShareRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTokenizeShareRecordIDByDenomKey(denom))
+	if bz == nil {
+		return record, false
+	}
+
+	var id gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &id)
+	return k.GetTokenizeShareRecord(ctx, id.Value)
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records of an owner.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx sdk.Context, owner sdk.AccAddress) (records []types.TokenizeShareRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTokenizeShareRecordIdsByOwnerPrefix(owner))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var id gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(iterator.Value(), &id)
+
+		record, found := k.GetTokenizeShareRecord(ctx, id.Value)
+		if !found {
+			panic("tokenize share record of the owner index not found")
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// GetAllTokenizeShareRecords returns all the tokenize share records.
+func (k Keeper) GetAllTokenizeShareRecords(ctx sdk.Context) (records []types.TokenizeShareRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenizeShareRecordPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.TokenizeShareRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return records
+}
+
+// AddTokenizeShareRecord stores a new tokenize share record with its indexes.
+func (k Keeper) AddTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) error {
+	if _, found := k.GetTokenizeShareRecord(ctx, record.Id); found {
+		return types.ErrTokenizeShareRecordAlreadyExists
+	}
+
+	k.setTokenizeShareRecord(ctx, record)
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+	k.setTokenizeShareRecordWithOwner(ctx, owner, record.Id)
+	k.setTokenizeShareRecordWithDenom(ctx, record.GetShareTokenDenom(), record.Id)
+
+	return nil
+}
+
+// DeleteTokenizeShareRecord deletes a tokenize share record and its indexes.
+func (k Keeper) DeleteTokenizeShareRecord(ctx sdk.Context, id uint64) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	owner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTokenizeShareRecordByIndexKey(id))
+	store.Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id))
+	store.Delete(types.GetTokenizeShareRecordIDByDenomKey(record.GetShareTokenDenom()))
+
+	return nil
+}
+
+// TransferTokenizeShareRecord sets the owner of a tokenize share record.
+func (k Keeper) TransferTokenizeShareRecord(ctx sdk.Context, id uint64, newOwner sdk.AccAddress) error {
+	record, found := k.GetTokenizeShareRecord(ctx, id)
+	if !found {
+		return types.ErrTokenizeShareRecordNotExists
+	}
+
+	oldOwner, err := sdk.AccAddressFromBech32(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetTokenizeShareRecordIDByOwnerAndIDKey(oldOwner, id))
+
+	record.Owner = newOwner.String()
+	k.setTokenizeShareRecord(ctx, record)
+	k.setTokenizeShareRecordWithOwner(ctx, newOwner, id)
+
+	return nil
+}
+
+func (k Keeper) setTokenizeShareRecord(ctx sdk.Context, record types.TokenizeShareRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordByIndexKey(record.Id), k.cdc.MustMarshal(&record))
+}
+
+func (k Keeper) setTokenizeShareRecordWithOwner(ctx sdk.Context, owner sdk.AccAddress, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByOwnerAndIDKey(owner, id), k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id}))
+}
+
+func (k Keeper) setTokenizeShareRecordWithDenom(ctx sdk.Context, denom string, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTokenizeShareRecordIDByDenomKey(denom), k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: id}))
+}
@@ -8,6 +8,8 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -32,6 +34,10 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 	// unbond all mature validators from the unbonding queue
 	k.UnbondAllMatureValidators(ctx)
 
+	// stop the old consensus addresses of the completed consensus public key rotations from
+	// resolving to their validators
+	k.PurgeMatureConsPubKeyRotations(ctx, ctx.BlockHeader().Time)
+
 	// Remove all mature unbonding delegations from the ubd queue.
 	matureUnbonds := k.DequeueAllMatureUBDQueue(ctx, ctx.BlockHeader().Time)
 	for _, dvPair := range matureUnbonds {
@@ -120,6 +126,13 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	// Retrieve the old consensus public keys of the validators which rotated
+	// their consensus public key in this block.
+	rotatedPubKeys, err := k.dequeueBlockConsPubKeyRotations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -166,12 +179,31 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 			return nil, err
 		}
 		oldPowerBytes, found := last[valAddrStr]
-		newPower := validator.ConsensusPower(powerReduction)
+		// the power of a validator is capped until its power cap ends, e.g. when soft jailed
+		newPower := validator.CappedConsensusPower(powerReduction, ctx.BlockTime())
 		newPowerBytes := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: newPower})
 
+		oldPubKey, rotated := rotatedPubKeys[valAddrStr]
+
+		switch {
+		// replace the old consensus public key by the new one if the validator
+		// rotated it while in the validator set
+		case found && rotated:
+			oldPubKeyUpdate, err := abciValidatorUpdateZero(oldPubKey)
+			if err != nil {
+				return nil, err
+			}
+			update := validator.ABCIValidatorUpdate(powerReduction)
+			update.Power = newPower
+			updates = append(updates, oldPubKeyUpdate, update)
+
+			k.SetLastValidatorPower(ctx, valAddr, newPower)
+
 		// update the validator set if power has changed
-		if !found || !bytes.Equal(oldPowerBytes, newPowerBytes) {
-			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
+		case !found || !bytes.Equal(oldPowerBytes, newPowerBytes):
+			update := validator.ABCIValidatorUpdate(powerReduction)
+			update.Power = newPower
+			updates = append(updates, update)
 
 			k.SetLastValidatorPower(ctx, valAddr, newPower)
 		}
@@ -195,7 +227,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		}
 		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
 		k.DeleteLastValidatorPower(ctx, validator.GetOperator())
-		updates = append(updates, validator.ABCIValidatorUpdateZero())
+
+		// the validator set only knows the old consensus public key of a validator
+		// which rotated it in this block
+		if oldPubKey, rotated := rotatedPubKeys[validator.OperatorAddress]; rotated {
+			oldPubKeyUpdate, err := abciValidatorUpdateZero(oldPubKey)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, oldPubKeyUpdate)
+		} else {
+			updates = append(updates, validator.ABCIValidatorUpdateZero())
+		}
 	}
 
 	// Update the pools based on the recent updates in the validator set:
@@ -395,3 +438,17 @@ func sortNoLongerBonded(last validatorsByAddr) ([][]byte, error) {
 
 	return noLongerBonded, nil
 }
+
+// abciValidatorUpdateZero returns the zero-power validator update removing a consensus public
+// key from the validator set.
+func abciValidatorUpdateZero(pk cryptotypes.PubKey) (abci.ValidatorUpdate, error) {
+	tmProtoPk, err := cryptocodec.ToTmProtoPublicKey(pk)
+	if err != nil {
+		return abci.ValidatorUpdate{}, err
+	}
+
+	return abci.ValidatorUpdate{
+		PubKey: tmProtoPk,
+		Power:  0,
+	}, nil
+}
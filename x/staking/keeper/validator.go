@@ -8,6 +8,7 @@ import (
 	gogotypes "github.com/gogo/protobuf/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -39,7 +40,17 @@ func (k Keeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress
 
 	opAddr := store.Get(types.GetValidatorByConsAddrKey(consAddr))
 	if opAddr == nil {
-		return validator, false
+		// the consensus address may be the old consensus address of a validator which rotated its
+		// consensus public key within the unbonding period
+		newConsAddr := k.ValidatorIdentifier(ctx, consAddr)
+		if newConsAddr.Equals(consAddr) {
+			return validator, false
+		}
+
+		opAddr = store.Get(types.GetValidatorByConsAddrKey(newConsAddr))
+		if opAddr == nil {
+			return validator, false
+		}
 	}
 
 	return k.GetValidator(ctx, opAddr)
@@ -144,7 +155,7 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	}
 
 	if newRate.LT(k.MinCommissionRate(ctx)) {
-		return commission, fmt.Errorf("cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
+		return commission, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
 	}
 
 	commission.Rate = newRate
@@ -792,6 +792,37 @@ func TestApplyAndReturnValidatorSetUpdatesMultipleValueChange(t *testing.T) {
 	require.Equal(t, validators[1].ABCIValidatorUpdate(app.StakingKeeper.PowerReduction(ctx)), updates[1])
 }
 
+func TestApplyAndReturnValidatorSetUpdatesPowerCap(t *testing.T) {
+	powers := []int64{10, 20}
+	app, ctx, _, _, validators := initValidators(t, 1000, 20, powers)
+
+	validators[0] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[0], false)
+	validators[1] = keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validators[1], false)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 2)
+
+	// the capped validator stays bonded with a reduced power until the cap ends
+	require.NoError(t, app.StakingKeeper.SetValidatorByConsAddr(ctx, validators[1]))
+	consAddr, err := validators[1].GetConsAddr()
+	require.NoError(t, err)
+	endTime := ctx.BlockTime().Add(time.Hour)
+	app.StakingKeeper.CapPower(ctx, consAddr, 5, endTime)
+
+	updates := applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+	require.Equal(t, int64(5), updates[0].Power)
+	require.Equal(t, int64(5), app.StakingKeeper.GetLastValidatorPower(ctx, validators[1].GetOperator()))
+	validator, found := app.StakingKeeper.GetValidator(ctx, validators[1].GetOperator())
+	require.True(t, found)
+	require.True(t, validator.IsBonded())
+	require.Equal(t, validators[1].Tokens, validator.Tokens)
+	applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 0)
+
+	// the power is restored once the cap ends
+	ctx = ctx.WithBlockTime(endTime)
+	updates = applyValidatorSetUpdates(t, ctx, app.StakingKeeper, 1)
+	require.Equal(t, validators[1].ABCIValidatorUpdate(app.StakingKeeper.PowerReduction(ctx)), updates[0])
+	require.Equal(t, int64(20), app.StakingKeeper.GetLastValidatorPower(ctx, validators[1].GetOperator()))
+}
+
 func TestApplyAndReturnValidatorSetUpdatesInserted(t *testing.T) {
 	powers := []int64{10, 20, 5, 15, 25}
 	app, ctx, _, _, validators := initValidators(t, 1000, 20, powers)
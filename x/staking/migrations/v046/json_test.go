@@ -36,19 +36,31 @@ func TestMigrateJSON(t *testing.T) {
 
 	// Make sure about new param MinCommissionRate.
 	expected := `{
+	"cons_pub_key_rotation_history": [],
 	"delegations": [],
 	"exported": false,
+	"last_tokenize_share_record_id": "0",
 	"last_total_power": "0",
 	"last_validator_powers": [],
 	"params": {
 		"bond_denom": "stake",
+		"global_liquid_staking_cap": "1.000000000000000000",
 		"historical_entries": 10000,
+		"key_rotation_fee": {
+			"amount": "1000000",
+			"denom": "stake"
+		},
 		"max_entries": 7,
 		"max_validators": 100,
 		"min_commission_rate": "0.000000000000000000",
-		"unbonding_time": "1814400s"
+		"min_self_delegation": "1",
+		"unbonding_time": "1814400s",
+		"validator_bond_factor": "-1.000000000000000000",
+		"validator_liquid_staking_cap": "1.000000000000000000"
 	},
 	"redelegations": [],
+	"tokenize_share_records": [],
+	"total_liquid_staked_tokens": "0",
 	"unbonding_delegations": [],
 	"validators": []
 }`
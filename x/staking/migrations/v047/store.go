@@ -0,0 +1,51 @@
+package v047
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations for the liquid staking.
+// The migration includes:
+//
+// - Setting the ValidatorBondFactor, GlobalLiquidStakingCap and
+// ValidatorLiquidStakingCap params in the paramstore
+// - Setting the ValidatorBondShares and LiquidShares of the validators to zero
+// - Setting the TotalLiquidStakedTokens to zero
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	migrateParamsStore(ctx, paramstore)
+	migrateValidators(ctx, storeKey, cdc)
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.TotalLiquidStakedTokensKey, cdc.MustMarshal(&sdk.IntProto{Int: sdk.ZeroInt()}))
+
+	return nil
+}
+
+func migrateParamsStore(ctx sdk.Context, paramstore paramtypes.Subspace) {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	paramstore.Set(ctx, types.KeyValidatorBondFactor, types.DefaultValidatorBondFactor)
+	paramstore.Set(ctx, types.KeyGlobalLiquidStakingCap, types.DefaultGlobalLiquidStakingCap)
+	paramstore.Set(ctx, types.KeyValidatorLiquidStakingCap, types.DefaultValidatorLiquidStakingCap)
+}
+
+func migrateValidators(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.ValidatorsKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+		validator.ValidatorBondShares = sdk.ZeroDec()
+		validator.LiquidShares = sdk.ZeroDec()
+		store.Set(iterator.Key(), types.MustMarshalValidator(cdc, &validator))
+	}
+}
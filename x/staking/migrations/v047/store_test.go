@@ -0,0 +1,53 @@
+package v047_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v047staking "github.com/cosmos/cosmos-sdk/x/staking/migrations/v047"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking")
+
+	// a validator stored without the liquid staking shares
+	_, pk, addr := testdata.KeyTestPubAddr()
+	validator, err := types.NewValidator(sdk.ValAddress(addr), pk, types.Description{})
+	require.NoError(t, err)
+	validator.ValidatorBondShares = sdk.Dec{}
+	validator.LiquidShares = sdk.Dec{}
+	store := ctx.KVStore(stakingKey)
+	store.Set(types.GetValidatorKey(validator.GetOperator()), types.MustMarshalValidator(encCfg.Codec, &validator))
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyValidatorBondFactor))
+	require.False(t, paramstore.Has(ctx, types.KeyGlobalLiquidStakingCap))
+	require.False(t, paramstore.Has(ctx, types.KeyValidatorLiquidStakingCap))
+
+	// Run migrations.
+	err = v047staking.MigrateStore(ctx, stakingKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new params are set.
+	require.True(t, paramstore.Has(ctx, types.KeyValidatorBondFactor))
+	require.True(t, paramstore.Has(ctx, types.KeyGlobalLiquidStakingCap))
+	require.True(t, paramstore.Has(ctx, types.KeyValidatorLiquidStakingCap))
+
+	// Make sure the validator shares are set.
+	validator = types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(validator.GetOperator())))
+	require.True(t, validator.ValidatorBondShares.IsZero())
+	require.True(t, validator.LiquidShares.IsZero())
+
+	require.NotNil(t, store.Get(types.TotalLiquidStakedTokensKey))
+}
@@ -0,0 +1,82 @@
+package v048
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations for the validator floors.
+// The migration includes:
+//
+// - Setting the MinSelfDelegation param in the paramstore, keeping the value
+// set by the upgrade handler if any
+// - Raising the commission rate (and max rate) of the validators below the
+// MinCommissionRate param up to it
+// - Raising the min self delegation of the validators below the
+// MinSelfDelegation param up to it
+// - Jailing the validators whose self delegation is below their new min self
+// delegation, as unbonding below it does
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	minSelfDelegation := types.DefaultMinSelfDelegation
+	paramstore.GetIfExists(ctx, types.KeyMinSelfDelegation, &minSelfDelegation)
+	paramstore.Set(ctx, types.KeyMinSelfDelegation, minSelfDelegation)
+
+	minCommissionRate := types.DefaultMinCommissionRate
+	paramstore.GetIfExists(ctx, types.KeyMinCommissionRate, &minCommissionRate)
+
+	migrateValidators(ctx, storeKey, cdc, minCommissionRate, minSelfDelegation)
+
+	return nil
+}
+
+func migrateValidators(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, minCommissionRate sdk.Dec, minSelfDelegation sdk.Int) {
+	kvStore := ctx.KVStore(storeKey)
+	store := prefix.NewStore(kvStore, types.ValidatorsKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator := types.MustUnmarshalValidator(cdc, iterator.Value())
+		if validator.Commission.Rate.GTE(minCommissionRate) && validator.MinSelfDelegation.GTE(minSelfDelegation) {
+			continue
+		}
+
+		if validator.Commission.Rate.LT(minCommissionRate) {
+			validator.Commission.Rate = minCommissionRate
+		}
+		if validator.Commission.MaxRate.LT(minCommissionRate) {
+			validator.Commission.MaxRate = minCommissionRate
+		}
+		if validator.MinSelfDelegation.LT(minSelfDelegation) {
+			validator.MinSelfDelegation = minSelfDelegation
+
+			if !validator.Jailed && selfDelegation(kvStore, cdc, validator).LT(minSelfDelegation) {
+				validator.Jailed = true
+				kvStore.Delete(types.GetValidatorsByPowerIndexKey(validator, sdk.DefaultPowerReduction))
+			}
+		}
+
+		store.Set(iterator.Key(), types.MustMarshalValidator(cdc, &validator))
+	}
+}
+
+// selfDelegation returns the tokens delegated by the operator of the validator.
+func selfDelegation(store sdk.KVStore, cdc codec.BinaryCodec, validator types.Validator) sdk.Int {
+	valAddr := validator.GetOperator()
+	bz := store.Get(types.GetDelegationKey(sdk.AccAddress(valAddr), valAddr))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	delegation := types.MustUnmarshalDelegation(cdc, bz)
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
@@ -0,0 +1,118 @@
+package v048_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v048staking "github.com/cosmos/cosmos-sdk/x/staking/migrations/v048"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking").
+		WithKeyTable(types.ParamKeyTable())
+
+	minCommissionRate := sdk.NewDecWithPrec(5, 2)
+	paramstore.Set(ctx, types.KeyMinCommissionRate, minCommissionRate)
+
+	store := ctx.KVStore(stakingKey)
+	newValidator := func(rate, maxRate sdk.Dec, minSelfDelegation sdk.Int) types.Validator {
+		_, pk, addr := testdata.KeyTestPubAddr()
+		validator, err := types.NewValidator(sdk.ValAddress(addr), pk, types.Description{})
+		require.NoError(t, err)
+		validator.Commission = types.NewCommission(rate, maxRate, sdk.ZeroDec())
+		validator.MinSelfDelegation = minSelfDelegation
+		store.Set(types.GetValidatorKey(validator.GetOperator()), types.MustMarshalValidator(encCfg.Codec, &validator))
+		return validator
+	}
+
+	// a validator below both floors
+	below := newValidator(sdk.ZeroDec(), sdk.NewDecWithPrec(1, 2), sdk.ZeroInt())
+	// a validator above both floors
+	above := newValidator(sdk.NewDecWithPrec(10, 2), sdk.NewDecWithPrec(20, 2), sdk.NewInt(100))
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyMinSelfDelegation))
+
+	// Run migrations.
+	err := v048staking.MigrateStore(ctx, stakingKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new param is set.
+	var minSelfDelegation sdk.Int
+	paramstore.Get(ctx, types.KeyMinSelfDelegation, &minSelfDelegation)
+	require.True(t, minSelfDelegation.Equal(types.DefaultMinSelfDelegation))
+
+	// Make sure the validator below the floors is raised.
+	validator := types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(below.GetOperator())))
+	require.True(t, validator.Commission.Rate.Equal(minCommissionRate))
+	require.True(t, validator.Commission.MaxRate.Equal(minCommissionRate))
+	require.True(t, validator.MinSelfDelegation.Equal(types.DefaultMinSelfDelegation))
+
+	// Make sure the validator above the floors is untouched.
+	validator = types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(above.GetOperator())))
+	require.True(t, validator.Commission.Rate.Equal(above.Commission.Rate))
+	require.True(t, validator.Commission.MaxRate.Equal(above.Commission.MaxRate))
+	require.True(t, validator.MinSelfDelegation.Equal(above.MinSelfDelegation))
+}
+
+func TestStoreMigrationMinSelfDelegation(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking").
+		WithKeyTable(types.ParamKeyTable())
+
+	// the upgrade handler sets a floor above the default one
+	floor := sdk.NewInt(1000)
+	paramstore.Set(ctx, types.KeyMinSelfDelegation, floor)
+
+	store := ctx.KVStore(stakingKey)
+	newValidator := func(selfDelegation sdk.Int) types.Validator {
+		_, pk, addr := testdata.KeyTestPubAddr()
+		validator, err := types.NewValidator(sdk.ValAddress(addr), pk, types.Description{})
+		require.NoError(t, err)
+		validator.Status = types.Bonded
+		validator, _ = validator.AddTokensFromDel(selfDelegation)
+		validator.MinSelfDelegation = sdk.OneInt()
+		delegation := types.NewDelegation(addr, validator.GetOperator(), validator.DelegatorShares)
+		store.Set(types.GetValidatorKey(validator.GetOperator()), types.MustMarshalValidator(encCfg.Codec, &validator))
+		store.Set(types.GetValidatorsByPowerIndexKey(validator, sdk.DefaultPowerReduction), validator.GetOperator())
+		store.Set(types.GetDelegationKey(addr, validator.GetOperator()), types.MustMarshalDelegation(encCfg.Codec, delegation))
+		return validator
+	}
+
+	below := newValidator(floor.SubRaw(1))
+	above := newValidator(floor)
+
+	err := v048staking.MigrateStore(ctx, stakingKey, encCfg.Codec, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the floor set by the upgrade handler is kept.
+	var minSelfDelegation sdk.Int
+	paramstore.Get(ctx, types.KeyMinSelfDelegation, &minSelfDelegation)
+	require.True(t, minSelfDelegation.Equal(floor))
+
+	// Make sure the validator self delegating less than the floor is jailed.
+	validator := types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(below.GetOperator())))
+	require.True(t, validator.MinSelfDelegation.Equal(floor))
+	require.True(t, validator.Jailed)
+	require.False(t, store.Has(types.GetValidatorsByPowerIndexKey(validator, sdk.DefaultPowerReduction)))
+
+	// Make sure the validator self delegating the floor is not jailed.
+	validator = types.MustUnmarshalValidator(encCfg.Codec, store.Get(types.GetValidatorKey(above.GetOperator())))
+	require.True(t, validator.MinSelfDelegation.Equal(floor))
+	require.False(t, validator.Jailed)
+	require.True(t, store.Has(types.GetValidatorsByPowerIndexKey(validator, sdk.DefaultPowerReduction)))
+}
@@ -0,0 +1,24 @@
+package v049
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations for the consensus public key
+// rotation. The migration includes:
+//
+// - Setting the KeyRotationFee param in the paramstore, in the bond denom of the chain
+func MigrateStore(ctx sdk.Context, paramstore paramtypes.Subspace) error {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	var bondDenom string
+	paramstore.Get(ctx, types.KeyBondDenom, &bondDenom)
+
+	paramstore.Set(ctx, types.KeyKeyRotationFee, sdk.NewCoin(bondDenom, types.DefaultKeyRotationFee.Amount))
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package v049_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v049staking "github.com/cosmos/cosmos-sdk/x/staking/migrations/v049"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking").
+		WithKeyTable(types.ParamKeyTable())
+	paramstore.Set(ctx, types.KeyBondDenom, "uatom")
+
+	// Check no params
+	require.False(t, paramstore.Has(ctx, types.KeyKeyRotationFee))
+
+	// Run migrations.
+	err := v049staking.MigrateStore(ctx, paramstore)
+	require.NoError(t, err)
+
+	// Make sure the new param is set in the bond denom.
+	var keyRotationFee sdk.Coin
+	paramstore.Get(ctx, types.KeyKeyRotationFee, &keyRotationFee)
+	require.Equal(t, sdk.NewCoin("uatom", types.DefaultKeyRotationFee.Amount), keyRotationFee)
+}
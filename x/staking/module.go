@@ -23,7 +23,7 @@ import (
 )
 
 const (
-	consensusVersion uint64 = 3
+	consensusVersion uint64 = 6
 )
 
 var (
@@ -140,6 +140,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	m := keeper.NewMigrator(am.keeper)
 	cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2)
 	cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3)
+	cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4)
+	cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5)
+	cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6)
 }
 
 // InitGenesis performs genesis initialization for the staking module. It returns
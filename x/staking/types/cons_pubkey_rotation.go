@@ -0,0 +1,80 @@
+package types
+
+import (
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxConsPubKeyRotations is the maximum number of consensus public key rotations of a validator
+// within an unbonding period.
+const MaxConsPubKeyRotations = 1
+
+var _ codectypes.UnpackInterfacesMessage = (*ConsPubKeyRotationHistory)(nil)
+
+// NewConsPubKeyRotationHistory creates a new consensus public key rotation record.
+//
+//nolint:interfacer
+func NewConsPubKeyRotationHistory(
+	valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey,
+	height int64, fee sdk.Coin, completionTime time.Time,
+) (ConsPubKeyRotationHistory, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	return ConsPubKeyRotationHistory{
+		OperatorAddress: valAddr.String(),
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          height,
+		Fee:             fee,
+		CompletionTime:  completionTime,
+	}, nil
+}
+
+// GetOperator returns the address of the validator rotating its consensus public key.
+func (h ConsPubKeyRotationHistory) GetOperator() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(h.OperatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// OldConsPubKey returns the consensus public key before the rotation.
+func (h ConsPubKeyRotationHistory) OldConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := h.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// NewConsPubKey returns the consensus public key after the rotation.
+func (h ConsPubKeyRotationHistory) NewConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := h.NewConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &pk); err != nil {
+		return err
+	}
+	return unpacker.UnpackAny(h.NewConsPubkey, &pk)
+}
@@ -50,4 +50,19 @@ var (
 	ErrNoHistoricalInfo                = sdkerrors.Register(ModuleName, 38, "no historical info found")
 	ErrEmptyValidatorPubKey            = sdkerrors.Register(ModuleName, 39, "empty validator public key")
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
+
+	ErrTokenizeShareRecordNotExists            = sdkerrors.Register(ModuleName, 41, "tokenize share record does not exist")
+	ErrTokenizeShareRecordAlreadyExists        = sdkerrors.Register(ModuleName, 42, "tokenize share record already exists")
+	ErrNotTokenizeShareRecordOwner             = sdkerrors.Register(ModuleName, 43, "not the owner of the tokenize share record")
+	ErrExceedingFreeVestingDelegations         = sdkerrors.Register(ModuleName, 44, "tokenization exceeds the free delegation of the vesting account")
+	ErrOnlyBondDenomAllowedForTokenize         = sdkerrors.Register(ModuleName, 45, "only bond denom is allowed for tokenize")
+	ErrInsufficientValidatorBondShares         = sdkerrors.Register(ModuleName, 46, "insufficient validator bond shares")
+	ErrGlobalLiquidStakingCapExceeded          = sdkerrors.Register(ModuleName, 47, "tokenization exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded       = sdkerrors.Register(ModuleName, 48, "tokenization exceeds the validator liquid staking cap")
+	ErrValidatorBondNotAllowedForTokenizeShare = sdkerrors.Register(ModuleName, 49, "validator bond delegations cannot be tokenized")
+	ErrRedelegationInProgress                  = sdkerrors.Register(ModuleName, 50, "delegations with a redelegation to the validator in progress cannot be tokenized")
+
+	ErrMinSelfDelegationLTMinimum = sdkerrors.Register(ModuleName, 51, "minimum self delegation cannot be less than the chain-wide minimum")
+
+	ErrExceedingMaxConsPubKeyRotations = sdkerrors.Register(ModuleName, 52, "exceeding the maximum number of consensus public key rotations within the unbonding period")
 )
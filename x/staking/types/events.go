@@ -2,14 +2,19 @@ package types
 
 // staking module event types
 const (
-	EventTypeCompleteUnbonding         = "complete_unbonding"
-	EventTypeCompleteRedelegation      = "complete_redelegation"
-	EventTypeCreateValidator           = "create_validator"
-	EventTypeEditValidator             = "edit_validator"
-	EventTypeDelegate                  = "delegate"
-	EventTypeUnbond                    = "unbond"
-	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
-	EventTypeRedelegate                = "redelegate"
+	EventTypeCompleteUnbonding           = "complete_unbonding"
+	EventTypeCompleteRedelegation        = "complete_redelegation"
+	EventTypeCreateValidator             = "create_validator"
+	EventTypeEditValidator               = "edit_validator"
+	EventTypeDelegate                    = "delegate"
+	EventTypeUnbond                      = "unbond"
+	EventTypeCancelUnbondingDelegation   = "cancel_unbonding_delegation"
+	EventTypeRedelegate                  = "redelegate"
+	EventTypeTokenizeShares              = "tokenize_shares"
+	EventTypeRedeemShares                = "redeem_shares"
+	EventTypeTransferTokenizeShareRecord = "transfer_tokenize_share_record"
+	EventTypeValidatorBond               = "validator_bond"
+	EventTypeRotateConsPubKey            = "rotate_cons_pubkey"
 
 	AttributeKeyValidator         = "validator"
 	AttributeKeyCommissionRate    = "commission_rate"
@@ -20,5 +25,10 @@ const (
 	AttributeKeyCreationHeight    = "creation_height"
 	AttributeKeyCompletionTime    = "completion_time"
 	AttributeKeyNewShares         = "new_shares"
+	AttributeKeyShareOwner        = "share_owner"
+	AttributeKeyShareRecordID     = "share_record_id"
+	AttributeKeyOldConsAddress    = "old_consensus_address"
+	AttributeKeyNewConsAddress    = "new_consensus_address"
+	AttributeKeyRotationFee       = "rotation_fee"
 	AttributeValueCategory        = ModuleName
 )
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState instanc e
@@ -19,7 +20,8 @@ func NewGenesisState(params Params, validators []Validator, delegations []Delega
 // DefaultGenesisState gets the raw genesis raw message for testing
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:                  DefaultParams(),
+		TotalLiquidStakedTokens: sdk.ZeroInt(),
 	}
 }
 
@@ -42,5 +44,10 @@ func (g GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
 			return err
 		}
 	}
+	for i := range g.ConsPubKeyRotationHistory {
+		if err := g.ConsPubKeyRotationHistory[i].UnpackInterfaces(c); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -44,6 +44,15 @@ type GenesisState struct {
 	// redelegations defines the redelegations active at genesis.
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	Exported      bool           `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// last_tokenize_share_record_id is the identifier of the last tokenize share record created.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,9,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
+	// tokenize_share_records defines the records of the tokenized delegations.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,10,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// total_liquid_staked_tokens is the amount of tokens tokenized, counted against the global
+	// liquid staking cap.
+	TotalLiquidStakedTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=total_liquid_staked_tokens,json=totalLiquidStakedTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_liquid_staked_tokens"`
+	// cons_pub_key_rotation_history defines the history of the consensus public key rotations.
+	ConsPubKeyRotationHistory []ConsPubKeyRotationHistory `protobuf:"bytes,12,rep,name=cons_pub_key_rotation_history,json=consPubKeyRotationHistory,proto3" json:"cons_pub_key_rotation_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +137,27 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsPubKeyRotationHistory() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.ConsPubKeyRotationHistory
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -179,38 +209,47 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6e, 0xd3, 0x40,
-	0x14, 0x86, 0x6d, 0x92, 0xa6, 0x61, 0x52, 0x10, 0x1a, 0x52, 0x64, 0xb2, 0x70, 0x42, 0x54, 0xa1,
-	0x08, 0xa8, 0xad, 0x86, 0x1d, 0x62, 0x43, 0x84, 0xa8, 0x40, 0x2c, 0x22, 0x17, 0x10, 0x62, 0x13,
-	0x4d, 0xea, 0xc1, 0xb5, 0xea, 0x78, 0xac, 0x79, 0x2f, 0xa5, 0xdc, 0x80, 0x25, 0x47, 0xe8, 0x21,
-	0x38, 0x44, 0x97, 0x15, 0x2b, 0xc4, 0xa2, 0x42, 0xc9, 0x86, 0x1b, 0xb0, 0x45, 0x9e, 0x99, 0x18,
-	0xa3, 0x60, 0x56, 0xc9, 0xe8, 0x7d, 0xff, 0x37, 0xbf, 0xe4, 0x37, 0x64, 0xe7, 0x50, 0xc0, 0x4c,
-	0x80, 0x0f, 0xc8, 0x8e, 0xe3, 0x34, 0xf2, 0x4f, 0xf6, 0xa6, 0x1c, 0xd9, 0x9e, 0x1f, 0xf1, 0x94,
-	0x43, 0x0c, 0x5e, 0x26, 0x05, 0x0a, 0x7a, 0x4b, 0x53, 0x9e, 0xa1, 0x3c, 0x43, 0x75, 0xda, 0x91,
-	0x88, 0x84, 0x42, 0xfc, 0xfc, 0x9f, 0xa6, 0x3b, 0x55, 0xce, 0x55, 0x5a, 0x53, 0xb7, 0x35, 0x35,
-	0xd1, 0x71, 0x73, 0x81, 0x3a, 0xf4, 0x7f, 0xd5, 0xc9, 0xd6, 0xbe, 0x2e, 0x70, 0x80, 0x0c, 0x39,
-	0x7d, 0x4c, 0x1a, 0x19, 0x93, 0x6c, 0x06, 0x8e, 0xdd, 0xb3, 0x07, 0xad, 0xa1, 0xeb, 0xfd, 0xbb,
-	0x90, 0x37, 0x56, 0xd4, 0xa8, 0x7e, 0x7e, 0xd9, 0xb5, 0x02, 0x93, 0xa1, 0x6f, 0xc9, 0x8d, 0x84,
-	0x01, 0x4e, 0x50, 0x20, 0x4b, 0x26, 0x99, 0xf8, 0xc0, 0xa5, 0x73, 0xa5, 0x67, 0x0f, 0xb6, 0x46,
-	0x5e, 0xce, 0x7d, 0xbf, 0xec, 0xde, 0x8d, 0x62, 0x3c, 0x9a, 0x4f, 0xbd, 0x43, 0x31, 0x33, 0x4d,
-	0xcc, 0xcf, 0x2e, 0x84, 0xc7, 0x3e, 0x7e, 0xcc, 0x38, 0x78, 0xcf, 0x53, 0x0c, 0xae, 0xe7, 0x9e,
-	0x57, 0xb9, 0x66, 0x9c, 0x5b, 0x68, 0x48, 0xb6, 0x95, 0xf9, 0x84, 0x25, 0x71, 0xc8, 0x50, 0x48,
-	0x6d, 0x07, 0xa7, 0xd6, 0xab, 0x0d, 0x5a, 0xc3, 0x7b, 0x55, 0x35, 0x5f, 0x32, 0xc0, 0x37, 0xab,
-	0x8c, 0x52, 0x99, 0xca, 0x37, 0x93, 0xb5, 0x09, 0xd0, 0x7d, 0x42, 0x8a, 0x0b, 0xc0, 0xa9, 0x2b,
-	0xf5, 0x9d, 0x2a, 0x75, 0x11, 0x36, 0xc6, 0x52, 0x94, 0xbe, 0x20, 0xad, 0x90, 0x27, 0x3c, 0x62,
-	0x18, 0x8b, 0x14, 0x9c, 0x0d, 0x65, 0xea, 0x57, 0x99, 0x9e, 0x16, 0xa8, 0x51, 0x95, 0xc3, 0xf4,
-	0x3d, 0xd9, 0x9e, 0xa7, 0x53, 0x91, 0x86, 0x71, 0x1a, 0x4d, 0xca, 0xd6, 0x86, 0xb2, 0xde, 0xaf,
-	0xb2, 0xbe, 0x5e, 0x85, 0xd6, 0xf4, 0xed, 0xf9, 0xfa, 0x08, 0xe8, 0x98, 0x5c, 0x93, 0xbc, 0xec,
-	0xdf, 0x54, 0xfe, 0x9d, 0x2a, 0x7f, 0x50, 0x82, 0x8d, 0xf8, 0x6f, 0x01, 0xed, 0x90, 0x26, 0x3f,
-	0xcd, 0x84, 0x44, 0x1e, 0x3a, 0xcd, 0x9e, 0x3d, 0x68, 0x06, 0xc5, 0xb9, 0x7f, 0x44, 0xe8, 0xfa,
-	0xb7, 0xa1, 0x43, 0xb2, 0xc9, 0xc2, 0x50, 0x72, 0xd0, 0xfb, 0x77, 0x75, 0xe4, 0x7c, 0xfd, 0xb2,
-	0xdb, 0x36, 0x05, 0x9e, 0xe8, 0xc9, 0x01, 0xca, 0x38, 0x8d, 0x82, 0x15, 0x48, 0xdb, 0x64, 0xe3,
-	0xcf, 0xa6, 0xd5, 0x02, 0x7d, 0x78, 0xd4, 0xfc, 0x74, 0xd6, 0xb5, 0x7e, 0x9e, 0x75, 0xad, 0xd1,
-	0xb3, 0xf3, 0x85, 0x6b, 0x5f, 0x2c, 0x5c, 0xfb, 0xc7, 0xc2, 0xb5, 0x3f, 0x2f, 0x5d, 0xeb, 0x62,
-	0xe9, 0x5a, 0xdf, 0x96, 0xae, 0xf5, 0xee, 0xc1, 0x7f, 0x97, 0xf1, 0xb4, 0x78, 0x56, 0x6a, 0x2d,
-	0xa7, 0x0d, 0xf5, 0x64, 0x1e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x73, 0xbd, 0x93, 0xb3, 0xc9,
-	0x03, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0xf6, 0xaf, 0x73, 0x07, 0x42, 0xa6, 0x1b, 0x59, 0xa5, 0xa5, 0x65, 0x9a, 0x50,
+	0x05, 0x2c, 0xd5, 0xc6, 0x0d, 0xed, 0x00, 0x05, 0x31, 0x06, 0x3b, 0x54, 0x29, 0x20, 0xc4, 0xc5,
+	0x72, 0x6b, 0x93, 0x5a, 0xcd, 0xe2, 0x60, 0x3b, 0x63, 0xe5, 0x13, 0x70, 0xe4, 0xc8, 0x71, 0x1f,
+	0x62, 0x1f, 0x62, 0xc7, 0x69, 0x27, 0xc4, 0x61, 0x42, 0xdb, 0x85, 0x8f, 0x81, 0x62, 0xbb, 0xa5,
+	0xd0, 0x65, 0x12, 0xa7, 0xd6, 0xf2, 0xf3, 0xfc, 0xde, 0xc7, 0xaf, 0xde, 0xbc, 0x60, 0xad, 0xcb,
+	0xe5, 0x1e, 0x97, 0x0d, 0xa9, 0x70, 0x9f, 0xc5, 0x61, 0x63, 0x7f, 0xa3, 0x43, 0x15, 0xde, 0x68,
+	0x84, 0x34, 0xa6, 0x92, 0x49, 0x3f, 0x11, 0x5c, 0x71, 0xb8, 0x64, 0x54, 0xbe, 0x55, 0xf9, 0x56,
+	0x55, 0x29, 0x87, 0x3c, 0xe4, 0x5a, 0xd2, 0xc8, 0xfe, 0x19, 0x75, 0x25, 0x8f, 0x39, 0x74, 0x1b,
+	0xd5, 0xb2, 0x51, 0x21, 0x63, 0xb7, 0x05, 0xf4, 0x61, 0xf5, 0x5b, 0x11, 0x2c, 0x6c, 0x9b, 0x00,
+	0x6d, 0x85, 0x15, 0x85, 0x5b, 0x60, 0x36, 0xc1, 0x02, 0xef, 0x49, 0xd7, 0xa9, 0x39, 0xf5, 0xd2,
+	0xa6, 0xe7, 0x5f, 0x1e, 0xc8, 0x6f, 0x69, 0x55, 0x73, 0xfa, 0xf8, 0xac, 0x5a, 0x08, 0xac, 0x07,
+	0xbe, 0x03, 0x37, 0x23, 0x2c, 0x15, 0x52, 0x5c, 0xe1, 0x08, 0x25, 0xfc, 0x13, 0x15, 0xee, 0xb5,
+	0x9a, 0x53, 0x5f, 0x68, 0xfa, 0x99, 0xee, 0xc7, 0x59, 0xf5, 0x6e, 0xc8, 0x54, 0x2f, 0xed, 0xf8,
+	0x5d, 0xbe, 0x67, 0x93, 0xd8, 0x9f, 0x75, 0x49, 0xfa, 0x0d, 0x35, 0x48, 0xa8, 0xf4, 0x77, 0x62,
+	0x15, 0xdc, 0xc8, 0x38, 0xaf, 0x33, 0x4c, 0x2b, 0xa3, 0x40, 0x02, 0x16, 0x35, 0x79, 0x1f, 0x47,
+	0x8c, 0x60, 0xc5, 0x85, 0xa1, 0x4b, 0x77, 0xaa, 0x36, 0x55, 0x2f, 0x6d, 0xde, 0xcb, 0x8b, 0xb9,
+	0x8b, 0xa5, 0x7a, 0x3b, 0xf4, 0x68, 0x94, 0x8d, 0x7c, 0x2b, 0x9a, 0xb8, 0x91, 0x70, 0x1b, 0x80,
+	0x51, 0x01, 0xe9, 0x4e, 0x6b, 0xf4, 0x9d, 0x3c, 0xf4, 0xc8, 0x6c, 0x89, 0x63, 0x56, 0xf8, 0x12,
+	0x94, 0x08, 0x8d, 0x68, 0x88, 0x15, 0xe3, 0xb1, 0x74, 0x67, 0x34, 0x69, 0x35, 0x8f, 0xf4, 0x6c,
+	0x24, 0xb5, 0xa8, 0x71, 0x33, 0xfc, 0x00, 0x16, 0xd3, 0xb8, 0xc3, 0x63, 0xc2, 0xe2, 0x10, 0x8d,
+	0x53, 0x67, 0x35, 0xf5, 0x7e, 0x1e, 0xf5, 0xcd, 0xd0, 0x34, 0x81, 0x2f, 0xa7, 0x93, 0x57, 0x12,
+	0xb6, 0xc0, 0x75, 0x41, 0xc7, 0xf9, 0x73, 0x9a, 0xbf, 0x96, 0xc7, 0x0f, 0x28, 0xf9, 0x17, 0xfc,
+	0x37, 0x00, 0x56, 0x40, 0x91, 0x1e, 0x24, 0x5c, 0x28, 0x4a, 0xdc, 0x62, 0xcd, 0xa9, 0x17, 0x83,
+	0xd1, 0x19, 0x3e, 0x06, 0x2b, 0x76, 0x54, 0xfa, 0x34, 0x66, 0x9f, 0x29, 0x92, 0x3d, 0x2c, 0x28,
+	0x12, 0xb4, 0xcb, 0x05, 0x41, 0x8c, 0xb8, 0xf3, 0x35, 0xa7, 0x3e, 0x1d, 0x2c, 0x9b, 0x39, 0x30,
+	0x9a, 0x76, 0x26, 0x09, 0xb4, 0x62, 0x87, 0xc0, 0x10, 0x2c, 0x5d, 0x6a, 0x96, 0x2e, 0xb8, 0xba,
+	0x31, 0x97, 0xe0, 0x86, 0x8d, 0x51, 0x93, 0x57, 0x12, 0x0e, 0x40, 0xc5, 0x0c, 0x74, 0xc4, 0x3e,
+	0xa6, 0x8c, 0xa0, 0x8c, 0x47, 0x89, 0x49, 0x2e, 0xdd, 0x52, 0xcd, 0xa9, 0xcf, 0x37, 0xb7, 0xfe,
+	0x6f, 0xbe, 0x4f, 0x8f, 0xd6, 0x81, 0x4d, 0x97, 0x4d, 0xfb, 0x6d, 0xcd, 0xdf, 0xd5, 0xf8, 0xb6,
+	0xa6, 0xeb, 0x8c, 0x59, 0xe9, 0x95, 0x2e, 0x8f, 0x25, 0x4a, 0xd2, 0x0e, 0xea, 0xd3, 0x01, 0x12,
+	0x5c, 0xe9, 0xde, 0xa2, 0x1e, 0x93, 0x8a, 0x8b, 0x81, 0xbb, 0xa0, 0x9f, 0xba, 0x91, 0xf7, 0xd4,
+	0xa7, 0x3c, 0x96, 0xad, 0xb4, 0xf3, 0x8a, 0x0e, 0x02, 0xeb, 0x7c, 0x61, 0x8c, 0xf6, 0xc1, 0xcb,
+	0xdd, 0x3c, 0xc1, 0x6a, 0x0f, 0xc0, 0xc9, 0x8f, 0x07, 0x6e, 0x82, 0x39, 0x4c, 0x88, 0xa0, 0xd2,
+	0x2c, 0x88, 0xf9, 0xa6, 0x7b, 0x7a, 0xb4, 0x5e, 0xb6, 0xd5, 0x9f, 0x98, 0x9b, 0xb6, 0x12, 0x2c,
+	0x0e, 0x83, 0xa1, 0x10, 0x96, 0xc1, 0xcc, 0x9f, 0x55, 0x30, 0x15, 0x98, 0xc3, 0xa3, 0xe2, 0x97,
+	0xc3, 0x6a, 0xe1, 0xd7, 0x61, 0xb5, 0xd0, 0x7c, 0x7e, 0x7c, 0xee, 0x39, 0x27, 0xe7, 0x9e, 0xf3,
+	0xf3, 0xdc, 0x73, 0xbe, 0x5e, 0x78, 0x85, 0x93, 0x0b, 0xaf, 0xf0, 0xfd, 0xc2, 0x2b, 0xbc, 0x7f,
+	0x70, 0x65, 0x37, 0x0f, 0x46, 0x7b, 0x4f, 0xf7, 0xb5, 0x33, 0xab, 0x77, 0xda, 0xc3, 0xdf, 0x03,
+	0x00, 0x91, 0xc8, 0xab, 0x6b, 0x6a, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +272,49 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsPubKeyRotationHistory) > 0 {
+		for iNdEx := len(m.ConsPubKeyRotationHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsPubKeyRotationHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size := m.TotalLiquidStakedTokens.Size()
+		i -= size
+		if _, err := m.TotalLiquidStakedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -425,6 +507,23 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TotalLiquidStakedTokens.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConsPubKeyRotationHistory) > 0 {
+		for _, e := range m.ConsPubKeyRotationHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -735,6 +834,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLiquidStakedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLiquidStakedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsPubKeyRotationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsPubKeyRotationHistory = append(m.ConsPubKeyRotationHistory, ConsPubKeyRotationHistory{})
+			if err := m.ConsPubKeyRotationHistory[len(m.ConsPubKeyRotationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
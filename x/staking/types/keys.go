@@ -49,6 +49,17 @@ var (
 	ValidatorQueueKey    = []byte{0x43} // prefix for the timestamps in validator queue
 
 	HistoricalInfoKey = []byte{0x50} // prefix for the historical info
+
+	TokenizeShareRecordPrefix          = []byte{0x81} // key for tokenize share record prefix
+	TokenizeShareRecordIDByOwnerPrefix = []byte{0x82} // key for tokenize share record id by owner prefix
+	TokenizeShareRecordIDByDenomPrefix = []byte{0x83} // key for tokenize share record id by denom prefix
+	LastTokenizeShareRecordIDKey       = []byte{0x84} // key for last tokenize share record id
+	TotalLiquidStakedTokensKey         = []byte{0x85} // key for total liquid staked tokens
+
+	ConsPubKeyRotationHistoryKey = []byte{0x91} // prefix for the history of the consensus public key rotations, by validator
+	BlockConsPubKeyRotationKey   = []byte{0x92} // prefix for the index of the consensus public key rotations, by height
+	OldToNewConsAddrKey          = []byte{0x93} // prefix for the index of the new consensus address of a rotated consensus address
+	ConsPubKeyRotationQueueKey   = []byte{0x94} // prefix for the timestamps in the consensus public key rotation queue
 )
 
 // GetValidatorKey creates the key for the validator with address
@@ -348,3 +359,81 @@ func GetREDsByDelToValDstIndexKey(delAddr sdk.AccAddress, valDstAddr sdk.ValAddr
 func GetHistoricalInfoKey(height int64) []byte {
 	return append(HistoricalInfoKey, []byte(strconv.FormatInt(height, 10))...)
 }
+
+// GetTokenizeShareRecordByIndexKey returns the key of a tokenize share record.
+// VALUE: staking/TokenizeShareRecord
+func GetTokenizeShareRecordByIndexKey(id uint64) []byte {
+	return append(TokenizeShareRecordPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIdsByOwnerPrefix returns the prefix of the index of the tokenize share
+// records of an owner.
+func GetTokenizeShareRecordIdsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(TokenizeShareRecordIDByOwnerPrefix, address.MustLengthPrefix(owner)...)
+}
+
+// GetTokenizeShareRecordIDByOwnerAndIDKey returns the index key of a tokenize share record of an owner.
+// VALUE: tokenize share record id
+func GetTokenizeShareRecordIDByOwnerAndIDKey(owner sdk.AccAddress, id uint64) []byte {
+	return append(GetTokenizeShareRecordIdsByOwnerPrefix(owner), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetTokenizeShareRecordIDByDenomKey returns the index key of the tokenize share record of a share
+// token denom.
+// VALUE: tokenize share record id
+func GetTokenizeShareRecordIDByDenomKey(denom string) []byte {
+	return append(TokenizeShareRecordIDByDenomPrefix, []byte(denom)...)
+}
+
+// GetValidatorConsPubKeyRotationHistoryPrefix returns the prefix of the consensus public key
+// rotation history of a validator.
+func GetValidatorConsPubKeyRotationHistoryPrefix(valAddr sdk.ValAddress) []byte {
+	return append(ConsPubKeyRotationHistoryKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetConsPubKeyRotationHistoryKey returns the key of the consensus public key rotation of a
+// validator at a height.
+// VALUE: staking/ConsPubKeyRotationHistory
+func GetConsPubKeyRotationHistoryKey(valAddr sdk.ValAddress, height int64) []byte {
+	return append(GetValidatorConsPubKeyRotationHistoryPrefix(valAddr), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetBlockConsPubKeyRotationPrefix returns the prefix of the index of the consensus public key
+// rotations at a height.
+func GetBlockConsPubKeyRotationPrefix(height int64) []byte {
+	return append(BlockConsPubKeyRotationKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetBlockConsPubKeyRotationKey returns the index key of the consensus public key rotation of a
+// validator at a height.
+// VALUE: none (key rearrangement used)
+func GetBlockConsPubKeyRotationKey(height int64, valAddr sdk.ValAddress) []byte {
+	return append(GetBlockConsPubKeyRotationPrefix(height), address.MustLengthPrefix(valAddr)...)
+}
+
+// ParseBlockConsPubKeyRotationKey returns the validator operator address of an index key of the
+// consensus public key rotations at a height.
+func ParseBlockConsPubKeyRotationKey(key []byte) sdk.ValAddress {
+	kv.AssertKeyAtLeastLength(key, 11)
+	return key[10:] // remove prefix bytes, height and address length
+}
+
+// GetOldToNewConsAddrKey returns the key of the new consensus address of a rotated consensus
+// address.
+// VALUE: new consensus address ([]byte)
+func GetOldToNewConsAddrKey(oldConsAddr sdk.ConsAddress) []byte {
+	return append(OldToNewConsAddrKey, address.MustLengthPrefix(oldConsAddr)...)
+}
+
+// GetConsPubKeyRotationTimeKey returns the prefix of the consensus public key rotations
+// completing at a timestamp.
+func GetConsPubKeyRotationTimeKey(timestamp time.Time) []byte {
+	return append(ConsPubKeyRotationQueueKey, address.MustLengthPrefix(sdk.FormatTimeBytes(timestamp))...)
+}
+
+// GetConsPubKeyRotationQueueKey returns the key of a rotated consensus address in the consensus
+// public key rotation queue.
+// VALUE: old consensus address ([]byte)
+func GetConsPubKeyRotationQueueKey(timestamp time.Time, oldConsAddr sdk.ConsAddress) []byte {
+	return append(GetConsPubKeyRotationTimeKey(timestamp), address.MustLengthPrefix(oldConsAddr)...)
+}
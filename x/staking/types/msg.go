@@ -10,12 +10,17 @@ import (
 
 // staking message types
 const (
-	TypeMsgUndelegate                = "begin_unbonding"
-	TypeMsgCancelUnbondingDelegation = "cancel_unbond"
-	TypeMsgEditValidator             = "edit_validator"
-	TypeMsgCreateValidator           = "create_validator"
-	TypeMsgDelegate                  = "delegate"
-	TypeMsgBeginRedelegate           = "begin_redelegate"
+	TypeMsgUndelegate                  = "begin_unbonding"
+	TypeMsgCancelUnbondingDelegation   = "cancel_unbond"
+	TypeMsgEditValidator               = "edit_validator"
+	TypeMsgCreateValidator             = "create_validator"
+	TypeMsgDelegate                    = "delegate"
+	TypeMsgBeginRedelegate             = "begin_redelegate"
+	TypeMsgTokenizeShares              = "tokenize_shares"
+	TypeMsgRedeemTokensForShares       = "redeem_tokens_for_shares"
+	TypeMsgTransferTokenizeShareRecord = "transfer_tokenize_share_record"
+	TypeMsgValidatorBond               = "validator_bond"
+	TypeMsgRotateConsPubKey            = "rotate_cons_pubkey"
 )
 
 var (
@@ -27,6 +32,12 @@ var (
 	_ sdk.Msg                            = &MsgUndelegate{}
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
+	_ sdk.Msg                            = &MsgTokenizeShares{}
+	_ sdk.Msg                            = &MsgRedeemTokensForShares{}
+	_ sdk.Msg                            = &MsgTransferTokenizeShareRecord{}
+	_ sdk.Msg                            = &MsgValidatorBond{}
+	_ sdk.Msg                            = &MsgRotateConsPubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotateConsPubKey)(nil)
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -398,3 +409,222 @@ func (msg MsgCancelUnbondingDelegation) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgTokenizeShares creates a new MsgTokenizeShares instance.
+//
+//nolint:interfacer
+func NewMsgTokenizeShares(delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount sdk.Coin, owner sdk.AccAddress) *MsgTokenizeShares {
+	return &MsgTokenizeShares{
+		DelegatorAddress:    delAddr.String(),
+		ValidatorAddress:    valAddr.String(),
+		Amount:              amount,
+		TokenizedShareOwner: owner.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) Type() string { return TypeMsgTokenizeShares }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTokenizeShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.TokenizedShareOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid tokenized share owner address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid shares amount")
+	}
+
+	return nil
+}
+
+// NewMsgRedeemTokensForShares creates a new MsgRedeemTokensForShares instance.
+//
+//nolint:interfacer
+func NewMsgRedeemTokensForShares(delAddr sdk.AccAddress, amount sdk.Coin) *MsgRedeemTokensForShares {
+	return &MsgRedeemTokensForShares{
+		DelegatorAddress: delAddr.String(),
+		Amount:           amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) Type() string { return TypeMsgRedeemTokensForShares }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRedeemTokensForShares) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid shares amount")
+	}
+
+	return nil
+}
+
+// NewMsgTransferTokenizeShareRecord creates a new MsgTransferTokenizeShareRecord instance.
+//
+//nolint:interfacer
+func NewMsgTransferTokenizeShareRecord(recordID uint64, sender, newOwner sdk.AccAddress) *MsgTransferTokenizeShareRecord {
+	return &MsgTransferTokenizeShareRecord{
+		TokenizeShareRecordId: recordID,
+		Sender:                sender.String(),
+		NewOwner:              newOwner.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) Type() string { return TypeMsgTransferTokenizeShareRecord }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgTransferTokenizeShareRecord) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgValidatorBond creates a new MsgValidatorBond instance.
+//
+//nolint:interfacer
+func NewMsgValidatorBond(delAddr sdk.AccAddress, valAddr sdk.ValAddress) *MsgValidatorBond {
+	return &MsgValidatorBond{
+		DelegatorAddress: delAddr.String(),
+		ValidatorAddress: valAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgValidatorBond) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgValidatorBond) Type() string { return TypeMsgValidatorBond }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgValidatorBond) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	return []sdk.AccAddress{delegator}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgValidatorBond) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgValidatorBond) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DelegatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	return nil
+}
+
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
+//
+//nolint:interfacer
+func NewMsgRotateConsPubKey(valAddr sdk.ValAddress, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotateConsPubKey{
+		ValidatorAddress: valAddr.String(),
+		NewPubkey:        pkAny,
+	}, nil
+}
+
+// Route implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Type() string { return TypeMsgRotateConsPubKey }
+
+// GetSigners implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSigners() []sdk.AccAddress {
+	valAddr, _ := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(msg.ValidatorAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+	if msg.NewPubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubkey, &pubKey)
+}
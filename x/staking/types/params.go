@@ -33,8 +33,25 @@ const (
 	DefaultHistoricalEntries uint32 = 10000
 )
 
-// DefaultMinCommissionRate is set to 0%
-var DefaultMinCommissionRate = sdk.ZeroDec()
+var (
+	// DefaultMinCommissionRate is set to 0%
+	DefaultMinCommissionRate = sdk.ZeroDec()
+
+	// DefaultValidatorBondFactor is set to -1, disabling the validator bond check
+	DefaultValidatorBondFactor = sdk.NewDec(-1)
+
+	// DefaultGlobalLiquidStakingCap is set to 100%
+	DefaultGlobalLiquidStakingCap = sdk.OneDec()
+
+	// DefaultValidatorLiquidStakingCap is set to 100%
+	DefaultValidatorLiquidStakingCap = sdk.OneDec()
+
+	// DefaultMinSelfDelegation is set to 1, the lowest self delegation a validator can declare
+	DefaultMinSelfDelegation = sdk.OneInt()
+
+	// DefaultKeyRotationFee is set to 1000000 of the default bond denom
+	DefaultKeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000000)
+)
 
 var (
 	KeyUnbondingTime     = []byte("UnbondingTime")
@@ -43,6 +60,12 @@ var (
 	KeyBondDenom         = []byte("BondDenom")
 	KeyHistoricalEntries = []byte("HistoricalEntries")
 	KeyMinCommissionRate = []byte("MinCommissionRate")
+
+	KeyValidatorBondFactor       = []byte("ValidatorBondFactor")
+	KeyGlobalLiquidStakingCap    = []byte("GlobalLiquidStakingCap")
+	KeyValidatorLiquidStakingCap = []byte("ValidatorLiquidStakingCap")
+	KeyMinSelfDelegation         = []byte("MinSelfDelegation")
+	KeyKeyRotationFee            = []byte("KeyRotationFee")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -53,14 +76,23 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec) Params {
+func NewParams(
+	unbondingTime time.Duration, maxValidators, maxEntries, historicalEntries uint32, bondDenom string, minCommissionRate sdk.Dec,
+	validatorBondFactor, globalLiquidStakingCap, validatorLiquidStakingCap sdk.Dec, minSelfDelegation math.Int,
+	keyRotationFee sdk.Coin,
+) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenom:         bondDenom,
-		MinCommissionRate: minCommissionRate,
+		UnbondingTime:             unbondingTime,
+		MaxValidators:             maxValidators,
+		MaxEntries:                maxEntries,
+		HistoricalEntries:         historicalEntries,
+		BondDenom:                 bondDenom,
+		MinCommissionRate:         minCommissionRate,
+		ValidatorBondFactor:       validatorBondFactor,
+		GlobalLiquidStakingCap:    globalLiquidStakingCap,
+		ValidatorLiquidStakingCap: validatorLiquidStakingCap,
+		MinSelfDelegation:         minSelfDelegation,
+		KeyRotationFee:            keyRotationFee,
 	}
 }
 
@@ -73,6 +105,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyHistoricalEntries, &p.HistoricalEntries, validateHistoricalEntries),
 		paramtypes.NewParamSetPair(KeyBondDenom, &p.BondDenom, validateBondDenom),
 		paramtypes.NewParamSetPair(KeyMinCommissionRate, &p.MinCommissionRate, validateMinCommissionRate),
+		paramtypes.NewParamSetPair(KeyValidatorBondFactor, &p.ValidatorBondFactor, validateValidatorBondFactor),
+		paramtypes.NewParamSetPair(KeyGlobalLiquidStakingCap, &p.GlobalLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyValidatorLiquidStakingCap, &p.ValidatorLiquidStakingCap, validateLiquidStakingCap),
+		paramtypes.NewParamSetPair(KeyMinSelfDelegation, &p.MinSelfDelegation, validateMinSelfDelegation),
+		paramtypes.NewParamSetPair(KeyKeyRotationFee, &p.KeyRotationFee, validateKeyRotationFee),
 	}
 }
 
@@ -85,6 +122,11 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		sdk.DefaultBondDenom,
 		DefaultMinCommissionRate,
+		DefaultValidatorBondFactor,
+		DefaultGlobalLiquidStakingCap,
+		DefaultValidatorLiquidStakingCap,
+		DefaultMinSelfDelegation,
+		DefaultKeyRotationFee,
 	)
 }
 
@@ -136,6 +178,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateValidatorBondFactor(p.ValidatorBondFactor); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.GlobalLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateLiquidStakingCap(p.ValidatorLiquidStakingCap); err != nil {
+		return err
+	}
+
+	if err := validateMinSelfDelegation(p.MinSelfDelegation); err != nil {
+		return err
+	}
+
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -232,3 +294,67 @@ func validateMinCommissionRate(i interface{}) error {
 
 	return nil
 }
+
+func validateValidatorBondFactor(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("validator bond factor cannot be nil")
+	}
+	if v.IsNegative() && !v.Equal(sdk.NewDec(-1)) {
+		return fmt.Errorf("invalid validator bond factor: %s, must be positive or -1", v)
+	}
+
+	return nil
+}
+
+func validateLiquidStakingCap(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("liquid staking cap cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("liquid staking cap cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("liquid staking cap cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinSelfDelegation(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("minimum self delegation cannot be nil")
+	}
+	if !v.IsPositive() {
+		return fmt.Errorf("minimum self delegation must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
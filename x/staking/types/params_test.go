@@ -36,3 +36,51 @@ func Test_validateParams(t *testing.T) {
 	params.MinCommissionRate = sdk.NewDec(2)
 	require.Error(t, params.Validate())
 }
+
+func TestValidateLiquidStakingParams(t *testing.T) {
+	params := types.DefaultParams()
+
+	params.ValidatorBondFactor = sdk.NewDec(250)
+	require.NoError(t, params.Validate())
+
+	params.ValidatorBondFactor = sdk.NewDec(-2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = sdk.ZeroDec()
+	require.NoError(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = sdk.NewDecWithPrec(101, 2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.ValidatorLiquidStakingCap = sdk.NewDec(-1)
+	require.Error(t, params.Validate())
+}
+
+func TestValidateMinSelfDelegation(t *testing.T) {
+	params := types.DefaultParams()
+
+	params.MinSelfDelegation = sdk.NewInt(1000)
+	require.NoError(t, params.Validate())
+
+	params.MinSelfDelegation = sdk.ZeroInt()
+	require.Error(t, params.Validate())
+
+	params.MinSelfDelegation = sdk.Int{}
+	require.Error(t, params.Validate())
+}
+
+func TestValidateKeyRotationFee(t *testing.T) {
+	params := types.DefaultParams()
+
+	params.KeyRotationFee = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+	require.NoError(t, params.Validate())
+
+	params.KeyRotationFee = sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(-1)}
+	require.Error(t, params.Validate())
+
+	params.KeyRotationFee = sdk.Coin{Denom: "", Amount: sdk.NewInt(1)}
+	require.Error(t, params.Validate())
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// TokenizeShareModuleAccountPrefix is the prefix of the names of the module accounts holding the
+// tokenized delegations.
+const TokenizeShareModuleAccountPrefix = "tokenizeshare_"
+
+// NewTokenizeShareRecord creates a new tokenize share record, held by the module account of its id.
+func NewTokenizeShareRecord(id uint64, owner sdk.AccAddress, validator sdk.ValAddress) TokenizeShareRecord {
+	return TokenizeShareRecord{
+		Id:            id,
+		Owner:         owner.String(),
+		ModuleAccount: fmt.Sprintf("%s%d", TokenizeShareModuleAccountPrefix, id),
+		Validator:     validator.String(),
+	}
+}
+
+// GetModuleAddress returns the address of the module account holding the tokenized delegation.
+func (r TokenizeShareRecord) GetModuleAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(r.ModuleAccount)
+}
+
+// GetShareTokenDenom returns the denom of the share tokens of the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
@@ -48,17 +48,19 @@ func NewValidator(operator sdk.ValAddress, pubKey cryptotypes.PubKey, descriptio
 	}
 
 	return Validator{
-		OperatorAddress:   operator.String(),
-		ConsensusPubkey:   pkAny,
-		Jailed:            false,
-		Status:            Unbonded,
-		Tokens:            sdk.ZeroInt(),
-		DelegatorShares:   sdk.ZeroDec(),
-		Description:       description,
-		UnbondingHeight:   int64(0),
-		UnbondingTime:     time.Unix(0, 0).UTC(),
-		Commission:        NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
-		MinSelfDelegation: sdk.OneInt(),
+		OperatorAddress:     operator.String(),
+		ConsensusPubkey:     pkAny,
+		Jailed:              false,
+		Status:              Unbonded,
+		Tokens:              sdk.ZeroInt(),
+		DelegatorShares:     sdk.ZeroDec(),
+		Description:         description,
+		UnbondingHeight:     int64(0),
+		UnbondingTime:       time.Unix(0, 0).UTC(),
+		Commission:          NewCommission(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		MinSelfDelegation:   sdk.OneInt(),
+		ValidatorBondShares: sdk.ZeroDec(),
+		LiquidShares:        sdk.ZeroDec(),
 	}, nil
 }
 
@@ -371,6 +373,17 @@ func (v Validator) PotentialConsensusPower(r math.Int) int64 {
 	return sdk.TokensToConsensusPower(v.Tokens, r)
 }
 
+// CappedConsensusPower returns the consensus-engine power at the given time,
+// capped by the power cap of the validator until it ends.
+func (v Validator) CappedConsensusPower(r math.Int, t time.Time) int64 {
+	power := v.ConsensusPower(r)
+	if v.PowerCap > 0 && t.Before(v.PowerCapEndTime) && power > v.PowerCap {
+		return v.PowerCap
+	}
+
+	return power
+}
+
 // UpdateStatus updates the location of the shares within a validator
 // to reflect the new status
 func (v Validator) UpdateStatus(newStatus BondStatus) Validator {